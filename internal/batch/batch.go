@@ -0,0 +1,163 @@
+// Package batch scores every PR-FAQ document under a directory.
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+// ErrNoDocuments is returned when a directory contains no PR-FAQ documents.
+var ErrNoDocuments = errors.New("no PR-FAQ documents found")
+
+// Extensions lists the file extensions treated as PR-FAQ documents.
+var Extensions = []string{".md", ".markdown", ".txt"}
+
+// Find returns the documents under root in lexical order. Hidden files and
+// directories, such as .git, are skipped.
+func Find(root string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != root && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.IsDir() && slices.Contains(Extensions, strings.ToLower(filepath.Ext(path))) {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", parser.ErrRead, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%w in %s", ErrNoDocuments, root)
+	}
+	return paths, nil
+}
+
+// Score parses and scores each document. Incomplete documents score zero
+// rather than failing the batch; only read errors stop it.
+func Score(paths []string, opts prfaq.Options) ([]prfaq.Result, error) {
+	return ScoreResumable(context.Background(), paths, opts, nil, nil, nil)
+}
+
+// Progress is called after each document of a batch with the time it took.
+// restored is set for documents taken from a checkpoint or the cache.
+type Progress func(path string, elapsed time.Duration, restored bool)
+
+// ScoreResumable is Score with a checkpoint: documents recorded in cp are
+// restored instead of scored, and each newly scored document is recorded as
+// soon as it finishes. Documents whose results are in cache are taken from
+// it, and the others are added to it. It stops between documents when ctx
+// is canceled. progress is called after each document. cp, cache, and
+// progress may be nil.
+func ScoreResumable(ctx context.Context, paths []string, opts prfaq.Options, cp *Checkpoint, cache *Cache, progress Progress) ([]prfaq.Result, error) {
+	if progress == nil {
+		progress = func(string, time.Duration, bool) {}
+	}
+	results := make([]prfaq.Result, 0, len(paths))
+	for _, path := range paths {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("stopped after %d of %d documents: %w", len(results), len(paths), err)
+		}
+		start := time.Now()
+
+		data, err := os.ReadFile(path) //nolint:gosec // path comes from walking a user-provided directory
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w: %w", path, parser.ErrRead, err)
+		}
+		sum := contentHash(data)
+		if cp != nil {
+			if result, ok := cp.restore(path, sum, opts); ok {
+				results = append(results, result)
+				progress(path, time.Since(start), true)
+				continue
+			}
+		}
+
+		key := ""
+		if cache != nil {
+			key = cache.key(path, data, opts)
+		}
+		result, deps, restored := prfaq.Result{}, map[string]string(nil), false
+		if key != "" {
+			result, deps, restored = cache.load(key)
+		}
+		if !restored {
+			doc, scored, err := scoreData(path, data, opts)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			result, deps = *scored, dependencyHashes(doc.Dependencies(path))
+			if key != "" {
+				// A cache that cannot be written only costs the next run time
+				_ = cache.store(key, deps, result)
+			}
+		}
+		if cp != nil {
+			if err := cp.record(path, sum, deps, opts, result); err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, result)
+		progress(path, time.Since(start), restored)
+	}
+	return results, nil
+}
+
+// ScoreFile parses and scores one document, named by its path.
+func ScoreFile(path string, opts prfaq.Options) (*prfaq.Result, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is user-provided CLI argument
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", parser.ErrRead, err)
+	}
+	_, result, err := scoreData(path, data, opts)
+	return result, err
+}
+
+// ScoreFiles parses and scores a document kept in several files, or listed
+// in a manifest, as one; see prfaq.ParseFiles. A single document file is
+// scored as ScoreFile does. The result is named by Name.
+func ScoreFiles(paths []string, opts prfaq.Options) (*prfaq.Result, error) {
+	if len(paths) == 1 && !prfaq.IsManifest(paths[0]) {
+		return ScoreFile(paths[0], opts)
+	}
+	doc, err := prfaq.ParseFiles(paths...)
+	if err != nil {
+		return nil, err
+	}
+	doc.Name = Name(paths)
+	return prfaq.Score(doc, opts)
+}
+
+// Name names a document kept in the files at paths in reports, e.g.
+// "pr.md+faq.md".
+func Name(paths []string) string {
+	return strings.Join(paths, "+")
+}
+
+// scoreData parses and scores a document's content, resolving its includes.
+func scoreData(path string, data []byte, opts prfaq.Options) (*prfaq.Document, *prfaq.Result, error) {
+	doc, err := prfaq.ParseSource(path, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc.Name = path
+	result, err := prfaq.Score(doc, opts)
+	return doc, result, err
+}
@@ -0,0 +1,134 @@
+package batch
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"b.md":           "# B",
+		"a.txt":          "# A",
+		"team/c.MD":      "# C",
+		"notes.json":     "{}",
+		".git/HEAD.md":   "ignored",
+		"team/.draft.md": "ignored",
+	})
+
+	got, err := Find(root)
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	want := []string{
+		filepath.Join(root, "a.txt"),
+		filepath.Join(root, "b.md"),
+		filepath.Join(root, "team", "c.MD"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Find() = %v, want %v", got, want)
+	}
+
+	if _, err := Find(t.TempDir()); !errors.Is(err, ErrNoDocuments) {
+		t.Errorf("Find(empty) error = %v, want ErrNoDocuments", err)
+	}
+}
+
+func TestScore(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"good.md":  "# Acme Launches Ledger Sync\n\n## Press Release\n\nSEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync.\n",
+		"empty.md": "# Notes\n",
+	})
+	paths, err := Find(root)
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+
+	results, err := Score(paths, prfaq.Options{})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if results[0].Name != paths[0] || results[0].Score != 0 {
+		t.Errorf("incomplete document = %s scored %d, want 0", results[0].Name, results[0].Score)
+	}
+	if results[1].Score == 0 {
+		t.Error("complete document scored 0")
+	}
+
+	if _, err := Score([]string{filepath.Join(root, "missing.md")}, prfaq.Options{}); err == nil {
+		t.Error("Score() of a missing file should fail")
+	}
+}
+
+func TestScoreFile_Images(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"team/prfaq.md":     "# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today announced Ledger Sync.\n\n## FAQ\n\n![Flow](img/flow.png)\n\n![Rollout](img/rollout.png)\n",
+		"team/img/flow.png": "",
+	})
+
+	result, err := ScoreFile(filepath.Join(root, "team", "prfaq.md"), prfaq.Options{})
+	if err != nil {
+		t.Fatalf("ScoreFile() error = %v", err)
+	}
+	var lines []int
+	for _, f := range result.Findings {
+		if f.RuleID == "image-missing-file" {
+			lines = append(lines, f.Line)
+		}
+	}
+	if !reflect.DeepEqual(lines, []int{11}) {
+		t.Errorf("image-missing-file on lines %v, want [11]", lines)
+	}
+}
+
+func TestScoreFiles(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"pr.md":  "# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today announced Ledger Sync, which is 3x faster.\n",
+		"faq.md": "## FAQ\n\nQ: How fast is it?\nA: Fast.\n",
+	})
+	paths := []string{filepath.Join(root, "pr.md"), filepath.Join(root, "faq.md")}
+
+	result, err := ScoreFiles(paths, prfaq.Options{})
+	if err != nil {
+		t.Fatalf("ScoreFiles() error = %v", err)
+	}
+	if result.Name != Name(paths) {
+		t.Errorf("Name = %q, want %q", result.Name, Name(paths))
+	}
+	var found bool
+	for _, f := range result.Findings {
+		if f.RuleID == "claim-unsubstantiated" {
+			found = true
+			if f.File != paths[0] || f.Line != 5 {
+				t.Errorf("finding at %s:%d, want %s:5", f.File, f.Line, paths[0])
+			}
+		}
+	}
+	if !found {
+		t.Error("no claim-unsubstantiated finding")
+	}
+}
@@ -0,0 +1,146 @@
+package batch
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bordenet/pr-faq-validator/internal/buildinfo"
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+// Cache keeps the result of each scored document across runs, keyed by a
+// hash of its content, its path, the scoring options, and the build, so a
+// repeated run skips the documents that have not changed. A result is also
+// scored again when a file it depends on changes: an included file, the
+// review sidecar, or a local image.
+type Cache struct {
+	dir  string
+	hits int
+}
+
+type cacheEntry struct {
+	// Deps maps each file the result depends on to its content hash, or
+	// to "" when it did not exist.
+	Deps   map[string]string `json:"deps"`
+	Result prfaq.Result      `json:"result"`
+}
+
+// DefaultCacheDir returns the directory results are cached in.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("no cache directory: %w", err)
+	}
+	return filepath.Join(dir, "pr-faq-validator", "results"), nil
+}
+
+// OpenCache opens the cache in dir, creating the directory if needed.
+func OpenCache(dir string) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to open cache: %w", err)
+	}
+	return &Cache{dir: dir}, nil
+}
+
+// Hits reports how many documents were taken from the cache.
+func (c *Cache) Hits() int {
+	return c.hits
+}
+
+// key identifies the result of the document at path with content data
+// under opts. It is "" when the result cannot be cached: semantic topic
+// matching, flow checking, and the duplicate corpus depend on more than
+// the document, and a rules pin that is not available scores nothing.
+func (c *Cache) key(path string, data []byte, opts prfaq.Options) string {
+	if opts.TopicMatcher != nil || opts.FlowChecker != nil || opts.Corpus != nil {
+		return ""
+	}
+	rules := rulesVersion(opts)
+	if rules == "" {
+		return ""
+	}
+	fingerprint, err := json.Marshal(struct {
+		Path    string
+		SHA256  string
+		Build   string
+		Rules   string
+		Options prfaq.Options
+	}{path, contentHash(data), buildinfo.Get().String(), rules, opts})
+	if err != nil {
+		return ""
+	}
+	return contentHash(fingerprint)
+}
+
+// load returns the cached result for key with the hashes of the files it
+// depends on, if every one of them is unchanged.
+func (c *Cache) load(key string) (prfaq.Result, map[string]string, bool) {
+	data, err := os.ReadFile(filepath.Join(c.dir, key+".json")) //nolint:gosec // key is a hex digest
+	if err != nil {
+		return prfaq.Result{}, nil, false
+	}
+	var e cacheEntry
+	if json.Unmarshal(data, &e) != nil || !unchanged(e.Deps) {
+		return prfaq.Result{}, nil, false
+	}
+	c.hits++
+	return e.Result, e.Deps, true
+}
+
+// store caches result for key with deps, the hashes of the files it
+// depends on. The entry is written to a temporary file first, so a
+// concurrent run never reads half of one.
+func (c *Cache) store(key string, deps map[string]string, result prfaq.Result) error {
+	data, err := json.Marshal(cacheEntry{Deps: deps, Result: result})
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+	tmp, err := os.CreateTemp(c.dir, key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+	_, werr := tmp.Write(data)
+	if cerr := tmp.Close(); werr == nil {
+		werr = cerr
+	}
+	if werr == nil {
+		werr = os.Rename(tmp.Name(), filepath.Join(c.dir, key+".json"))
+	}
+	if werr != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache: %w", werr)
+	}
+	return nil
+}
+
+// dependencyHashes maps each of paths to its content hash, or to "" when
+// it does not exist.
+func dependencyHashes(paths []string) map[string]string {
+	deps := make(map[string]string, len(paths))
+	for _, path := range paths {
+		deps[path] = fileHash(path)
+	}
+	return deps
+}
+
+// unchanged reports whether every file in deps still has its hash.
+func unchanged(deps map[string]string) bool {
+	for path, sum := range deps {
+		if fileHash(path) != sum {
+			return false
+		}
+	}
+	return true
+}
+
+// fileHash is the content hash of the file at path, or "" when it does not
+// exist or cannot be read.
+func fileHash(path string) string {
+	data, err := os.ReadFile(path) //nolint:gosec // path is a document dependency
+	if err != nil {
+		return ""
+	}
+	return contentHash(data)
+}
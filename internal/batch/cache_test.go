@@ -0,0 +1,94 @@
+package batch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+type allTopics struct{}
+
+func (allTopics) MatchTopics(_, required []string) ([]bool, error) {
+	matched := make([]bool, len(required))
+	for i := range matched {
+		matched[i] = true
+	}
+	return matched, nil
+}
+
+func TestScoreResumable_Cache(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"a.md":   "# Acme Launches Ledger Sync\n\n## Press Release\n\nSEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync.\n\n![Dashboard](dashboard.png)\n",
+		"faq.md": "## FAQ\n\nQ: What is it?\nA: A ledger.\n",
+		"b.md":   "# Notes\n\n## Press Release\n\nAcme launched it.\n\n{{include: faq.md}}\n",
+	})
+	a, b := filepath.Join(root, "a.md"), filepath.Join(root, "b.md")
+	cache, err := OpenCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("OpenCache() error = %v", err)
+	}
+
+	run := func(opts prfaq.Options, want int) []prfaq.Result {
+		t.Helper()
+		before := cache.Hits()
+		var restored int
+		results, err := ScoreResumable(context.Background(), []string{a, b}, opts, nil, cache, func(_ string, _ time.Duration, r bool) {
+			if r {
+				restored++
+			}
+		})
+		if err != nil {
+			t.Fatalf("ScoreResumable() error = %v", err)
+		}
+		if got := cache.Hits() - before; got != want || restored != want {
+			t.Errorf("%d cache hits, %d reported restored, want %d", got, restored, want)
+		}
+		return results
+	}
+
+	first := run(prfaq.Options{}, 0)
+	second := run(prfaq.Options{}, 2)
+	if second[0].Score != first[0].Score || second[0].Name != a || len(second[0].Findings) != len(first[0].Findings) {
+		t.Errorf("cached %+v, want %+v", second[0], first[0])
+	}
+
+	// Other options are scored again, and then cached under their own key
+	run(prfaq.Options{Audience: prfaq.AudienceDeveloper}, 0)
+	run(prfaq.Options{Audience: prfaq.AudienceDeveloper}, 2)
+
+	// So is a document whose content, include, sidecar, or image changes
+	writeFiles(t, root, map[string]string{"a.md": "# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today announced Ledger Sync.\n"})
+	run(prfaq.Options{}, 1)
+	writeFiles(t, root, map[string]string{"faq.md": "## FAQ\n\nQ: What is it?\nA: A ledger for finance teams.\n"})
+	run(prfaq.Options{}, 1)
+	writeFiles(t, root, map[string]string{filepath.Base(parser.ReviewFile(b)): "status: approved\n"})
+	run(prfaq.Options{}, 1)
+	writeFiles(t, root, map[string]string{"a.md": "# Acme Launches Ledger Sync\n\n## Press Release\n\nSEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync.\n\n![Dashboard](dashboard.png)\n"})
+	run(prfaq.Options{}, 2)
+	writeFiles(t, root, map[string]string{"dashboard.png": "png"})
+	run(prfaq.Options{}, 1)
+
+	// Semantic topic matching depends on more than the document
+	run(prfaq.Options{TopicMatcher: allTopics{}}, 0)
+	run(prfaq.Options{TopicMatcher: allTopics{}}, 0)
+}
+
+func TestCache_Corrupt(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := OpenCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "abc.json"), []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := cache.load("abc"); ok || cache.Hits() != 0 {
+		t.Error("load() of a corrupt entry = ok, want a miss")
+	}
+}
@@ -0,0 +1,79 @@
+package batch
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// ErrGit is returned when git cannot list the changed documents, for
+// instance because the directory is not in a repository or a ref is unknown.
+var ErrGit = errors.New("git failed")
+
+// Changed returns the documents under root that differ from since, in
+// lexical order. Uncommitted and untracked files count as changed. With
+// since set, commits since it diverged from HEAD count as well, so
+// "origin/main" selects the documents a branch touches. Deleted files are
+// left out. It returns an empty list, not ErrNoDocuments, when nothing
+// changed.
+func Changed(root, since string) ([]string, error) {
+	base := "HEAD"
+	if since != "" {
+		out, err := git(root, "merge-base", since, "HEAD")
+		if err != nil {
+			return nil, err
+		}
+		base = strings.TrimSpace(string(out))
+	}
+
+	// --relative limits the diff to root and makes its paths relative to it,
+	// matching ls-files
+	diff, err := git(root, "diff", "--name-only", "--relative", "--diff-filter=d", "-z", base, "--")
+	if err != nil {
+		return nil, err
+	}
+	untracked, err := git(root, "ls-files", "--others", "--exclude-standard", "-z", "--", ".")
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, name := range strings.Split(string(diff)+string(untracked), "\x00") {
+		if name == "" || !isDocument(name) {
+			continue
+		}
+		paths = append(paths, filepath.Join(root, filepath.FromSlash(name)))
+	}
+	slices.Sort(paths)
+	return slices.Compact(paths), nil
+}
+
+// isDocument reports whether a slash-separated path relative to the scanned
+// directory is one Find would return.
+func isDocument(name string) bool {
+	for _, part := range strings.Split(name, "/") {
+		if strings.HasPrefix(part, ".") {
+			return false
+		}
+	}
+	return slices.Contains(Extensions, strings.ToLower(filepath.Ext(name)))
+}
+
+// git runs a git subcommand in dir and returns its standard output.
+func git(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...) //nolint:gosec // arguments are fixed subcommands and a user-provided ref
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: git %s: %s", ErrGit, args[0], msg)
+		}
+		return nil, fmt.Errorf("%w: git %s: %w", ErrGit, args[0], err)
+	}
+	return out, nil
+}
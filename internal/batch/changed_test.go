@@ -0,0 +1,98 @@
+package batch
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// gitRepo creates a repository in a temp dir with files committed on main.
+func gitRepo(t *testing.T, files map[string]string) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	root := t.TempDir()
+	writeFiles(t, root, files)
+	runGit(t, root, "init", "-q", "-b", "main")
+	runGit(t, root, "add", "-A")
+	runGit(t, root, "commit", "-q", "-m", "initial")
+	return root
+}
+
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...) //nolint:gosec // test code
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+}
+
+func TestChanged(t *testing.T) {
+	root := gitRepo(t, map[string]string{
+		"docs/a.md":     "# A",
+		"docs/b.md":     "# B",
+		"docs/gone.md":  "# Gone",
+		"other/c.md":    "# C",
+		"docs/data.csv": "x",
+	})
+
+	// A commit on a branch, then uncommitted, untracked, and deleted files
+	runGit(t, root, "checkout", "-q", "-b", "feature")
+	writeFiles(t, root, map[string]string{"docs/b.md": "# B2"})
+	runGit(t, root, "commit", "-q", "-am", "edit b")
+	writeFiles(t, root, map[string]string{
+		"docs/a.md":        "# A2",
+		"docs/new.md":      "# New",
+		"docs/.draft.md":   "hidden",
+		"docs/data.csv":    "y",
+		"other/c.md":       "# C2",
+		"docs/sub/deep.md": "# Deep",
+	})
+	if err := os.Remove(filepath.Join(root, "docs/gone.md")); err != nil {
+		t.Fatal(err)
+	}
+
+	docs := filepath.Join(root, "docs")
+	tests := []struct {
+		name  string
+		since string
+		want  []string
+	}{
+		{"working tree", "", []string{"a.md", "new.md", "sub/deep.md"}},
+		{"since main", "main", []string{"a.md", "b.md", "new.md", "sub/deep.md"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Changed(docs, tt.since)
+			if err != nil {
+				t.Fatalf("Changed() error = %v", err)
+			}
+			want := make([]string, len(tt.want))
+			for i, name := range tt.want {
+				want[i] = filepath.Join(docs, filepath.FromSlash(name))
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Changed() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestChanged_Errors(t *testing.T) {
+	root := gitRepo(t, map[string]string{"a.md": "# A"})
+
+	got, err := Changed(root, "")
+	if err != nil || len(got) != 0 {
+		t.Errorf("Changed() = %v, %v, want no documents and no error", got, err)
+	}
+	if _, err := Changed(root, "no-such-ref"); !errors.Is(err, ErrGit) {
+		t.Errorf("Changed() unknown ref error = %v, want ErrGit", err)
+	}
+	if _, err := Changed(t.TempDir(), ""); !errors.Is(err, ErrGit) {
+		t.Errorf("Changed() outside a repository error = %v, want ErrGit", err)
+	}
+}
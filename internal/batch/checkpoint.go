@@ -0,0 +1,176 @@
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+// DefaultCheckpoint is the checkpoint file written during directory runs.
+const DefaultCheckpoint = ".prfaq-validator.checkpoint"
+
+// Checkpoint records each finished document of a directory run as one JSON
+// line, so an interrupted run can resume without scoring it again. A
+// document is only restored while its content, the files it depends on,
+// the scoring options, and the rules version are unchanged.
+type Checkpoint struct {
+	path     string
+	file     *os.File
+	done     map[string]checkpointEntry
+	restored int
+}
+
+type checkpointEntry struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+	// Options is the fingerprint of the options and rules version the
+	// result was scored with; see optionsFingerprint.
+	Options string `json:"options"`
+	// Deps maps each file the result depends on, such as an included
+	// file or a sidecar, to its content hash, or to "" when it did not exist.
+	Deps   map[string]string `json:"deps,omitempty"`
+	Result prfaq.Result      `json:"result"`
+}
+
+// OpenCheckpoint opens the checkpoint file at path. With resume, the entries
+// already in the file can be restored and new ones are appended; otherwise
+// the file starts empty.
+func OpenCheckpoint(path string, resume bool) (*Checkpoint, error) {
+	c := &Checkpoint{path: path, done: map[string]checkpointEntry{}}
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	torn := false
+	if resume {
+		var err error
+		if torn, err = c.load(); err != nil {
+			return nil, err
+		}
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+
+	file, err := os.OpenFile(path, flags, 0o600) //nolint:gosec // path is user-provided CLI argument
+	if err != nil {
+		return nil, fmt.Errorf("failed to open checkpoint: %w", err)
+	}
+	c.file = file
+	if torn {
+		// Terminate the torn line so the next entry starts on its own line
+		if _, err := file.WriteString("\n"); err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("failed to write checkpoint: %w", err)
+		}
+	}
+	return c, nil
+}
+
+// load reads the existing entries. A missing file resumes nothing, and a
+// torn last line from an interrupted write is ignored and reported.
+func (c *Checkpoint) load() (torn bool, err error) {
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 64<<20)
+	for scanner.Scan() {
+		var e checkpointEntry
+		if json.Unmarshal(scanner.Bytes(), &e) == nil && e.Path != "" {
+			c.done[e.Path] = e
+		}
+	}
+	return len(data) > 0 && data[len(data)-1] != '\n', scanner.Err()
+}
+
+// restore returns the recorded result for a document with the given content
+// hash, if it was scored with the same options and none of the files it
+// depends on has changed since.
+func (c *Checkpoint) restore(path, sum string, opts prfaq.Options) (prfaq.Result, bool) {
+	e, ok := c.done[path]
+	if !ok || e.SHA256 != sum || e.Options == "" || e.Options != optionsFingerprint(opts) || !unchanged(e.Deps) {
+		return prfaq.Result{}, false
+	}
+	c.restored++
+	return e.Result, true
+}
+
+// optionsFingerprint identifies the scoring options and the rules version
+// they resolve to, so a result scored by other rules, such as another
+// release's, or under any other option is scored again. The topic matcher,
+// flow checker, and corpus only count as set or not. It is "" when the
+// rules pin is not available.
+func optionsFingerprint(opts prfaq.Options) string {
+	rules := rulesVersion(opts)
+	if rules == "" {
+		return ""
+	}
+	semantic, flow, corpus := opts.TopicMatcher != nil, opts.FlowChecker != nil, opts.Corpus != nil
+	opts.TopicMatcher, opts.FlowChecker, opts.Corpus = nil, nil, nil
+	fingerprint, err := json.Marshal(struct {
+		Rules                  string
+		Options                prfaq.Options
+		Semantic, Flow, Corpus bool
+	}{rules, opts, semantic, flow, corpus})
+	if err != nil {
+		return ""
+	}
+	return contentHash(fingerprint)
+}
+
+// rulesVersion is the rules version documents are scored with under opts,
+// or "" when the pin is not available, which matches no recorded result.
+func rulesVersion(opts prfaq.Options) string {
+	rules, err := parser.ResolveRules(opts.RulesVersion)
+	if err != nil {
+		return ""
+	}
+	return rules.String()
+}
+
+// record appends a finished document with deps, the hashes of the files it
+// depends on.
+func (c *Checkpoint) record(path, sum string, deps map[string]string, opts prfaq.Options, result prfaq.Result) error {
+	line, err := json.Marshal(checkpointEntry{Path: path, SHA256: sum, Options: optionsFingerprint(opts), Deps: deps, Result: result})
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint entry: %w", err)
+	}
+	if _, err := c.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	return nil
+}
+
+// Restored reports how many documents were taken from the checkpoint.
+func (c *Checkpoint) Restored() int {
+	return c.restored
+}
+
+// Close closes the checkpoint file, keeping it for a later resume.
+func (c *Checkpoint) Close() error {
+	return c.file.Close()
+}
+
+// Remove closes and deletes the checkpoint once the run has completed.
+func (c *Checkpoint) Remove() error {
+	if err := c.file.Close(); err != nil {
+		return err
+	}
+	return os.Remove(c.path)
+}
+
+// contentHash identifies a document version in the checkpoint.
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
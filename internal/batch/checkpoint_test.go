@@ -0,0 +1,298 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+func TestScoreResumable(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"a.md": "# Acme Launches Ledger Sync\n\n## Press Release\n\nSEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync.\n",
+		"b.md": "# Notes\n",
+	})
+	a, b := filepath.Join(root, "a.md"), filepath.Join(root, "b.md")
+	cpPath := filepath.Join(t.TempDir(), "checkpoint")
+
+	// An interrupted run that only finished a.md
+	cp, err := OpenCheckpoint(cpPath, false)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint() error = %v", err)
+	}
+	first, err := ScoreResumable(context.Background(), []string{a}, prfaq.Options{}, cp, nil, nil)
+	if err != nil {
+		t.Fatalf("ScoreResumable() error = %v", err)
+	}
+	if err := cp.Close(); err != nil {
+		t.Fatal(err)
+	}
+	appendTornLine(t, cpPath)
+
+	cp, err = OpenCheckpoint(cpPath, true)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint(resume) error = %v", err)
+	}
+	results, err := ScoreResumable(context.Background(), []string{a, b}, prfaq.Options{}, cp, nil, nil)
+	if err != nil {
+		t.Fatalf("ScoreResumable() error = %v", err)
+	}
+	if cp.Restored() != 1 || len(results) != 2 {
+		t.Fatalf("restored %d of %d results, want 1 of 2", cp.Restored(), len(results))
+	}
+	if results[0].Score != first[0].Score || results[0].Name != a {
+		t.Errorf("restored %+v, want %+v", results[0], first[0])
+	}
+	if err := cp.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Entries appended after the torn line are still readable
+	cp, err = OpenCheckpoint(cpPath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reported []string
+	progress := func(path string, _ time.Duration, restored bool) {
+		if restored {
+			reported = append(reported, path)
+		}
+	}
+	if _, err := ScoreResumable(context.Background(), []string{a, b}, prfaq.Options{}, cp, nil, progress); err != nil {
+		t.Fatal(err)
+	}
+	if cp.Restored() != 2 {
+		t.Errorf("restored %d documents on the second resume, want 2", cp.Restored())
+	}
+	if len(reported) != 2 {
+		t.Errorf("progress reported %v as restored, want both documents", reported)
+	}
+	if err := cp.Remove(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(cpPath); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("checkpoint still exists after Remove: %v", err)
+	}
+}
+
+func TestScoreResumable_ChangedDocument(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{"a.md": "# Notes\n"})
+	path := filepath.Join(root, "a.md")
+	cpPath := filepath.Join(t.TempDir(), "checkpoint")
+
+	cp, err := OpenCheckpoint(cpPath, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ScoreResumable(context.Background(), []string{path}, prfaq.Options{}, cp, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	_ = cp.Close()
+
+	writeFiles(t, root, map[string]string{"a.md": "# Edited Notes\n"})
+	tests := []struct {
+		name string
+		opts prfaq.Options
+	}{
+		{"edited content", prfaq.Options{}},
+		{"different options", prfaq.Options{Explain: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cp, err := OpenCheckpoint(cpPath, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() { _ = cp.Close() }()
+			if _, err := ScoreResumable(context.Background(), []string{path}, tt.opts, cp, nil, nil); err != nil {
+				t.Fatal(err)
+			}
+			if cp.Restored() != 0 {
+				t.Errorf("restored %d documents, want 0", cp.Restored())
+			}
+		})
+	}
+}
+
+func TestScoreResumable_ChangedOptions(t *testing.T) {
+	path := filepath.Join("..", "..", "testdata", "example_prfaq_1.md")
+	tests := []struct {
+		name string
+		opts prfaq.Options
+		want int
+	}{
+		{"same options", prfaq.Options{}, 1},
+		{"scoring mode", prfaq.Options{ScoringMode: "severity"}, 0},
+		{"weights", prfaq.Options{Weights: map[string]float64{"Quote Quality": 0}}, 0},
+		{"hedge severity", prfaq.Options{HedgeSeverity: "error"}, 0},
+		{"public company", prfaq.Options{PublicCompany: true}, 0},
+		{"legal screening", prfaq.Options{Legal: prfaq.LegalOptions{Strict: true}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A run interrupted after scoring the document with the default options
+			cpPath := filepath.Join(t.TempDir(), "checkpoint")
+			cp, err := OpenCheckpoint(cpPath, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := ScoreResumable(context.Background(), []string{path}, prfaq.Options{}, cp, nil, nil); err != nil {
+				t.Fatal(err)
+			}
+			_ = cp.Close()
+
+			cp, err = OpenCheckpoint(cpPath, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() { _ = cp.Close() }()
+			results, err := ScoreResumable(context.Background(), []string{path}, tt.opts, cp, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cp.Restored() != tt.want {
+				t.Errorf("restored %d documents, want %d", cp.Restored(), tt.want)
+			}
+			fresh, err := ScoreFile(path, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if results[0].Score != fresh.Score {
+				t.Errorf("resumed score = %d, want %d as scored afresh", results[0].Score, fresh.Score)
+			}
+		})
+	}
+}
+
+func TestScoreResumable_ChangedDependency(t *testing.T) {
+	doc := "# Notes\n\n## Press Release\n\nAcme launched it.\n\n{{include: faq.md}}\n"
+	tests := []struct {
+		name    string
+		changed func(path string) (name, content string)
+		want    int
+	}{
+		{"unchanged", nil, 1},
+		{"included file", func(string) (string, string) {
+			return "faq.md", "## FAQ\n\nQ: What is it?\nA: A ledger for finance teams.\n"
+		}, 0},
+		{"review sidecar", func(path string) (string, string) {
+			return filepath.Base(parser.ReviewFile(path)), "status: approved\n"
+		}, 0},
+		{"dismissals sidecar", func(path string) (string, string) {
+			return filepath.Base(parser.DismissalsFile(path)), "dismissed:\n  - rule: headline-too-short\n    status: wont-fix\n"
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeFiles(t, root, map[string]string{"a.md": doc, "faq.md": "## FAQ\n\nQ: What is it?\nA: A ledger.\n"})
+			path := filepath.Join(root, "a.md")
+			cache, err := OpenCache(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := ScoreResumable(context.Background(), []string{path}, prfaq.Options{}, nil, cache, nil); err != nil {
+				t.Fatal(err)
+			}
+
+			// An interrupted run that took the document from the cache
+			cpPath := filepath.Join(t.TempDir(), "checkpoint")
+			cp, err := OpenCheckpoint(cpPath, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := ScoreResumable(context.Background(), []string{path}, prfaq.Options{}, cp, cache, nil); err != nil {
+				t.Fatal(err)
+			}
+			_ = cp.Close()
+			if cache.Hits() != 1 {
+				t.Fatalf("%d cache hits, want 1", cache.Hits())
+			}
+
+			if tt.changed != nil {
+				name, content := tt.changed(path)
+				writeFiles(t, root, map[string]string{name: content})
+			}
+			cp, err = OpenCheckpoint(cpPath, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() { _ = cp.Close() }()
+			if _, err := ScoreResumable(context.Background(), []string{path}, prfaq.Options{}, cp, nil, nil); err != nil {
+				t.Fatal(err)
+			}
+			if cp.Restored() != tt.want {
+				t.Errorf("restored %d documents, want %d", cp.Restored(), tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreResumable_RulesVersion(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{"a.md": "# Notes\n"})
+	path := filepath.Join(root, "a.md")
+	cpPath := filepath.Join(t.TempDir(), "checkpoint")
+	v1 := prfaq.Options{RulesVersion: "rules/v1"}
+
+	cp, err := OpenCheckpoint(cpPath, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ScoreResumable(context.Background(), []string{path}, v1, cp, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	_ = cp.Close()
+
+	for _, tt := range []struct {
+		opts prfaq.Options
+		want int
+	}{
+		{v1, 1},
+		{prfaq.Options{}, 0},
+	} {
+		cp, err := OpenCheckpoint(cpPath, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ScoreResumable(context.Background(), []string{path}, tt.opts, cp, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+		if cp.Restored() != tt.want {
+			t.Errorf("pinned to %q: restored %d documents, want %d", tt.opts.RulesVersion, cp.Restored(), tt.want)
+		}
+		_ = cp.Close()
+	}
+}
+
+func TestScoreResumable_Canceled(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{"a.md": "# Notes\n"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := ScoreResumable(ctx, []string{filepath.Join(root, "a.md")}, prfaq.Options{}, nil, nil, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ScoreResumable() error = %v, want context.Canceled", err)
+	}
+}
+
+// appendTornLine simulates a write cut short by an interrupt.
+func appendTornLine(t *testing.T, path string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600) //nolint:gosec // test code
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = f.Close() }()
+	if _, err := f.WriteString(`{"path":"trunc`); err != nil {
+		t.Fatal(err)
+	}
+}
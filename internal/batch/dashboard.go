@@ -0,0 +1,164 @@
+package batch
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+// Dashboard list lengths.
+const (
+	worstOffenderCount = 10
+	commonIssueCount   = 10
+)
+
+// Summary aggregates a batch run for the portfolio dashboard.
+type Summary struct {
+	Documents    int
+	AverageScore float64
+	Bands        []BandCount       // documents per report status band, best first
+	Histogram    []Bucket          // documents per 10-point score range, lowest first
+	Categories   []CategoryAverage // in report order
+	Worst        []Offender        // lowest-scoring documents, worst first
+	CommonIssues []IssueCount      // most widespread rules, most documents first
+	Trends       []Trend           // score history by document name; set from a History
+}
+
+// BandCount is the number of documents in one report status band.
+type BandCount struct {
+	Label     string
+	Documents int
+}
+
+// Bucket is the number of documents scoring from Min to Max inclusive.
+type Bucket struct {
+	Min, Max  int
+	Documents int
+}
+
+// CategoryAverage is the mean score of one category across the batch.
+type CategoryAverage struct {
+	Name    string
+	Average float64
+	Max     int
+}
+
+// Offender is a low-scoring document.
+type Offender struct {
+	Name   string
+	Title  string
+	Score  int
+	Errors int // findings with error severity
+}
+
+// IssueCount is how many documents a rule fired on.
+type IssueCount struct {
+	RuleID    string
+	Category  string
+	Message   string // the first message seen for the rule
+	Documents int
+}
+
+// newSummary returns an empty summary with every band and bucket present.
+func newSummary(documents int) Summary {
+	s := Summary{
+		Documents: documents,
+		Bands: []BandCount{
+			{Label: parser.StatusLabel(parser.ThresholdExcellent)},
+			{Label: parser.StatusLabel(parser.ThresholdGood)},
+			{Label: parser.StatusLabel(parser.ThresholdNeedsWork)},
+			{Label: parser.StatusLabel(0)},
+		},
+	}
+	for lo := 0; lo < 100; lo += 10 {
+		hi := lo + 9
+		if hi == 99 {
+			hi = 100 // the top bucket includes a perfect score
+		}
+		s.Histogram = append(s.Histogram, Bucket{Min: lo, Max: hi})
+	}
+	return s
+}
+
+// Summarize aggregates scored results.
+func Summarize(results []prfaq.Result) Summary {
+	s := newSummary(len(results))
+
+	categoryTotals := make(map[string]int)
+	issues := make(map[string]*IssueCount)
+	total := 0
+	for _, r := range results {
+		total += r.Score
+		s.countBand(r.Score)
+		s.Histogram[min(r.Score/10, 9)].Documents++
+		for _, c := range r.Categories {
+			categoryTotals[c.Name] += c.Score
+		}
+		s.Worst = append(s.Worst, offender(r))
+		countIssues(issues, r.Findings)
+	}
+	if len(results) > 0 {
+		s.AverageScore = float64(total) / float64(len(results))
+	}
+
+	for _, c := range parser.AllCategories() {
+		avg := CategoryAverage{Name: c.Name, Max: c.Max}
+		if len(results) > 0 {
+			avg.Average = float64(categoryTotals[c.Name]) / float64(len(results))
+		}
+		s.Categories = append(s.Categories, avg)
+	}
+
+	slices.SortStableFunc(s.Worst, func(a, b Offender) int {
+		return cmp.Or(cmp.Compare(a.Score, b.Score), cmp.Compare(a.Name, b.Name))
+	})
+	s.Worst = s.Worst[:min(len(s.Worst), worstOffenderCount)]
+
+	for _, issue := range issues {
+		s.CommonIssues = append(s.CommonIssues, *issue)
+	}
+	slices.SortFunc(s.CommonIssues, func(a, b IssueCount) int {
+		return cmp.Or(cmp.Compare(b.Documents, a.Documents), cmp.Compare(a.RuleID, b.RuleID))
+	})
+	s.CommonIssues = s.CommonIssues[:min(len(s.CommonIssues), commonIssueCount)]
+
+	return s
+}
+
+// countBand adds a document to the report status band of score.
+func (s *Summary) countBand(score int) {
+	label := parser.StatusLabel(score)
+	for i := range s.Bands {
+		if s.Bands[i].Label == label {
+			s.Bands[i].Documents++
+		}
+	}
+}
+
+func offender(r prfaq.Result) Offender {
+	o := Offender{Name: r.Name, Title: r.Title, Score: r.Score}
+	for _, f := range r.Findings {
+		if f.Severity == string(parser.SeverityError) {
+			o.Errors++
+		}
+	}
+	return o
+}
+
+// countIssues adds one document's findings, counting each rule once.
+func countIssues(issues map[string]*IssueCount, findings []prfaq.Finding) {
+	seen := make(map[string]bool)
+	for _, f := range findings {
+		if seen[f.RuleID] {
+			continue
+		}
+		seen[f.RuleID] = true
+		if issue, ok := issues[f.RuleID]; ok {
+			issue.Documents++
+			continue
+		}
+		issues[f.RuleID] = &IssueCount{RuleID: f.RuleID, Category: f.Category, Message: f.Message, Documents: 1}
+	}
+}
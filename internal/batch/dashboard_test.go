@@ -0,0 +1,81 @@
+package batch
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+func testResults() []prfaq.Result {
+	return []prfaq.Result{
+		{
+			Name: "a.md", Title: "Alpha", Score: 85,
+			Categories: []prfaq.Category{{Name: "Headline Quality", Score: 10, Max: 10}},
+			Findings:   []prfaq.Finding{{RuleID: "five-ws-who", Category: "5 Ws Coverage", Severity: "warning", Message: "WHO missing"}},
+		},
+		{
+			Name: "b.md", Title: "Beta | Launch", Score: 35,
+			Categories: []prfaq.Category{{Name: "Headline Quality", Score: 4, Max: 10}},
+			Findings: []prfaq.Finding{
+				{RuleID: "five-ws-who", Category: "5 Ws Coverage", Severity: "warning", Message: "WHO missing"},
+				{RuleID: "release-date-missing", Category: "Release Date", Severity: "error", Message: "No date"},
+				{RuleID: "release-date-missing", Category: "Release Date", Severity: "error", Message: "No date"},
+			},
+		},
+		{Name: "c.md", Score: 100},
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	s := Summarize(testResults())
+
+	if s.Documents != 3 || s.AverageScore != 220.0/3 {
+		t.Errorf("Documents = %d, AverageScore = %v", s.Documents, s.AverageScore)
+	}
+	if s.Bands[0].Documents != 2 || s.Bands[3].Documents != 1 {
+		t.Errorf("Bands = %+v", s.Bands)
+	}
+	if s.Histogram[3].Documents != 1 || s.Histogram[8].Documents != 1 || s.Histogram[9].Documents != 1 {
+		t.Errorf("Histogram = %+v", s.Histogram)
+	}
+	if s.Categories[0].Name != "Headline Quality" || s.Categories[0].Average != 14.0/3 {
+		t.Errorf("Categories[0] = %+v", s.Categories[0])
+	}
+	if s.Worst[0].Name != "b.md" || s.Worst[0].Errors != 2 {
+		t.Errorf("Worst[0] = %+v", s.Worst[0])
+	}
+	// Rules count once per document
+	want := []IssueCount{
+		{RuleID: "five-ws-who", Category: "5 Ws Coverage", Message: "WHO missing", Documents: 2},
+		{RuleID: "release-date-missing", Category: "Release Date", Message: "No date", Documents: 1},
+	}
+	if len(s.CommonIssues) != len(want) || s.CommonIssues[0] != want[0] || s.CommonIssues[1] != want[1] {
+		t.Errorf("CommonIssues = %+v, want %+v", s.CommonIssues, want)
+	}
+}
+
+func TestWriteDashboard(t *testing.T) {
+	s := Summarize(testResults())
+
+	var md bytes.Buffer
+	if err := WriteMarkdown(&md, s); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+	for _, want := range []string{"**Average Score:** 73.3/100", "| 30-39 | 1 | " + strings.Repeat("█", histogramWidth) + " |", `Beta \| Launch`, "| five-ws-who | 5 Ws Coverage | 2 |"} {
+		if !strings.Contains(md.String(), want) {
+			t.Errorf("markdown missing %q\n%s", want, md.String())
+		}
+	}
+
+	var html bytes.Buffer
+	if err := WriteHTML(&html, s); err != nil {
+		t.Fatalf("WriteHTML() error = %v", err)
+	}
+	for _, want := range []string{"<title>PR-FAQ Portfolio Dashboard</title>", `style="width: 100%"`, "Beta | Launch"} {
+		if !strings.Contains(html.String(), want) {
+			t.Errorf("HTML missing %q", want)
+		}
+	}
+}
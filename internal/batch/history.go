@@ -0,0 +1,171 @@
+package batch
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+// DefaultTrendRuns is how many runs a dashboard trend covers by default.
+const DefaultTrendRuns = 10
+
+// sparkBlocks are the unicode levels of a text sparkline, lowest first.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// HistoryRun is the scores of one directory run, one JSON line of a history
+// file.
+type HistoryRun struct {
+	At     time.Time      `json:"at"`
+	Scores map[string]int `json:"scores"` // overall score by document name
+}
+
+// History is the record of past directory runs kept in a JSON Lines file,
+// oldest first, from which the dashboard charts score trends.
+type History struct {
+	path string
+	runs []HistoryRun
+}
+
+// LoadHistory reads the history file at path. A missing file is an empty
+// history; Record creates it.
+func LoadHistory(path string) (*History, error) {
+	h := &History{path: path}
+	file, err := os.Open(path) //nolint:gosec // path is user-provided CLI argument
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 16<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var run HistoryRun
+		if err := json.Unmarshal(scanner.Bytes(), &run); err != nil {
+			return nil, fmt.Errorf("failed to read history: %s:%d: %w", path, line, err)
+		}
+		h.runs = append(h.runs, run)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	return h, nil
+}
+
+// Record appends the scores of results, a run finished at at, to the
+// history and its file.
+func (h *History) Record(at time.Time, results []prfaq.Result) error {
+	run := HistoryRun{At: at.UTC(), Scores: make(map[string]int, len(results))}
+	for _, r := range results {
+		run.Scores[r.Name] = r.Score
+	}
+	data, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("failed to record history: %w", err)
+	}
+	file, err := os.OpenFile(h.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600) //nolint:gosec // path is user-provided CLI argument
+	if err != nil {
+		return fmt.Errorf("failed to record history: %w", err)
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to record history: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to record history: %w", err)
+	}
+	h.runs = append(h.runs, run)
+	return nil
+}
+
+// Trend is one document's overall score across recent runs, oldest first.
+type Trend struct {
+	Name   string
+	Scores []int
+}
+
+// Trends returns the scores over the last n runs of every document in the
+// latest run, by name. It is empty until there are two runs to compare.
+func (h *History) Trends(n int) []Trend {
+	if len(h.runs) < 2 || n < 1 {
+		return nil
+	}
+	recent := h.runs[max(len(h.runs)-n, 0):]
+	latest := recent[len(recent)-1]
+	trends := make([]Trend, 0, len(latest.Scores))
+	for name := range latest.Scores {
+		t := Trend{Name: name}
+		for _, run := range recent {
+			if score, ok := run.Scores[name]; ok {
+				t.Scores = append(t.Scores, score)
+			}
+		}
+		trends = append(trends, t)
+	}
+	slices.SortFunc(trends, func(a, b Trend) int { return strings.Compare(a.Name, b.Name) })
+	return trends
+}
+
+// Latest returns the most recent score.
+func (t Trend) Latest() int {
+	return t.Scores[len(t.Scores)-1]
+}
+
+// Change returns the latest score less the first in the trend.
+func (t Trend) Change() int {
+	return t.Latest() - t.Scores[0]
+}
+
+// levels scales the scores to 0 through top, from the lowest score in the
+// trend to the highest. A flat trend sits at the middle.
+func (t Trend) levels(top int) []float64 {
+	lo, hi := slices.Min(t.Scores), slices.Max(t.Scores)
+	levels := make([]float64, len(t.Scores))
+	for i, s := range t.Scores {
+		if hi == lo {
+			levels[i] = float64(top) / 2
+			continue
+		}
+		levels[i] = float64((s-lo)*top) / float64(hi-lo)
+	}
+	return levels
+}
+
+// Sparkline draws the trend in unicode blocks, one per run.
+func (t Trend) Sparkline() string {
+	var b strings.Builder
+	for _, level := range t.levels(len(sparkBlocks) - 1) {
+		b.WriteRune(sparkBlocks[int(level)])
+	}
+	return b.String()
+}
+
+// sparklineWidth and sparklineHeight are the size of an SVG sparkline.
+const sparklineWidth, sparklineHeight = 100, 20
+
+// sparklinePoints returns the points of an SVG polyline drawing the trend.
+func (t Trend) sparklinePoints() string {
+	levels := t.levels(sparklineHeight - 2)
+	points := make([]string, len(levels))
+	for i, level := range levels {
+		x := 0.0
+		if len(levels) > 1 {
+			x = float64(i*sparklineWidth) / float64(len(levels)-1)
+		}
+		points[i] = fmt.Sprintf("%.1f,%.1f", x, sparklineHeight-1-level)
+	}
+	return strings.Join(points, " ")
+}
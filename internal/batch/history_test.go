@@ -0,0 +1,108 @@
+package batch
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+func TestHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	h, err := LoadHistory(path)
+	if err != nil {
+		t.Fatalf("LoadHistory(missing) error = %v", err)
+	}
+	start := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	runs := [][]prfaq.Result{
+		{{Name: "a.md", Score: 40}, {Name: "gone.md", Score: 70}},
+		{{Name: "a.md", Score: 55}},
+		{{Name: "a.md", Score: 70}, {Name: "b.md", Score: 90}},
+	}
+	for i, results := range runs {
+		if got := h.Trends(DefaultTrendRuns); i < 2 && got != nil {
+			t.Errorf("Trends() after %d runs = %+v, want none", i, got)
+		}
+		if err := h.Record(start.AddDate(0, 0, i), results); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	// A reloaded history sees every run recorded so far
+	h, err = LoadHistory(path)
+	if err != nil {
+		t.Fatalf("LoadHistory() error = %v", err)
+	}
+	want := []Trend{{Name: "a.md", Scores: []int{40, 55, 70}}, {Name: "b.md", Scores: []int{90}}}
+	if got := h.Trends(DefaultTrendRuns); !reflect.DeepEqual(got, want) {
+		t.Errorf("Trends() = %+v, want %+v", got, want)
+	}
+	if got := h.Trends(2); !reflect.DeepEqual(got[0].Scores, []int{55, 70}) {
+		t.Errorf("Trends(2) = %+v, want the last 2 runs", got)
+	}
+
+	if err := os.WriteFile(path, []byte("{not json\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadHistory(path); err == nil || !strings.Contains(err.Error(), ":1:") {
+		t.Errorf("LoadHistory(corrupt) error = %v, want line 1", err)
+	}
+}
+
+func TestTrend_Sparkline(t *testing.T) {
+	tests := []struct {
+		scores []int
+		want   string
+		change int
+	}{
+		{[]int{40, 55, 70}, "▁▄█", 30},
+		{[]int{80, 60}, "█▁", -20},
+		{[]int{75, 75, 75}, "▄▄▄", 0},
+		{[]int{90}, "▄", 0},
+	}
+	for _, tt := range tests {
+		trend := Trend{Name: "a.md", Scores: tt.scores}
+		if got := trend.Sparkline(); got != tt.want {
+			t.Errorf("Sparkline(%v) = %q, want %q", tt.scores, got, tt.want)
+		}
+		if got := trend.Change(); got != tt.change {
+			t.Errorf("Change(%v) = %d, want %d", tt.scores, got, tt.change)
+		}
+	}
+}
+
+func TestWriteDashboard_Trends(t *testing.T) {
+	s := Summarize(testResults())
+	s.Trends = []Trend{{Name: "a.md", Scores: []int{40, 55, 70}}}
+
+	var md bytes.Buffer
+	if err := WriteMarkdown(&md, s); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+	if want := "| a.md | ▁▄█ | 70 | +30 |"; !strings.Contains(md.String(), want) {
+		t.Errorf("markdown missing %q\n%s", want, md.String())
+	}
+
+	var html bytes.Buffer
+	if err := WriteHTML(&html, s); err != nil {
+		t.Fatalf("WriteHTML() error = %v", err)
+	}
+	if want := `<polyline points="0.0,19.0 50.0,10.0 100.0,1.0"`; !strings.Contains(html.String(), want) {
+		t.Errorf("HTML missing %q\n%s", want, html.String())
+	}
+
+	// No history, no section
+	s.Trends = nil
+	md.Reset()
+	if err := WriteMarkdown(&md, s); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+	if strings.Contains(md.String(), "Score Trends") {
+		t.Error("markdown has a Score Trends section without history")
+	}
+}
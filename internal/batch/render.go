@@ -0,0 +1,156 @@
+package batch
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+)
+
+// histogramWidth is the length of the longest histogram bar.
+const histogramWidth = 20
+
+// barLength scales n against the largest bucket.
+func (s Summary) barLength(n int) int {
+	largest := 0
+	for _, b := range s.Histogram {
+		largest = max(largest, b.Documents)
+	}
+	if largest == 0 {
+		return 0
+	}
+	return n * histogramWidth / largest
+}
+
+// WriteMarkdown renders the summary as a markdown dashboard.
+func WriteMarkdown(w io.Writer, s Summary) error {
+	var b strings.Builder
+	b.WriteString("# PR-FAQ Portfolio Dashboard\n\n")
+	fmt.Fprintf(&b, "**Documents:** %d\n", s.Documents)
+	fmt.Fprintf(&b, "**Average Score:** %.1f/100\n\n", s.AverageScore)
+
+	b.WriteString("## Status\n\n| Status | Documents |\n|--------|-----------|\n")
+	for _, band := range s.Bands {
+		fmt.Fprintf(&b, "| %s | %d |\n", band.Label, band.Documents)
+	}
+
+	b.WriteString("\n## Score Distribution\n\n| Score | Documents | |\n|-------|-----------|---|\n")
+	for _, bucket := range s.Histogram {
+		fmt.Fprintf(&b, "| %d-%d | %d | %s |\n", bucket.Min, bucket.Max, bucket.Documents, strings.Repeat("█", s.barLength(bucket.Documents)))
+	}
+
+	b.WriteString("\n## Category Averages\n\n| Category | Average | Max |\n|----------|---------|-----|\n")
+	for _, c := range s.Categories {
+		fmt.Fprintf(&b, "| %s | %.1f | %d |\n", c.Name, c.Average, c.Max)
+	}
+
+	b.WriteString("\n## Worst Offenders\n\n| Document | Title | Score | Errors |\n|----------|-------|-------|--------|\n")
+	for _, o := range s.Worst {
+		fmt.Fprintf(&b, "| %s | %s | %d | %d |\n", cell(o.Name), cell(o.Title), o.Score, o.Errors)
+	}
+
+	b.WriteString("\n## Most Common Issues\n\n| Rule | Category | Documents | Example |\n|------|----------|-----------|---------|\n")
+	for _, issue := range s.CommonIssues {
+		fmt.Fprintf(&b, "| %s | %s | %d | %s |\n", issue.RuleID, issue.Category, issue.Documents, cell(issue.Message))
+	}
+
+	if len(s.Trends) > 0 {
+		b.WriteString("\n## Score Trends\n\n| Document | Trend | Score | Change |\n|----------|-------|-------|--------|\n")
+		for _, t := range s.Trends {
+			fmt.Fprintf(&b, "| %s | %s | %d | %+d |\n", cell(t.Name), t.Sparkline(), t.Latest(), t.Change())
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// cell escapes text for a markdown table cell.
+func cell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+var dashboardTemplate = template.Must(template.New("dashboard").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>PR-FAQ Portfolio Dashboard</title>
+<style>
+body { font-family: system-ui, sans-serif; margin: 2rem auto; max-width: 960px; color: #222; }
+table { border-collapse: collapse; margin-bottom: 2rem; width: 100%; }
+th, td { border-bottom: 1px solid #ddd; padding: 0.4rem 0.6rem; text-align: left; }
+.bar { background: #4c72b0; height: 1rem; }
+</style>
+</head>
+<body>
+<h1>PR-FAQ Portfolio Dashboard</h1>
+<p><strong>Documents:</strong> {{.Summary.Documents}}<br>
+<strong>Average Score:</strong> {{printf "%.1f" .Summary.AverageScore}}/100</p>
+
+<h2>Status</h2>
+<table>
+<tr><th>Status</th><th>Documents</th></tr>
+{{range .Summary.Bands}}<tr><td>{{.Label}}</td><td>{{.Documents}}</td></tr>
+{{end}}</table>
+
+<h2>Score Distribution</h2>
+<table>
+<tr><th>Score</th><th>Documents</th><th></th></tr>
+{{range .Histogram}}<tr><td>{{.Min}}-{{.Max}}</td><td>{{.Documents}}</td><td><div class="bar" style="width: {{.Percent}}%"></div></td></tr>
+{{end}}</table>
+
+<h2>Category Averages</h2>
+<table>
+<tr><th>Category</th><th>Average</th><th>Max</th></tr>
+{{range .Summary.Categories}}<tr><td>{{.Name}}</td><td>{{printf "%.1f" .Average}}</td><td>{{.Max}}</td></tr>
+{{end}}</table>
+
+<h2>Worst Offenders</h2>
+<table>
+<tr><th>Document</th><th>Title</th><th>Score</th><th>Errors</th></tr>
+{{range .Summary.Worst}}<tr><td>{{.Name}}</td><td>{{.Title}}</td><td>{{.Score}}</td><td>{{.Errors}}</td></tr>
+{{end}}</table>
+
+<h2>Most Common Issues</h2>
+<table>
+<tr><th>Rule</th><th>Category</th><th>Documents</th><th>Example</th></tr>
+{{range .Summary.CommonIssues}}<tr><td>{{.RuleID}}</td><td>{{.Category}}</td><td>{{.Documents}}</td><td>{{.Message}}</td></tr>
+{{end}}</table>
+{{if .Trends}}
+<h2>Score Trends</h2>
+<table>
+<tr><th>Document</th><th>Trend</th><th>Score</th><th>Change</th></tr>
+{{range .Trends}}<tr><td>{{.Name}}</td><td><svg width="{{.Width}}" height="{{.Height}}" role="img" aria-label="{{.Scores}}"><polyline points="{{.Points}}" fill="none" stroke="#4c72b0" stroke-width="1.5"/></svg></td><td>{{.Latest}}</td><td>{{printf "%+d" .Change}}</td></tr>
+{{end}}</table>
+{{end}}</body>
+</html>
+`))
+
+// htmlBucket is a histogram row with its bar width as a percentage.
+type htmlBucket struct {
+	Bucket
+	Percent int
+}
+
+// htmlTrend is a score trend with its SVG sparkline.
+type htmlTrend struct {
+	Trend
+	Points        string
+	Width, Height int
+}
+
+// WriteHTML renders the summary as a self-contained HTML page.
+func WriteHTML(w io.Writer, s Summary) error {
+	data := struct {
+		Summary   Summary
+		Histogram []htmlBucket
+		Trends    []htmlTrend
+	}{Summary: s}
+	for _, b := range s.Histogram {
+		data.Histogram = append(data.Histogram, htmlBucket{Bucket: b, Percent: s.barLength(b.Documents) * 100 / histogramWidth})
+	}
+	for _, t := range s.Trends {
+		data.Trends = append(data.Trends, htmlTrend{Trend: t, Points: t.sparklinePoints(), Width: sparklineWidth, Height: sparklineHeight})
+	}
+	return dashboardTemplate.Execute(w, data)
+}
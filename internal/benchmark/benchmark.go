@@ -0,0 +1,163 @@
+// Package benchmark compares a document's scores with a corpus of
+// well-written press releases, so a score reads as "better than most
+// published releases" rather than as a bare number.
+package benchmark
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+
+	"github.com/bordenet/pr-faq-validator/internal/batch"
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+// corpus is the bundled set of launch announcements written in the style of
+// published press releases. Each has a dateline, customer quotes with
+// metrics, availability and pricing, boilerplate, and a short FAQ.
+//
+//go:embed corpus/*.md
+var corpus embed.FS
+
+// overall is the Stats key of the overall score.
+const overall = "Overall"
+
+// Stats are the scores of every corpus document, by category.
+type Stats struct {
+	Source    string           // "bundled corpus" or the corpus directory
+	Documents int              // number of documents scored
+	scores    map[string][]int // sorted ascending, keyed by category name or overall
+}
+
+// Bundled scores the bundled corpus with opts. The corpus is scored on every
+// call rather than shipped as precomputed statistics, so the comparison
+// always uses this release's rules and the caller's audience and document type.
+func Bundled(opts prfaq.Options) (*Stats, error) {
+	names, err := fs.Glob(corpus, "corpus/*.md")
+	if err != nil {
+		return nil, err
+	}
+	docs := make(map[string][]byte, len(names))
+	for _, name := range names {
+		data, err := corpus.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		docs[path.Base(name)] = data
+	}
+	return newStats("bundled corpus", docs, opts)
+}
+
+// FromDir scores the documents under dir with opts, for teams that benchmark
+// against their own collection of published releases.
+func FromDir(dir string, opts prfaq.Options) (*Stats, error) {
+	paths, err := batch.Find(dir)
+	if err != nil {
+		return nil, err
+	}
+	docs := make(map[string][]byte, len(paths))
+	for _, p := range paths {
+		data, err := os.ReadFile(p) //nolint:gosec // path comes from walking a user-provided directory
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		docs[p] = data
+	}
+	return newStats(dir, docs, opts)
+}
+
+func newStats(source string, docs map[string][]byte, opts prfaq.Options) (*Stats, error) {
+	stats := &Stats{Source: source, Documents: len(docs), scores: map[string][]int{}}
+	for name, data := range docs {
+		doc, err := prfaq.Parse(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		result, err := prfaq.Score(doc, opts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		stats.scores[overall] = append(stats.scores[overall], result.Score)
+		for _, c := range result.Categories {
+			stats.scores[c.Name] = append(stats.scores[c.Name], c.Score)
+		}
+	}
+	for _, s := range stats.scores {
+		slices.Sort(s)
+	}
+	return stats, nil
+}
+
+// Comparison places one score of a document within the corpus.
+type Comparison struct {
+	Category   string
+	Score      int
+	Max        int
+	Percentile int // share of corpus documents scoring lower, counting ties as half
+	Median     int // corpus median
+}
+
+// Compare places the overall score and each category of result within stats.
+func Compare(result prfaq.Result, stats *Stats) []Comparison {
+	out := []Comparison{stats.compare(overall, result.Score, 100)}
+	for _, c := range result.Categories {
+		out = append(out, stats.compare(c.Name, c.Score, c.Max))
+	}
+	return out
+}
+
+func (s *Stats) compare(category string, score, maxScore int) Comparison {
+	c := Comparison{Category: category, Score: score, Max: maxScore}
+	scores := s.scores[category]
+	if len(scores) == 0 {
+		return c
+	}
+	below, ties := 0, 0
+	for _, v := range scores {
+		switch {
+		case v < score:
+			below++
+		case v == score:
+			ties++
+		}
+	}
+	c.Percentile = (200*below + 100*ties) / (2 * len(scores))
+	c.Median = scores[len(scores)/2]
+	return c
+}
+
+// Write prints the comparisons as a table, e.g.
+// "Headline Quality    6/10   35th percentile   (corpus median 8)".
+func Write(w io.Writer, comparisons []Comparison, stats *Stats) error {
+	if _, err := fmt.Fprintf(w, "Benchmark against %d documents (%s)\n\n", stats.Documents, stats.Source); err != nil {
+		return err
+	}
+	for _, c := range comparisons {
+		_, err := fmt.Fprintf(w, "%-20s %3d/%-3d  %-16s (corpus median %d)\n",
+			c.Category, c.Score, c.Max, ordinal(c.Percentile)+" percentile", c.Median)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ordinal formats n as "1st", "22nd", "35th", and so on.
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,77 @@
+package benchmark
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+func TestBundled(t *testing.T) {
+	stats, err := Bundled(prfaq.Options{})
+	if err != nil {
+		t.Fatalf("Bundled() error = %v", err)
+	}
+	if stats.Documents < 5 {
+		t.Errorf("Documents = %d, want a corpus of at least 5", stats.Documents)
+	}
+	for category, scores := range stats.scores {
+		if len(scores) != stats.Documents {
+			t.Errorf("%s has %d scores, want %d", category, len(scores), stats.Documents)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	stats := &Stats{Documents: 4, scores: map[string][]int{
+		overall:            {50, 60, 70, 80},
+		"Headline Quality": {4, 6, 8, 8},
+	}}
+	result := prfaq.Result{Score: 70, Categories: []prfaq.Category{{Name: "Headline Quality", Score: 8, Max: 10}, {Name: "Unscored", Score: 1, Max: 5}}}
+
+	got := Compare(result, stats)
+	want := []Comparison{
+		{Category: overall, Score: 70, Max: 100, Percentile: 62, Median: 70},
+		{Category: "Headline Quality", Score: 8, Max: 10, Percentile: 75, Median: 8},
+		{Category: "Unscored", Score: 1, Max: 5},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Compare() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Compare()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFromDir(t *testing.T) {
+	dir := t.TempDir()
+	doc := "# Acme Launches Ledger Sync\n\n## Press Release\n\nSEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync.\n"
+	if err := os.WriteFile(filepath.Join(dir, "a.md"), []byte(doc), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	stats, err := FromDir(dir, prfaq.Options{})
+	if err != nil {
+		t.Fatalf("FromDir() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, Compare(prfaq.Result{Score: 100}, stats), stats); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "against 1 documents") || !strings.Contains(out, "100th percentile") {
+		t.Errorf("Write() = %q, want the corpus size and percentile", out)
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	for n, want := range map[int]string{0: "0th", 1: "1st", 2: "2nd", 3: "3rd", 11: "11th", 12: "12th", 22: "22nd", 35: "35th", 100: "100th", 101: "101st"} {
+		if got := ordinal(n); got != want {
+			t.Errorf("ordinal(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Package blame finds the commit and author that last changed each line of
+// a file, so findings can be routed to the contributor who wrote them.
+//
+// It runs the git command rather than reading the repository itself, so it
+// needs git on PATH. In exchange it follows the user's git configuration,
+// such as blame.ignoreRevsFile and mailmaps, and reads every repository
+// format git does.
+package blame
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrGit is returned when git cannot blame the file, for instance because it
+// is not in a repository.
+var ErrGit = errors.New("git blame failed")
+
+// ErrNoGit is returned when the git command is not installed.
+var ErrNoGit = errors.New("git not found on PATH")
+
+// Check reports ErrNoGit when the git command File runs is not installed.
+func Check() error {
+	if _, err := exec.LookPath("git"); err != nil {
+		return fmt.Errorf("%w: %w", ErrNoGit, err)
+	}
+	return nil
+}
+
+// Line is the last change to one line of a file.
+type Line struct {
+	Commit string // full hash; "" for a line not yet committed
+	Author string
+	Email  string
+	Time   time.Time
+}
+
+// Short returns the abbreviated commit hash, or "" for an uncommitted line.
+func (l Line) Short() string {
+	if len(l.Commit) > 7 {
+		return l.Commit[:7]
+	}
+	return l.Commit
+}
+
+// String describes the change, e.g. "3f2a9c1 by Jane Doe on 2026-03-04".
+func (l Line) String() string {
+	if l.Commit == "" {
+		return "not committed yet"
+	}
+	return fmt.Sprintf("%s by %s on %s", l.Short(), l.Author, l.Time.UTC().Format(time.DateOnly))
+}
+
+// File blames the file at path and returns its lines by 1-based line number.
+func File(path string) (map[int]Line, error) {
+	cmd := exec.Command("git", "-C", filepath.Dir(path), "blame", "--line-porcelain", "--", filepath.Base(path)) //nolint:gosec // path is user-provided CLI argument
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if errors.Is(err, exec.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %w", ErrNoGit, err)
+	}
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", ErrGit, msg)
+		}
+		return nil, fmt.Errorf("%w: %w", ErrGit, err)
+	}
+	return parse(out)
+}
+
+// parse reads git blame --line-porcelain output: for every line, a header
+// "<commit> <original line> <final line> [<group size>]", then "key value"
+// fields, then the line content prefixed with a tab.
+func parse(out []byte) (map[int]Line, error) {
+	lines := map[int]Line{}
+	var cur Line
+	final := 0
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+		if strings.HasPrefix(text, "\t") {
+			lines[final] = cur
+			continue
+		}
+		key, value, _ := strings.Cut(text, " ")
+		switch key {
+		case "author":
+			cur.Author = value
+		case "author-mail":
+			cur.Email = strings.Trim(value, "<>")
+		case "author-time":
+			secs, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: bad author-time %q", ErrGit, value)
+			}
+			cur.Time = time.Unix(secs, 0)
+		default:
+			if fields := strings.Fields(text); len(fields) >= 3 && isHash(key) {
+				n, err := strconv.Atoi(fields[2])
+				if err != nil {
+					return nil, fmt.Errorf("%w: bad header %q", ErrGit, text)
+				}
+				cur, final = Line{Commit: key}, n
+				// Lines not yet committed carry an all-zero hash
+				if strings.Trim(key, "0") == "" {
+					cur.Commit = ""
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGit, err)
+	}
+	return lines, nil
+}
+
+// isHash reports whether s is a full SHA-1 or SHA-256 commit hash.
+func isHash(s string) bool {
+	if len(s) != 40 && len(s) != 64 {
+		return false
+	}
+	return strings.Trim(s, "0123456789abcdef") == ""
+}
@@ -0,0 +1,105 @@
+package blame
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const porcelain = `ec99b2f4eab4c44389cbdd77713b6ddf8f6f5573 1 1 2
+author Jane Doe
+author-mail <jane@example.com>
+author-time 1772582400
+author-tz +0000
+summary initial
+boundary
+filename prfaq.md
+	# Title
+ec99b2f4eab4c44389cbdd77713b6ddf8f6f5573 2 2
+author Jane Doe
+author-mail <jane@example.com>
+author-time 1772582400
+author-tz +0000
+summary initial
+filename prfaq.md
+	
+0000000000000000000000000000000000000000 3 3 1
+author Not Committed Yet
+author-mail <not.committed.yet>
+author-time 1772668800
+author-tz +0000
+summary Version of prfaq.md from prfaq.md
+filename prfaq.md
+	author 1 2 3
+`
+
+func TestParse(t *testing.T) {
+	lines, err := parse([]byte(porcelain))
+	if err != nil {
+		t.Fatalf("parse() error = %v", err)
+	}
+	if len(lines) != 3 {
+		t.Fatalf("parse() = %d lines, want 3", len(lines))
+	}
+	tests := []struct {
+		line int
+		want string
+	}{
+		{1, "ec99b2f by Jane Doe on 2026-03-04"},
+		{2, "ec99b2f by Jane Doe on 2026-03-04"},
+		{3, "not committed yet"},
+	}
+	for _, tt := range tests {
+		if got := lines[tt.line].String(); got != tt.want {
+			t.Errorf("line %d = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+	if lines[1].Email != "jane@example.com" || !lines[1].Time.Equal(time.Unix(1772582400, 0)) {
+		t.Errorf("line 1 = %+v, want email and author time", lines[1])
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	if err := Check(); !errors.Is(err, ErrNoGit) {
+		t.Errorf("Check() without git error = %v, want ErrNoGit", err)
+	}
+	if _, err := File(filepath.Join(t.TempDir(), "prfaq.md")); !errors.Is(err, ErrNoGit) {
+		t.Errorf("File() without git error = %v, want ErrNoGit", err)
+	}
+}
+
+func TestFile(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "prfaq.md")
+	if err := os.WriteFile(path, []byte("# Title\n\nBody\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := File(path); !errors.Is(err, ErrGit) {
+		t.Errorf("File() outside a repository error = %v, want ErrGit", err)
+	}
+
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "prfaq.md"},
+		{"-c", "user.name=Jane Doe", "-c", "user.email=jane@example.com", "commit", "-q", "-m", "initial"},
+	} {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...) //nolint:gosec // test code
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	lines, err := File(path)
+	if err != nil {
+		t.Fatalf("File() error = %v", err)
+	}
+	if len(lines) != 3 || lines[3].Author != "Jane Doe" || lines[3].Commit == "" {
+		t.Errorf("File() = %+v, want 3 lines committed by Jane Doe", lines)
+	}
+}
@@ -0,0 +1,81 @@
+// Package buildinfo describes the running binary. Release builds set the
+// variables with -ldflags, for example:
+//
+//	go build -ldflags "-X github.com/bordenet/pr-faq-validator/internal/buildinfo.Version=v1.2.3"
+//
+// Builds without ldflags fall back to the module and VCS information the Go
+// toolchain embeds, so "go install ...@v1.2.3" still reports v1.2.3.
+package buildinfo
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+)
+
+// Set at build time with -ldflags "-X".
+var (
+	Version = "dev"
+	Commit  = ""
+	Date    = ""
+)
+
+// Info is the build information of the running binary.
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	Date      string `json:"date,omitempty"`
+	GoVersion string `json:"go_version"`
+	Platform  string `json:"platform"`
+	// Rules is the scoring model version. Packages that import buildinfo
+	// define it, so Get leaves it for the caller to fill in.
+	Rules string `json:"rules,omitempty"`
+}
+
+// Get returns the build information, preferring the ldflags values.
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		Date:      Date,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	if info.Version == "dev" && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+		info.Version = bi.Main.Version
+	}
+	for _, s := range bi.Settings {
+		switch {
+		case s.Key == "vcs.revision" && info.Commit == "":
+			info.Commit = s.Value
+		case s.Key == "vcs.time" && info.Date == "":
+			info.Date = s.Value
+		}
+	}
+	return info
+}
+
+// String is the one-line form used in reports, such as
+// "v1.2.3 (commit 1a2b3c4, built 2026-01-02T15:04:05Z)".
+func (i Info) String() string {
+	s := i.Version
+	switch {
+	case i.Commit != "" && i.Date != "":
+		s += fmt.Sprintf(" (commit %s, built %s)", shortCommit(i.Commit), i.Date)
+	case i.Commit != "":
+		s += fmt.Sprintf(" (commit %s)", shortCommit(i.Commit))
+	}
+	return s
+}
+
+func shortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	return commit
+}
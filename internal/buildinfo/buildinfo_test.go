@@ -0,0 +1,36 @@
+package buildinfo
+
+import "testing"
+
+func TestInfo_String(t *testing.T) {
+	tests := []struct {
+		name string
+		info Info
+		want string
+	}{
+		{"version only", Info{Version: "dev"}, "dev"},
+		{"commit", Info{Version: "v1.2.3", Commit: "1a2b3c4d5e6f"}, "v1.2.3 (commit 1a2b3c4)"},
+		{"commit and date", Info{Version: "v1.2.3", Commit: "1a2b3c4", Date: "2026-01-02T15:04:05Z"}, "v1.2.3 (commit 1a2b3c4, built 2026-01-02T15:04:05Z)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.info.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGet_PrefersLdflags(t *testing.T) {
+	oldVersion, oldCommit := Version, Commit
+	t.Cleanup(func() { Version, Commit = oldVersion, oldCommit })
+	Version, Commit = "v9.9.9", "abcdef0"
+
+	info := Get()
+	if info.Version != "v9.9.9" || info.Commit != "abcdef0" {
+		t.Errorf("Get() = %+v, want the ldflags values", info)
+	}
+	if info.GoVersion == "" || info.Platform == "" {
+		t.Errorf("Get() = %+v, want the Go version and platform", info)
+	}
+}
@@ -0,0 +1,109 @@
+// Package clipboard copies text to the system clipboard. It uses the
+// platform's clipboard command when one is installed, and otherwise an OSC 52
+// escape sequence, which most terminals honor, including over SSH.
+package clipboard
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrEmpty is returned by Copy when there is no text to copy.
+var ErrEmpty = errors.New("nothing to copy")
+
+// Terminal is the method Copy reports when it wrote an OSC 52 sequence.
+const Terminal = "terminal"
+
+// command is a program that reads the text to copy on stdin.
+type command struct {
+	name string
+	args []string
+}
+
+// commands returns the clipboard programs to try on goos, in order.
+func commands(goos string, getenv func(string) string) []command {
+	switch goos {
+	case "darwin":
+		return []command{{"pbcopy", nil}}
+	case "windows":
+		return []command{{"clip", nil}}
+	}
+	var out []command
+	if getenv("WAYLAND_DISPLAY") != "" {
+		out = append(out, command{"wl-copy", nil})
+	}
+	return append(out,
+		command{"xclip", []string{"-selection", "clipboard"}},
+		command{"xsel", []string{"--clipboard", "--input"}},
+		command{"clip.exe", nil}, // WSL
+	)
+}
+
+// copier is Copy with its environment injected, for tests.
+type copier struct {
+	goos     string
+	getenv   func(string) string
+	lookPath func(string) (string, error)
+	run      func(path string, args []string, stdin string) error
+	terminal io.Writer
+}
+
+// Copy puts text on the clipboard and returns how: the name of the
+// clipboard command, or Terminal when it fell back to OSC 52.
+func Copy(text string) (string, error) {
+	return copier{
+		goos:     runtime.GOOS,
+		getenv:   os.Getenv,
+		lookPath: exec.LookPath,
+		run:      run,
+		terminal: os.Stderr, // stdout belongs to the TUI renderer
+	}.copy(text)
+}
+
+func (c copier) copy(text string) (string, error) {
+	if strings.TrimSpace(text) == "" {
+		return "", ErrEmpty
+	}
+	// Over SSH the local clipboard is the one the user wants, and only the terminal can reach it
+	if c.getenv("SSH_TTY") == "" {
+		for _, cmd := range commands(c.goos, c.getenv) {
+			path, err := c.lookPath(cmd.name)
+			if err != nil {
+				continue
+			}
+			if err := c.run(path, cmd.args, text); err != nil {
+				return "", fmt.Errorf("%s: %w", cmd.name, err)
+			}
+			return cmd.name, nil
+		}
+	}
+	if _, err := io.WriteString(c.terminal, osc52(text, c.getenv("TMUX") != "")); err != nil {
+		return "", fmt.Errorf("failed to write to the terminal: %w", err)
+	}
+	return Terminal, nil
+}
+
+// osc52 returns the escape sequence that asks the terminal to set its
+// clipboard to text. Inside tmux it is wrapped so tmux passes it through.
+func osc52(text string, tmux bool) string {
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+	if tmux {
+		return "\x1bPtmux;\x1b" + seq + "\x1b\\"
+	}
+	return seq
+}
+
+// run starts the clipboard program at path with text on stdin. Its output
+// is discarded rather than captured: xclip keeps running to serve the
+// selection, and would hold a captured pipe open.
+func run(path string, args []string, stdin string) error {
+	cmd := exec.Command(path, args...) //nolint:gosec // path is a clipboard program found on PATH
+	cmd.Stdin = strings.NewReader(stdin)
+	return cmd.Run()
+}
@@ -0,0 +1,69 @@
+package clipboard
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestCopy(t *testing.T) {
+	tests := []struct {
+		name      string
+		goos      string
+		env       map[string]string
+		installed []string
+		want      string
+	}{
+		{"macOS", "darwin", nil, []string{"pbcopy"}, "pbcopy"},
+		{"Windows", "windows", nil, []string{"clip"}, "clip"},
+		{"Wayland", "linux", map[string]string{"WAYLAND_DISPLAY": "wayland-0"}, []string{"wl-copy", "xclip"}, "wl-copy"},
+		{"X11", "linux", nil, []string{"wl-copy", "xsel"}, "xsel"},
+		{"no command", "linux", nil, nil, Terminal},
+		{"SSH", "linux", map[string]string{"SSH_TTY": "/dev/pts/0"}, []string{"xclip"}, Terminal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ran string
+			var terminal strings.Builder
+			c := copier{
+				goos:   tt.goos,
+				getenv: func(k string) string { return tt.env[k] },
+				lookPath: func(name string) (string, error) {
+					for _, n := range tt.installed {
+						if n == name {
+							return "/usr/bin/" + name, nil
+						}
+					}
+					return "", exec.ErrNotFound
+				},
+				run: func(path string, _ []string, stdin string) error {
+					ran = path + " <- " + stdin
+					return nil
+				},
+				terminal: &terminal,
+			}
+			got, err := c.copy("hello")
+			if err != nil || got != tt.want {
+				t.Fatalf("copy() = %q, %v; want %q", got, err, tt.want)
+			}
+			if tt.want == Terminal {
+				if terminal.String() != "\x1b]52;c;aGVsbG8=\a" {
+					t.Errorf("terminal got %q, want the OSC 52 sequence", terminal.String())
+				}
+			} else if ran != "/usr/bin/"+tt.want+" <- hello" {
+				t.Errorf("ran %q", ran)
+			}
+		})
+	}
+
+	if _, err := (copier{}).copy("  \n"); !errors.Is(err, ErrEmpty) {
+		t.Errorf("copy() of blank text error = %v, want ErrEmpty", err)
+	}
+}
+
+func TestOSC52_Tmux(t *testing.T) {
+	if got := osc52("hi", true); got != "\x1bPtmux;\x1b\x1b]52;c;aGk=\a\x1b\\" {
+		t.Errorf("osc52() = %q", got)
+	}
+}
@@ -0,0 +1,163 @@
+// Package completion generates shell completion scripts for the CLI.
+//
+// The scripts complete subcommands and known flag values from the Spec, and
+// read flag names from the binary's own -h output at completion time, so
+// they never go stale when flags are added.
+package completion
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Shells lists the supported shells.
+var Shells = []string{"bash", "zsh", "fish"}
+
+// ErrUnknownShell is returned for shells not listed in Shells.
+var ErrUnknownShell = errors.New("unknown shell")
+
+// Command is a subcommand of the program.
+type Command struct {
+	Name string
+	Help string
+}
+
+// Spec describes what to complete.
+type Spec struct {
+	Program     string
+	Subcommands []Command
+	// Values are the candidates for the argument after a word, such as the
+	// formats after "-format". Other arguments complete as file names.
+	Values map[string][]string
+}
+
+// Write writes the completion script for shell.
+func Write(w io.Writer, shell string, spec Spec) error {
+	t, ok := scripts[shell]
+	if !ok {
+		return fmt.Errorf("%w: %q (want %s)", ErrUnknownShell, shell, strings.Join(Shells, ", "))
+	}
+	return t.Execute(w, newData(spec))
+}
+
+type value struct {
+	Word       string
+	Candidates string
+}
+
+type data struct {
+	Program     string
+	Func        string // shell function name prefix
+	Subcommands []Command
+	Names       string // space-separated subcommand names
+	Values      []value
+}
+
+func newData(spec Spec) data {
+	d := data{
+		Program:     spec.Program,
+		Func:        "_" + strings.NewReplacer("-", "_", ".", "_").Replace(spec.Program),
+		Subcommands: spec.Subcommands,
+	}
+	names := make([]string, len(spec.Subcommands))
+	for i, c := range spec.Subcommands {
+		names[i] = c.Name
+	}
+	d.Names = strings.Join(names, " ")
+
+	for word, candidates := range spec.Values {
+		d.Values = append(d.Values, value{Word: word, Candidates: strings.Join(candidates, " ")})
+	}
+	sort.Slice(d.Values, func(i, j int) bool { return d.Values[i].Word < d.Values[j].Word })
+	return d
+}
+
+// flagNames extracts "-name" from the flag package's usage output.
+const flagNames = `sed -n 's/^  \(-[^ ]*\).*/\1/p'`
+
+var scripts = map[string]*template.Template{
+	"bash": template.Must(template.New("bash").Parse(`# bash completion for {{.Program}}
+# Load with: source <({{.Program}} completion bash)
+{{.Func}}() {
+    local cur="${COMP_WORDS[COMP_CWORD]}" prev="${COMP_WORDS[COMP_CWORD-1]}" sub=""
+    if [[ ${COMP_CWORD} -gt 1 && " {{.Names}} " == *" ${COMP_WORDS[1]} "* ]]; then
+        sub="${COMP_WORDS[1]}"
+    fi
+
+    case "$prev" in
+{{- range .Values}}
+        {{.Word}}) COMPREPLY=($(compgen -W "{{.Candidates}}" -- "$cur")); return ;;
+{{- end}}
+    esac
+
+    if [[ "$cur" == -* ]]; then
+        COMPREPLY=($(compgen -W "$("${COMP_WORDS[0]}" $sub -h 2>&1 | ` + flagNames + `)" -- "$cur"))
+    elif [[ ${COMP_CWORD} -eq 1 ]]; then
+        COMPREPLY=($(compgen -W "{{.Names}}" -- "$cur"))
+    else
+        COMPREPLY=($(compgen -f -- "$cur"))
+    fi
+}
+complete -o filenames -F {{.Func}} {{.Program}}
+`)),
+
+	"zsh": template.Must(template.New("zsh").Parse(`#compdef {{.Program}}
+# zsh completion for {{.Program}}
+# Load with: source <({{.Program}} completion zsh)
+{{.Func}}() {
+    local sub=""
+    if (( CURRENT > 2 )) && [[ " {{.Names}} " == *" ${words[2]} "* ]]; then
+        sub=${words[2]}
+    fi
+
+    case ${words[CURRENT-1]} in
+{{- range .Values}}
+        {{.Word}}) compadd -- {{.Candidates}}; return ;;
+{{- end}}
+    esac
+
+    if [[ ${words[CURRENT]} == -* ]]; then
+        compadd -- ${(f)"$(${words[1]} ${sub} -h 2>&1 | ` + flagNames + `)"}
+    elif (( CURRENT == 2 )); then
+        local -a subcommands=({{range .Subcommands}}'{{.Name}}:{{.Help}}' {{end}})
+        _describe 'command' subcommands
+    else
+        _files
+    fi
+}
+
+if [[ "${funcstack[1]}" == "{{.Func}}" ]]; then
+    {{.Func}} "$@"
+else
+    compdef {{.Func}} {{.Program}}
+fi
+`)),
+
+	"fish": template.Must(template.New("fish").Parse(`# fish completion for {{.Program}}
+# Load with: {{.Program}} completion fish | source
+function _{{.Func}}_flags
+    set -l tokens (commandline -opc)
+    set -l sub
+    if test (count $tokens) -gt 1; and contains -- $tokens[2] {{.Names}}
+        set sub $tokens[2]
+    end
+    $tokens[1] $sub -h 2>&1 | string match -r '^  -\S+' | string trim
+end
+
+function _{{.Func}}_prev
+    test (commandline -opc)[-1] = $argv[1]
+end
+
+complete -c {{.Program}} -n 'string match -q -- "-*" (commandline -ct)' -f -a '(_{{.Func}}_flags)'
+{{- range .Subcommands}}
+complete -c {{$.Program}} -n '__fish_use_subcommand' -f -a {{.Name}} -d '{{.Help}}'
+{{- end}}
+{{- range .Values}}
+complete -c {{$.Program}} -n '_{{$.Func}}_prev {{.Word}}' -f -a '{{.Candidates}}'
+{{- end}}
+`)),
+}
@@ -0,0 +1,51 @@
+package completion
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+var testSpec = Spec{
+	Program:     "pr-faq-validator",
+	Subcommands: []Command{{Name: "lsp", Help: "Run the language server"}, {Name: "doctor", Help: "Diagnose"}},
+	Values:      map[string][]string{"-format": {"json", "gcc"}},
+}
+
+func TestWrite(t *testing.T) {
+	for _, shell := range Shells {
+		t.Run(shell, func(t *testing.T) {
+			var sb strings.Builder
+			if err := Write(&sb, shell, testSpec); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			for _, want := range []string{"_pr_faq_validator", "lsp", "doctor", "-format", "json gcc", " -h 2>&1"} {
+				if !strings.Contains(sb.String(), want) {
+					t.Errorf("%s script missing %q\n%s", shell, want, sb.String())
+				}
+			}
+		})
+	}
+
+	if err := Write(&strings.Builder{}, "tcsh", testSpec); !errors.Is(err, ErrUnknownShell) {
+		t.Errorf("Write(tcsh) error = %v, want ErrUnknownShell", err)
+	}
+}
+
+func TestWrite_BashSyntax(t *testing.T) {
+	bash, err := exec.LookPath("bash")
+	if err != nil {
+		t.Skip("bash not installed")
+	}
+	var sb strings.Builder
+	if err := Write(&sb, "bash", testSpec); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	cmd := exec.Command(bash, "-n") //nolint:gosec // test code
+	cmd.Stdin = strings.NewReader(sb.String())
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("bash -n: %v\n%s", err, out)
+	}
+}
@@ -0,0 +1,167 @@
+// Package config loads optional validator settings from a YAML file.
+package config
+
+import "errors"
+
+// ErrInvalid is returned when an explicitly named or present config file
+// cannot be read or parsed.
+var ErrInvalid = errors.New("invalid config")
+
+// DefaultFile is the config file looked up in the working directory when no path is given.
+const DefaultFile = ".prfaq-validator.yaml"
+
+// Config holds all settings read from the config file.
+type Config struct {
+	// MinScore is the overall score below which a run exits with code 1.
+	// Zero disables the threshold; -min-score overrides it.
+	MinScore    int               `yaml:"min_score"`
+	LLM         LLMConfig         `yaml:"llm"`
+	Tickets     TicketsConfig     `yaml:"tickets"`
+	Export      ExportConfig      `yaml:"export"`
+	Boilerplate BoilerplateConfig `yaml:"boilerplate"`
+	Hedging     HedgingConfig     `yaml:"hedging"`
+	Compliance  ComplianceConfig  `yaml:"compliance"`
+	Telemetry   TelemetryConfig   `yaml:"telemetry"`
+	// Audience is the readership scores are tuned for: general, consumer,
+	// enterprise, developer, or internal. -audience overrides it.
+	Audience string `yaml:"audience"`
+	// Tone is the register documents are written in when their front matter
+	// sets none: formal, conversational, or technical. -tone overrides both.
+	Tone string `yaml:"tone"`
+	// RulesVersion pins the scoring model, e.g. "rules/v1", so a release that
+	// retunes the scoring fails loudly instead of changing scores. -rules-version
+	// overrides it.
+	RulesVersion string `yaml:"rules_version"`
+	// Weights scale categories of the overall score, by name, e.g.
+	// "Quote Quality: 0.5", from 0 to 3. They override the document type's
+	// weights; unlisted categories keep them.
+	Weights map[string]float64 `yaml:"weights"`
+	Scoring ScoringConfig      `yaml:"scoring"`
+}
+
+// LLMConfig controls requests to the AI provider.
+type LLMConfig struct {
+	// RequestsPerMinute caps AI requests per minute (0 for no limit).
+	RequestsPerMinute int `yaml:"requests_per_minute"`
+	// TokensPerMinute caps estimated prompt and response tokens per minute (0 for no limit).
+	TokensPerMinute int `yaml:"tokens_per_minute"`
+	// Redact turns on -redact for every run.
+	Redact bool `yaml:"redact"`
+	// RedactTerms are redacted along with the detected entities, e.g.
+	// codenames and unannounced product names.
+	RedactTerms []string `yaml:"redact_terms"`
+	// AllowPII sends content the pre-flight scan flags, with a warning, as
+	// -allow-pii does.
+	AllowPII bool `yaml:"allow_pii"`
+	// PIIAllowlist are values the scan never flags, such as a public support
+	// address.
+	PIIAllowlist []string `yaml:"pii_allowlist"`
+	// InternalDomains are domains whose hostnames the scan flags, e.g.
+	// corp.example.com.
+	InternalDomains []string `yaml:"internal_domains"`
+	// Proxy is the URL of the proxy every AI request goes through, e.g.
+	// http://proxy.corp.example.com:3128. Empty uses HTTPS_PROXY.
+	Proxy string `yaml:"proxy"`
+	// LogOmitContent keeps the prompts and responses out of -llm-log
+	// transcripts, which then record only metadata and token counts.
+	LogOmitContent bool `yaml:"log_omit_content"`
+}
+
+// ScoringConfig selects how the overall score is computed.
+type ScoringConfig struct {
+	// Mode is "categories", the default, which adds up the category points,
+	// or "severity", which starts at 100 and takes off points per finding.
+	Mode string `yaml:"mode"`
+	// SeverityCosts are the points a finding costs in severity mode, by
+	// severity, e.g. "warning: 2". Unlisted severities keep their defaults.
+	SeverityCosts map[string]int `yaml:"severity_costs"`
+}
+
+// HedgingConfig controls the findings for hedging phrases such as "we hope".
+type HedgingConfig struct {
+	// Severity is the severity of hedging findings: error, warning (the
+	// default), or info. It does not change the points hedging costs.
+	Severity string `yaml:"severity"`
+}
+
+// ComplianceConfig holds the disclosure rules the press release must follow.
+type ComplianceConfig struct {
+	// PublicCompany requires a safe-harbor statement when the press release
+	// makes forward-looking statements.
+	PublicCompany bool `yaml:"public_company"`
+	// LegalPhrases are screened in the press release along with the built-in
+	// guarantees and superiority claims, e.g. "certified".
+	LegalPhrases []string `yaml:"legal_phrases"`
+	// RegisteredMarks must carry ® on their first use in the press release.
+	RegisteredMarks []string `yaml:"registered_marks"`
+	// BrandNames are the canonical spellings of product and company names,
+	// e.g. "PR-FAQ Validator". Other spellings and casings are flagged.
+	BrandNames []string `yaml:"brand_names"`
+	// CodenamePatterns are regular expressions matching internal codenames,
+	// e.g. "Project [A-Z][a-z]+". Matches in the press release or external
+	// FAQ are reported as leaks.
+	CodenamePatterns []string `yaml:"codename_patterns"`
+	// LegalStrict reports the legal screening findings as errors, as
+	// -legal-strict does.
+	LegalStrict bool `yaml:"legal_strict"`
+}
+
+// TelemetryConfig opts in to anonymous telemetry.
+type TelemetryConfig struct {
+	// Endpoint is the http or https URL each run posts its score
+	// distribution and rule hit counts to. Empty, the default, sends nothing;
+	// -telemetry overrides it and -no-telemetry turns it off.
+	Endpoint string `yaml:"endpoint"`
+}
+
+// TicketsConfig controls ticket creation for critical findings.
+type TicketsConfig struct {
+	// Provider is the issue tracker to use: "jira" or "linear".
+	Provider string `yaml:"provider"`
+	// Project is the Jira project key or the Linear team ID.
+	Project string `yaml:"project"`
+	// Labels are attached to every ticket (label IDs for Linear).
+	Labels []string `yaml:"labels"`
+	// BaseURL is the Jira site URL, e.g. https://example.atlassian.net.
+	BaseURL string `yaml:"base_url"`
+	// IssueType is the Jira issue type for new tickets (default "Task").
+	IssueType string `yaml:"issue_type"`
+}
+
+// ExportConfig controls where -export publishes the report.
+type ExportConfig struct {
+	// Provider is the destination: "notion" or "gdocs".
+	Provider string `yaml:"provider"`
+	// Parent is the Notion page ID to create the report under, or the ID of
+	// the Google Doc to comment on.
+	Parent string `yaml:"parent"`
+}
+
+// BoilerplateConfig holds the company facts "pr-faq-validator boilerplate"
+// drafts an "About <Company>" section from.
+type BoilerplateConfig struct {
+	Company      string `yaml:"company"`
+	Founded      string `yaml:"founded"`
+	Headquarters string `yaml:"headquarters"`
+	// Mission completes the sentence "<Company> is on a mission to ...".
+	Mission string `yaml:"mission"`
+	// Description is optional further sentences, such as scale or customers.
+	Description  string `yaml:"description"`
+	URL          string `yaml:"url"`
+	ContactName  string `yaml:"contact_name"`
+	ContactEmail string `yaml:"contact_email"`
+	ContactPhone string `yaml:"contact_phone"`
+}
+
+// Load reads the config file at path. If path is empty, DefaultFile is used
+// when present and an empty config is returned when it is not.
+func Load(path string) (*Config, error) {
+	return LoadOver(nil, path)
+}
+
+// LoadOver reads the config file at path, as Load does, layered over policy
+// and under the user's config file; see LoadLayers. A nil policy is Load.
+func LoadOver(policy *Policy, path string) (*Config, error) {
+	cfg, _, err := LoadLayers(policy, path)
+	return cfg, err
+}
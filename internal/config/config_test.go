@@ -0,0 +1,124 @@
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("parses tickets section", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		content := `tickets:
+  provider: jira
+  project: DOCS
+  labels: [prfaq, review]
+  base_url: https://example.atlassian.net
+`
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+
+		cfg, err := Load(path)
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		if cfg.Tickets.Provider != "jira" {
+			t.Errorf("Provider = %q, want %q", cfg.Tickets.Provider, "jira")
+		}
+		if cfg.Tickets.Project != "DOCS" {
+			t.Errorf("Project = %q, want %q", cfg.Tickets.Project, "DOCS")
+		}
+		if len(cfg.Tickets.Labels) != 2 {
+			t.Errorf("Labels = %v, want 2 labels", cfg.Tickets.Labels)
+		}
+	})
+
+	t.Run("parses boilerplate section", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		content := `boilerplate:
+  company: Acme
+  founded: "2019"
+  contact_email: press@acme.com
+`
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+
+		cfg, err := Load(path)
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		if b := cfg.Boilerplate; b.Company != "Acme" || b.Founded != "2019" || b.ContactEmail != "press@acme.com" {
+			t.Errorf("Boilerplate = %+v", b)
+		}
+	})
+
+	t.Run("missing explicit file is an error", func(t *testing.T) {
+		_, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+		if !errors.Is(err, ErrInvalid) || !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Load() error = %v, want ErrInvalid wrapping fs.ErrNotExist", err)
+		}
+	})
+
+	t.Run("missing default file yields empty config", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		cfg, err := Load("")
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		if cfg.Tickets.Provider != "" {
+			t.Errorf("expected empty config, got %+v", cfg)
+		}
+	})
+
+	t.Run("invalid yaml is an error", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "bad.yaml")
+		if err := os.WriteFile(path, []byte("tickets: [unclosed"), 0600); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+		if _, err := Load(path); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Load() error = %v, want ErrInvalid", err)
+		}
+	})
+
+	t.Run("parses llm rate limits", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		if err := os.WriteFile(path, []byte("llm:\n  requests_per_minute: 50\n  tokens_per_minute: 40000\n"), 0600); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+		cfg, err := Load(path)
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		if cfg.LLM.RequestsPerMinute != 50 || cfg.LLM.TokensPerMinute != 40000 {
+			t.Errorf("LLM = %+v", cfg.LLM)
+		}
+
+		if err := os.WriteFile(path, []byte("llm:\n  tokens_per_minute: -1\n"), 0600); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+		if _, err := Load(path); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Load() error = %v, want ErrInvalid for a negative limit", err)
+		}
+
+		if err := os.WriteFile(path, []byte("llm:\n  proxy: proxy.example.com:3128\n"), 0600); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+		if _, err := Load(path); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Load() error = %v, want ErrInvalid for a proxy without a scheme", err)
+		}
+	})
+
+	t.Run("min_score out of range is an error", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		if err := os.WriteFile(path, []byte("min_score: 120\n"), 0600); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+		if _, err := Load(path); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Load() error = %v, want ErrInvalid", err)
+		}
+	})
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultOrigin is the origin of a setting no layer sets.
+const DefaultOrigin = "default"
+
+// userFile returns the user's config file. It is a variable so tests do not
+// read the real one.
+var userFile = UserFile
+
+// UserFile returns the user's config file, e.g.
+// ~/.config/pr-faq-validator/config.yaml on Linux.
+func UserFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("no user config directory: %w", err)
+	}
+	return filepath.Join(dir, "pr-faq-validator", "config.yaml"), nil
+}
+
+// Origins maps each setting, by its dotted key such as "llm.redact", to the
+// layer that set it: a policy URL or path, or a config file path.
+type Origins map[string]string
+
+// Of returns the origin of the setting at key, or DefaultOrigin.
+func (o Origins) Of(key string) string {
+	if origin, ok := o[key]; ok {
+		return origin
+	}
+	return DefaultOrigin
+}
+
+// layer is one source of settings.
+type layer struct {
+	name string
+	data []byte
+}
+
+// LoadLayers merges the config layers, each overriding the ones before it:
+// the built-in defaults, the organization policy, the config file at path
+// (DefaultFile when path is empty and the file exists), and the user's
+// config file when it exists. Command-line flags override the result. It
+// also returns where each setting came from.
+func LoadLayers(policy *Policy, path string) (*Config, Origins, error) {
+	var layers []layer
+	if policy != nil {
+		layers = append(layers, layer{"policy " + policy.Source, policy.Data})
+	}
+
+	explicit := path != ""
+	if !explicit {
+		path = DefaultFile
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // path is user-provided CLI argument
+	switch {
+	case err == nil:
+		layers = append(layers, layer{path, data})
+	case !explicit && errors.Is(err, fs.ErrNotExist):
+	default:
+		return nil, nil, fmt.Errorf("%w: failed to read %s: %w", ErrInvalid, path, err)
+	}
+
+	if user, err := userFile(); err == nil && user != "" {
+		data, err := os.ReadFile(user) //nolint:gosec // the user's own config file
+		switch {
+		case err == nil:
+			layers = append(layers, layer{user, data})
+		case !errors.Is(err, fs.ErrNotExist):
+			return nil, nil, fmt.Errorf("%w: failed to read %s: %w", ErrInvalid, user, err)
+		}
+	}
+
+	var cfg Config
+	origins := Origins{}
+	for _, l := range layers {
+		if err := yaml.Unmarshal(l.data, &cfg); err != nil {
+			return nil, nil, fmt.Errorf("%w: failed to parse %s: %w", ErrInvalid, l.name, err)
+		}
+		var doc yaml.Node
+		if err := yaml.Unmarshal(l.data, &doc); err != nil {
+			return nil, nil, fmt.Errorf("%w: failed to parse %s: %w", ErrInvalid, l.name, err)
+		}
+		if len(doc.Content) > 0 {
+			recordOrigins(doc.Content[0], "", l.name, origins)
+		}
+	}
+
+	if cfg.MinScore < 0 || cfg.MinScore > 100 {
+		return nil, nil, fmt.Errorf("%w: %s: min_score %d is outside 0-100", ErrInvalid, origins.Of("min_score"), cfg.MinScore)
+	}
+	if cfg.LLM.RequestsPerMinute < 0 || cfg.LLM.TokensPerMinute < 0 {
+		origin := origins.Of("llm.requests_per_minute")
+		if cfg.LLM.TokensPerMinute < 0 {
+			origin = origins.Of("llm.tokens_per_minute")
+		}
+		return nil, nil, fmt.Errorf("%w: %s: llm rate limits cannot be negative", ErrInvalid, origin)
+	}
+	if cfg.LLM.Proxy != "" {
+		if u, err := url.Parse(cfg.LLM.Proxy); err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, nil, fmt.Errorf("%w: %s: llm.proxy %q is not a URL such as http://proxy.example.com:3128", ErrInvalid, origins.Of("llm.proxy"), cfg.LLM.Proxy)
+		}
+	}
+	return &cfg, origins, nil
+}
+
+// recordOrigins records name as the origin of every setting in the mapping
+// node, under prefix. A nested mapping records its settings, not itself; a
+// list is one setting, as a later layer replaces it whole.
+func recordOrigins(node *yaml.Node, prefix, name string, origins Origins) {
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := prefix + node.Content[i].Value
+		if value := node.Content[i+1]; value.Kind == yaml.MappingNode {
+			recordOrigins(value, key+".", name, origins)
+		} else {
+			origins[key] = name
+		}
+	}
+}
+
+// Show writes cfg as YAML with the origin of each setting as a comment.
+func Show(w io.Writer, cfg *Config, origins Origins) error {
+	var doc yaml.Node
+	if err := doc.Encode(cfg); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	annotate(&doc, "", origins)
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	return enc.Close()
+}
+
+// annotate sets the comment of each setting in the mapping node to its origin.
+func annotate(node *yaml.Node, prefix string, origins Origins) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if value.Kind == yaml.MappingNode {
+			annotate(value, prefix+key.Value+".", origins)
+			continue
+		}
+		comment := origins.Of(prefix + key.Value)
+		if value.Kind == yaml.SequenceNode && len(value.Content) == 0 {
+			// an empty list is written inline, after the key's comment would go
+			value.LineComment = comment
+		} else {
+			key.LineComment = comment
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestMain keeps the tests from reading the real user config file.
+func TestMain(m *testing.M) {
+	userFile = func() (string, error) { return "", nil }
+	os.Exit(m.Run())
+}
+
+func TestLoadLayers(t *testing.T) {
+	dir := t.TempDir()
+	repo := filepath.Join(dir, "repo.yaml")
+	user := filepath.Join(dir, "user.yaml")
+	write := func(path, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(repo, "min_score: 60\ntone: conversational\nllm:\n  redact: true\n")
+	write(user, "tone: technical\n")
+	userFile = func() (string, error) { return user, nil }
+	t.Cleanup(func() { userFile = func() (string, error) { return "", nil } })
+	policy := &Policy{Source: "https://example.com/policy.yaml", Data: []byte("min_score: 70\naudience: enterprise\n")}
+
+	cfg, origins, err := LoadLayers(policy, repo)
+	if err != nil {
+		t.Fatalf("LoadLayers() error = %v", err)
+	}
+	if cfg.MinScore != 60 || cfg.Audience != "enterprise" || cfg.Tone != "technical" || !cfg.LLM.Redact {
+		t.Errorf("config = %+v", cfg)
+	}
+	wantOrigins := map[string]string{
+		"min_score":        repo,
+		"audience":         "policy https://example.com/policy.yaml",
+		"tone":             user,
+		"llm.redact":       repo,
+		"llm.redact_terms": DefaultOrigin,
+	}
+	for key, want := range wantOrigins {
+		if got := origins.Of(key); got != want {
+			t.Errorf("origin of %s = %q, want %q", key, got, want)
+		}
+	}
+
+	t.Run("invalid value names its layer", func(t *testing.T) {
+		write(user, "min_score: 140\n")
+		_, _, err := LoadLayers(policy, repo)
+		if !errors.Is(err, ErrInvalid) || !strings.Contains(err.Error(), user) {
+			t.Errorf("error = %v, want ErrInvalid naming %s", err, user)
+		}
+	})
+}
+
+func TestShow(t *testing.T) {
+	cfg := &Config{MinScore: 70, LLM: LLMConfig{RedactTerms: []string{"Falcon"}}}
+	origins := Origins{"min_score": "policy.yaml", "llm.redact_terms": ".prfaq-validator.yaml"}
+	var out strings.Builder
+	if err := Show(&out, cfg, origins); err != nil {
+		t.Fatalf("Show() error = %v", err)
+	}
+	for _, line := range []string{
+		"min_score: 70 # policy.yaml\n",
+		"  redact_terms: # .prfaq-validator.yaml\n    - Falcon\n",
+		"  redact: false # default\n",
+		"  pii_allowlist: [] # default\n",
+	} {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("Show() lacks %q:\n%s", line, out.String())
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// PolicyEnv names the organization policy when -policy is not given, so a
+// managed environment can set it once for every writer.
+const PolicyEnv = "PRFAQ_POLICY"
+
+// PolicyTTL is how long a fetched policy is used before it is fetched again.
+const PolicyTTL = time.Hour
+
+// maxPolicySize caps the policy download.
+const maxPolicySize = 1 << 20
+
+// ErrPolicy is returned when a policy cannot be read, or fetched with no
+// cached copy to fall back on.
+var ErrPolicy = errors.New("policy unavailable")
+
+// Policy is an organization's config, published at a URL or a shared path,
+// that the local config file is layered over.
+type Policy struct {
+	Source string // URL or path the policy was named by
+	Data   []byte
+	// Cached is set when Data came from the cache rather than the source,
+	// and Stale when that was because the fetch failed.
+	Cached bool
+	Stale  bool
+}
+
+// PolicyCache returns the directory fetched policies are cached in.
+func PolicyCache() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("%w: no cache directory: %w", ErrPolicy, err)
+	}
+	return filepath.Join(dir, "pr-faq-validator", "policy"), nil
+}
+
+// LoadPolicy reads the policy at source. A file path is read as is. An http
+// or https URL is fetched at most once per PolicyTTL and cached in cacheDir;
+// when the fetch fails, or offline is set, the cached copy is used however
+// old it is.
+func LoadPolicy(ctx context.Context, client *http.Client, source, cacheDir string, offline bool, now time.Time) (*Policy, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		data, err := os.ReadFile(source) //nolint:gosec // path is user-provided CLI argument
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrPolicy, err)
+		}
+		return &Policy{Source: source, Data: data}, nil
+	}
+
+	sum := sha256.Sum256([]byte(source))
+	cached := filepath.Join(cacheDir, hex.EncodeToString(sum[:8])+".yaml")
+	info, statErr := os.Stat(cached)
+	if statErr == nil && (offline || now.Sub(info.ModTime()) < PolicyTTL) {
+		data, err := os.ReadFile(cached) //nolint:gosec // path is in the policy cache
+		if err == nil {
+			return &Policy{Source: source, Data: data, Cached: true}, nil
+		}
+	}
+	if offline {
+		return nil, fmt.Errorf("%w: %s is not cached and -offline forbids fetching it", ErrPolicy, source)
+	}
+
+	data, err := fetchPolicy(ctx, client, source)
+	if err != nil {
+		if old, rerr := os.ReadFile(cached); rerr == nil { //nolint:gosec // path is in the policy cache
+			return &Policy{Source: source, Data: old, Cached: true, Stale: true}, nil
+		}
+		return nil, fmt.Errorf("%w: %w", ErrPolicy, err)
+	}
+	if err := os.MkdirAll(cacheDir, 0o750); err == nil {
+		_ = os.WriteFile(cached, data, 0o600)
+	}
+	return &Policy{Source: source, Data: data}, nil
+}
+
+func fetchPolicy(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", "pr-faq-validator")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("policy request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxPolicySize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", url, err)
+	}
+	return body, nil
+}
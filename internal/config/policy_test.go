@@ -0,0 +1,133 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadPolicy(t *testing.T) {
+	body := "min_score: 70\n"
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		if body == "" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	cache := t.TempDir()
+	now := time.Now()
+
+	p, err := LoadPolicy(ctx, srv.Client(), srv.URL, cache, false, now)
+	if err != nil {
+		t.Fatalf("LoadPolicy() error = %v", err)
+	}
+	if string(p.Data) != body || p.Cached {
+		t.Errorf("policy = %+v, want fetched %q", p, body)
+	}
+
+	t.Run("fresh cache is not fetched again", func(t *testing.T) {
+		p, err := LoadPolicy(ctx, srv.Client(), srv.URL, cache, false, now.Add(PolicyTTL/2))
+		if err != nil || !p.Cached || requests != 1 {
+			t.Errorf("policy = %+v, error = %v, %d requests; want the cached copy", p, err, requests)
+		}
+	})
+
+	t.Run("expired cache is fetched again", func(t *testing.T) {
+		body = "min_score: 80\n"
+		p, err := LoadPolicy(ctx, srv.Client(), srv.URL, cache, false, now.Add(2*PolicyTTL))
+		if err != nil || p.Cached || string(p.Data) != body {
+			t.Errorf("policy = %+v, error = %v; want %q fetched", p, err, body)
+		}
+	})
+
+	t.Run("failed fetch falls back to the cache", func(t *testing.T) {
+		body = ""
+		p, err := LoadPolicy(ctx, srv.Client(), srv.URL, cache, false, now.Add(4*PolicyTTL))
+		if err != nil || !p.Stale || string(p.Data) != "min_score: 80\n" {
+			t.Errorf("policy = %+v, error = %v; want the stale cached copy", p, err)
+		}
+	})
+
+	t.Run("offline uses the cache however old", func(t *testing.T) {
+		before := requests
+		p, err := LoadPolicy(ctx, srv.Client(), srv.URL, cache, true, now.Add(100*PolicyTTL))
+		if err != nil || !p.Cached || requests != before {
+			t.Errorf("policy = %+v, error = %v; want the cached copy without a request", p, err)
+		}
+	})
+
+	t.Run("offline without a cache fails", func(t *testing.T) {
+		_, err := LoadPolicy(ctx, srv.Client(), srv.URL, t.TempDir(), true, now)
+		if !errors.Is(err, ErrPolicy) {
+			t.Errorf("error = %v, want ErrPolicy", err)
+		}
+	})
+
+	t.Run("failed fetch without a cache fails", func(t *testing.T) {
+		_, err := LoadPolicy(ctx, srv.Client(), srv.URL, t.TempDir(), false, now)
+		if !errors.Is(err, ErrPolicy) {
+			t.Errorf("error = %v, want ErrPolicy", err)
+		}
+	})
+
+	t.Run("path", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "policy.yaml")
+		if err := os.WriteFile(path, []byte("tone: technical\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		p, err := LoadPolicy(ctx, srv.Client(), path, cache, true, now)
+		if err != nil || string(p.Data) != "tone: technical\n" {
+			t.Errorf("policy = %+v, error = %v", p, err)
+		}
+	})
+}
+
+func TestLoadOver(t *testing.T) {
+	policy := &Policy{Source: "https://example.com/policy.yaml", Data: []byte(`min_score: 70
+tone: technical
+llm:
+  redact: true
+  redact_terms: [Falcon]
+`)}
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("tone: conversational\nllm:\n  requests_per_minute: 10\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadOver(policy, path)
+	if err != nil {
+		t.Fatalf("LoadOver() error = %v", err)
+	}
+	if cfg.MinScore != 70 || cfg.Tone != "conversational" {
+		t.Errorf("min_score = %d, tone = %q; want 70 from the policy, conversational from the file", cfg.MinScore, cfg.Tone)
+	}
+	if !cfg.LLM.Redact || len(cfg.LLM.RedactTerms) != 1 || cfg.LLM.RequestsPerMinute != 10 {
+		t.Errorf("llm = %+v, want the policy's redaction and the file's rate limit", cfg.LLM)
+	}
+
+	t.Run("no config file", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		cfg, err := LoadOver(policy, "")
+		if err != nil || cfg.Tone != "technical" {
+			t.Errorf("LoadOver() = %+v, %v; want the policy alone", cfg, err)
+		}
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		_, err := LoadOver(&Policy{Source: "policy.yaml", Data: []byte("min_score: 140\n")}, path)
+		if !errors.Is(err, ErrInvalid) {
+			t.Errorf("error = %v, want ErrInvalid", err)
+		}
+	})
+}
@@ -0,0 +1,129 @@
+// Package crosscheck compares the deterministic scores of a press release
+// with the AI review's ratings of it, so a strong disagreement is called out
+// with both views instead of being left as two unrelated numbers.
+package crosscheck
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// Gap is the difference, in points out of 10, at which the rules and the
+// AI review disagree about a category.
+const Gap = 4.0
+
+// Overall is the Category of a disagreement about the press release as a
+// whole.
+const Overall = "Overall"
+
+// maxFindings is the number of rule findings a disagreement quotes.
+const maxFindings = 3
+
+// Disagreement is a category that the rules and the AI review rate far apart.
+type Disagreement struct {
+	Category string
+	Rules    float64 // the deterministic score, out of 10
+	AI       float64 // the AI review's rating, out of 10
+	// Findings are the rule findings in the category: the rules' view.
+	Findings []string
+	// Reason is the AI review's reason for its rating: its view.
+	Reason string
+}
+
+// Compare returns the disagreements between the press release's
+// deterministic scores and its AI review, the overall score first and then
+// the categories in report order. Categories the review did not rate are
+// skipped.
+func Compare(sections *parser.SpecSections, review *llm.Feedback) []Disagreement {
+	if review == nil || sections.PRScore == nil {
+		return nil
+	}
+
+	var out []Disagreement
+	if review.Scored {
+		rules := float64(sections.PRScore.OverallScore) / 10
+		if math.Abs(rules-review.Score) >= Gap {
+			out = append(out, Disagreement{Category: Overall, Rules: rules, AI: review.Score})
+		}
+	}
+
+	ratings := make(map[string]llm.CategoryRating, len(review.Categories))
+	for _, r := range review.Categories {
+		ratings[strings.ToLower(r.Name)] = r
+	}
+	findings := make(map[string][]string)
+	for _, f := range sections.Findings() {
+		findings[f.Category] = append(findings[f.Category], f.Message)
+	}
+	for _, c := range sections.PRScore.QualityBreakdown.Categories() {
+		r, ok := ratings[strings.ToLower(c.Name)]
+		if !ok || c.Max == 0 {
+			continue
+		}
+		rules := float64(c.Score) * 10 / float64(c.Max)
+		if math.Abs(rules-r.Score) < Gap {
+			continue
+		}
+		out = append(out, Disagreement{Category: c.Name, Rules: rules, AI: r.Score, Findings: findings[c.Name], Reason: r.Reason})
+	}
+	return out
+}
+
+// Summary states the two scores, e.g. "Credibility: the rules score 3/10,
+// the AI review 8/10".
+func (d Disagreement) Summary() string {
+	return fmt.Sprintf("%s: the rules score %s/10, the AI review %s/10", d.Category, format(d.Rules), format(d.AI))
+}
+
+// RulesView explains the deterministic score with its findings.
+func (d Disagreement) RulesView() string {
+	switch {
+	case len(d.Findings) > maxFindings:
+		return strings.Join(d.Findings[:maxFindings], "; ") + fmt.Sprintf("; and %d more", len(d.Findings)-maxFindings)
+	case len(d.Findings) > 0:
+		return strings.Join(d.Findings, "; ")
+	case d.Category == Overall:
+		return "see the score breakdown"
+	case d.Rules > d.AI:
+		return "no findings in this category"
+	default:
+		return "points withheld without a finding; run with -explain for the trace"
+	}
+}
+
+// AIView explains the AI review's rating.
+func (d Disagreement) AIView() string {
+	switch {
+	case d.Reason != "":
+		return d.Reason
+	case d.Category == Overall:
+		return "see the review's rationale"
+	default:
+		return "no reason given"
+	}
+}
+
+// Report renders disagreements as a plain-text callout, or "" when there
+// are none.
+func Report(disagreements []Disagreement) string {
+	if len(disagreements) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("== Disagreement: rules vs. AI review ==\n")
+	b.WriteString("The two scores differ by " + format(Gap) + " or more points out of 10. Neither is authoritative here: check both views before acting on either.\n")
+	for _, d := range disagreements {
+		fmt.Fprintf(&b, "\n%s\n  Rules: %s\n  AI review: %s\n", d.Summary(), d.RulesView(), d.AIView())
+	}
+	return b.String()
+}
+
+// format prints a score with at most one decimal.
+func format(score float64) string {
+	return strconv.FormatFloat(math.Round(score*10)/10, 'f', -1, 64)
+}
@@ -0,0 +1,79 @@
+package crosscheck
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+const doc = `# Acme Launches Ledger Sync
+
+## Press Release
+
+Acme today announced Ledger Sync. It is the best tool for finance teams.
+`
+
+func TestCompare(t *testing.T) {
+	sections, err := parser.Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	breakdown := sections.PRScore.QualityBreakdown
+
+	review := &llm.Feedback{
+		Score:  opposite(float64(sections.PRScore.OverallScore) / 10),
+		Scored: true,
+		Categories: []llm.CategoryRating{
+			{Name: "credibility", Score: opposite(float64(breakdown.CredibilityScore)), Reason: "the claims are plausible"},
+			{Name: "Headline Quality", Score: float64(breakdown.HeadlineScore)},
+			{Name: "Launch Readiness", Score: 0},
+		},
+	}
+
+	got := Compare(sections, review)
+	if len(got) != 2 || got[0].Category != Overall || got[1].Category != "Credibility" {
+		t.Fatalf("Compare() = %+v, want Overall and Credibility", got)
+	}
+	if got[1].Reason != "the claims are plausible" || got[1].RulesView() == "" {
+		t.Errorf("Credibility disagreement = %+v, want both views", got[1])
+	}
+
+	if got := Compare(sections, &llm.Feedback{Comments: "unscored"}); got != nil {
+		t.Errorf("Compare() of an unscored review = %+v, want none", got)
+	}
+	if got := Compare(sections, nil); got != nil {
+		t.Errorf("Compare() without a review = %+v, want none", got)
+	}
+}
+
+// opposite returns the far end of the 0-10 scale from score.
+func opposite(score float64) float64 {
+	if score >= 5 {
+		return 0
+	}
+	return 10
+}
+
+func TestReport(t *testing.T) {
+	if got := Report(nil); got != "" {
+		t.Errorf("Report(nil) = %q, want empty", got)
+	}
+
+	got := Report([]Disagreement{
+		{Category: "Credibility", Rules: 2, AI: 8.25, Findings: []string{"a", "b", "c", "d"}, Reason: "cites a pilot"},
+		{Category: "Structure", Rules: 2, AI: 9},
+	})
+	for _, want := range []string{
+		"Credibility: the rules score 2/10, the AI review 8.3/10",
+		"Rules: a; b; c; and 1 more",
+		"AI review: cites a pilot",
+		"Rules: points withheld without a finding",
+		"AI review: no reason given",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Report() missing %q:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Package doctor diagnoses the validator's environment and configuration,
+// so misconfiguration surfaces before an analysis run instead of deep inside one.
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/config"
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/prompts"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Status is the outcome of one check.
+type Status string
+
+// Check outcomes. Only failures make the environment unusable; warnings
+// disable optional features such as AI feedback.
+const (
+	StatusOK   Status = "ok"
+	StatusWarn Status = "warn"
+	StatusFail Status = "FAIL"
+)
+
+// Check is the result of one diagnostic. Fix says how to resolve a warning or failure.
+type Check struct {
+	Name   string
+	Status Status
+	Detail string
+	Fix    string
+}
+
+// Options configures Run.
+type Options struct {
+	Version    string        // the binary's version
+	ConfigFile string        // -config value; empty for the default lookup
+	Offline    bool          // skip the provider reachability check
+	Timeout    time.Duration // for the reachability check; 10s when zero
+}
+
+// Run performs every check in order.
+func Run(opts Options) []Check {
+	_, keyErr := llm.ProviderName()
+	aiEnabled := !opts.Offline && keyErr == nil
+	return []Check{
+		checkVersion(opts.Version),
+		checkConfig(opts.ConfigFile),
+		checkAPIKey(opts.Offline),
+		checkProvider(aiEnabled, opts.Timeout),
+		checkPrompts(aiEnabled),
+		checkTerminal(),
+	}
+}
+
+// Failed reports whether any check failed.
+func Failed(checks []Check) bool {
+	for _, c := range checks {
+		if c.Status == StatusFail {
+			return true
+		}
+	}
+	return false
+}
+
+// Write prints one line per check, followed by its fix when there is one.
+func Write(w io.Writer, checks []Check) error {
+	for _, c := range checks {
+		if _, err := fmt.Fprintf(w, "[%-4s] %-9s %s\n", c.Status, c.Name, c.Detail); err != nil {
+			return err
+		}
+		if c.Fix != "" && c.Status != StatusOK {
+			if _, err := fmt.Fprintf(w, "%16s fix: %s\n", "", c.Fix); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkVersion(version string) Check {
+	return Check{
+		Name:   "version",
+		Status: StatusOK,
+		Detail: fmt.Sprintf("pr-faq-validator %s (%s, %s/%s)", version, runtime.Version(), runtime.GOOS, runtime.GOARCH),
+	}
+}
+
+func checkConfig(path string) Check {
+	c := Check{Name: "config"}
+	if _, err := config.Load(path); err != nil {
+		c.Status, c.Detail = StatusFail, err.Error()
+		c.Fix = "correct the YAML, or pass -config with a valid file"
+		return c
+	}
+
+	c.Status = StatusOK
+	switch {
+	case path != "":
+		c.Detail = path + " is valid"
+	case fileExists(config.DefaultFile):
+		c.Detail = config.DefaultFile + " is valid"
+	default:
+		c.Detail = "no " + config.DefaultFile + " in the working directory; using defaults"
+	}
+	return c
+}
+
+func checkAPIKey(offline bool) Check {
+	c := Check{Name: "api key"}
+	if offline {
+		c.Status, c.Detail = StatusOK, "offline mode: AI feedback is disabled"
+		return c
+	}
+
+	name, err := llm.ProviderName()
+	switch {
+	case errors.Is(err, llm.ErrNoAPIKey):
+		c.Status, c.Detail = StatusWarn, fmt.Sprintf("%v; AI feedback will be skipped", err)
+		c.Fix = "export OPENAI_API_KEY, ANTHROPIC_API_KEY, or GEMINI_API_KEY (scores do not need any)"
+		if name == llm.ProviderBedrock {
+			c.Fix = "export AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, and AWS_REGION (scores do not need them)"
+		}
+	case err != nil:
+		c.Status, c.Detail = StatusFail, err.Error()
+		c.Fix = fmt.Sprintf("set %s to one of %s, or unset it", llm.ProviderEnv, strings.Join(llm.ProviderNames, ", "))
+	default:
+		c.Status, c.Detail = StatusOK, fmt.Sprintf("using %s (%s)", name, llm.ProviderModel(name))
+	}
+	return c
+}
+
+func checkProvider(aiEnabled bool, timeout time.Duration) Check {
+	c := Check{Name: "provider", Status: StatusOK}
+	if !aiEnabled {
+		c.Detail = "skipped: AI feedback is disabled"
+		return c
+	}
+
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	name, err := llm.Ping(ctx)
+	if err != nil {
+		c.Status, c.Detail = StatusFail, err.Error()
+		c.Fix = "check that the API key is valid and that " + name + " is reachable from this network (HTTPS_PROXY or llm.proxy, firewall)"
+		return c
+	}
+	c.Detail = name + " is reachable and accepted the API key"
+	return c
+}
+
+// checkPrompts loads the AI prompt files. Missing prompts only fail the
+// check when AI feedback is enabled.
+func checkPrompts(aiEnabled bool) Check {
+	c := Check{Name: "prompts"}
+	for _, path := range []string{llm.ReviewPrompt, llm.RewritePrompt, llm.HeadlinePrompt, llm.QuestionPrompt, llm.GlossaryPrompt, llm.FlowPrompt} {
+		if _, err := prompts.DefaultLoader.Load(path); err != nil {
+			c.Status, c.Detail = StatusWarn, err.Error()
+			if aiEnabled {
+				c.Status = StatusFail
+			}
+			c.Fix = "fix the prompt file, or remove " + prompts.DefaultLoader.Dir() + " to use the prompts built into the binary"
+			return c
+		}
+	}
+	c.Status, c.Detail = StatusOK, "loaded from "+prompts.DefaultLoader.Dir()
+	return c
+}
+
+func checkTerminal() Check {
+	c := Check{Name: "terminal", Status: StatusOK}
+	profile := lipgloss.ColorProfile().Name()
+	c.Detail = "color profile " + profile
+	if _, noColor := os.LookupEnv("NO_COLOR"); noColor {
+		c.Detail += " (NO_COLOR is set)"
+		return c
+	}
+	if profile == "Ascii" {
+		c.Status = StatusWarn
+		c.Detail += ": the TUI will render without colors"
+		c.Fix = "set TERM (e.g. TERM=xterm-256color), or use -no-tui or -format"
+	}
+	return c
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,84 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "")
+	t.Setenv("ANTHROPIC_API_KEY", "")
+	t.Setenv("PRFAQ_LLM_PROVIDER", "")
+
+	badConfig := filepath.Join(t.TempDir(), "bad.yaml")
+	if err := os.WriteFile(badConfig, []byte("tickets: [unclosed"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		opts       Options
+		wantStatus map[string]Status
+		wantFailed bool
+	}{
+		{
+			name:       "no api key only warns",
+			opts:       Options{Version: "v1.0.0"},
+			wantStatus: map[string]Status{"version": StatusOK, "config": StatusOK, "api key": StatusWarn, "provider": StatusOK},
+		},
+		{
+			name:       "offline",
+			opts:       Options{Offline: true},
+			wantStatus: map[string]Status{"api key": StatusOK, "provider": StatusOK},
+		},
+		{
+			name:       "invalid config fails",
+			opts:       Options{ConfigFile: badConfig, Offline: true},
+			wantStatus: map[string]Status{"config": StatusFail},
+			wantFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checks := Run(tt.opts)
+			for _, c := range checks {
+				if want, ok := tt.wantStatus[c.Name]; ok && c.Status != want {
+					t.Errorf("%s = %s (%s), want %s", c.Name, c.Status, c.Detail, want)
+				}
+			}
+			if Failed(checks) != tt.wantFailed {
+				t.Errorf("Failed() = %v, want %v", Failed(checks), tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestRun_UnknownProvider(t *testing.T) {
+	t.Setenv("PRFAQ_LLM_PROVIDER", "acme")
+
+	for _, c := range Run(Options{}) {
+		if c.Name == "api key" && (c.Status != StatusFail || !strings.Contains(c.Fix, "PRFAQ_LLM_PROVIDER")) {
+			t.Errorf("api key check = %+v, want a failure naming PRFAQ_LLM_PROVIDER", c)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var sb strings.Builder
+	err := Write(&sb, []Check{
+		{Name: "version", Status: StatusOK, Detail: "pr-faq-validator dev", Fix: "unused"},
+		{Name: "config", Status: StatusFail, Detail: "invalid config", Fix: "correct the YAML"},
+	})
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	want := "[ok  ] version   pr-faq-validator dev\n" +
+		"[FAIL] config    invalid config\n" +
+		"                 fix: correct the YAML\n"
+	if sb.String() != want {
+		t.Errorf("Write() =\n%s\nwant\n%s", sb.String(), want)
+	}
+}
@@ -0,0 +1,106 @@
+// Package export publishes a PR-FAQ report where the team tracks document
+// reviews: as a Notion page, or as a comment on the Google Doc under review.
+package export
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/config"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+// maxFindings caps the findings listed in an export; the rest are counted.
+const maxFindings = 25
+
+// Destination publishes a report.
+type Destination interface {
+	// Publish sends the report for result and returns a link to it.
+	Publish(ctx context.Context, result prfaq.Result) (string, error)
+}
+
+// New builds a Destination for the configured provider, reading credentials
+// from the environment (NOTION_TOKEN or GOOGLE_ACCESS_TOKEN).
+func New(cfg config.ExportConfig) (Destination, error) {
+	if cfg.Parent == "" {
+		return nil, fmt.Errorf("export: parent is not configured")
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	switch strings.ToLower(cfg.Provider) {
+	case "notion":
+		token := os.Getenv("NOTION_TOKEN")
+		if token == "" {
+			return nil, fmt.Errorf("export: notion requires NOTION_TOKEN")
+		}
+		return &notion{endpoint: notionEndpoint, token: token, parent: cfg.Parent, client: client}, nil
+	case "gdocs":
+		token := os.Getenv("GOOGLE_ACCESS_TOKEN")
+		if token == "" {
+			return nil, fmt.Errorf("export: gdocs requires GOOGLE_ACCESS_TOKEN")
+		}
+		return &googleDoc{endpoint: driveEndpoint, token: token, docID: cfg.Parent, client: client}, nil
+	case "":
+		return nil, fmt.Errorf("export: provider is not configured")
+	default:
+		return nil, fmt.Errorf("export: unknown provider %q (want notion or gdocs)", cfg.Provider)
+	}
+}
+
+// section is a heading and its bullet points.
+type section struct {
+	heading string
+	items   []string
+}
+
+// title names the exported report, e.g. "PR-FAQ review: Acme Launches Sync".
+func title(result prfaq.Result) string {
+	if result.Title == "" {
+		return "PR-FAQ review: Untitled PR-FAQ"
+	}
+	return "PR-FAQ review: " + result.Title
+}
+
+// headline is the one-line verdict, e.g. "Score: 72/100 (Good), rules/v1.0.0".
+func headline(result prfaq.Result) string {
+	line := fmt.Sprintf("Score: %d/100 (%s)", result.Score, parser.StatusLabel(result.Score))
+	if result.Rules != "" {
+		line += ", " + result.Rules
+	}
+	return line
+}
+
+// outline lays out the report body shared by every destination.
+func outline(result prfaq.Result) []section {
+	scores := section{heading: "Scores"}
+	for _, c := range result.Categories {
+		scores.items = append(scores.items, fmt.Sprintf("%s: %d/%d", c.Name, c.Score, c.Max))
+	}
+	sections := []section{scores}
+	if len(result.Strengths) > 0 {
+		sections = append(sections, section{heading: "Strengths", items: result.Strengths})
+	}
+
+	findings := section{heading: "Findings"}
+	for i, f := range result.Findings {
+		if i == maxFindings {
+			findings.items = append(findings.items, fmt.Sprintf("... and %d more", len(result.Findings)-maxFindings))
+			break
+		}
+		where := ""
+		if f.Line > 0 {
+			where = fmt.Sprintf(" line %d:", f.Line)
+		}
+		findings.items = append(findings.items, fmt.Sprintf("[%s]%s %s (%s)", f.Severity, where, f.Message, f.RuleID))
+	}
+	if len(findings.items) == 0 {
+		findings.items = []string{"None."}
+	}
+	return append(sections, findings)
+}
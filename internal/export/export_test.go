@@ -0,0 +1,71 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/config"
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+func TestNew(t *testing.T) {
+	t.Setenv("NOTION_TOKEN", "secret")
+	t.Setenv("GOOGLE_ACCESS_TOKEN", "")
+
+	tests := []struct {
+		name    string
+		cfg     config.ExportConfig
+		wantErr string
+	}{
+		{"notion", config.ExportConfig{Provider: "Notion", Parent: "page-1"}, ""},
+		{"missing parent", config.ExportConfig{Provider: "notion"}, "parent is not configured"},
+		{"missing provider", config.ExportConfig{Parent: "page-1"}, "provider is not configured"},
+		{"unknown provider", config.ExportConfig{Provider: "confluence", Parent: "page-1"}, "unknown provider"},
+		{"missing token", config.ExportConfig{Provider: "gdocs", Parent: "doc-1"}, "GOOGLE_ACCESS_TOKEN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("New() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("New() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOutline(t *testing.T) {
+	result := prfaq.Result{
+		Score:      72,
+		Categories: []prfaq.Category{{Name: "Headline Quality", Score: 8, Max: 10}},
+		Strengths:  []string{"Clear headline"},
+	}
+	for i := range maxFindings + 3 {
+		result.Findings = append(result.Findings, prfaq.Finding{Severity: "warning", Line: i + 1, Message: fmt.Sprintf("finding %d", i), RuleID: "rule"})
+	}
+
+	sections := outline(result)
+	if len(sections) != 3 {
+		t.Fatalf("outline() = %d sections, want scores, strengths, and findings", len(sections))
+	}
+	if got := sections[0].items[0]; got != "Headline Quality: 8/10" {
+		t.Errorf("score item = %q", got)
+	}
+	findings := sections[2].items
+	if len(findings) != maxFindings+1 || findings[len(findings)-1] != "... and 3 more" {
+		t.Errorf("findings = %d items ending %q, want %d ending with the count left out", len(findings), findings[len(findings)-1], maxFindings+1)
+	}
+	if findings[0] != "[warning] line 1: finding 0 (rule)" {
+		t.Errorf("first finding = %q", findings[0])
+	}
+
+	if got := outline(prfaq.Result{})[1].items; len(got) != 1 || got[0] != "None." {
+		t.Errorf("findings of a clean result = %v, want [None.]", got)
+	}
+}
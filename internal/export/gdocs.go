@@ -0,0 +1,73 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+const driveEndpoint = "https://www.googleapis.com/drive/v3"
+
+// googleDoc adds the report summary as a comment on a Google Doc, through the
+// Drive API, so it shows up next to the document being reviewed.
+type googleDoc struct {
+	endpoint string
+	token    string // OAuth access token with a Drive scope
+	docID    string
+	client   *http.Client
+}
+
+// Publish implements Destination.
+func (g *googleDoc) Publish(ctx context.Context, result prfaq.Result) (string, error) {
+	payload, err := json.Marshal(map[string]string{"content": commentText(result)})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode google docs request: %w", err)
+	}
+
+	endpoint := g.endpoint + "/files/" + url.PathEscape(g.docID) + "/comments?fields=id"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("failed to build google docs request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+g.token)
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("google docs request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("google docs: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	var comment struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&comment); err != nil {
+		return "", fmt.Errorf("failed to decode google docs response: %w", err)
+	}
+	return "https://docs.google.com/document/d/" + url.PathEscape(g.docID) + "/edit?disco=" + url.QueryEscape(comment.ID), nil
+}
+
+// commentText is the report as plain text, since comments are not formatted.
+func commentText(result prfaq.Result) string {
+	var b strings.Builder
+	b.WriteString(title(result) + "\n" + headline(result) + "\n")
+	for _, s := range outline(result) {
+		b.WriteString("\n" + s.heading + ":\n")
+		for _, item := range s.items {
+			b.WriteString("- " + item + "\n")
+		}
+	}
+	b.WriteString("\nPosted by pr-faq-validator.")
+	return b.String()
+}
@@ -0,0 +1,48 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+func TestGoogleDocPublish(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/files/doc-1/comments" || r.URL.Query().Get("fields") != "id" {
+			t.Errorf("unexpected %s %s", r.Method, r.URL)
+		}
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf("Authorization = %q", r.Header.Get("Authorization"))
+		}
+		var body struct {
+			Content string `json:"content"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for _, want := range []string{"PR-FAQ review: Acme Launches Sync", "Score: 72/100", "Findings:\n- [critical] line 3: Headline lacks a metric (headline-metric)"} {
+			if !strings.Contains(body.Content, want) {
+				t.Errorf("comment missing %q:\n%s", want, body.Content)
+			}
+		}
+		_, _ = w.Write([]byte(`{"id": "AAAA1"}`))
+	}))
+	t.Cleanup(server.Close)
+	g := &googleDoc{endpoint: server.URL, token: "secret", docID: "doc-1", client: server.Client()}
+
+	result := prfaq.Result{
+		Title:    "Acme Launches Sync",
+		Score:    72,
+		Findings: []prfaq.Finding{{Severity: "critical", Line: 3, Message: "Headline lacks a metric", RuleID: "headline-metric"}},
+	}
+	link, err := g.Publish(context.Background(), result)
+	if err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if link != "https://docs.google.com/document/d/doc-1/edit?disco=AAAA1" {
+		t.Errorf("Publish() = %q", link)
+	}
+}
@@ -0,0 +1,123 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+const (
+	notionEndpoint = "https://api.notion.com/v1"
+	notionVersion  = "2022-06-28"
+	// notionMaxBlocks is the most child blocks Notion accepts per request.
+	notionMaxBlocks = 100
+	// notionMaxText is the longest text Notion accepts in one rich text object.
+	notionMaxText = 2000
+)
+
+// notion creates a page with the report under a parent page.
+type notion struct {
+	endpoint string
+	token    string
+	parent   string // page ID
+	client   *http.Client
+}
+
+type notionBlock map[string]interface{}
+
+// Publish implements Destination. Blocks beyond the first request's limit are
+// appended to the new page in further requests.
+func (n *notion) Publish(ctx context.Context, result prfaq.Result) (string, error) {
+	blocks := notionBlocks(result)
+	first := blocks[:min(len(blocks), notionMaxBlocks)]
+	page := map[string]interface{}{
+		"parent": map[string]string{"page_id": n.parent},
+		"properties": map[string]interface{}{
+			"title": map[string]interface{}{"title": richText(title(result))},
+		},
+		"children": first,
+	}
+	var created struct {
+		ID  string `json:"id"`
+		URL string `json:"url"`
+	}
+	if err := n.do(ctx, http.MethodPost, "/pages", page, &created); err != nil {
+		return "", err
+	}
+	for rest := blocks[len(first):]; len(rest) > 0; {
+		chunk := rest[:min(len(rest), notionMaxBlocks)]
+		rest = rest[len(chunk):]
+		body := map[string]interface{}{"children": chunk}
+		if err := n.do(ctx, http.MethodPatch, "/blocks/"+created.ID+"/children", body, nil); err != nil {
+			return created.URL, err
+		}
+	}
+	return created.URL, nil
+}
+
+// notionBlocks renders the report as a paragraph, then a heading and
+// bulleted list per section.
+func notionBlocks(result prfaq.Result) []notionBlock {
+	blocks := []notionBlock{textBlock("paragraph", headline(result))}
+	for _, s := range outline(result) {
+		blocks = append(blocks, textBlock("heading_2", s.heading))
+		for _, item := range s.items {
+			blocks = append(blocks, textBlock("bulleted_list_item", item))
+		}
+	}
+	return blocks
+}
+
+func textBlock(kind, text string) notionBlock {
+	return notionBlock{
+		"object": "block",
+		"type":   kind,
+		kind:     map[string]interface{}{"rich_text": richText(text)},
+	}
+}
+
+func richText(text string) []map[string]interface{} {
+	if r := []rune(text); len(r) > notionMaxText {
+		text = string(r[:notionMaxText-1]) + "…"
+	}
+	return []map[string]interface{}{{"type": "text", "text": map[string]string{"content": text}}}
+}
+
+func (n *notion) do(ctx context.Context, method, path string, body, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode notion request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, n.endpoint+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build notion request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+n.token)
+	req.Header.Set("Notion-Version", notionVersion)
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("notion request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("notion: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode notion response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
+)
+
+func TestNotionPublish(t *testing.T) {
+	var appended int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("Notion-Version") != notionVersion {
+			t.Errorf("headers = %v", r.Header)
+		}
+		var body struct {
+			Parent   map[string]string `json:"parent"`
+			Children []json.RawMessage `json:"children"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if len(body.Children) > notionMaxBlocks {
+			t.Errorf("%s %s sent %d blocks, more than Notion accepts", r.Method, r.URL.Path, len(body.Children))
+		}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/pages":
+			if body.Parent["page_id"] != "parent-1" {
+				t.Errorf("parent = %v", body.Parent)
+			}
+			_, _ = w.Write([]byte(`{"id": "page-9", "url": "https://www.notion.so/page-9"}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/blocks/page-9/children":
+			appended += len(body.Children)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+	n := &notion{endpoint: server.URL, token: "secret", parent: "parent-1", client: server.Client()}
+
+	// Enough categories to need a second request
+	result := prfaq.Result{Title: "Acme Launches Sync", Score: 72}
+	for range notionMaxBlocks {
+		result.Categories = append(result.Categories, prfaq.Category{Name: "Category", Score: 1, Max: 2})
+	}
+
+	link, err := n.Publish(context.Background(), result)
+	if err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if link != "https://www.notion.so/page-9" {
+		t.Errorf("Publish() = %q", link)
+	}
+	if want := len(notionBlocks(result)) - notionMaxBlocks; appended != want {
+		t.Errorf("appended %d blocks, want %d", appended, want)
+	}
+}
+
+func TestNotionPublish_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message": "Could not find page"}`, http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+	n := &notion{endpoint: server.URL, token: "secret", parent: "missing", client: server.Client()}
+
+	_, err := n.Publish(context.Background(), prfaq.Result{})
+	if err == nil || !strings.Contains(err.Error(), "Could not find page") {
+		t.Errorf("Publish() error = %v, want the API message", err)
+	}
+}
+
+func TestRichText_Truncates(t *testing.T) {
+	text := richText(strings.Repeat("é", notionMaxText+10))[0]["text"].(map[string]string)["content"]
+	if n := len([]rune(text)); n != notionMaxText {
+		t.Errorf("rich text is %d characters, want %d", n, notionMaxText)
+	}
+}
@@ -0,0 +1,41 @@
+// Package flow checks whether a press release tells a coherent story, from
+// problem to solution to evidence to availability, by asking the AI model
+// where the story breaks between paragraphs.
+package flow
+
+import (
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// CheckFunc returns the breaks in the story paragraphs tell.
+type CheckFunc func(paragraphs []string) ([]llm.FlowBreak, error)
+
+// Checker is a parser.FlowChecker that asks a model. A Checker is safe for
+// concurrent use when its CheckFunc is.
+type Checker struct {
+	check CheckFunc
+}
+
+// New returns a Checker that asks with llm.CheckFlow.
+func New() *Checker {
+	return NewWith(llm.CheckFlow)
+}
+
+// NewWith returns a Checker that asks with check.
+func NewWith(check CheckFunc) *Checker {
+	return &Checker{check: check}
+}
+
+// CheckFlow returns the breaks in the story paragraphs tell, as flow issues.
+func (c *Checker) CheckFlow(paragraphs []string) ([]parser.FlowIssue, error) {
+	breaks, err := c.check(paragraphs)
+	if err != nil {
+		return nil, err
+	}
+	issues := make([]parser.FlowIssue, len(breaks))
+	for i, b := range breaks {
+		issues[i] = parser.FlowIssue{Kind: parser.FlowKind(b.Kind), Paragraph: b.Paragraph, Stage: b.Stage, Detail: b.Detail}
+	}
+	return issues, nil
+}
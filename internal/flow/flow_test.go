@@ -0,0 +1,38 @@
+package flow
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+func TestChecker_CheckFlow(t *testing.T) {
+	c := NewWith(func(paragraphs []string) ([]llm.FlowBreak, error) {
+		if len(paragraphs) != 2 {
+			t.Errorf("paragraphs = %q", paragraphs)
+		}
+		return []llm.FlowBreak{
+			{Kind: "missing", Stage: "evidence", Detail: "No proof."},
+			{Kind: "non-sequitur", Paragraph: 2, Detail: "Unrelated."},
+		}, nil
+	})
+	got, err := c.CheckFlow([]string{"Acme launched Ledger Sync.", "Acme was founded in 2009."})
+	if err != nil {
+		t.Fatalf("CheckFlow() error = %v", err)
+	}
+	want := []parser.FlowIssue{
+		{Kind: parser.FlowMissing, Stage: "evidence", Detail: "No proof."},
+		{Kind: parser.FlowNonSequitur, Paragraph: 2, Detail: "Unrelated."},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("CheckFlow() = %+v, want %+v", got, want)
+	}
+
+	failing := NewWith(func([]string) ([]llm.FlowBreak, error) { return nil, llm.ErrOffline })
+	if _, err := failing.CheckFlow([]string{"x"}); !errors.Is(err, llm.ErrOffline) {
+		t.Errorf("CheckFlow() error = %v, want ErrOffline", err)
+	}
+}
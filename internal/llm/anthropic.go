@@ -0,0 +1,189 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// ClaudeSonnet is the model identifier used with the Anthropic provider.
+const ClaudeSonnet = "claude-sonnet-4-5"
+
+// AnthropicModelEnv selects the Anthropic model, e.g. "claude-opus-4-1".
+const AnthropicModelEnv = "PRFAQ_ANTHROPIC_MODEL"
+
+const (
+	anthropicVersion   = "2023-06-01"
+	anthropicMaxTokens = 2048
+	// anthropicMaxTemperature is the highest temperature the Messages API accepts.
+	anthropicMaxTemperature = 1.0
+)
+
+// anthropicBaseURL is the Messages API host; tests point it at a local server.
+var anthropicBaseURL = "https://api.anthropic.com"
+
+// anthropicProvider calls the Messages API directly. It sets two cache
+// breakpoints: one after the system prompt, which never changes within a run,
+// and one on the newest user turn, so the next call in the same Session reads
+// the whole earlier conversation from the cache.
+type anthropicProvider struct {
+	apiKey  string
+	baseURL string
+	model   string
+	client  http.Client
+}
+
+type anthropicCacheControl struct {
+	Type string `json:"type"`
+}
+
+type anthropicBlock struct {
+	Type         string                 `json:"type"`
+	Text         string                 `json:"text"`
+	CacheControl *anthropicCacheControl `json:"cache_control,omitempty"`
+}
+
+type anthropicMessage struct {
+	Role    string           `json:"role"`
+	Content []anthropicBlock `json:"content"`
+}
+
+type anthropicRequest struct {
+	// Model is empty, and AnthropicVersion set, in the body of a Bedrock
+	// request, which names the model in its path.
+	Model            string `json:"model,omitempty"`
+	AnthropicVersion string `json:"anthropic_version,omitempty"`
+
+	MaxTokens   int                `json:"max_tokens"`
+	Temperature *float64           `json:"temperature,omitempty"`
+	System      []anthropicBlock   `json:"system,omitempty"`
+	Messages    []anthropicMessage `json:"messages"`
+}
+
+type anthropicResponse struct {
+	Content []anthropicBlock `json:"content"`
+	Usage   struct {
+		InputTokens              int `json:"input_tokens"`
+		OutputTokens             int `json:"output_tokens"`
+		CacheCreationInputTokens int `json:"cache_creation_input_tokens"`
+		CacheReadInputTokens     int `json:"cache_read_input_tokens"`
+	} `json:"usage"`
+}
+
+func (p *anthropicProvider) Ping(ctx context.Context) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/v1/models", nil)
+	if err != nil {
+		return err
+	}
+	_, err = p.do(httpReq)
+	return err
+}
+
+// do sends an authenticated request and returns the body of a 2xx response.
+func (p *anthropicProvider) do(httpReq *http.Request) ([]byte, error) {
+	httpReq.Header.Set("x-api-key", p.apiKey)
+	httpReq.Header.Set("anthropic-version", anthropicVersion)
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, &anthropicError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(data))}
+	}
+	return data, nil
+}
+
+// anthropicError is a non-2xx response from the Messages API.
+type anthropicError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *anthropicError) Error() string {
+	return fmt.Sprintf("anthropic: status %d: %s", e.StatusCode, e.Message)
+}
+
+// ephemeral marks a block as a prompt cache breakpoint.
+var ephemeral = &anthropicCacheControl{Type: "ephemeral"}
+
+// newAnthropicRequest converts req, placing the cache breakpoints. A
+// temperature above the API's maximum of 1 is lowered to it.
+func newAnthropicRequest(model string, req Request) anthropicRequest {
+	out := anthropicRequest{
+		Model:     model,
+		MaxTokens: anthropicMaxTokens,
+		System:    []anthropicBlock{{Type: "text", Text: req.System, CacheControl: ephemeral}},
+	}
+	if req.MaxTokens > 0 {
+		out.MaxTokens = req.MaxTokens
+	}
+	if req.Temperature != nil {
+		t := min(*req.Temperature, anthropicMaxTemperature)
+		out.Temperature = &t
+	}
+	for i, m := range req.Messages {
+		block := anthropicBlock{Type: "text", Text: m.Content}
+		if i == len(req.Messages)-1 {
+			block.CacheControl = ephemeral
+		}
+		out.Messages = append(out.Messages, anthropicMessage{Role: m.Role, Content: []anthropicBlock{block}})
+	}
+	return out
+}
+
+func (p *anthropicProvider) Complete(ctx context.Context, req Request) (Response, error) {
+	body, err := json.Marshal(newAnthropicRequest(p.model, req))
+	if err != nil {
+		return Response{}, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/v1/messages", bytes.NewReader(body))
+	if err != nil {
+		return Response{}, err
+	}
+	httpReq.Header.Set("content-type", "application/json")
+
+	data, err := p.do(httpReq)
+	if err != nil {
+		return Response{}, err
+	}
+
+	return anthropicText(ProviderAnthropic, data)
+}
+
+// anthropicText returns the text and usage of a Messages API response body,
+// logging the usage under the provider's name.
+func anthropicText(name string, data []byte) (Response, error) {
+	var decoded anthropicResponse
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return Response{}, fmt.Errorf("%w: invalid response: %w", ErrRequestFailed, err)
+	}
+	slog.Debug("LLM usage", "provider", name, "input_tokens", decoded.Usage.InputTokens,
+		"cache_write_tokens", decoded.Usage.CacheCreationInputTokens, "cache_read_tokens", decoded.Usage.CacheReadInputTokens)
+
+	var text strings.Builder
+	for _, block := range decoded.Content {
+		if block.Type == "text" {
+			text.WriteString(block.Text)
+		}
+	}
+	if text.Len() == 0 {
+		return Response{}, fmt.Errorf("%w: empty response", ErrRequestFailed)
+	}
+	return Response{Text: text.String(), Usage: Usage{
+		InputTokens:  decoded.Usage.InputTokens + decoded.Usage.CacheCreationInputTokens + decoded.Usage.CacheReadInputTokens,
+		OutputTokens: decoded.Usage.OutputTokens,
+		CachedTokens: decoded.Usage.CacheReadInputTokens,
+	}}, nil
+}
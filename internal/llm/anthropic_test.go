@@ -0,0 +1,169 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAnthropicProvider_CacheBreakpoints(t *testing.T) {
+	var got anthropicRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-api-key") != "test-key" || r.Header.Get("anthropic-version") == "" {
+			t.Errorf("missing auth headers: %v", r.Header)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("invalid request body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"content":[{"type":"text","text":"Looks good."}],"usage":{"input_tokens":10,"cache_read_input_tokens":900}}`))
+	}))
+	defer srv.Close()
+
+	p := &anthropicProvider{apiKey: "test-key", baseURL: srv.URL, model: ClaudeSonnet}
+	resp, err := p.Complete(context.Background(), Request{
+		System: "You are a reviewer.",
+		Messages: []Message{
+			{Role: roleUser, Content: "Review the press release."},
+			{Role: roleAssistant, Content: "Feedback."},
+			{Role: roleUser, Content: "Review the FAQs."},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if resp.Text != "Looks good." {
+		t.Errorf("text = %q", resp.Text)
+	}
+	if want := (Usage{InputTokens: 910, CachedTokens: 900}); resp.Usage != want {
+		t.Errorf("usage = %+v, want %+v", resp.Usage, want)
+	}
+
+	if len(got.System) != 1 || got.System[0].CacheControl == nil {
+		t.Errorf("system prompt should be a cache breakpoint: %+v", got.System)
+	}
+	for i, m := range got.Messages {
+		cached := m.Content[0].CacheControl != nil
+		if want := i == len(got.Messages)-1; cached != want {
+			t.Errorf("message %d cache_control = %v, want %v", i, cached, want)
+		}
+	}
+}
+
+func TestNewAnthropicRequest_Sampling(t *testing.T) {
+	if got := newAnthropicRequest(ClaudeSonnet, Request{}); got.MaxTokens != anthropicMaxTokens || got.Temperature != nil {
+		t.Errorf("defaults = max_tokens %d, temperature %v", got.MaxTokens, got.Temperature)
+	}
+	hot := 1.4
+	got := newAnthropicRequest(ClaudeSonnet, Request{Temperature: &hot, MaxTokens: 500})
+	if got.MaxTokens != 500 || got.Temperature == nil || *got.Temperature != anthropicMaxTemperature {
+		t.Errorf("max_tokens %d, temperature %v, want 500 and %v", got.MaxTokens, got.Temperature, anthropicMaxTemperature)
+	}
+}
+
+func TestAnthropicProvider_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"type":"error","error":{"type":"overloaded_error"}}`, statusOverloaded)
+	}))
+	defer srv.Close()
+
+	p := &anthropicProvider{apiKey: "test-key", baseURL: srv.URL, model: ClaudeSonnet}
+	_, err := p.Complete(context.Background(), Request{System: "s", Messages: []Message{{Role: roleUser, Content: "u"}}})
+	if httpStatus(err) != statusOverloaded {
+		t.Errorf("httpStatus(%v) = %d, want %d", err, httpStatus(err), statusOverloaded)
+	}
+}
+
+func TestAnthropicProvider_Ping(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/models" {
+			t.Errorf("ping sent %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("x-api-key") != "good-key" {
+			http.Error(w, `{"type":"error"}`, http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer srv.Close()
+
+	p := &anthropicProvider{apiKey: "good-key", baseURL: srv.URL, model: ClaudeSonnet}
+	if err := p.Ping(context.Background()); err != nil {
+		t.Errorf("Ping() error = %v", err)
+	}
+	p.apiKey = "bad-key"
+	if err := p.Ping(context.Background()); httpStatus(err) != http.StatusUnauthorized {
+		t.Errorf("Ping() with a bad key = %v, want status 401", err)
+	}
+}
+
+func TestProviderName_Unknown(t *testing.T) {
+	t.Setenv(ProviderEnv, "claude")
+	t.Setenv("ANTHROPIC_API_KEY", "k")
+	if _, err := ProviderName(); !errors.Is(err, ErrUnknownProvider) || errors.Is(err, ErrRequestFailed) {
+		t.Errorf("ProviderName() error = %v, want ErrUnknownProvider", err)
+	}
+}
+
+func TestProviderModel_Anthropic(t *testing.T) {
+	t.Setenv(AnthropicModelEnv, "")
+	if got := ProviderModel(ProviderAnthropic); got != ClaudeSonnet {
+		t.Errorf("ProviderModel() = %q, want %q", got, ClaudeSonnet)
+	}
+	t.Setenv(AnthropicModelEnv, "claude-opus-4-1")
+	if got := ProviderModel(ProviderAnthropic); got != "claude-opus-4-1" {
+		t.Errorf("ProviderModel() = %q with %s set", got, AnthropicModelEnv)
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	tests := []struct {
+		name      string
+		env       map[string]string
+		want      string
+		wantNoKey bool
+	}{
+		{"openai by default", map[string]string{"OPENAI_API_KEY": "k"}, ProviderOpenAI, false},
+		{"anthropic key alone", map[string]string{"ANTHROPIC_API_KEY": "k"}, ProviderAnthropic, false},
+		{"explicit anthropic", map[string]string{"OPENAI_API_KEY": "k", "ANTHROPIC_API_KEY": "k", ProviderEnv: "anthropic"}, ProviderAnthropic, false},
+		{"explicit anthropic without key", map[string]string{"OPENAI_API_KEY": "k", ProviderEnv: "anthropic"}, "", true},
+		{"no keys", nil, "", true},
+		{"bedrock", map[string]string{"AWS_ACCESS_KEY_ID": "id", "AWS_SECRET_ACCESS_KEY": "secret", "AWS_REGION": "us-west-2", ProviderEnv: "bedrock"}, ProviderBedrock, false},
+		{"bedrock without credentials", map[string]string{"ANTHROPIC_API_KEY": "k", "AWS_REGION": "us-west-2", ProviderEnv: "bedrock"}, "", true},
+		{"gemini key alone", map[string]string{GeminiKeyEnv: "k"}, ProviderGemini, false},
+		{"explicit gemini without key", map[string]string{"OPENAI_API_KEY": "k", ProviderEnv: "gemini"}, "", true},
+		{"bedrock without region", map[string]string{"AWS_ACCESS_KEY_ID": "id", "AWS_SECRET_ACCESS_KEY": "secret", ProviderEnv: "bedrock"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"OPENAI_API_KEY", "ANTHROPIC_API_KEY", ProviderEnv, "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_REGION", "AWS_DEFAULT_REGION", GeminiKeyEnv} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			p, err := newProvider()
+			if tt.wantNoKey {
+				if !errors.Is(err, ErrNoAPIKey) {
+					t.Errorf("newProvider() error = %v, want ErrNoAPIKey", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newProvider() error = %v", err)
+			}
+			got := ProviderOpenAI
+			switch p.(type) {
+			case *anthropicProvider:
+				got = ProviderAnthropic
+			case *bedrockProvider:
+				got = ProviderBedrock
+			case *geminiProvider:
+				got = ProviderGemini
+			}
+			if got != tt.want {
+				t.Errorf("newProvider() = %T, want %s", p, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// BedrockClaudeSonnet is the default model of the Bedrock provider: the
+// cross-region inference profile of Claude Sonnet in the US regions.
+const BedrockClaudeSonnet = "us.anthropic.claude-sonnet-4-5-20250929-v1:0"
+
+// Environment variables of the Bedrock provider, besides the standard AWS
+// credentials and region.
+const (
+	// BedrockModelEnv selects the model or inference profile ID, e.g.
+	// "eu.anthropic.claude-sonnet-4-5-20250929-v1:0".
+	BedrockModelEnv = "PRFAQ_BEDROCK_MODEL"
+	// BedrockEndpointEnv is the AWS variable that overrides the Bedrock
+	// Runtime endpoint, such as a VPC interface endpoint. AWS_ENDPOINT_URL,
+	// which overrides every service, is used when it is unset.
+	BedrockEndpointEnv = "AWS_ENDPOINT_URL_BEDROCK_RUNTIME"
+)
+
+const (
+	// bedrockAnthropicVersion is the Messages API version Bedrock expects
+	// in the request body.
+	bedrockAnthropicVersion = "bedrock-2023-05-31"
+	// bedrockService is the service name Bedrock Runtime requests are
+	// signed for.
+	bedrockService = "bedrock"
+)
+
+// bedrockProvider calls Anthropic models through Amazon Bedrock's
+// InvokeModel API, so requests stay in the caller's AWS account and can go
+// through a VPC endpoint. The body is the Messages API's, with the same cache
+// breakpoints as anthropicProvider.
+type bedrockProvider struct {
+	creds    awsCredentials
+	region   string
+	endpoint string
+	model    string
+	client   http.Client
+	now      func() time.Time
+}
+
+// newBedrockProvider configures the provider for model from the environment.
+func newBedrockProvider(model string) *bedrockProvider {
+	region := awsRegion()
+	endpoint := os.Getenv(BedrockEndpointEnv)
+	if endpoint == "" {
+		endpoint = os.Getenv("AWS_ENDPOINT_URL")
+	}
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://bedrock-runtime.%s.amazonaws.com", region)
+	}
+	return &bedrockProvider{
+		creds: awsCredentials{
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		},
+		region:   region,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		model:    model,
+		client:   *httpClient(),
+		now:      time.Now,
+	}
+}
+
+// awsRegion returns the region from AWS_REGION, or AWS_DEFAULT_REGION.
+func awsRegion() string {
+	if r := os.Getenv("AWS_REGION"); r != "" {
+		return r
+	}
+	return os.Getenv("AWS_DEFAULT_REGION")
+}
+
+func (p *bedrockProvider) Complete(ctx context.Context, req Request) (Response, error) {
+	data, err := p.invoke(ctx, req)
+	if err != nil {
+		return Response{}, err
+	}
+	return anthropicText(ProviderBedrock, data)
+}
+
+// ping invokes the model for a one-token reply: Bedrock Runtime has no
+// cheaper request that checks both the credentials and access to the model.
+func (p *bedrockProvider) Ping(ctx context.Context) error {
+	_, err := p.invoke(ctx, Request{Messages: []Message{{Role: roleUser, Content: "ping"}}, MaxTokens: 1})
+	return err
+}
+
+// invoke sends req to the model and returns the body of a 2xx response.
+func (p *bedrockProvider) invoke(ctx context.Context, req Request) ([]byte, error) {
+	body := newAnthropicRequest("", req)
+	body.AnthropicVersion = bedrockAnthropicVersion
+	if req.System == "" {
+		body.System = nil
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	url := p.endpoint + "/model/" + awsEscape(p.model, true) + "/invoke"
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	signV4(httpReq, data, p.creds, p.region, bedrockService, p.now())
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	out, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, &bedrockError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(out))}
+	}
+	return out, nil
+}
+
+// bedrockError is a non-2xx response from Bedrock Runtime, such as a
+// ThrottlingException (429) or AccessDeniedException (403).
+type bedrockError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *bedrockError) Error() string {
+	return fmt.Sprintf("bedrock: status %d: %s", e.StatusCode, e.Message)
+}
@@ -0,0 +1,82 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBedrockProvider_Complete(t *testing.T) {
+	var got map[string]json.RawMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want := "/model/us.anthropic.claude-sonnet-4-5-20250929-v1%3A0/invoke"; r.URL.EscapedPath() != want {
+			t.Errorf("path = %s, want %s", r.URL.EscapedPath(), want)
+		}
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20250301/us-west-2/bedrock/aws4_request, ") {
+			t.Errorf("Authorization = %q", auth)
+		}
+		if !strings.Contains(auth, "x-amz-security-token") || r.Header.Get("X-Amz-Security-Token") != "token" {
+			t.Errorf("session token not sent and signed: %v", r.Header)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("invalid request body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"content":[{"type":"text","text":"Looks good."}],"usage":{"input_tokens":10}}`))
+	}))
+	defer srv.Close()
+
+	p := &bedrockProvider{
+		creds:    awsCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret", SessionToken: "token"},
+		region:   "us-west-2",
+		endpoint: srv.URL,
+		model:    BedrockClaudeSonnet,
+		now:      func() time.Time { return time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC) },
+	}
+	resp, err := p.Complete(context.Background(), Request{System: "You are a reviewer.", Messages: []Message{{Role: roleUser, Content: "Review it."}}})
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if resp.Text != "Looks good." {
+		t.Errorf("text = %q", resp.Text)
+	}
+	if string(got["anthropic_version"]) != `"`+bedrockAnthropicVersion+`"` {
+		t.Errorf("anthropic_version = %s", got["anthropic_version"])
+	}
+	if _, ok := got["model"]; ok {
+		t.Errorf("body names the model: %s", got["model"])
+	}
+}
+
+func TestBedrockProvider_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message":"Too many requests"}`, http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	p := &bedrockProvider{region: "us-east-1", endpoint: srv.URL, model: BedrockClaudeSonnet, now: time.Now}
+	err := p.Ping(context.Background())
+	if httpStatus(err) != http.StatusTooManyRequests {
+		t.Errorf("httpStatus(%v) = %d, want %d", err, httpStatus(err), http.StatusTooManyRequests)
+	}
+}
+
+func TestNewBedrockProvider_Endpoint(t *testing.T) {
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "eu-west-1")
+	t.Setenv("AWS_ENDPOINT_URL", "")
+	t.Setenv(BedrockEndpointEnv, "")
+	t.Setenv(BedrockModelEnv, "")
+	if p := newBedrockProvider(ProviderModel(ProviderBedrock)); p.endpoint != "https://bedrock-runtime.eu-west-1.amazonaws.com" || p.model != BedrockClaudeSonnet {
+		t.Errorf("endpoint = %s, model = %s", p.endpoint, p.model)
+	}
+	t.Setenv(BedrockEndpointEnv, "https://vpce-0abc.bedrock-runtime.eu-west-1.vpce.amazonaws.com/")
+	t.Setenv(BedrockModelEnv, "eu.anthropic.claude-sonnet-4-5-20250929-v1:0")
+	if p := newBedrockProvider(ProviderModel(ProviderBedrock)); p.endpoint != "https://vpce-0abc.bedrock-runtime.eu-west-1.vpce.amazonaws.com" || !strings.HasPrefix(p.model, "eu.") {
+		t.Errorf("endpoint = %s, model = %s", p.endpoint, p.model)
+	}
+}
@@ -0,0 +1,90 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// EmbeddingModel is the OpenAI model Embed uses.
+const EmbeddingModel = openai.SmallEmbedding3
+
+// ErrNoEmbeddings is returned by Embed when the configured provider has no
+// embeddings API.
+var ErrNoEmbeddings = errors.New("provider has no embeddings API")
+
+// Embedder is a Client that can embed text.
+type Embedder interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// Embed returns an embedding vector for each of texts, in order. Requests are
+// redacted, scanned, and rate limited like completions. OpenAI and Gemini
+// embed text; with Anthropic or Bedrock Embed fails with ErrNoEmbeddings.
+func Embed(texts []string) ([][]float32, error) {
+	if Offline() {
+		return nil, ErrOffline
+	}
+	if len(texts) == 0 {
+		return nil, nil
+	}
+	p, err := newProvider()
+	if err != nil {
+		return nil, err
+	}
+	return embedWith(p, texts)
+}
+
+func embedWith(p Client, texts []string) ([][]float32, error) {
+	e, ok := p.(Embedder)
+	if !ok {
+		name, _ := ProviderName()
+		return nil, fmt.Errorf("%w: %s; set OPENAI_API_KEY and %s=%s, or use %s", ErrNoEmbeddings, name, ProviderEnv, ProviderOpenAI, ProviderGemini)
+	}
+
+	if r := redactor.Load(); r != nil {
+		texts = r.RedactAll(texts)
+	}
+	if s := preflight.Load(); s != nil {
+		for _, text := range texts {
+			if err := checkSensitive(s, text); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	chars := 0
+	for _, text := range texts {
+		chars += len(text)
+	}
+	var vectors [][]float32
+	err := send(chars/4, func(ctx context.Context) error {
+		var err error
+		vectors, err = e.Embed(ctx, texts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(vectors) != len(texts) {
+		return nil, fmt.Errorf("%w: got %d embeddings for %d texts", ErrRequestFailed, len(vectors), len(texts))
+	}
+	return vectors, nil
+}
+
+func (p *openAIProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	resp, err := p.client.CreateEmbeddings(ctx, openai.EmbeddingRequestStrings{Input: texts, Model: EmbeddingModel})
+	if err != nil {
+		return nil, err
+	}
+	vectors := make([][]float32, len(resp.Data))
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(vectors) {
+			return nil, fmt.Errorf("%w: embedding index %d out of range", ErrRequestFailed, d.Index)
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	return vectors, nil
+}
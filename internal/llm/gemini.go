@@ -0,0 +1,209 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// Gemini25Pro is the default model of the Gemini provider.
+const Gemini25Pro = "gemini-2.5-pro"
+
+// GeminiEmbeddingModel is the model the Gemini provider embeds text with.
+const GeminiEmbeddingModel = "gemini-embedding-001"
+
+// Environment variables of the Gemini provider.
+const (
+	// GeminiKeyEnv holds the Gemini API key, e.g. from Google AI Studio.
+	GeminiKeyEnv = "GEMINI_API_KEY"
+	// GeminiModelEnv selects the model, e.g. "gemini-2.5-flash".
+	GeminiModelEnv = "PRFAQ_GEMINI_MODEL"
+)
+
+// geminiBaseURL is the Gemini API host; tests point it at a local server.
+var geminiBaseURL = "https://generativelanguage.googleapis.com"
+
+// geminiProvider calls the Gemini API's generateContent method. Gemini
+// caches long repeated prefixes implicitly, so, as with OpenAI, keeping the
+// system prompt and history identical across calls is all it needs.
+type geminiProvider struct {
+	apiKey  string
+	baseURL string
+	model   string
+	client  http.Client
+}
+
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+type geminiContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []geminiPart `json:"parts"`
+}
+
+type geminiGenerationConfig struct {
+	Temperature     *float64 `json:"temperature,omitempty"`
+	MaxOutputTokens int      `json:"maxOutputTokens,omitempty"`
+}
+
+type geminiRequest struct {
+	SystemInstruction *geminiContent          `json:"systemInstruction,omitempty"`
+	Contents          []geminiContent         `json:"contents"`
+	GenerationConfig  *geminiGenerationConfig `json:"generationConfig,omitempty"`
+}
+
+type geminiResponse struct {
+	Candidates []struct {
+		Content      geminiContent `json:"content"`
+		FinishReason string        `json:"finishReason"`
+	} `json:"candidates"`
+	UsageMetadata struct {
+		PromptTokenCount        int `json:"promptTokenCount"`
+		CandidatesTokenCount    int `json:"candidatesTokenCount"`
+		CachedContentTokenCount int `json:"cachedContentTokenCount"`
+	} `json:"usageMetadata"`
+}
+
+// geminiRole maps a conversation role to Gemini's, which calls the
+// assistant "model".
+func geminiRole(role string) string {
+	if role == roleAssistant {
+		return "model"
+	}
+	return role
+}
+
+// newGeminiRequest converts req.
+func newGeminiRequest(req Request) geminiRequest {
+	out := geminiRequest{}
+	if req.System != "" {
+		out.SystemInstruction = &geminiContent{Parts: []geminiPart{{Text: req.System}}}
+	}
+	for _, m := range req.Messages {
+		out.Contents = append(out.Contents, geminiContent{Role: geminiRole(m.Role), Parts: []geminiPart{{Text: m.Content}}})
+	}
+	if req.Temperature != nil || req.MaxTokens > 0 {
+		out.GenerationConfig = &geminiGenerationConfig{Temperature: req.Temperature, MaxOutputTokens: req.MaxTokens}
+	}
+	return out
+}
+
+func (p *geminiProvider) Complete(ctx context.Context, req Request) (Response, error) {
+	data, err := p.post(ctx, p.model+":generateContent", newGeminiRequest(req))
+	if err != nil {
+		return Response{}, err
+	}
+
+	var decoded geminiResponse
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return Response{}, fmt.Errorf("%w: invalid response: %w", ErrRequestFailed, err)
+	}
+	slog.Debug("LLM usage", "provider", ProviderGemini, "input_tokens", decoded.UsageMetadata.PromptTokenCount,
+		"cached_tokens", decoded.UsageMetadata.CachedContentTokenCount)
+
+	var text strings.Builder
+	if len(decoded.Candidates) > 0 {
+		for _, part := range decoded.Candidates[0].Content.Parts {
+			text.WriteString(part.Text)
+		}
+	}
+	if text.Len() == 0 {
+		return Response{}, fmt.Errorf("%w: empty response", ErrRequestFailed)
+	}
+	return Response{Text: text.String(), Usage: Usage{
+		InputTokens:  decoded.UsageMetadata.PromptTokenCount,
+		OutputTokens: decoded.UsageMetadata.CandidatesTokenCount,
+		CachedTokens: decoded.UsageMetadata.CachedContentTokenCount,
+	}}, nil
+}
+
+func (p *geminiProvider) Ping(ctx context.Context) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/v1beta/models/"+p.model, nil)
+	if err != nil {
+		return err
+	}
+	_, err = p.do(httpReq)
+	return err
+}
+
+func (p *geminiProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	type embedRequest struct {
+		Model   string        `json:"model"`
+		Content geminiContent `json:"content"`
+	}
+	body := struct {
+		Requests []embedRequest `json:"requests"`
+	}{}
+	for _, text := range texts {
+		body.Requests = append(body.Requests, embedRequest{Model: "models/" + GeminiEmbeddingModel, Content: geminiContent{Parts: []geminiPart{{Text: text}}}})
+	}
+	data, err := p.post(ctx, GeminiEmbeddingModel+":batchEmbedContents", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded struct {
+		Embeddings []struct {
+			Values []float32 `json:"values"`
+		} `json:"embeddings"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("%w: invalid response: %w", ErrRequestFailed, err)
+	}
+	vectors := make([][]float32, len(decoded.Embeddings))
+	for i, e := range decoded.Embeddings {
+		vectors[i] = e.Values
+	}
+	return vectors, nil
+}
+
+// post sends body to a model method, such as "gemini-2.5-pro:generateContent",
+// and returns the body of a 2xx response.
+func (p *geminiProvider) post(ctx context.Context, method string, body any) ([]byte, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/v1beta/models/"+method, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	return p.do(httpReq)
+}
+
+// do sends an authenticated request and returns the body of a 2xx response.
+func (p *geminiProvider) do(httpReq *http.Request) ([]byte, error) {
+	httpReq.Header.Set("x-goog-api-key", p.apiKey)
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, &geminiError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(data))}
+	}
+	return data, nil
+}
+
+// geminiError is a non-2xx response from the Gemini API.
+type geminiError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *geminiError) Error() string {
+	return fmt.Sprintf("gemini: status %d: %s", e.StatusCode, e.Message)
+}
@@ -0,0 +1,92 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGeminiProvider_Complete(t *testing.T) {
+	var got geminiRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1beta/models/gemini-2.5-flash:generateContent" || r.Header.Get("x-goog-api-key") != "test-key" {
+			t.Errorf("request = %s %v", r.URL.Path, r.Header)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("invalid request body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"candidates":[{"content":{"role":"model","parts":[{"text":"Looks "},{"text":"good."}]}}],"usageMetadata":{"promptTokenCount":10}}`))
+	}))
+	defer srv.Close()
+
+	p := &geminiProvider{apiKey: "test-key", baseURL: srv.URL, model: "gemini-2.5-flash"}
+	temp := 0.3
+	resp, err := p.Complete(context.Background(), Request{
+		System:      "You are a reviewer.",
+		Messages:    []Message{{Role: roleUser, Content: "Review it."}, {Role: roleAssistant, Content: "Feedback."}, {Role: roleUser, Content: "Again."}},
+		Temperature: &temp,
+		MaxTokens:   500,
+	})
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if resp.Text != "Looks good." {
+		t.Errorf("text = %q", resp.Text)
+	}
+	if got.SystemInstruction == nil || got.SystemInstruction.Parts[0].Text != "You are a reviewer." {
+		t.Errorf("systemInstruction = %+v", got.SystemInstruction)
+	}
+	if len(got.Contents) != 3 || got.Contents[1].Role != "model" {
+		t.Errorf("contents = %+v, want the assistant turn as model", got.Contents)
+	}
+	if got.GenerationConfig == nil || *got.GenerationConfig.Temperature != temp || got.GenerationConfig.MaxOutputTokens != 500 {
+		t.Errorf("generationConfig = %+v", got.GenerationConfig)
+	}
+}
+
+func TestGeminiProvider_Embed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1beta/models/"+GeminiEmbeddingModel+":batchEmbedContents" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"embeddings":[{"values":[1,0]},{"values":[0,1]}]}`))
+	}))
+	defer srv.Close()
+
+	p := &geminiProvider{apiKey: "test-key", baseURL: srv.URL, model: Gemini25Pro}
+	vectors, err := embedWith(p, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("embedWith() error = %v", err)
+	}
+	if len(vectors) != 2 || vectors[1][1] != 1 {
+		t.Errorf("vectors = %v", vectors)
+	}
+}
+
+func TestGeminiProvider_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"error":{"status":"RESOURCE_EXHAUSTED"}}`, http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	p := &geminiProvider{apiKey: "test-key", baseURL: srv.URL, model: Gemini25Pro}
+	if err := p.Ping(context.Background()); httpStatus(err) != http.StatusTooManyRequests {
+		t.Errorf("httpStatus(%v) = %d, want %d", err, httpStatus(err), http.StatusTooManyRequests)
+	}
+}
+
+func TestProviderModel(t *testing.T) {
+	t.Setenv(GeminiModelEnv, "")
+	if got := ProviderModel(ProviderGemini); got != Gemini25Pro {
+		t.Errorf("ProviderModel() = %q, want %q", got, Gemini25Pro)
+	}
+	t.Setenv(GeminiModelEnv, "gemini-2.5-flash")
+	if got := ProviderModel(ProviderGemini); got != "gemini-2.5-flash" {
+		t.Errorf("ProviderModel() = %q with %s set", got, GeminiModelEnv)
+	}
+	if got := ProviderModel(ProviderOpenAI); got != GPT4O {
+		t.Errorf("ProviderModel(openai) = %q, want %q", got, GPT4O)
+	}
+}
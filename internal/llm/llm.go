@@ -1,116 +1,830 @@
-// Package llm provides integration with OpenAI's GPT models for qualitative feedback.
+// Package llm provides integration with OpenAI and Anthropic models for qualitative feedback.
 package llm
 
 import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"math"
 	"math/rand"
 	"net/http"
-	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/bordenet/pr-faq-validator/internal/pii"
 	"github.com/bordenet/pr-faq-validator/internal/prompts"
-	openai "github.com/sashabaranov/go-openai"
+	"github.com/bordenet/pr-faq-validator/internal/redact"
 )
 
 // GPT4O is the model identifier for OpenAI's GPT-4o model.
 const GPT4O = "gpt-4o"
 
+// statusOverloaded is the status Anthropic returns when its API is overloaded.
+const statusOverloaded = 529
+
+var (
+	// ErrOffline is returned by every LLM call while offline mode is enabled.
+	ErrOffline = errors.New("offline mode: LLM calls are disabled")
+	// ErrNoAPIKey is returned, prefixed with the variable name, when the
+	// selected provider's API key is not set.
+	ErrNoAPIKey = errors.New("not set")
+	// ErrUnknownProvider is returned when PRFAQ_LLM_PROVIDER names no
+	// provider.
+	ErrUnknownProvider = errors.New("unknown provider")
+	// ErrRequestFailed wraps every failed or unusable model response.
+	ErrRequestFailed = errors.New("LLM error")
+	// ErrSensitiveContent is returned when the pre-flight scan finds personal
+	// data or secrets in a request and sending them is not allowed.
+	ErrSensitiveContent = errors.New("content contains personal data or secrets")
+)
+
+var offline atomic.Bool
+
+// SetOffline enables or disables offline mode. While enabled, no request
+// leaves the process and every call fails fast with ErrOffline.
+func SetOffline(enabled bool) {
+	offline.Store(enabled)
+}
+
+// Offline reports whether offline mode is enabled.
+func Offline() bool {
+	return offline.Load()
+}
+
+var redactor atomic.Pointer[redact.Redactor]
+
+var (
+	preflight atomic.Pointer[pii.Scanner]
+	allowPII  atomic.Bool
+	warnedPII sync.Map // match descriptions already logged
+)
+
+// maxPIIList is the number of matches an ErrSensitiveContent message lists.
+const maxPIIList = 5
+
+// SetPreflight scans every request with s before it is sent. Flagged
+// content fails the request with ErrSensitiveContent, or is logged and sent
+// when allow is set. nil disables the scan.
+func SetPreflight(s *pii.Scanner, allow bool) {
+	preflight.Store(s)
+	allowPII.Store(allow)
+}
+
+// checkPreflight scans the newest message of req, the only one not sent
+// before in its conversation.
+func checkPreflight(req Request) error {
+	s := preflight.Load()
+	if s == nil || len(req.Messages) == 0 {
+		return nil
+	}
+	return checkSensitive(s, req.Messages[len(req.Messages)-1].Content)
+}
+
+// checkSensitive scans text with s and fails with ErrSensitiveContent, unless
+// sending it is allowed.
+func checkSensitive(s *pii.Scanner, text string) error {
+	matches := s.Scan(text)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	found := make([]string, len(matches))
+	for i, m := range matches {
+		found[i] = m.String()
+	}
+	if allowPII.Load() {
+		for _, f := range found {
+			if _, seen := warnedPII.LoadOrStore(f, true); !seen {
+				slog.Warn("sending sensitive content to the AI provider", "match", f)
+			}
+		}
+		return nil
+	}
+	if len(found) > maxPIIList {
+		found = append(found[:maxPIIList], fmt.Sprintf("and %d more", len(found)-maxPIIList))
+	}
+	return fmt.Errorf("%w: %s; remove it, or use -allow-pii to send it anyway", ErrSensitiveContent, strings.Join(found, ", "))
+}
+
+// SetRedactor redacts every request with r before it is sent and restores
+// the placeholders in the response. nil sends content as written.
+func SetRedactor(r *redact.Redactor) {
+	redactor.Store(r)
+}
+
+// Prompt files used by the analysis functions, relative to the prompts directory.
+const (
+	ReviewPrompt   = "analysis/section_review.yaml"
+	RewritePrompt  = "analysis/section_rewrite.yaml"
+	HeadlinePrompt = "analysis/headline_suggestions.yaml"
+	QuestionPrompt = "analysis/faq_questions.yaml"
+	GlossaryPrompt = "analysis/glossary.yaml"
+	FlowPrompt     = "analysis/narrative_flow.yaml"
+	RubricPrompt   = "analysis/faq_rubric.yaml"
+)
+
+// ReloadPrompts drops the cached prompt templates so edited prompt files
+// take effect on the next request.
+func ReloadPrompts() {
+	prompts.DefaultLoader.ClearCache()
+}
+
 // Feedback contains qualitative analysis feedback from the LLM.
 type Feedback struct {
 	Section  string
 	Comments string
-	Score    float64
+	Score    float64 // 0-10 when Scored
+	Scored   bool    // set when the review gave an overall score
+	// Categories are the review's ratings of the press release scoring
+	// categories, such as Credibility, in the order given.
+	Categories []CategoryRating
+}
+
+// CategoryRating is the AI review's rating of one scoring category.
+type CategoryRating struct {
+	Name   string
+	Score  float64 // 0-10
+	Reason string  // "" when the review gave none
 }
 
-// AnalyzeSection sends a section to the LLM for qualitative feedback.
+// AnalyzeSection sends a section to the LLM for qualitative feedback. To
+// review several sections of one document, use a Session instead.
 func AnalyzeSection(sectionName, content string) (*Feedback, error) {
-	apiKey := os.Getenv("OPENAI_API_KEY")
-	if apiKey == "" {
-		return nil, fmt.Errorf("OPENAI_API_KEY not set")
+	return NewSession().AnalyzeSection(sectionName, content)
+}
+
+// Session reviews the sections of one document as a single conversation.
+// Every call resends the same system prompt and the earlier exchanges
+// unchanged, so providers with prompt caching serve that prefix from their
+// cache instead of billing it again: Anthropic through explicit cache
+// breakpoints, OpenAI automatically once the prefix is long enough. A
+// Session is safe for concurrent use; calls run one at a time.
+type Session struct {
+	mu       sync.Mutex
+	provider Client
+	history  []Message
+}
+
+// NewSession starts an empty review conversation.
+func NewSession() *Session {
+	return &Session{}
+}
+
+// AnalyzeSection reviews one section, with the sections reviewed earlier in
+// the session as context.
+func (s *Session) AnalyzeSection(sectionName, content string) (*Feedback, error) {
+	if Offline() {
+		return nil, ErrOffline
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.provider == nil {
+		p, err := newProvider()
+		if err != nil {
+			return nil, err
+		}
+		s.provider = p
+	}
+
+	req, err := newRequest(ReviewPrompt, map[string]interface{}{
+		"section_name": sectionName,
+		"content":      content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Messages = append(slices.Clone(s.history), req.Messages...)
+	text, err := complete(s.provider, req)
+	if err != nil {
+		return nil, err
+	}
+	s.history = append(req.Messages, Message{Role: roleAssistant, Content: text})
+
+	feedback := &Feedback{Section: sectionName, Comments: text}
+	feedback.Score, feedback.Scored = parseScore(text)
+	feedback.Categories = parseCategoryRatings(text)
+	return feedback, nil
+}
+
+var (
+	// reviewScore matches the review's overall "**Score: 7/10**" line.
+	reviewScore = regexp.MustCompile(`(?im)^[\s*]*score[\s*]*:[\s*]*(\d+(?:\.\d+)?)\s*/\s*10\b`)
+	// categoryRating matches a "- Credibility: 4/10 - no figures" list item.
+	categoryRating = regexp.MustCompile(`^[-*•]\s+\**([A-Za-z0-9][A-Za-z0-9 &]*?)\**\s*:\s*\**(\d+(?:\.\d+)?)\s*/\s*10\b\**\s*(?:[-–—:]\s*(.*))?$`)
+)
+
+// parseScore returns the overall score out of 10 from a review.
+func parseScore(text string) (float64, bool) {
+	m := reviewScore.FindStringSubmatch(text)
+	if m == nil {
+		return 0, false
+	}
+	score, err := strconv.ParseFloat(m[1], 64)
+	if err != nil || score > 10 {
+		return 0, false
+	}
+	return score, true
+}
+
+// parseCategoryRatings returns the "Name: X/10 - reason" list items of a
+// review, the per-category ratings the press release review asks for.
+func parseCategoryRatings(text string) []CategoryRating {
+	var ratings []CategoryRating
+	for _, line := range strings.Split(text, "\n") {
+		m := categoryRating.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		score, err := strconv.ParseFloat(m[2], 64)
+		if err != nil || score > 10 {
+			continue
+		}
+		ratings = append(ratings, CategoryRating{Name: strings.TrimSpace(m[1]), Score: score, Reason: strings.TrimSpace(m[3])})
+	}
+	return ratings
+}
+
+// RewriteSection asks the LLM to rewrite a section so it resolves the given issues.
+// It returns the rewritten markdown only.
+func RewriteSection(sectionName, content string, issues []string) (string, error) {
+	if Offline() {
+		return "", ErrOffline
 	}
 
-	// Load prompt template from YAML
-	loader := prompts.DefaultLoader
-	promptTemplate, err := loader.Load("analysis/section_review.yaml")
+	p, err := newProvider()
 	if err != nil {
-		return nil, fmt.Errorf("failed to load prompt template: %w", err)
+		return "", err
 	}
 
-	// Render prompts with variables
-	vars := map[string]interface{}{
+	req, err := newRequest(RewritePrompt, map[string]interface{}{
 		"section_name": sectionName,
 		"content":      content,
+		"issues":       issues,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	text, err := complete(p, req)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(text), nil
+}
+
+// SuggestHeadlines asks the LLM for up to count alternative headlines for a
+// press release. Duplicates and the current title are dropped.
+func SuggestHeadlines(title, pressRelease string, count int) ([]string, error) {
+	if Offline() {
+		return nil, ErrOffline
+	}
+
+	p, err := newProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := newRequest(HeadlinePrompt, map[string]interface{}{
+		"title":   title,
+		"content": pressRelease,
+		"count":   count,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := complete(p, req)
+	if err != nil {
+		return nil, err
+	}
+
+	headlines := parseHeadlines(text, title, count)
+	if len(headlines) == 0 {
+		return nil, fmt.Errorf("%w: no headlines in response", ErrRequestFailed)
+	}
+	return headlines, nil
+}
+
+// headlineMarker matches list numbering, bullets, and heading marks.
+var headlineMarker = regexp.MustCompile(`^(?:#+|[-*•]|\d+[.)])\s+`)
+
+// parseHeadlines takes one headline per line, stripping the numbering,
+// bullets, quotes, and preambles models add despite being asked not to.
+func parseHeadlines(text, title string, count int) []string {
+	seen := map[string]bool{strings.ToLower(title): true}
+	var headlines []string
+	for _, line := range strings.Split(text, "\n") {
+		line = headlineMarker.ReplaceAllString(strings.TrimSpace(line), "")
+		line = strings.TrimSpace(strings.Trim(line, `"'“”*`))
+		// Skip blank lines, repeats, and preambles such as "Here are five headlines:"
+		if line == "" || strings.HasSuffix(line, ":") || seen[strings.ToLower(line)] {
+			continue
+		}
+		seen[strings.ToLower(line)] = true
+		headlines = append(headlines, line)
+		if len(headlines) == count {
+			break
+		}
+	}
+	return headlines
+}
+
+// Question is a question the FAQ does not answer yet, and who would ask it.
+type Question struct {
+	Asker string // Customer, Executive, or Journalist
+	Text  string
+}
+
+// SuggestQuestions asks the model for up to count questions that readers of
+// the press release would ask and the FAQ does not answer, most important
+// first.
+func SuggestQuestions(pressRelease, faqs string, count int) ([]Question, error) {
+	if Offline() {
+		return nil, ErrOffline
+	}
+
+	p, err := newProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := newRequest(QuestionPrompt, map[string]interface{}{
+		"content": pressRelease,
+		"faqs":    faqs,
+		"count":   count,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := complete(p, req)
+	if err != nil {
+		return nil, err
+	}
+
+	questions := parseQuestions(text, count)
+	if len(questions) == 0 {
+		return nil, fmt.Errorf("%w: no questions in response", ErrRequestFailed)
+	}
+	return questions, nil
+}
+
+// questionAsker matches the "Customer:" label of a suggested question.
+var questionAsker = regexp.MustCompile(`(?i)^\**(customer|executive|journalist)\**\s*:\s*\**\s*`)
+
+// parseQuestions takes one question per line, stripping numbering, bullets,
+// and emphasis, and skipping lines that are not questions. Unlabeled
+// questions have no Asker.
+func parseQuestions(text string, count int) []Question {
+	seen := map[string]bool{}
+	var questions []Question
+	for _, line := range strings.Split(text, "\n") {
+		line = headlineMarker.ReplaceAllString(strings.TrimSpace(line), "")
+		var q Question
+		if m := questionAsker.FindStringSubmatch(line); m != nil {
+			q.Asker = strings.ToUpper(m[1][:1]) + strings.ToLower(m[1][1:])
+			line = line[len(m[0]):]
+		}
+		q.Text = strings.TrimSpace(strings.Trim(line, `"'“”*`))
+		if !strings.HasSuffix(q.Text, "?") || seen[strings.ToLower(q.Text)] {
+			continue
+		}
+		seen[strings.ToLower(q.Text)] = true
+		questions = append(questions, q)
+		if len(questions) == count {
+			break
+		}
+	}
+	return questions
+}
+
+// Definition is a one-line glossary definition of a term.
+type Definition struct {
+	Term string
+	Text string
+}
+
+// DefineTerms asks the model for a one-line definition of each term as the
+// document uses it, followed by up to extra technical terms of the
+// document's that the model adds itself.
+func DefineTerms(content string, terms []string, extra int) ([]Definition, error) {
+	if Offline() {
+		return nil, ErrOffline
+	}
+
+	p, err := newProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := newRequest(GlossaryPrompt, map[string]interface{}{
+		"content": content,
+		"terms":   strings.Join(terms, "\n"),
+		"extra":   extra,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := complete(p, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defs := parseDefinitions(text)
+	if len(defs) == 0 {
+		return nil, fmt.Errorf("%w: no definitions in response", ErrRequestFailed)
+	}
+	return defs, nil
+}
+
+// parseDefinitions takes one "Term: definition" per line, stripping the
+// numbering, bullets, and emphasis models add despite being asked not to.
+func parseDefinitions(text string) []Definition {
+	seen := map[string]bool{}
+	var defs []Definition
+	for _, line := range strings.Split(text, "\n") {
+		line = headlineMarker.ReplaceAllString(strings.TrimSpace(line), "")
+		term, def, ok := strings.Cut(line, ":")
+		term = strings.TrimSpace(strings.Trim(term, "*_`"))
+		def = strings.TrimSpace(strings.TrimLeft(def, "*_ "))
+		if !ok || term == "" || def == "" || seen[strings.ToLower(term)] {
+			continue
+		}
+		seen[strings.ToLower(term)] = true
+		defs = append(defs, Definition{Term: term, Text: def})
+	}
+	return defs
+}
+
+// FlowBreak is a place where a press release's story breaks.
+type FlowBreak struct {
+	Kind      string // missing, order, gap, or non-sequitur
+	Paragraph int    // 1-based; 0 for a missing stage
+	Stage     string // problem, solution, evidence, or availability; "" when not given
+	Detail    string
+}
+
+// flowKinds are the kinds of break the flow prompt reports.
+var flowKinds = []string{"missing", "order", "gap", "non-sequitur"}
+
+// CheckFlow asks the model where the story the press release paragraphs
+// tell, from problem to solution to evidence to availability, breaks. A
+// coherent story has no breaks.
+func CheckFlow(paragraphs []string) ([]FlowBreak, error) {
+	if Offline() {
+		return nil, ErrOffline
+	}
+
+	p, err := newProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	numbered := make([]string, len(paragraphs))
+	for i, para := range paragraphs {
+		numbered[i] = fmt.Sprintf("[%d] %s", i+1, para)
+	}
+	req, err := newRequest(FlowPrompt, map[string]interface{}{
+		"paragraphs": strings.Join(numbered, "\n\n"),
+		"count":      len(paragraphs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := complete(p, req)
+	if err != nil {
+		return nil, err
+	}
+
+	breaks, ok := parseFlow(text, len(paragraphs))
+	if !ok {
+		return nil, fmt.Errorf("%w: no flow issues or NONE in response", ErrRequestFailed)
+	}
+	return breaks, nil
+}
+
+// parseFlow takes one "kind | paragraph | stage | explanation" line per
+// break, skipping lines of another shape and paragraphs out of range. It
+// reports false when the response has neither a break nor NONE.
+func parseFlow(text string, paragraphs int) ([]FlowBreak, bool) {
+	var breaks []FlowBreak
+	none := false
+	for _, line := range strings.Split(text, "\n") {
+		line = headlineMarker.ReplaceAllString(strings.TrimSpace(line), "")
+		if strings.EqualFold(strings.Trim(line, "*_."), "none") {
+			none = true
+			continue
+		}
+		fields := strings.SplitN(line, "|", 4)
+		if len(fields) != 4 {
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(strings.Trim(strings.TrimSpace(fields[i]), "*_`"))
+		}
+		b := FlowBreak{Kind: strings.ToLower(fields[0]), Stage: strings.ToLower(fields[2]), Detail: fields[3]}
+		if !slices.Contains(flowKinds, b.Kind) || b.Detail == "" {
+			continue
+		}
+		if b.Stage == "-" {
+			b.Stage = ""
+		}
+		if n, err := strconv.Atoi(strings.Trim(fields[1], "[]P")); err == nil {
+			b.Paragraph = n
+		}
+		if b.Paragraph < 0 || b.Paragraph > paragraphs || (b.Paragraph == 0) != (b.Kind == "missing") {
+			continue
+		}
+		breaks = append(breaks, b)
+	}
+	return breaks, none || len(breaks) > 0
+}
+
+// FAQAnswer is a question of the FAQ and its answer, as sent for rating.
+type FAQAnswer struct {
+	Question string
+	Answer   string
+}
+
+// AnswerRating is the rubric rating of one FAQ answer, each criterion 1-5.
+type AnswerRating struct {
+	Question   string
+	Directness int // answers the question asked, up front
+	Evidence   int // backs the answer with figures, sources, or examples
+	TradeOffs  int // is honest about costs, limits, and risks
+	Note       string
+}
+
+// Rating is the answer's mean rating, 1-5.
+func (r AnswerRating) Rating() float64 {
+	return float64(r.Directness+r.Evidence+r.TradeOffs) / 3
+}
+
+// FAQQuality aggregates answer ratings into a 0-100 score: 0 when every
+// answer rates 1 on every criterion, 100 when every one rates 5.
+func FAQQuality(ratings []AnswerRating) int {
+	if len(ratings) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, r := range ratings {
+		sum += r.Rating()
+	}
+	return int(math.Round((sum/float64(len(ratings)) - 1) * 25))
+}
+
+// RateAnswers asks the model to rate every FAQ answer for directness,
+// evidence, and honesty about trade-offs. The ratings are in question order;
+// a question the model skipped has none.
+func RateAnswers(faqs []FAQAnswer) ([]AnswerRating, error) {
+	if Offline() {
+		return nil, ErrOffline
+	}
+
+	p, err := newProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	numbered := make([]string, len(faqs))
+	for i, f := range faqs {
+		answer := f.Answer
+		if answer == "" {
+			answer = "(no answer)"
+		}
+		numbered[i] = fmt.Sprintf("[%d] Q: %s\nA: %s", i+1, f.Question, answer)
+	}
+	req, err := newRequest(RubricPrompt, map[string]interface{}{
+		"faqs":  strings.Join(numbered, "\n\n"),
+		"count": len(faqs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := complete(p, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := parseRubric(text, faqs)
+	if len(ratings) == 0 {
+		return nil, fmt.Errorf("%w: no answer ratings in response", ErrRequestFailed)
+	}
+	return ratings, nil
+}
+
+// parseRubric takes one "number | directness | evidence | trade-offs |
+// note" line per answer, skipping lines of another shape, ratings outside
+// 1-5, and numbers out of range or already rated.
+func parseRubric(text string, faqs []FAQAnswer) []AnswerRating {
+	rated := make([]*AnswerRating, len(faqs))
+	for _, line := range strings.Split(text, "\n") {
+		line = headlineMarker.ReplaceAllString(strings.TrimSpace(line), "")
+		fields := strings.SplitN(line, "|", 5)
+		if len(fields) != 5 {
+			continue
+		}
+		var nums [4]int
+		ok := true
+		for i := range nums {
+			n, err := strconv.Atoi(strings.Trim(strings.TrimSpace(fields[i]), "[]*_`Q"))
+			if err != nil || (i > 0 && (n < 1 || n > 5)) {
+				ok = false
+				break
+			}
+			nums[i] = n
+		}
+		if !ok || nums[0] < 1 || nums[0] > len(faqs) || rated[nums[0]-1] != nil {
+			continue
+		}
+		rated[nums[0]-1] = &AnswerRating{
+			Question:   faqs[nums[0]-1].Question,
+			Directness: nums[1],
+			Evidence:   nums[2],
+			TradeOffs:  nums[3],
+			Note:       strings.TrimSpace(strings.Trim(strings.TrimSpace(fields[4]), "*_`")),
+		}
+	}
+	var ratings []AnswerRating
+	for _, r := range rated {
+		if r != nil {
+			ratings = append(ratings, *r)
+		}
+	}
+	return ratings
+}
+
+// Generation overrides the sampling parameters of every prompt.
+type Generation struct {
+	Temperature *float64 // nil keeps each prompt's temperature
+	MaxTokens   int      // 0 keeps each prompt's max_tokens
+}
+
+var generation atomic.Pointer[Generation]
+
+// SetGeneration overrides the temperature and max_tokens parameters of the
+// prompt files for every request.
+func SetGeneration(g Generation) {
+	generation.Store(&g)
+}
+
+// newRequest loads a prompt template from the default loader and renders it
+// with vars into a single-turn request. The request takes the template's
+// temperature and max_tokens parameters unless SetGeneration overrides them.
+func newRequest(path string, vars map[string]interface{}) (Request, error) {
+	promptTemplate, err := prompts.DefaultLoader.Load(path)
+	if err != nil {
+		return Request{}, fmt.Errorf("failed to load prompt template: %w", err)
 	}
 
 	systemPrompt, err := promptTemplate.RenderSystemPrompt(vars)
 	if err != nil {
-		return nil, fmt.Errorf("failed to render system prompt: %w", err)
+		return Request{}, fmt.Errorf("failed to render system prompt: %w", err)
 	}
 
 	userPrompt, err := promptTemplate.RenderUserPrompt(vars)
 	if err != nil {
-		return nil, fmt.Errorf("failed to render user prompt: %w", err)
+		return Request{}, fmt.Errorf("failed to render user prompt: %w", err)
 	}
 
-	client := openai.NewClient(apiKey)
-	ctx := context.Background()
+	req := Request{Prompt: path, System: systemPrompt, Messages: []Message{{Role: roleUser, Content: userPrompt}}}
+	if t, ok := numberParameter(promptTemplate, "temperature"); ok {
+		req.Temperature = &t
+	}
+	if n, ok := numberParameter(promptTemplate, "max_tokens"); ok && n > 0 {
+		req.MaxTokens = int(n)
+	}
+	if g := generation.Load(); g != nil {
+		if g.Temperature != nil {
+			req.Temperature = g.Temperature
+		}
+		if g.MaxTokens > 0 {
+			req.MaxTokens = g.MaxTokens
+		}
+	}
+	return req, nil
+}
+
+// numberParameter returns a numeric prompt parameter. YAML decodes whole
+// numbers as int and the rest as float64.
+func numberParameter(t *prompts.PromptTemplate, key string) (float64, bool) {
+	switch v := t.GetParameter(key, nil).(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// complete sends a conversation to the provider, redacted and scanned as
+// configured, and restores redacted entities in the reply. The exchange is
+// recorded, as sent, when transcripts are on.
+func complete(p Client, req Request) (string, error) {
+	if Offline() {
+		return "", ErrOffline
+	}
+
+	r := redactor.Load()
+	if r != nil {
+		req = redactRequest(r, req)
+	}
+	if err := checkPreflight(req); err != nil {
+		return "", err
+	}
 
-	var resp openai.ChatCompletionResponse
+	var resp Response
+	start := time.Now()
+	err := send(estimateTokens(req), func(ctx context.Context) error {
+		var err error
+		resp, err = p.Complete(ctx, req)
+		return err
+	})
+	if t := transcript.Load(); t != nil {
+		t.record(start, req, resp, err)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	text := resp.Text
+	if r != nil {
+		text = r.Restore(text)
+	}
+	return text, nil
+}
+
+// send makes an API call that costs about tokens, retrying transient
+// failures with exponential backoff and jitter.
+func send(tokens int, call func(ctx context.Context) error) error {
+	ctx := context.Background()
 	var apiErr error
 
 	const maxAttempts = 5
 	baseDelay := time.Second
 
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		resp, apiErr = client.CreateChatCompletion(
-			ctx,
-			openai.ChatCompletionRequest{
-				Model: GPT4O,
-				Messages: []openai.ChatCompletionMessage{
-					{Role: openai.ChatMessageRoleSystem, Content: systemPrompt},
-					{Role: openai.ChatMessageRoleUser, Content: userPrompt},
-				},
-			},
-		)
+		rateLimiter.wait(tokens)
+		apiErr = call(ctx)
 
 		// success
 		if apiErr == nil {
-			break
+			return nil
+		}
+		if errors.Is(apiErr, ErrRequestFailed) {
+			return apiErr
 		}
 
 		// check if error is retryable
-		var openaiErr *openai.APIError
-		if errors.As(apiErr, &openaiErr) {
-			switch openaiErr.HTTPStatusCode {
-			case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
-				// retryable, continue
-			default:
-				// not retryable
-				return nil, fmt.Errorf("LLM error (non-retryable): %w", apiErr)
-			}
-		} else {
+		switch status := httpStatus(apiErr); status {
+		case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout, statusOverloaded:
+			// retryable, continue
+		case 0:
 			// unknown or non-API error
-			return nil, fmt.Errorf("LLM error: %w", apiErr)
+			return fmt.Errorf("%w: %w", ErrRequestFailed, apiErr)
+		default:
+			// not retryable
+			return fmt.Errorf("%w (non-retryable): %w", ErrRequestFailed, apiErr)
 		}
 
 		// backoff
 		jitter := time.Duration(rand.Intn(300)) * time.Millisecond //nolint:gosec // weak random is fine for jitter
 		delay := baseDelay * (1 << (attempt - 1))                  // exponential
+		slog.Debug("retrying LLM request", "attempt", attempt, "delay", delay+jitter, "error", apiErr)
 		time.Sleep(delay + jitter)
 	}
 
 	// if we failed all attempts
-	if apiErr != nil {
-		return nil, fmt.Errorf("LLM error: exceeded retries: %w", apiErr)
-	}
+	return fmt.Errorf("%w: exceeded retries: %w", ErrRequestFailed, apiErr)
+}
 
-	text := resp.Choices[0].Message.Content
+// redactRequest returns a copy of req with the system prompt and every
+// message redacted.
+func redactRequest(r *redact.Redactor, req Request) Request {
+	texts := []string{req.System}
+	for _, m := range req.Messages {
+		texts = append(texts, m.Content)
+	}
+	texts = r.RedactAll(texts)
 
-	return &Feedback{
-		Section:  sectionName,
-		Comments: text,
-		Score:    0, // optional TODO: parse score
-	}, nil
+	out := req
+	out.System = texts[0]
+	out.Messages = make([]Message, len(req.Messages))
+	for i, m := range req.Messages {
+		out.Messages[i] = Message{Role: m.Role, Content: texts[i+1]}
+	}
+	return out
 }
@@ -1,11 +1,22 @@
 package llm
 
 import (
+	"context"
+	"errors"
 	"os"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/pii"
+	"github.com/bordenet/pr-faq-validator/internal/redact"
 )
 
 func TestAnalyzeSection_NoAPIKey(t *testing.T) {
+	t.Setenv("ANTHROPIC_API_KEY", "")
+	t.Setenv(ProviderEnv, "")
+
 	// Save original API key
 	originalKey := os.Getenv("OPENAI_API_KEY")
 	defer func() {
@@ -131,3 +142,337 @@ func TestFeedbackFields(t *testing.T) {
 		})
 	}
 }
+
+func TestRewriteSection_NoAPIKey(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "")
+	t.Setenv("ANTHROPIC_API_KEY", "")
+	t.Setenv(ProviderEnv, "")
+
+	_, err := RewriteSection("Press Release", "Test content", []string{"Missing headline/title"})
+	if err == nil {
+		t.Fatal("Expected error when OPENAI_API_KEY is not set, got nil")
+	}
+	if !errors.Is(err, ErrNoAPIKey) {
+		t.Errorf("Expected ErrNoAPIKey, got %v", err)
+	}
+}
+
+func TestOfflineMode(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "test-key-for-testing")
+	SetOffline(true)
+	defer SetOffline(false)
+
+	if !Offline() {
+		t.Fatal("Offline() = false after SetOffline(true)")
+	}
+
+	if _, err := AnalyzeSection("Press Release", "Test content"); !errors.Is(err, ErrOffline) {
+		t.Errorf("AnalyzeSection() error = %v, want ErrOffline", err)
+	}
+	if _, err := RewriteSection("Press Release", "Test content", nil); !errors.Is(err, ErrOffline) {
+		t.Errorf("RewriteSection() error = %v, want ErrOffline", err)
+	}
+	if _, err := SuggestHeadlines("Title", "Test content", 5); !errors.Is(err, ErrOffline) {
+		t.Errorf("SuggestHeadlines() error = %v, want ErrOffline", err)
+	}
+	if _, err := CheckFlow([]string{"Test content"}); !errors.Is(err, ErrOffline) {
+		t.Errorf("CheckFlow() error = %v, want ErrOffline", err)
+	}
+	if _, err := RateAnswers([]FAQAnswer{{Question: "Is it free?"}}); !errors.Is(err, ErrOffline) {
+		t.Errorf("RateAnswers() error = %v, want ErrOffline", err)
+	}
+	if _, err := complete(nil, Request{System: "system"}); !errors.Is(err, ErrOffline) {
+		t.Errorf("complete() error = %v, want ErrOffline", err)
+	}
+}
+
+func TestParseHeadlines(t *testing.T) {
+	text := `Here are your headlines:
+1. Acme Launches CloudSync
+2) "Acme Cuts Backup Time by 80%"
+- 3x Faster Backups Arrive for Small Teams
+* acme launches cloudsync
+
+Current Title`
+	got := parseHeadlines(text, "Current Title", 3)
+	want := []string{"Acme Launches CloudSync", "Acme Cuts Backup Time by 80%", "3x Faster Backups Arrive for Small Teams"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("parseHeadlines() = %q, want %q", got, want)
+	}
+
+	got = parseHeadlines(text, "Current Title", 10)
+	if len(got) != 3 {
+		t.Errorf("parseHeadlines() = %q, want duplicates and the current title dropped", got)
+	}
+}
+
+func TestParseReview(t *testing.T) {
+	text := `**Strengths:**
+- Headline names the customer: clear
+- The hook cites a 40% drop in churn
+
+**Score: 7.5/10**
+
+**Category Scores:**
+- Headline Quality: 8/10 - names the product and the customer
+- **Credibility**: 3/10
+* 5 Ws Coverage: 9/10 — covers all five
+- Tone & Readability: 12/10 - out of range`
+
+	score, scored := parseScore(text)
+	if !scored || score != 7.5 {
+		t.Errorf("parseScore() = %v, %v, want 7.5", score, scored)
+	}
+	if _, scored := parseScore("Looks fine overall."); scored {
+		t.Error("parseScore() of a review without a score reported one")
+	}
+
+	want := []CategoryRating{
+		{Name: "Headline Quality", Score: 8, Reason: "names the product and the customer"},
+		{Name: "Credibility", Score: 3},
+		{Name: "5 Ws Coverage", Score: 9, Reason: "covers all five"},
+	}
+	if got := parseCategoryRatings(text); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseCategoryRatings() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseQuestions(t *testing.T) {
+	text := `Here are the questions:
+1. Customer: How much does the Pro plan cost?
+2) **Executive:** What does the launch depend on?
+- journalist: "How is this different from Dropbox?"
+Customer: how much does the pro plan cost?
+Customer: Pricing details
+What happens to my files if I cancel?`
+	got := parseQuestions(text, 10)
+	want := []Question{
+		{"Customer", "How much does the Pro plan cost?"},
+		{"Executive", "What does the launch depend on?"},
+		{"Journalist", "How is this different from Dropbox?"},
+		{"", "What happens to my files if I cancel?"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("parseQuestions() = %+v, want %+v", got, want)
+	}
+	if got := parseQuestions(text, 2); len(got) != 2 {
+		t.Errorf("parseQuestions() with count 2 = %+v", got)
+	}
+}
+
+func TestParseDefinitions(t *testing.T) {
+	text := `Here are the definitions:
+1. **Ledger Sync**: Northwind's nightly reconciliation service.
+- SOC 2: System and Organization Controls 2, a security audit: annual.
+ledger sync: A repeat.
+Nothing to define here`
+	got := parseDefinitions(text)
+	want := []Definition{
+		{"Ledger Sync", "Northwind's nightly reconciliation service."},
+		{"SOC 2", "System and Organization Controls 2, a security audit: annual."},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("parseDefinitions() = %+v, want %+v", got, want)
+	}
+}
+
+// recordingProvider answers every request and keeps what it was sent.
+type recordingProvider struct {
+	requests []Request
+	reply    string // "feedback" when empty
+}
+
+func (p *recordingProvider) Ping(context.Context) error { return nil }
+
+func (p *recordingProvider) Complete(_ context.Context, req Request) (Response, error) {
+	p.requests = append(p.requests, req)
+	if p.reply != "" {
+		return Response{Text: p.reply}, nil
+	}
+	return Response{Text: "feedback", Usage: Usage{InputTokens: 100, OutputTokens: 20}}, nil
+}
+
+// embeddingProvider is a recordingProvider that embeds every text as its length.
+type embeddingProvider struct {
+	recordingProvider
+	texts []string
+}
+
+func (p *embeddingProvider) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	p.texts = append(p.texts, texts...)
+	out := make([][]float32, len(texts))
+	for i, t := range texts {
+		out[i] = []float32{float32(len(t))}
+	}
+	return out, nil
+}
+
+func TestParseFlow(t *testing.T) {
+	text := `Here is what I found:
+- missing | - | evidence | Nothing shows the product works.
+**non-sequitur** | [3] | - | The founding story does not follow from the launch.
+gap | P2 | solution | How the sync fixes late closes is not said.
+order | 9 | availability | Out of range.
+missing | 2 | problem | A missing stage has no paragraph.
+tangent | 2 | - | Unknown kind.
+A line without separators`
+	got, ok := parseFlow(text, 4)
+	want := []FlowBreak{
+		{Kind: "missing", Stage: "evidence", Detail: "Nothing shows the product works."},
+		{Kind: "non-sequitur", Paragraph: 3, Detail: "The founding story does not follow from the launch."},
+		{Kind: "gap", Paragraph: 2, Stage: "solution", Detail: "How the sync fixes late closes is not said."},
+	}
+	if !ok || !slices.Equal(got, want) {
+		t.Errorf("parseFlow() = %+v, %v, want %+v", got, ok, want)
+	}
+
+	if got, ok := parseFlow("NONE", 4); !ok || len(got) != 0 {
+		t.Errorf("parseFlow(NONE) = %+v, %v, want no breaks", got, ok)
+	}
+	if _, ok := parseFlow("The story is fine.", 4); ok {
+		t.Error("parseFlow() of prose should report no usable response")
+	}
+}
+
+func TestParseRubric(t *testing.T) {
+	faqs := []FAQAnswer{{Question: "Is it free?"}, {Question: "Does it upload documents?"}, {Question: "Who is it for?"}}
+	text := `Ratings:
+2 | 5 | 2 | 3 | Say where the documents are processed.
+[1] | **4** | 1 | 1 | Name the license and any limits.
+1 | 5 | 5 | 5 | A second rating of the same answer.
+3 | 6 | 1 | 1 | Out of range.
+4 | 3 | 3 | 3 | No such question.
+A line without separators`
+	got := parseRubric(text, faqs)
+	want := []AnswerRating{
+		{Question: "Is it free?", Directness: 4, Evidence: 1, TradeOffs: 1, Note: "Name the license and any limits."},
+		{Question: "Does it upload documents?", Directness: 5, Evidence: 2, TradeOffs: 3, Note: "Say where the documents are processed."},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("parseRubric() = %+v, want %+v", got, want)
+	}
+
+	if got := FAQQuality(want); got != 42 {
+		t.Errorf("FAQQuality() = %d, want 42", got)
+	}
+	if got := FAQQuality([]AnswerRating{{Directness: 5, Evidence: 5, TradeOffs: 5}}); got != 100 {
+		t.Errorf("FAQQuality() of top ratings = %d, want 100", got)
+	}
+}
+
+func TestEmbedWith(t *testing.T) {
+	if _, err := embedWith(&recordingProvider{}, []string{"What's the pricing?"}); !errors.Is(err, ErrNoEmbeddings) {
+		t.Errorf("embedWith() without embeddings error = %v, want ErrNoEmbeddings", err)
+	}
+
+	SetRedactor(redact.New([]string{"Falcon"}))
+	defer SetRedactor(nil)
+	p := &embeddingProvider{}
+	vectors, err := embedWith(p, []string{"What does Falcon cost?", "Is it safe?"})
+	if err != nil {
+		t.Fatalf("embedWith() error = %v", err)
+	}
+	if len(vectors) != 2 || p.texts[0] != "What does [TERM_1] cost?" {
+		t.Errorf("embedWith() = %v, sent %q", vectors, p.texts)
+	}
+
+	SetPreflight(pii.New(nil, nil), false)
+	defer SetPreflight(nil, false)
+	if _, err := embedWith(p, []string{"Email jane@acme.internal?"}); !errors.Is(err, ErrSensitiveContent) {
+		t.Errorf("embedWith() with an email error = %v, want ErrSensitiveContent", err)
+	}
+}
+
+func TestSession_ReusesConversation(t *testing.T) {
+	p := &recordingProvider{}
+	session := &Session{provider: p}
+
+	if _, err := session.AnalyzeSection("Press Release", "PR content"); err != nil {
+		t.Fatalf("AnalyzeSection() error = %v", err)
+	}
+	if _, err := session.AnalyzeSection("FAQs", "FAQ content"); err != nil {
+		t.Fatalf("AnalyzeSection() error = %v", err)
+	}
+
+	if len(p.requests) != 2 {
+		t.Fatalf("got %d requests, want 2", len(p.requests))
+	}
+	first, second := p.requests[0], p.requests[1]
+	if first.System == "" || first.System != second.System {
+		t.Error("system prompt should be identical across the session")
+	}
+	if len(second.Messages) != 3 || second.Messages[0] != first.Messages[0] || second.Messages[1].Role != roleAssistant {
+		t.Errorf("second request should extend the first conversation: %+v", second.Messages)
+	}
+}
+
+func TestComplete_Redacts(t *testing.T) {
+	SetRedactor(redact.New([]string{"Falcon"}))
+	defer SetRedactor(nil)
+
+	p := &recordingProvider{reply: "Lead with what [TERM_1] saves [COMPANY_1] customers."}
+	text, err := complete(p, Request{
+		System:    "Review this section.",
+		Messages:  []Message{{Role: roleUser, Content: "Acme today announced Falcon at $5 per seat."}},
+		MaxTokens: 300,
+	})
+	if err != nil {
+		t.Fatalf("complete() error = %v", err)
+	}
+	if sent := p.requests[0].Messages[0].Content; sent != "[COMPANY_1] today announced [TERM_1] at [AMOUNT_1] per seat." {
+		t.Errorf("sent %q, want entities redacted", sent)
+	}
+	if p.requests[0].MaxTokens != 300 {
+		t.Errorf("sent max_tokens %d, want 300", p.requests[0].MaxTokens)
+	}
+	if text != "Lead with what Falcon saves Acme customers." {
+		t.Errorf("complete() = %q, want placeholders restored", text)
+	}
+}
+
+func TestComplete_Preflight(t *testing.T) {
+	defer SetPreflight(nil, false)
+	req := Request{Messages: []Message{{Role: roleUser, Content: "Questions go to jane@acme.internal."}}}
+
+	SetPreflight(pii.New(nil, nil), false)
+	p := &recordingProvider{}
+	if _, err := complete(p, req); !errors.Is(err, ErrSensitiveContent) {
+		t.Fatalf("complete() error = %v, want ErrSensitiveContent", err)
+	}
+	if len(p.requests) != 0 {
+		t.Error("flagged content was sent")
+	}
+
+	SetPreflight(pii.New(nil, nil), true)
+	if _, err := complete(p, req); err != nil {
+		t.Fatalf("complete() with allow error = %v", err)
+	}
+	if len(p.requests) != 1 {
+		t.Error("allowed content was not sent")
+	}
+}
+
+func TestNewRequest_Parameters(t *testing.T) {
+	defer SetGeneration(Generation{})
+
+	req, err := newRequest(ReviewPrompt, map[string]interface{}{"section_name": "FAQs", "content": "Q: Why?"})
+	if err != nil {
+		t.Fatalf("newRequest() error = %v", err)
+	}
+	if req.Temperature == nil || *req.Temperature != 0.5 || req.MaxTokens != 1500 {
+		t.Errorf("temperature %v, max_tokens %d, want the prompt file's 0.5 and 1500", req.Temperature, req.MaxTokens)
+	}
+	if len(req.Messages) != 1 || !strings.Contains(req.Messages[0].Content, "Q: Why?") {
+		t.Errorf("messages = %+v", req.Messages)
+	}
+
+	zero := 0.0
+	SetGeneration(Generation{Temperature: &zero, MaxTokens: 300})
+	req, err = newRequest(ReviewPrompt, map[string]interface{}{"section_name": "FAQs", "content": "Q: Why?"})
+	if err != nil {
+		t.Fatalf("newRequest() error = %v", err)
+	}
+	if req.Temperature == nil || *req.Temperature != 0 || req.MaxTokens != 300 {
+		t.Errorf("temperature %v, max_tokens %d, want the overrides 0 and 300", req.Temperature, req.MaxTokens)
+	}
+}
@@ -0,0 +1,302 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// Provider names accepted in PRFAQ_LLM_PROVIDER.
+const (
+	ProviderOpenAI    = "openai"
+	ProviderAnthropic = "anthropic"
+	ProviderBedrock   = "bedrock"
+	ProviderGemini    = "gemini"
+)
+
+// ProviderEnv selects the provider explicitly. When it is unset, Anthropic is
+// used if only ANTHROPIC_API_KEY is set, Gemini if only GEMINI_API_KEY is,
+// and OpenAI otherwise; Bedrock is only used when selected.
+const ProviderEnv = "PRFAQ_LLM_PROVIDER"
+
+// Message roles in a conversation.
+const (
+	roleUser      = "user"
+	roleAssistant = "assistant"
+)
+
+// Message is one turn of a conversation.
+type Message struct {
+	Role    string
+	Content string
+}
+
+// Request is a conversation to complete. System is the static instruction
+// block; providers that support prompt caching mark it cacheable.
+type Request struct {
+	Prompt   string // the prompt file it was rendered from, for transcripts
+	System   string
+	Messages []Message
+	// Temperature is nil and MaxTokens zero to use the provider's default.
+	Temperature *float64
+	MaxTokens   int
+}
+
+// Usage is the token accounting the provider reported for one completion.
+type Usage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+	// CachedTokens are the input tokens served from the prompt cache.
+	CachedTokens int `json:"cached_tokens"`
+}
+
+// Response is a completed turn.
+type Response struct {
+	Text  string
+	Usage Usage
+}
+
+// Client completes conversations with a language model. Each provider is
+// one; SetClient replaces the configured provider with another, such as a
+// Replayer or a test double.
+type Client interface {
+	Complete(ctx context.Context, req Request) (Response, error)
+	// Ping makes the cheapest authenticated request the API offers.
+	Ping(ctx context.Context) error
+}
+
+var override atomic.Pointer[Client]
+
+// SetClient sends every request to c instead of the provider the
+// environment selects, so no API key is needed. nil restores the provider.
+func SetClient(c Client) {
+	if c == nil {
+		override.Store(nil)
+		return
+	}
+	override.Store(&c)
+}
+
+// providerSpec registers a provider: the settings it needs, the model it
+// uses, and how to build it.
+type providerSpec struct {
+	// keys are the environment variables that must all be set.
+	keys []string
+	// check reports any other missing setting, wrapping ErrNoAPIKey; nil
+	// when there is none.
+	check func() error
+	// model is the default model, and modelEnv the variable that overrides
+	// it; "" when the model is fixed.
+	model    string
+	modelEnv string
+	build    func(model string) Client
+}
+
+// ProviderNames lists the providers accepted in PRFAQ_LLM_PROVIDER.
+var ProviderNames = []string{ProviderOpenAI, ProviderAnthropic, ProviderBedrock, ProviderGemini}
+
+// providers is the registry of providers, by name.
+var providers = map[string]providerSpec{
+	ProviderOpenAI: {
+		keys:     []string{"OPENAI_API_KEY"},
+		model:    GPT4O,
+		modelEnv: OpenAIModelEnv,
+		build: func(model string) Client {
+			config := openai.DefaultConfig(os.Getenv("OPENAI_API_KEY"))
+			config.BaseURL = baseURL(OpenAIBaseURLEnv, config.BaseURL)
+			config.HTTPClient = httpClient()
+			return &openAIProvider{client: openai.NewClientWithConfig(config), model: model}
+		},
+	},
+	ProviderAnthropic: {
+		keys:     []string{"ANTHROPIC_API_KEY"},
+		model:    ClaudeSonnet,
+		modelEnv: AnthropicModelEnv,
+		build: func(model string) Client {
+			return &anthropicProvider{
+				apiKey:  os.Getenv("ANTHROPIC_API_KEY"),
+				baseURL: baseURL(AnthropicBaseURLEnv, anthropicBaseURL),
+				model:   model,
+				client:  *httpClient(),
+			}
+		},
+	},
+	ProviderBedrock: {
+		keys: []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY"},
+		check: func() error {
+			if awsRegion() == "" {
+				return fmt.Errorf("AWS_REGION %w", ErrNoAPIKey)
+			}
+			return nil
+		},
+		model:    BedrockClaudeSonnet,
+		modelEnv: BedrockModelEnv,
+		build:    func(model string) Client { return newBedrockProvider(model) },
+	},
+	ProviderGemini: {
+		keys:     []string{GeminiKeyEnv},
+		model:    Gemini25Pro,
+		modelEnv: GeminiModelEnv,
+		build: func(model string) Client {
+			return &geminiProvider{apiKey: os.Getenv(GeminiKeyEnv), baseURL: geminiBaseURL, model: model, client: *httpClient()}
+		},
+	},
+}
+
+// ProviderName returns the provider that AI requests will use. It fails with
+// ErrUnknownProvider when PRFAQ_LLM_PROVIDER names none, and with ErrNoAPIKey
+// when a setting that provider needs, such as its API key, is not set.
+func ProviderName() (string, error) {
+	name := os.Getenv(ProviderEnv)
+	if name == "" {
+		name = ProviderOpenAI
+		// A lone Anthropic or Gemini key selects its provider
+		for _, other := range []string{ProviderAnthropic, ProviderGemini} {
+			if os.Getenv("OPENAI_API_KEY") == "" && os.Getenv(providers[other].keys[0]) != "" {
+				name = other
+				break
+			}
+		}
+	}
+
+	spec, ok := providers[name]
+	if !ok {
+		return name, fmt.Errorf("%w %q in %s (want one of %s)", ErrUnknownProvider, name, ProviderEnv, strings.Join(ProviderNames, ", "))
+	}
+	var missing []string
+	for _, key := range spec.keys {
+		if os.Getenv(key) == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return name, fmt.Errorf("%s %w", strings.Join(missing, " and "), ErrNoAPIKey)
+	}
+	if spec.check != nil {
+		if err := spec.check(); err != nil {
+			return name, err
+		}
+	}
+	return name, nil
+}
+
+// ProviderModel returns the model a provider uses: the value of its model
+// variable, such as PRFAQ_GEMINI_MODEL, or its default.
+func ProviderModel(name string) string {
+	spec := providers[name]
+	if spec.modelEnv != "" {
+		if model := os.Getenv(spec.modelEnv); model != "" {
+			return model
+		}
+	}
+	return spec.model
+}
+
+// newProvider returns the client set with SetClient, or else the
+// configured provider.
+func newProvider() (Client, error) {
+	if c := override.Load(); c != nil {
+		return *c, nil
+	}
+	return configuredProvider()
+}
+
+// configuredProvider returns the provider the environment selects, or
+// ErrNoAPIKey when its key is unset.
+func configuredProvider() (Client, error) {
+	name, err := ProviderName()
+	if err != nil {
+		return nil, err
+	}
+	return providers[name].build(ProviderModel(name)), nil
+}
+
+// Ping checks that the configured provider is reachable and accepts the API
+// key, without running a completion. It returns the provider name.
+func Ping(ctx context.Context) (string, error) {
+	if Offline() {
+		return "", ErrOffline
+	}
+	p, err := newProvider()
+	if err != nil {
+		return "", err
+	}
+	name, _ := ProviderName()
+	if err := p.Ping(ctx); err != nil {
+		return name, fmt.Errorf("%w: %w", ErrRequestFailed, err)
+	}
+	return name, nil
+}
+
+// openAIProvider uses the chat completions API. OpenAI caches prompt prefixes
+// automatically, so keeping the system prompt and history identical across
+// calls is all it needs.
+type openAIProvider struct {
+	client *openai.Client
+	model  string
+}
+
+func (p *openAIProvider) Complete(ctx context.Context, req Request) (Response, error) {
+	messages := make([]openai.ChatCompletionMessage, 0, len(req.Messages)+1)
+	messages = append(messages, openai.ChatCompletionMessage{Role: openai.ChatMessageRoleSystem, Content: req.System})
+	for _, m := range req.Messages {
+		messages = append(messages, openai.ChatCompletionMessage{Role: m.Role, Content: m.Content})
+	}
+
+	chat := openai.ChatCompletionRequest{Model: p.model, Messages: messages, MaxTokens: req.MaxTokens}
+	if req.Temperature != nil {
+		// The client omits a zero temperature, which the API would read as its default of 1
+		chat.Temperature = max(float32(*req.Temperature), math.SmallestNonzeroFloat32)
+	}
+	resp, err := p.client.CreateChatCompletion(ctx, chat)
+	if err != nil {
+		return Response{}, err
+	}
+
+	cached := 0
+	if resp.Usage.PromptTokensDetails != nil {
+		cached = resp.Usage.PromptTokensDetails.CachedTokens
+	}
+	slog.Debug("LLM usage", "provider", ProviderOpenAI, "input_tokens", resp.Usage.PromptTokens, "cached_tokens", cached)
+
+	if len(resp.Choices) == 0 {
+		return Response{}, fmt.Errorf("%w: empty response", ErrRequestFailed)
+	}
+	return Response{
+		Text:  resp.Choices[0].Message.Content,
+		Usage: Usage{InputTokens: resp.Usage.PromptTokens, OutputTokens: resp.Usage.CompletionTokens, CachedTokens: cached},
+	}, nil
+}
+
+func (p *openAIProvider) Ping(ctx context.Context) error {
+	_, err := p.client.ListModels(ctx)
+	return err
+}
+
+// httpStatus returns the HTTP status code of a provider API error, or 0.
+func httpStatus(err error) int {
+	var openaiErr *openai.APIError
+	if errors.As(err, &openaiErr) {
+		return openaiErr.HTTPStatusCode
+	}
+	var anthropicErr *anthropicError
+	if errors.As(err, &anthropicErr) {
+		return anthropicErr.StatusCode
+	}
+	var bedrockErr *bedrockError
+	if errors.As(err, &bedrockErr) {
+		return bedrockErr.StatusCode
+	}
+	var geminiErr *geminiError
+	if errors.As(err, &geminiErr) {
+		return geminiErr.StatusCode
+	}
+	return 0
+}
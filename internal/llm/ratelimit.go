@@ -0,0 +1,120 @@
+package llm
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// RateLimit caps LLM traffic on the client side so long runs stay under the
+// provider's quota instead of spending the retry budget on 429 responses.
+// Zero limits are unlimited.
+type RateLimit struct {
+	RequestsPerMinute int
+	TokensPerMinute   int
+	// OnThrottle, if set, is called before the limiter delays a request.
+	OnThrottle func(wait time.Duration)
+}
+
+// estimatedResponseTokens is added to each request's prompt size because
+// providers count generated tokens against the same per-minute quota.
+const estimatedResponseTokens = 1000
+
+// limiter enforces a RateLimit with two token buckets, one for requests and
+// one for tokens, that refill continuously at their per-minute rate. A
+// request larger than the remaining budget borrows from the future, so even
+// a prompt larger than the whole minute's budget eventually goes through.
+type limiter struct {
+	mu       sync.Mutex
+	limit    RateLimit
+	requests float64 // available request budget
+	tokens   float64 // available token budget
+	last     time.Time
+	now      func() time.Time
+	sleep    func(time.Duration)
+}
+
+func newLimiter(limit RateLimit) *limiter {
+	l := &limiter{now: time.Now, sleep: time.Sleep}
+	l.reset(limit)
+	return l
+}
+
+// reset applies limit with full buckets.
+func (l *limiter) reset(limit RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limit = limit
+	l.requests = float64(limit.RequestsPerMinute)
+	l.tokens = float64(limit.TokensPerMinute)
+	l.last = time.Time{}
+}
+
+var rateLimiter = newLimiter(RateLimit{})
+
+// SetRateLimit replaces the limit applied to every subsequent LLM request.
+func SetRateLimit(limit RateLimit) {
+	rateLimiter.reset(limit)
+}
+
+// reserve takes one request and tokens from the budget and returns how long
+// the caller must wait before sending.
+func (l *limiter) reserve(tokens int) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	elapsed := time.Duration(0)
+	if !l.last.IsZero() {
+		elapsed = now.Sub(l.last)
+	}
+	l.last = now
+
+	wait := take(&l.requests, l.limit.RequestsPerMinute, 1, elapsed)
+	return max(wait, take(&l.tokens, l.limit.TokensPerMinute, tokens, elapsed))
+}
+
+// take refills a bucket of the given per-minute capacity for elapsed time,
+// withdraws cost, and returns the wait until the balance is non-negative.
+func take(balance *float64, perMinute, cost int, elapsed time.Duration) time.Duration {
+	if perMinute <= 0 {
+		return 0
+	}
+	rate := float64(perMinute) / time.Minute.Seconds()
+	*balance = min(*balance+rate*elapsed.Seconds(), float64(perMinute)) - float64(cost)
+	if *balance >= 0 {
+		return 0
+	}
+	return time.Duration(-*balance / rate * float64(time.Second))
+}
+
+// wait blocks until a request of the given size fits the limit.
+func (l *limiter) wait(tokens int) {
+	d := l.reserve(tokens)
+	if d <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	notify := l.limit.OnThrottle
+	l.mu.Unlock()
+	if notify != nil {
+		notify(d)
+	} else {
+		slog.Info("throttling LLM requests", "wait", d)
+	}
+	l.sleep(d)
+}
+
+// estimateTokens approximates a request's token cost at four characters per
+// token, plus its max_tokens or a typical response.
+func estimateTokens(req Request) int {
+	chars := len(req.System)
+	for _, m := range req.Messages {
+		chars += len(m.Content)
+	}
+	if req.MaxTokens > 0 {
+		return chars/4 + req.MaxTokens
+	}
+	return chars/4 + estimatedResponseTokens
+}
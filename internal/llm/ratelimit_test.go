@@ -0,0 +1,81 @@
+package llm
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock drives a limiter without real sleeps.
+type fakeClock struct {
+	now   time.Time
+	slept []time.Duration
+}
+
+func (c *fakeClock) sleep(d time.Duration) {
+	c.slept = append(c.slept, d)
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter(limit RateLimit) (*limiter, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)}
+	l := newLimiter(limit)
+	l.now = func() time.Time { return clock.now }
+	l.sleep = clock.sleep
+	return l, clock
+}
+
+func TestLimiter_RequestsPerMinute(t *testing.T) {
+	l, clock := newTestLimiter(RateLimit{RequestsPerMinute: 2})
+
+	for range 3 {
+		l.wait(0)
+	}
+	if len(clock.slept) != 1 || clock.slept[0] != 30*time.Second {
+		t.Errorf("slept %v, want one 30s wait for the third request", clock.slept)
+	}
+}
+
+func TestLimiter_TokensPerMinute(t *testing.T) {
+	var throttled []time.Duration
+	l, clock := newTestLimiter(RateLimit{
+		TokensPerMinute: 6000,
+		OnThrottle:      func(d time.Duration) { throttled = append(throttled, d) },
+	})
+
+	l.wait(4000)
+	l.wait(4000) // 2000 tokens short: refills at 100/s
+	if len(clock.slept) != 1 || clock.slept[0] != 20*time.Second {
+		t.Errorf("slept %v, want one 20s wait", clock.slept)
+	}
+	if len(throttled) != 1 {
+		t.Errorf("OnThrottle called %d times, want 1", len(throttled))
+	}
+
+	// Idle time refills the bucket, but never beyond one minute's budget
+	clock.now = clock.now.Add(time.Hour)
+	l.wait(6000)
+	if len(clock.slept) != 1 {
+		t.Errorf("slept %v after refilling, want no new wait", clock.slept)
+	}
+}
+
+func TestLimiter_Unlimited(t *testing.T) {
+	l, clock := newTestLimiter(RateLimit{})
+	for range 100 {
+		l.wait(1_000_000)
+	}
+	if len(clock.slept) != 0 {
+		t.Errorf("slept %v without a limit", clock.slept)
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	req := Request{System: "12345678", Messages: []Message{{Role: roleUser, Content: "1234"}}}
+	if got, want := estimateTokens(req), 3+estimatedResponseTokens; got != want {
+		t.Errorf("estimateTokens() = %d, want %d", got, want)
+	}
+	req.MaxTokens = 500
+	if got, want := estimateTokens(req), 3+500; got != want {
+		t.Errorf("estimateTokens() with max_tokens = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,190 @@
+package llm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNoFixture is returned by a Replayer for a request that was never recorded.
+var ErrNoFixture = errors.New("no recorded response")
+
+// fixture is the file a Recorder writes for one request. The request is
+// kept so fixtures can be reviewed, and the response edited, by hand.
+type fixture struct {
+	Request    *Request    `json:"request,omitempty"`
+	Texts      []string    `json:"texts,omitempty"`
+	Response   *Response   `json:"response,omitempty"`
+	Embeddings [][]float32 `json:"embeddings,omitempty"`
+}
+
+// fixtureName names the fixture of a request after its prompt file and a
+// hash of everything sent, e.g. section_review-3f2a9c0b1d4e.json, so the
+// same document, prompts, and flags find the same fixture.
+func fixtureName(prefix string, key any) (string, error) {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return prefix + "-" + hex.EncodeToString(sum[:6]) + ".json", nil
+}
+
+func requestFixture(req Request) (string, error) {
+	prefix := "request"
+	if req.Prompt != "" {
+		prefix = strings.TrimSuffix(path.Base(req.Prompt), path.Ext(req.Prompt))
+	}
+	return fixtureName(prefix, req)
+}
+
+func embedFixture(texts []string) (string, error) {
+	return fixtureName("embed", texts)
+}
+
+// Replayer is a Client that answers from the fixtures a Recorder wrote, so
+// the AI pipeline runs deterministically without a network or API keys.
+type Replayer struct {
+	dir string
+}
+
+// NewReplayer replays the fixtures in dir.
+func NewReplayer(dir string) (*Replayer, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open fixtures: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("failed to open fixtures: %s is not a directory", dir)
+	}
+	return &Replayer{dir: dir}, nil
+}
+
+// Complete returns the recorded response to req, or ErrNoFixture.
+func (r *Replayer) Complete(_ context.Context, req Request) (Response, error) {
+	name, err := requestFixture(req)
+	if err != nil {
+		return Response{}, err
+	}
+	f, err := r.load(name)
+	if err != nil {
+		return Response{}, err
+	}
+	if f.Response == nil {
+		return Response{}, fmt.Errorf("%w: %s has no response", ErrNoFixture, name)
+	}
+	return *f.Response, nil
+}
+
+// Embed returns the recorded embeddings of texts, or ErrNoFixture.
+func (r *Replayer) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	name, err := embedFixture(texts)
+	if err != nil {
+		return nil, err
+	}
+	f, err := r.load(name)
+	if err != nil {
+		return nil, err
+	}
+	return f.Embeddings, nil
+}
+
+// Ping always succeeds: replaying needs nothing outside dir.
+func (r *Replayer) Ping(context.Context) error {
+	return nil
+}
+
+func (r *Replayer) load(name string) (fixture, error) {
+	var f fixture
+	data, err := os.ReadFile(filepath.Join(r.dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return f, fmt.Errorf("%w: %s in %s; record it with -llm-record", ErrNoFixture, name, r.dir)
+	}
+	if err != nil {
+		return f, err
+	}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return f, fmt.Errorf("invalid fixture %s: %w", name, err)
+	}
+	return f, nil
+}
+
+// Recorder is a Client that sends requests to the configured provider and
+// saves every response as a fixture for a Replayer.
+type Recorder struct {
+	dir string
+}
+
+// NewRecorder records fixtures into dir, creating it if needed.
+func NewRecorder(dir string) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create fixtures directory: %w", err)
+	}
+	return &Recorder{dir: dir}, nil
+}
+
+// Complete completes req with the configured provider and records the response.
+func (r *Recorder) Complete(ctx context.Context, req Request) (Response, error) {
+	p, err := configuredProvider()
+	if err != nil {
+		return Response{}, err
+	}
+	resp, err := p.Complete(ctx, req)
+	if err != nil {
+		return Response{}, err
+	}
+	name, err := requestFixture(req)
+	if err != nil {
+		return Response{}, err
+	}
+	return resp, r.save(name, fixture{Request: &req, Response: &resp})
+}
+
+// Embed embeds texts with the configured provider and records the vectors.
+func (r *Recorder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	p, err := configuredProvider()
+	if err != nil {
+		return nil, err
+	}
+	e, ok := p.(Embedder)
+	if !ok {
+		return nil, ErrNoEmbeddings
+	}
+	vectors, err := e.Embed(ctx, texts)
+	if err != nil {
+		return nil, err
+	}
+	name, err := embedFixture(texts)
+	if err != nil {
+		return nil, err
+	}
+	return vectors, r.save(name, fixture{Texts: texts, Embeddings: vectors})
+}
+
+// Ping pings the configured provider.
+func (r *Recorder) Ping(ctx context.Context) error {
+	p, err := configuredProvider()
+	if err != nil {
+		return err
+	}
+	return p.Ping(ctx)
+}
+
+func (r *Recorder) save(name string, f fixture) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(r.dir, name), append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to record fixture: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package llm
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecordReplay(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"content":[{"type":"text","text":"Lead with the customer."}],"usage":{"input_tokens":120,"output_tokens":8}}`))
+	}))
+	defer srv.Close()
+
+	dir := filepath.Join(t.TempDir(), "fixtures")
+	t.Setenv(ProviderEnv, ProviderAnthropic)
+	t.Setenv("ANTHROPIC_API_KEY", "test-key")
+	t.Setenv(AnthropicBaseURLEnv, srv.URL)
+
+	recorder, err := NewRecorder(dir)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	SetClient(recorder)
+	defer SetClient(nil)
+	recorded, err := AnalyzeSection("Press Release", "Acme launches Ledger Sync.")
+	if err != nil {
+		t.Fatalf("AnalyzeSection() while recording error = %v", err)
+	}
+	if files, _ := filepath.Glob(filepath.Join(dir, "section_review-*.json")); len(files) != 1 || calls != 1 {
+		t.Fatalf("recorded %v with %d calls, want one section_review fixture", files, calls)
+	}
+
+	// Replaying needs neither the provider nor its key
+	t.Setenv("ANTHROPIC_API_KEY", "")
+	srv.Close()
+	replayer, err := NewReplayer(dir)
+	if err != nil {
+		t.Fatalf("NewReplayer() error = %v", err)
+	}
+	SetClient(replayer)
+	replayed, err := AnalyzeSection("Press Release", "Acme launches Ledger Sync.")
+	if err != nil {
+		t.Fatalf("AnalyzeSection() while replaying error = %v", err)
+	}
+	if replayed.Comments != recorded.Comments {
+		t.Errorf("replayed %q, want the recorded %q", replayed.Comments, recorded.Comments)
+	}
+
+	if _, err := AnalyzeSection("Press Release", "Acme launches Ledger Sync today."); !errors.Is(err, ErrNoFixture) {
+		t.Errorf("AnalyzeSection() of an unrecorded section error = %v, want ErrNoFixture", err)
+	}
+}
+
+func TestReplayer_Embed(t *testing.T) {
+	dir := t.TempDir()
+	name, err := embedFixture([]string{"What does it cost?"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(`{"embeddings":[[0.5,0.25]]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	replayer, err := NewReplayer(dir)
+	if err != nil {
+		t.Fatalf("NewReplayer() error = %v", err)
+	}
+	vectors, err := embedWith(replayer, []string{"What does it cost?"})
+	if err != nil {
+		t.Fatalf("embedWith() error = %v", err)
+	}
+	if len(vectors) != 1 || vectors[0][1] != 0.25 {
+		t.Errorf("embedWith() = %v, want the recorded vector", vectors)
+	}
+
+	if _, err := NewReplayer(filepath.Join(dir, "missing")); err == nil {
+		t.Error("NewReplayer() of a missing directory error = nil")
+	}
+}
@@ -0,0 +1,32 @@
+package llm
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestSignV4 checks the signer against the get-vanilla case of the AWS
+// Signature Version 4 test suite.
+func TestSignV4(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds := awsCredentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+	signV4(req, nil, creds, "us-east-1", "service", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	want := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31"
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("Authorization = %q, want %q", got, want)
+	}
+}
+
+func TestAWSEscape(t *testing.T) {
+	if got, want := awsEscape("/model/anthropic.claude-v2:1/invoke", false), "/model/anthropic.claude-v2%3A1/invoke"; got != want {
+		t.Errorf("awsEscape() = %q, want %q", got, want)
+	}
+	if got, want := awsEscape("a/b c", true), "a%2Fb%20c"; got != want {
+		t.Errorf("awsEscape() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,134 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/pii"
+)
+
+// secretEnv are the variables whose values never appear in a transcript,
+// even when a document or error message repeats them.
+var secretEnv = []string{"OPENAI_API_KEY", "ANTHROPIC_API_KEY", GeminiKeyEnv, "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN"}
+
+// transcriptLog writes one file per completion to a directory.
+type transcriptLog struct {
+	dir         string
+	omitContent bool
+	run         string // the run's start time, which prefixes every file name
+	seq         atomic.Int64
+}
+
+var transcript atomic.Pointer[transcriptLog]
+
+// SetTranscript writes every AI exchange of the run to dir: the prompt as
+// sent, after redaction, the response, the provider and model, timing, and
+// token counts, one JSON file per request. API keys and secrets are masked.
+// With omitContent, the prompt and response are reduced to their lengths.
+// An empty dir stops writing transcripts.
+func SetTranscript(dir string, omitContent bool) error {
+	if dir == "" {
+		transcript.Store(nil)
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create transcript directory: %w", err)
+	}
+	transcript.Store(&transcriptLog{dir: dir, omitContent: omitContent, run: time.Now().UTC().Format("20060102T150405Z")})
+	return nil
+}
+
+type transcriptMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content,omitempty"`
+	Chars   int    `json:"chars"`
+}
+
+// transcriptEntry is the file written for one completion.
+type transcriptEntry struct {
+	Time        time.Time           `json:"time"`
+	DurationMS  int64               `json:"duration_ms"`
+	Provider    string              `json:"provider"`
+	Model       string              `json:"model"`
+	Prompt      string              `json:"prompt,omitempty"`
+	Temperature *float64            `json:"temperature,omitempty"`
+	MaxTokens   int                 `json:"max_tokens,omitempty"`
+	System      transcriptMessage   `json:"system"`
+	Messages    []transcriptMessage `json:"messages"`
+	Response    *transcriptMessage  `json:"response,omitempty"`
+	Usage       Usage               `json:"usage"`
+	Error       string              `json:"error,omitempty"`
+}
+
+// record writes the exchange that started at start. A failed write is
+// logged; it never fails the request.
+func (t *transcriptLog) record(start time.Time, req Request, resp Response, err error) {
+	name, _ := ProviderName()
+	entry := transcriptEntry{
+		Time:        start.UTC(),
+		DurationMS:  time.Since(start).Milliseconds(),
+		Provider:    name,
+		Model:       ProviderModel(name),
+		Prompt:      req.Prompt,
+		Temperature: req.Temperature,
+		MaxTokens:   req.MaxTokens,
+		System:      t.turn("system", req.System),
+		Usage:       resp.Usage,
+	}
+	for _, m := range req.Messages {
+		entry.Messages = append(entry.Messages, t.turn(m.Role, m.Content))
+	}
+	if err != nil {
+		entry.Error = maskSecrets(err.Error())
+	} else {
+		reply := t.turn(roleAssistant, resp.Text)
+		entry.Response = &reply
+	}
+
+	data, jsonErr := json.MarshalIndent(entry, "", "  ")
+	if jsonErr != nil {
+		slog.Warn("failed to encode LLM transcript", "error", jsonErr)
+		return
+	}
+	file := filepath.Join(t.dir, t.fileName(req.Prompt))
+	if writeErr := os.WriteFile(file, append(data, '\n'), 0o600); writeErr != nil {
+		slog.Warn("failed to write LLM transcript", "file", file, "error", writeErr)
+	}
+}
+
+// turn records a turn, or only its length when content is omitted.
+func (t *transcriptLog) turn(role, content string) transcriptMessage {
+	m := transcriptMessage{Role: role, Chars: len(content)}
+	if !t.omitContent {
+		m.Content = maskSecrets(content)
+	}
+	return m
+}
+
+// fileName numbers the run's transcripts in request order, e.g.
+// 20261016T091500Z-003-section_review.json.
+func (t *transcriptLog) fileName(prompt string) string {
+	name := strings.TrimSuffix(path.Base(prompt), path.Ext(prompt))
+	if prompt == "" {
+		name = "request"
+	}
+	return fmt.Sprintf("%s-%03d-%s.json", t.run, t.seq.Add(1), name)
+}
+
+// maskSecrets masks API keys in text: those in the environment, and any in
+// a recognized key format.
+func maskSecrets(text string) string {
+	for _, key := range secretEnv {
+		if v := os.Getenv(key); len(v) >= 8 {
+			text = strings.ReplaceAll(text, v, v[:4]+"****")
+		}
+	}
+	return pii.MaskSecrets(text)
+}
@@ -0,0 +1,67 @@
+package llm
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTranscript(t *testing.T) {
+	t.Setenv(ProviderEnv, ProviderOpenAI)
+	t.Setenv("OPENAI_API_KEY", "local-gateway-key-1234")
+
+	tests := []struct {
+		name        string
+		omitContent bool
+		wantContent string
+	}{
+		{"content", false, "Review loca****: it calls sk-p****."},
+		{"metadata only", true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "llm-log")
+			if err := SetTranscript(dir, tt.omitContent); err != nil {
+				t.Fatalf("SetTranscript() error = %v", err)
+			}
+			defer func() { _ = SetTranscript("", false) }()
+
+			user := "Review local-gateway-key-1234: it calls sk-proj-abcdefghijklmnopqrstuvwxyz."
+			req := Request{Prompt: ReviewPrompt, System: "You are a reviewer.", Messages: []Message{{Role: roleUser, Content: user}}}
+			if _, err := complete(&recordingProvider{}, req); err != nil {
+				t.Fatalf("complete() error = %v", err)
+			}
+
+			files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+			if len(files) != 1 || !strings.HasSuffix(files[0], "-001-section_review.json") {
+				t.Fatalf("transcript files = %v, want one numbered section_review file", files)
+			}
+			data, err := os.ReadFile(files[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			var entry transcriptEntry
+			if err := json.Unmarshal(data, &entry); err != nil {
+				t.Fatalf("transcript is not JSON: %v", err)
+			}
+
+			if entry.Provider != ProviderOpenAI || entry.Model != GPT4O || entry.Prompt != ReviewPrompt {
+				t.Errorf("entry = %s/%s/%s, want the provider, model, and prompt", entry.Provider, entry.Model, entry.Prompt)
+			}
+			if entry.Usage.InputTokens != 100 || entry.Usage.OutputTokens != 20 {
+				t.Errorf("usage = %+v, want the provider's token counts", entry.Usage)
+			}
+			if len(entry.Messages) != 1 || entry.Messages[0].Content != tt.wantContent || entry.Messages[0].Chars != len(user) {
+				t.Errorf("messages = %+v, want content %q", entry.Messages, tt.wantContent)
+			}
+			if entry.Response == nil || entry.Response.Chars != len("feedback") {
+				t.Errorf("response = %+v, want the reply's length", entry.Response)
+			}
+			if strings.Contains(string(data), "local-gateway-key-1234") {
+				t.Error("transcript contains the API key")
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package llm
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// Variables that route provider traffic somewhere other than the vendor's
+// public API, such as an OpenAI-compatible gateway (LiteLLM, vLLM) or an
+// internal mirror.
+const (
+	// OpenAIBaseURLEnv overrides the OpenAI API base URL, including its
+	// version path, e.g. http://litellm.internal:4000/v1.
+	OpenAIBaseURLEnv = "OPENAI_BASE_URL"
+	// OpenAIModelEnv overrides the OpenAI model, for gateways that serve
+	// other models under the same API.
+	OpenAIModelEnv = "PRFAQ_OPENAI_MODEL"
+	// AnthropicBaseURLEnv overrides the Anthropic API host.
+	AnthropicBaseURLEnv = "ANTHROPIC_BASE_URL"
+)
+
+var customClient atomic.Pointer[http.Client]
+
+// SetHTTPClient sends every provider request through c, e.g. one with a
+// corporate CA pool or an authenticating transport. nil restores the default
+// client, which honors HTTPS_PROXY, HTTP_PROXY, and NO_PROXY.
+func SetHTTPClient(c *http.Client) {
+	customClient.Store(c)
+}
+
+// httpClient returns the client set with SetHTTPClient, or the default one.
+func httpClient() *http.Client {
+	if c := customClient.Load(); c != nil {
+		return c
+	}
+	return &http.Client{}
+}
+
+// NewProxyClient returns a client that sends requests through the proxy at
+// proxyURL, for every host, instead of the proxy the environment names. An
+// empty proxyURL returns the default client.
+func NewProxyClient(proxyURL string) (*http.Client, error) {
+	if proxyURL == "" {
+		return &http.Client{}, nil
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: want scheme://host[:port]", proxyURL)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyURL(u)
+	return &http.Client{Transport: transport}, nil
+}
+
+// baseURL returns the value of env without a trailing slash, or def when it
+// is unset.
+func baseURL(env, def string) string {
+	if v := os.Getenv(env); v != "" {
+		return strings.TrimSuffix(v, "/")
+	}
+	return def
+}
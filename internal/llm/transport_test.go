@@ -0,0 +1,116 @@
+package llm
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// roundTripFunc lets a test observe requests sent through an injected client.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestOpenAIProvider_BaseURL(t *testing.T) {
+	var path, auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, auth = r.URL.Path, r.Header.Get("Authorization")
+		_, _ = io.WriteString(w, `{"choices":[{"message":{"role":"assistant","content":"ok"}}]}`)
+	}))
+	defer srv.Close()
+
+	t.Setenv(ProviderEnv, ProviderOpenAI)
+	t.Setenv("OPENAI_API_KEY", "gateway-key")
+	t.Setenv(OpenAIBaseURLEnv, srv.URL+"/v1/")
+	t.Setenv(OpenAIModelEnv, "llama-3.1-70b")
+
+	p, err := newProvider()
+	if err != nil {
+		t.Fatalf("newProvider() error = %v", err)
+	}
+	if got := p.(*openAIProvider).model; got != "llama-3.1-70b" {
+		t.Errorf("model = %q, want the %s override", got, OpenAIModelEnv)
+	}
+	resp, err := p.Complete(context.Background(), Request{System: "s", Messages: []Message{{Role: roleUser, Content: "u"}}})
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if resp.Text != "ok" {
+		t.Errorf("Complete() = %q, want %q", resp.Text, "ok")
+	}
+	if path != "/v1/chat/completions" {
+		t.Errorf("request path = %q, want /v1/chat/completions", path)
+	}
+	if auth != "Bearer gateway-key" {
+		t.Errorf("Authorization = %q, want the API key", auth)
+	}
+}
+
+func TestSetHTTPClient(t *testing.T) {
+	var hosts []string
+	SetHTTPClient(&http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		hosts = append(hosts, r.URL.Host)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}")), Request: r}, nil
+	})})
+	defer SetHTTPClient(nil)
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{"openai", map[string]string{"OPENAI_API_KEY": "k", ProviderEnv: ProviderOpenAI}, "api.openai.com"},
+		{"anthropic", map[string]string{"ANTHROPIC_API_KEY": "k", ProviderEnv: ProviderAnthropic}, "api.anthropic.com"},
+		{"anthropic gateway", map[string]string{"ANTHROPIC_API_KEY": "k", ProviderEnv: ProviderAnthropic, AnthropicBaseURLEnv: "https://gateway.internal/"}, "gateway.internal"},
+		{"gemini", map[string]string{GeminiKeyEnv: "k", ProviderEnv: ProviderGemini}, "generativelanguage.googleapis.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"OPENAI_API_KEY", "ANTHROPIC_API_KEY", GeminiKeyEnv, ProviderEnv, OpenAIBaseURLEnv, AnthropicBaseURLEnv} {
+				t.Setenv(key, tt.env[key])
+			}
+			hosts = nil
+
+			p, err := newProvider()
+			if err != nil {
+				t.Fatalf("newProvider() error = %v", err)
+			}
+			_ = p.Ping(context.Background())
+			if len(hosts) != 1 || hosts[0] != tt.want {
+				t.Errorf("injected client saw hosts %v, want [%s]", hosts, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewProxyClient(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy receives the absolute URL of the target
+		proxied = r.URL.String()
+		_, _ = io.WriteString(w, "via proxy")
+	}))
+	defer proxy.Close()
+
+	c, err := NewProxyClient(proxy.URL)
+	if err != nil {
+		t.Fatalf("NewProxyClient() error = %v", err)
+	}
+	resp, err := c.Get("http://llm.example.com/v1/models")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	_ = resp.Body.Close()
+	if proxied != "http://llm.example.com/v1/models" {
+		t.Errorf("proxy saw %q, want the target URL", proxied)
+	}
+
+	for _, bad := range []string{"proxy.internal:3128", "://"} {
+		if _, err := NewProxyClient(bad); err == nil {
+			t.Errorf("NewProxyClient(%q) error = nil, want invalid proxy URL", bad)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Package logging builds the structured slog logger shared by every CLI mode.
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+)
+
+// ErrUnknownFormat is returned for log formats other than text and json.
+var ErrUnknownFormat = errors.New("unknown log format")
+
+// Options controls where log records go and how much is logged.
+type Options struct {
+	// Verbosity raises the level: 0 logs warnings and errors, 1 (-v) adds
+	// info, 2 or more (-vv) adds debug.
+	Verbosity int
+	// Format is "text" (the default) or "json".
+	Format string
+	// File appends logs to this path instead of Output.
+	File string
+	// Output is the destination when File is empty, typically os.Stderr.
+	// A nil Output discards records, which keeps the TUI's terminal clean.
+	Output io.Writer
+}
+
+// Level maps a verbosity count to a slog level.
+func Level(verbosity int) slog.Level {
+	switch {
+	case verbosity >= 2:
+		return slog.LevelDebug
+	case verbosity == 1:
+		return slog.LevelInfo
+	default:
+		return slog.LevelWarn
+	}
+}
+
+// New builds a logger from opts. The returned closer releases the log file,
+// if one was opened, and is always safe to call.
+func New(opts Options) (*slog.Logger, io.Closer, error) {
+	out := opts.Output
+	var closer io.Closer = nopCloser{}
+	if opts.File != "" {
+		file, err := os.OpenFile(opts.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600) //nolint:gosec // path is user-provided CLI argument
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open log file: %w", err)
+		}
+		out, closer = file, file
+	}
+	if out == nil {
+		out = io.Discard
+	}
+
+	handlerOpts := &slog.HandlerOptions{Level: Level(opts.Verbosity)}
+	switch opts.Format {
+	case "", "text":
+		return slog.New(slog.NewTextHandler(out, handlerOpts)), closer, nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(out, handlerOpts)), closer, nil
+	default:
+		_ = closer.Close()
+		return nil, nil, fmt.Errorf("%w: %q (supported: text, json)", ErrUnknownFormat, opts.Format)
+	}
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
@@ -0,0 +1,104 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLevel(t *testing.T) {
+	tests := []struct {
+		verbosity int
+		want      slog.Level
+	}{
+		{0, slog.LevelWarn},
+		{1, slog.LevelInfo},
+		{2, slog.LevelDebug},
+		{5, slog.LevelDebug},
+	}
+	for _, tt := range tests {
+		if got := Level(tt.verbosity); got != tt.want {
+			t.Errorf("Level(%d) = %v, want %v", tt.verbosity, got, tt.want)
+		}
+	}
+}
+
+func TestNew_TextVerbosity(t *testing.T) {
+	var buf bytes.Buffer
+	logger, closer, err := New(Options{Output: &buf})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer func() { _ = closer.Close() }()
+
+	logger.Info("hidden")
+	logger.Warn("shown", "key", "value")
+
+	out := buf.String()
+	if strings.Contains(out, "hidden") {
+		t.Errorf("info record logged at default verbosity: %q", out)
+	}
+	if !strings.Contains(out, "msg=shown") || !strings.Contains(out, "key=value") {
+		t.Errorf("output = %q, want text warning record", out)
+	}
+}
+
+func TestNew_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger, _, err := New(Options{Verbosity: 2, Format: "json", Output: &buf})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	logger.Debug("parsed", "file", "doc.md")
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("output is not JSON: %v (%q)", err, buf.String())
+	}
+	if record["msg"] != "parsed" || record["file"] != "doc.md" || record["level"] != "DEBUG" {
+		t.Errorf("record = %v", record)
+	}
+}
+
+func TestNew_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "validator.log")
+	logger, closer, err := New(Options{File: path, Output: os.Stderr})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	logger.Error("boom")
+	if err := closer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // test code with controlled paths
+	if err != nil {
+		t.Fatalf("failed to read log file: %v", err)
+	}
+	if !strings.Contains(string(data), "msg=boom") {
+		t.Errorf("log file = %q", data)
+	}
+}
+
+func TestNew_NilOutputDiscards(t *testing.T) {
+	logger, _, err := New(Options{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	logger.Error("dropped") // must not panic
+}
+
+func TestNew_Errors(t *testing.T) {
+	if _, _, err := New(Options{Format: "xml"}); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("New(xml) error = %v, want ErrUnknownFormat", err)
+	}
+	if _, _, err := New(Options{File: "/nonexistent/dir/log.txt"}); err == nil {
+		t.Error("New() with unwritable file should fail")
+	}
+}
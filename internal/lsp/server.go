@@ -0,0 +1,335 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf16"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// RewriteCommand is the workspace command that applies an AI rewrite to a section.
+const RewriteCommand = "prfaq.rewriteSection"
+
+const diagnosticSource = "pr-faq-validator"
+
+// RewriteFunc rewrites a section so it resolves the given issue messages.
+type RewriteFunc func(section, content string, issues []string) (string, error)
+
+// Server is a single-client LSP server speaking JSON-RPC over a stream.
+type Server struct {
+	in      *bufio.Reader
+	out     io.Writer
+	outMu   sync.Mutex
+	rewrite RewriteFunc
+
+	docs   map[string]string
+	nextID int
+}
+
+// NewServer creates a server reading requests from in and writing to out.
+// rewrite may be nil, in which case no rewrite code actions are offered.
+func NewServer(in io.Reader, out io.Writer, rewrite RewriteFunc) *Server {
+	return &Server{
+		in:      bufio.NewReader(in),
+		out:     out,
+		rewrite: rewrite,
+		docs:    make(map[string]string),
+	}
+}
+
+// Run serves requests until the client sends exit or closes the stream.
+func (s *Server) Run() error {
+	for {
+		body, err := readMessage(s.in)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		var req request
+		if err := json.Unmarshal(body, &req); err != nil {
+			if err := s.replyError(nil, codeParseError, err.Error()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if req.Method == "exit" {
+			return nil
+		}
+		if req.Method == "" {
+			// response to one of our requests (workspace/applyEdit)
+			continue
+		}
+
+		if err := s.handle(req); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(req request) error {
+	result, rpcErr := s.dispatch(req)
+	if req.ID == nil {
+		// notification: no reply
+		return nil
+	}
+	if rpcErr != nil {
+		return s.replyError(req.ID, rpcErr.Code, rpcErr.Message)
+	}
+	return s.send(response{JSONRPC: "2.0", ID: req.ID, Result: result})
+}
+
+func (s *Server) dispatch(req request) (interface{}, *rpcError) {
+	switch req.Method {
+	case "initialize":
+		return map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync":       map[string]interface{}{"openClose": true, "change": 1},
+				"hoverProvider":          true,
+				"codeActionProvider":     s.rewrite != nil,
+				"executeCommandProvider": map[string]interface{}{"commands": []string{RewriteCommand}},
+			},
+			"serverInfo": map[string]string{"name": diagnosticSource},
+		}, nil
+	case "initialized", "textDocument/didSave", "$/cancelRequest", "$/setTrace":
+		return nil, nil
+	case "shutdown":
+		return nil, nil
+	case "textDocument/didOpen":
+		var p didOpenParams
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		s.docs[p.TextDocument.URI] = p.TextDocument.Text
+		return nil, s.publish(p.TextDocument.URI)
+	case "textDocument/didChange":
+		var p didChangeParams
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		if n := len(p.ContentChanges); n > 0 {
+			s.docs[p.TextDocument.URI] = p.ContentChanges[n-1].Text
+		}
+		return nil, s.publish(p.TextDocument.URI)
+	case "textDocument/didClose":
+		var p textDocumentParams
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		delete(s.docs, p.TextDocument.URI)
+		if err := s.sendDiagnostics(p.TextDocument.URI, []Diagnostic{}); err != nil {
+			return nil, &rpcError{Code: codeInternalError, Message: err.Error()}
+		}
+		return nil, nil
+	case "textDocument/hover":
+		var p hoverParams
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		return s.hover(p), nil
+	case "textDocument/codeAction":
+		var p codeActionParams
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		return s.codeActions(p), nil
+	case "workspace/executeCommand":
+		var p executeCommandParams
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		return nil, s.executeCommand(p)
+	}
+	return nil, &rpcError{Code: codeMethodNotFound, Message: "method not found: " + req.Method}
+}
+
+// analyze parses the open document, returning nil if it is unknown or unparseable.
+func (s *Server) analyze(uri string) *parser.SpecSections {
+	text, ok := s.docs[uri]
+	if !ok {
+		return nil
+	}
+	sections, err := parser.Parse(strings.NewReader(text))
+	if err != nil {
+		return nil
+	}
+	return sections
+}
+
+func (s *Server) publish(uri string) *rpcError {
+	sections := s.analyze(uri)
+	diagnostics := []Diagnostic{}
+	if sections != nil {
+		lines := strings.Split(s.docs[uri], "\n")
+		for _, f := range sections.Findings() {
+			diagnostics = append(diagnostics, Diagnostic{
+				Range:    lineRange(lines, f.Line, f.Line),
+				Severity: severityCode(f.Severity),
+				Code:     f.RuleID,
+				Source:   diagnosticSource,
+				Message:  f.Message,
+			})
+		}
+	}
+	if err := s.sendDiagnostics(uri, diagnostics); err != nil {
+		return &rpcError{Code: codeInternalError, Message: err.Error()}
+	}
+	return nil
+}
+
+func (s *Server) sendDiagnostics(uri string, diagnostics []Diagnostic) error {
+	return s.send(outgoingRequest{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params:  map[string]interface{}{"uri": uri, "diagnostics": diagnostics},
+	})
+}
+
+func (s *Server) hover(p hoverParams) interface{} {
+	sections := s.analyze(p.TextDocument.URI)
+	if sections == nil {
+		return nil
+	}
+
+	var parts []string
+	seen := make(map[string]bool)
+	for _, f := range sections.Findings() {
+		if f.Line-1 != p.Position.Line || seen[f.RuleID] {
+			continue
+		}
+		seen[f.RuleID] = true
+		rule, ok := parser.LookupRule(f.RuleID)
+		if !ok {
+			continue
+		}
+		parts = append(parts, parser.FormatRuleHelp(rule))
+	}
+
+	if len(parts) == 0 {
+		return nil
+	}
+	return map[string]interface{}{
+		"contents": map[string]string{"kind": "markdown", "value": strings.Join(parts, "\n\n---\n\n")},
+	}
+}
+
+func (s *Server) codeActions(p codeActionParams) []codeAction {
+	actions := []codeAction{}
+	if s.rewrite == nil {
+		return actions
+	}
+	sections := s.analyze(p.TextDocument.URI)
+	if sections == nil {
+		return actions
+	}
+
+	section := sections.SectionAt(p.Range.Start.Line + 1)
+	if section == "" {
+		return actions
+	}
+	title := "Rewrite " + section + " with AI"
+	return append(actions, codeAction{
+		Title: title,
+		Kind:  "refactor.rewrite",
+		Command: command{
+			Title:     title,
+			Command:   RewriteCommand,
+			Arguments: []interface{}{p.TextDocument.URI, section},
+		},
+	})
+}
+
+func (s *Server) executeCommand(p executeCommandParams) *rpcError {
+	if p.Command != RewriteCommand || len(p.Arguments) != 2 {
+		return &rpcError{Code: codeInvalidParams, Message: "unsupported command " + p.Command}
+	}
+	if s.rewrite == nil {
+		return &rpcError{Code: codeInternalError, Message: "AI rewrite is not available"}
+	}
+
+	var uri, section string
+	if json.Unmarshal(p.Arguments[0], &uri) != nil || json.Unmarshal(p.Arguments[1], &section) != nil {
+		return &rpcError{Code: codeInvalidParams, Message: "expected [uri, section] arguments"}
+	}
+
+	sections := s.analyze(uri)
+	if sections == nil {
+		return &rpcError{Code: codeInvalidParams, Message: "document is not open: " + uri}
+	}
+
+	content, span := sections.PressRelease, sections.Positions.PressRelease
+	var issues []string
+	if section == "FAQs" {
+		content, span = sections.FAQs, sections.Positions.FAQs
+	} else {
+		issues = sections.PRScore.QualityBreakdown.Issues
+	}
+	if span.Start == 0 {
+		return &rpcError{Code: codeInvalidParams, Message: "section not found: " + section}
+	}
+
+	rewritten, err := s.rewrite(section, content, issues)
+	if err != nil {
+		return &rpcError{Code: codeInternalError, Message: fmt.Sprintf("rewrite failed: %v", err)}
+	}
+
+	lines := strings.Split(s.docs[uri], "\n")
+	s.nextID++
+	edit := TextEdit{Range: lineRange(lines, span.Start, span.End), NewText: rewritten}
+	err = s.send(outgoingRequest{
+		JSONRPC: "2.0",
+		ID:      s.nextID,
+		Method:  "workspace/applyEdit",
+		Params: map[string]interface{}{
+			"label": "Rewrite " + section,
+			"edit":  map[string]interface{}{"changes": map[string][]TextEdit{uri: {edit}}},
+		},
+	})
+	if err != nil {
+		return &rpcError{Code: codeInternalError, Message: err.Error()}
+	}
+	return nil
+}
+
+func (s *Server) replyError(id *json.RawMessage, code int, message string) error {
+	return s.send(errorResponse{JSONRPC: "2.0", ID: id, Error: rpcError{Code: code, Message: message}})
+}
+
+func (s *Server) send(msg interface{}) error {
+	s.outMu.Lock()
+	defer s.outMu.Unlock()
+	return writeMessage(s.out, msg)
+}
+
+// lineRange covers 1-based source lines first..last, ending after the last character.
+func lineRange(lines []string, first, last int) Range {
+	endLine := last - 1
+	endChar := 0
+	if endLine >= 0 && endLine < len(lines) {
+		endChar = len(utf16.Encode([]rune(strings.TrimSuffix(lines[endLine], "\r"))))
+	}
+	return Range{
+		Start: Position{Line: first - 1},
+		End:   Position{Line: endLine, Character: endChar},
+	}
+}
+
+func severityCode(sev parser.Severity) int {
+	switch sev {
+	case parser.SeverityError:
+		return 1
+	case parser.SeverityWarning:
+		return 2
+	default:
+		return 3
+	}
+}
@@ -0,0 +1,217 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+const testDoc = "# Short\n\n## Press Release\n\nWe made a thing.\n\nIt is revolutionary, groundbreaking, world-class and cutting-edge.\n\nAbout us.\n"
+
+// session feeds framed messages to a server and collects everything it writes.
+func session(t *testing.T, rewrite RewriteFunc, msgs ...interface{}) []map[string]interface{} {
+	t.Helper()
+	var in bytes.Buffer
+	for _, msg := range msgs {
+		if err := writeMessage(&in, msg); err != nil {
+			t.Fatalf("writeMessage() error = %v", err)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := NewServer(&in, &out, rewrite).Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	var replies []map[string]interface{}
+	r := bufio.NewReader(&out)
+	for {
+		body, err := readMessage(r)
+		if err != nil {
+			break
+		}
+		var msg map[string]interface{}
+		if err := json.Unmarshal(body, &msg); err != nil {
+			t.Fatalf("invalid server output: %v", err)
+		}
+		replies = append(replies, msg)
+	}
+	return replies
+}
+
+func rpc(id int, method string, params interface{}) map[string]interface{} {
+	msg := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+	if id > 0 {
+		msg["id"] = id
+	}
+	return msg
+}
+
+func openDoc() map[string]interface{} {
+	return rpc(0, "textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": "file:///doc.md", "text": testDoc},
+	})
+}
+
+func findMethod(msgs []map[string]interface{}, method string) map[string]interface{} {
+	for _, msg := range msgs {
+		if msg["method"] == method {
+			return msg
+		}
+	}
+	return nil
+}
+
+func findID(msgs []map[string]interface{}, id float64) map[string]interface{} {
+	for _, msg := range msgs {
+		if msg["id"] == id && msg["method"] == nil {
+			return msg
+		}
+	}
+	return nil
+}
+
+func TestServer_InitializeAndShutdown(t *testing.T) {
+	replies := session(t, nil,
+		rpc(1, "initialize", map[string]interface{}{}),
+		rpc(2, "shutdown", nil),
+		rpc(0, "exit", nil),
+	)
+
+	init := findID(replies, 1)
+	if init == nil {
+		t.Fatal("missing initialize response")
+	}
+	caps := init["result"].(map[string]interface{})["capabilities"].(map[string]interface{})
+	if caps["hoverProvider"] != true {
+		t.Errorf("hoverProvider = %v, want true", caps["hoverProvider"])
+	}
+	if caps["codeActionProvider"] != false {
+		t.Errorf("codeActionProvider = %v, want false without rewrite", caps["codeActionProvider"])
+	}
+	if findID(replies, 2) == nil {
+		t.Error("missing shutdown response")
+	}
+}
+
+func TestServer_PublishesDiagnostics(t *testing.T) {
+	replies := session(t, nil, openDoc())
+
+	msg := findMethod(replies, "textDocument/publishDiagnostics")
+	if msg == nil {
+		t.Fatal("no diagnostics published")
+	}
+	diags := msg["params"].(map[string]interface{})["diagnostics"].([]interface{})
+	if len(diags) == 0 {
+		t.Fatal("expected diagnostics for weak document")
+	}
+
+	var sawHeadline bool
+	for _, d := range diags {
+		diag := d.(map[string]interface{})
+		if diag["code"] == "headline-too-short" {
+			sawHeadline = true
+			start := diag["range"].(map[string]interface{})["start"].(map[string]interface{})
+			if start["line"] != float64(0) {
+				t.Errorf("headline diagnostic line = %v, want 0", start["line"])
+			}
+		}
+	}
+	if !sawHeadline {
+		t.Error("missing headline-too-short diagnostic")
+	}
+}
+
+func TestServer_Hover(t *testing.T) {
+	replies := session(t, nil, openDoc(), rpc(3, "textDocument/hover", map[string]interface{}{
+		"textDocument": map[string]string{"uri": "file:///doc.md"},
+		"position":     map[string]int{"line": 0, "character": 2},
+	}))
+
+	hover := findID(replies, 3)
+	if hover == nil || hover["result"] == nil {
+		t.Fatal("expected hover result on title line")
+	}
+	value := hover["result"].(map[string]interface{})["contents"].(map[string]interface{})["value"].(string)
+	if !strings.Contains(value, "headline-too-short") {
+		t.Errorf("hover = %q, want headline rule explanation", value)
+	}
+}
+
+func TestServer_CodeActionAndRewrite(t *testing.T) {
+	var gotSection string
+	rewrite := func(section, _ string, issues []string) (string, error) {
+		gotSection = section
+		if len(issues) == 0 {
+			t.Error("expected issues to be passed to rewrite")
+		}
+		return "Rewritten.", nil
+	}
+
+	replies := session(t, rewrite, openDoc(),
+		rpc(4, "textDocument/codeAction", map[string]interface{}{
+			"textDocument": map[string]string{"uri": "file:///doc.md"},
+			"range":        map[string]interface{}{"start": map[string]int{"line": 4}, "end": map[string]int{"line": 4}},
+		}),
+		rpc(5, "workspace/executeCommand", map[string]interface{}{
+			"command":   RewriteCommand,
+			"arguments": []string{"file:///doc.md", "Press Release"},
+		}),
+	)
+
+	actions := findID(replies, 4)["result"].([]interface{})
+	if len(actions) != 1 {
+		t.Fatalf("got %d code actions, want 1", len(actions))
+	}
+
+	if gotSection != "Press Release" {
+		t.Errorf("rewrite section = %q", gotSection)
+	}
+	edit := findMethod(replies, "workspace/applyEdit")
+	if edit == nil {
+		t.Fatal("expected workspace/applyEdit request")
+	}
+	changes := edit["params"].(map[string]interface{})["edit"].(map[string]interface{})["changes"].(map[string]interface{})
+	edits := changes["file:///doc.md"].([]interface{})
+	if edits[0].(map[string]interface{})["newText"] != "Rewritten." {
+		t.Errorf("edit = %v", edits[0])
+	}
+}
+
+func TestServer_RewriteError(t *testing.T) {
+	rewrite := func(string, string, []string) (string, error) { return "", errors.New("no key") }
+	replies := session(t, rewrite, openDoc(), rpc(6, "workspace/executeCommand", map[string]interface{}{
+		"command":   RewriteCommand,
+		"arguments": []string{"file:///doc.md", "Press Release"},
+	}))
+
+	reply := findID(replies, 6)
+	if reply == nil || reply["error"] == nil {
+		t.Fatalf("expected error reply, got %v", reply)
+	}
+}
+
+func TestServer_UnknownMethod(t *testing.T) {
+	replies := session(t, nil, rpc(7, "textDocument/completion", map[string]interface{}{}))
+	reply := findID(replies, 7)
+	if reply == nil || reply["error"] == nil {
+		t.Fatal("expected method-not-found error")
+	}
+	if code := reply["error"].(map[string]interface{})["code"]; code != float64(codeMethodNotFound) {
+		t.Errorf("error code = %v", code)
+	}
+}
+
+func TestLineRange(t *testing.T) {
+	lines := []string{"héllo", "wörld 🌍"}
+	r := lineRange(lines, 1, 2)
+	if r.Start.Line != 0 || r.End.Line != 1 {
+		t.Errorf("lines = %d..%d", r.Start.Line, r.End.Line)
+	}
+	if r.End.Character != 8 {
+		t.Errorf("end character = %d, want 8 UTF-16 units", r.End.Character)
+	}
+}
@@ -0,0 +1,268 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// antiPatternRules is the first rules version with the anti-pattern
+// catalog.
+var antiPatternRules = RulesVersion{Major: 3, Minor: 1}
+
+// AntiPattern is a named way PR-FAQs commonly go wrong, with an example of
+// the fix. Its Name is also the message of its findings, followed by ": "
+// and what was found.
+type AntiPattern struct {
+	ID          string // rule ID without the "antipattern-" prefix
+	Name        string
+	Category    string
+	Severity    Severity
+	Explanation string
+	Fix         string // an example of the fix
+	detect      func(s *SpecSections, paragraphs []Paragraph) (line int, detail string, found bool)
+}
+
+// AntiPatternHit is an anti-pattern found in a document.
+type AntiPatternHit struct {
+	AntiPattern
+	Line   int    // 1-based
+	Detail string // what was found, e.g. "the announcement is in paragraph 3"
+}
+
+var (
+	// problemPattern matches prose that describes a customer problem.
+	problemPattern = regexp.MustCompile(`(?i)\b(?:problems?|pain(?:ful)?|struggl\w*|frustrat\w*|wast\w*|challeng\w*|difficult\w*|hard to|tedious|manual(?:ly)?|error-prone|costly|expensive|can't|cannot|unable to|too (?:long|slow|much|many)|spend(?:s|ing)?\s+(?:\S+\s+){0,3}(?:hours|days|weeks))\b`)
+	// bulletPattern matches a list item.
+	bulletPattern = regexp.MustCompile(`^\s*(?:[-*+•]|\d+[.)])\s+\S`)
+	// announcementPattern matches the verbs that announce the news.
+	announcementPattern = regexp.MustCompile(`(?i)\b(?:announc\w*|launch\w*|introduc\w*|unveil\w*|releas(?:e|es|ed|ing)|now available|available (?:today|now))\b`)
+	// excitedOpenerPattern matches an opening that leads with the company's
+	// feelings, as in "We're excited to announce".
+	excitedOpenerPattern = regexp.MustCompile(`(?i)\b(?:excited|thrilled|delighted|pleased|proud|happy|honored)\s+to\s+(?:announce|introduce|launch|share|unveil|present)\b`)
+	// roadmapPattern matches internal planning language.
+	roadmapPattern = regexp.MustCompile(`(?i)\b(?:roadmap|phase (?:2|two|ii)|next (?:quarter|release|phase)|future releases?|coming soon|later this year|(?:in|by) (?:Q[1-4]|H[12])\b|we plan to|we intend to|we will eventually|planned for)`)
+)
+
+// minFeatureBullets is the fewest list items that make a press release read
+// as a feature list, if they are also a third of its lines.
+const minFeatureBullets = 5
+
+// AntiPatterns is the catalog of anti-patterns, in report order.
+var AntiPatterns = []AntiPattern{
+	{
+		ID: "solution-without-problem", Name: "Solution looking for a problem", Category: "Newsworthy Hook", Severity: SeverityWarning,
+		Explanation: "The press release describes the product but never the customer problem it solves, so readers cannot tell why it matters.",
+		Fix:         "Before the product, state the pain: \"Finance teams spend three days every month matching bank feeds to the ledger by hand.\"",
+		detect:      detectSolutionWithoutProblem,
+	},
+	{
+		ID: "feature-list", Name: "Feature list as press release", Category: "Structure", Severity: SeverityWarning,
+		Explanation: "A list of features tells readers what was built, not what changes for them. Journalists skip releases that read like a spec.",
+		Fix:         "Pick the two or three features that matter most and write each as a customer benefit: \"Close the month in a day instead of three.\"",
+		detect:      detectFeatureList,
+	},
+	{
+		ID: "buried-lede", Name: "Buried lede", Category: "Structure", Severity: SeverityError,
+		Explanation: "The first paragraph does not carry the news: the announcement, or the metric that proves it, only appears later, where many readers never get to. The message suggests a paragraph order.",
+		Fix:         "Open with who, what, and why: \"Acme today launched Ledger Sync, which reconciles bank feeds overnight so finance teams close in a day.\"",
+		detect:      detectBuriedLede,
+	},
+	{
+		ID: "excited-opener", Name: "\"We're excited\" opener", Category: "Fluff Avoidance", Severity: SeverityWarning,
+		Explanation: "Opening with the company's excitement spends the most-read sentence on something no reader cares about.",
+		Fix:         "Replace \"Acme is excited to announce Ledger Sync\" with \"Acme today launched Ledger Sync\" and go straight to the benefit.",
+		detect:      detectExcitedOpener,
+	},
+	{
+		ID: "roadmap-leakage", Name: "Roadmap leakage", Category: "Credibility", Severity: SeverityWarning,
+		Explanation: "An external press release describes what customers get on launch day. Roadmap plans and phases are commitments the launch cannot keep and belong in the internal FAQ.",
+		Fix:         "Move \"Phase 2 will add payroll in Q3\" to the internal FAQ, and describe only what is available at launch.",
+		detect:      detectRoadmapLeakage,
+	},
+}
+
+// antiPatternCatalog returns the catalog rule of every anti-pattern. The
+// explanation ends with the example fix.
+func antiPatternCatalog() []Rule {
+	rules := make([]Rule, len(AntiPatterns))
+	for i, p := range AntiPatterns {
+		rules[i] = Rule{ID: "antipattern-" + p.ID, Category: p.Category, Severity: p.Severity,
+			Message: p.Name, Explanation: p.Explanation + " Fix: " + p.Fix}
+	}
+	return rules
+}
+
+// AntiPatterns returns the anti-patterns found in the press release, in
+// catalog order, each at most once.
+func (s *SpecSections) AntiPatterns() []AntiPatternHit {
+	paragraphs := pressReleaseParagraphs(s)
+	if len(paragraphs) == 0 {
+		return nil
+	}
+	var hits []AntiPatternHit
+	for _, p := range AntiPatterns {
+		if line, detail, found := p.detect(s, paragraphs); found {
+			hits = append(hits, AntiPatternHit{AntiPattern: p, Line: line, Detail: detail})
+		}
+	}
+	return hits
+}
+
+// scoreAntiPatterns reports every anti-pattern found. Findings cost no
+// points.
+func scoreAntiPatterns(s *SpecSections) analysis {
+	a := analysis{category: "Structure"}
+	for _, hit := range s.AntiPatterns() {
+		a.issueAt(hit.Line, hit.Name+": "+hit.Detail)
+	}
+	return a
+}
+
+// isAntiPatternIssue reports whether an issue comes from the anti-pattern
+// catalog, which the report lists in a section of its own.
+func isAntiPatternIssue(issue string) bool {
+	head, _, _ := strings.Cut(issue, ": ")
+	for _, p := range AntiPatterns {
+		if head == p.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// pressReleaseParagraphs returns the prose paragraphs of the press release,
+// without headings, release designations, and end marks.
+func pressReleaseParagraphs(s *SpecSections) []Paragraph {
+	if s.Tree == nil || s.Positions.PressRelease.Start == 0 {
+		return nil
+	}
+	var out []Paragraph
+	for _, p := range treeParagraphs(s.Tree) {
+		if s.Positions.PressRelease.contains(p.Span.Start) && isProse(p.Text) && !isFurniture(strings.TrimSpace(p.Text)) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func detectSolutionWithoutProblem(_ *SpecSections, paragraphs []Paragraph) (int, string, bool) {
+	for _, p := range paragraphs {
+		if problemPattern.MatchString(p.Text) {
+			return 0, "", false
+		}
+	}
+	return paragraphs[0].Span.Start, "the press release never says what customer problem it solves", true
+}
+
+func detectFeatureList(_ *SpecSections, paragraphs []Paragraph) (int, string, bool) {
+	lines, bullets, first := 0, 0, 0
+	for _, p := range paragraphs {
+		for i, line := range strings.Split(p.Text, "\n") {
+			lines++
+			if bulletPattern.MatchString(line) {
+				bullets++
+				if first == 0 {
+					first = p.Span.Start + i
+				}
+			}
+		}
+	}
+	if bullets < minFeatureBullets || bullets*3 < lines {
+		return 0, "", false
+	}
+	return first, fmt.Sprintf("%d of its %d lines are list items", bullets, lines), true
+}
+
+// detectBuriedLede finds news that is not in the lead: an announcement
+// that only appears in a later paragraph, or, from rules/v3.2, a lead
+// without numbers while the strongest metric waits until paragraph 3 or
+// later. Quote paragraphs are not news, so their metrics are not counted.
+func detectBuriedLede(s *SpecSections, paragraphs []Paragraph) (int, string, bool) {
+	if !announcementPattern.MatchString(paragraphs[0].Text) {
+		for i, p := range paragraphs[1:] {
+			if announcementPattern.MatchString(p.Text) {
+				return p.Span.Start, fmt.Sprintf("the announcement is in paragraph %d, not the lead; %s", i+2, reorder(i+1)), true
+			}
+		}
+	}
+	if s.ScoringRules().less(buriedMetricRules) {
+		return 0, "", false
+	}
+	strongest, best := -1, 0
+	var metric string
+	for i, p := range paragraphs {
+		if isQuoteParagraph(p.Text) {
+			continue
+		}
+		metrics, types := detectMetricsInText(p.Text)
+		if strength := scoreQuote(metrics, types); strength > best {
+			strongest, best, metric = i, strength, metrics[0]
+		}
+		if i == 0 && len(metrics) > 0 {
+			return 0, "", false
+		}
+	}
+	if strongest < maxLedeParagraph {
+		return 0, "", false
+	}
+	return paragraphs[strongest].Span.Start, fmt.Sprintf("the strongest metric (%q) is in paragraph %d and the lead has none; %s", metric, strongest+1, reorder(strongest)), true
+}
+
+// maxLedeParagraph is the number of paragraphs the key metric may trail the
+// lead by: the lead and the paragraph after it.
+const maxLedeParagraph = 2
+
+// buriedMetricRules is the first rules version that reports a buried lede
+// for a metric that comes after paragraph 2.
+var buriedMetricRules = RulesVersion{Major: 3, Minor: 2}
+
+// reorder suggests moving the paragraph at index i to the front, e.g.
+// "reorder the paragraphs as 3, 1, 2".
+func reorder(i int) string {
+	order := []string{fmt.Sprint(i + 1)}
+	for j := 1; j <= i; j++ {
+		order = append(order, fmt.Sprint(j))
+	}
+	return "reorder the paragraphs as " + strings.Join(order, ", ")
+}
+
+func detectExcitedOpener(_ *SpecSections, paragraphs []Paragraph) (int, string, bool) {
+	sentences := paragraphs[0].Sentences
+	if len(sentences) == 0 {
+		return 0, "", false
+	}
+	m := excitedOpenerPattern.FindString(sentences[0].Text)
+	if m == "" {
+		return 0, "", false
+	}
+	return sentences[0].Position.Line, fmt.Sprintf("the first sentence says %q", m), true
+}
+
+func detectRoadmapLeakage(s *SpecSections, paragraphs []Paragraph) (int, string, bool) {
+	if !s.DocType.profile().media {
+		return 0, "", false
+	}
+	for _, p := range paragraphs {
+		for _, sentence := range p.Sentences {
+			if m := roadmapPattern.FindString(sentence.Text); m != "" {
+				return sentence.Position.Line, fmt.Sprintf("%q describes plans, not the launch", m), true
+			}
+		}
+	}
+	return 0, "", false
+}
+
+// writeAntiPatterns writes the report section explaining each anti-pattern
+// found and how to fix it.
+func writeAntiPatterns(report *strings.Builder, hits []AntiPatternHit) {
+	if len(hits) == 0 {
+		return
+	}
+	report.WriteString("## 🚩 Anti-Patterns\n\n")
+	for _, hit := range hits {
+		fmt.Fprintf(report, "### %s (line %d)\n\n", hit.Name, hit.Line)
+		report.WriteString(upperFirst(hit.Detail) + ". " + hit.Explanation + "\n\n")
+		report.WriteString("**Fix:** " + hit.Fix + "\n\n")
+	}
+}
@@ -0,0 +1,104 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSpecSections_AntiPatterns(t *testing.T) {
+	lead := "SEATTLE, WA - March 3, 2026 - Acme today launched Ledger Sync, which reconciles bank feeds overnight.\n\n"
+	problem := "Finance teams spend three days every month matching bank feeds by hand.\n\n"
+	tests := []struct {
+		name     string
+		docType  string
+		pr       string
+		want     string // anti-pattern ID, or "" for none
+		wantLine int
+	}{
+		{"clean", "", lead + problem, "", 0},
+		{"solution without a problem", "", lead + "Ledger Sync connects to 40 banks.\n\n", "solution-without-problem", 5},
+		{"feature list", "", lead + problem + "Features:\n- Bank feeds\n- Card feeds\n- Payroll\n- Rules\n- Audit log\n\n", "feature-list", 10},
+		{"buried lede", "", problem + lead, "buried-lede", 7},
+		{"buried metric", "", lead + problem + "Ledger Sync connects to every major bank.\n\nCustomers in the beta closed their books 70% faster.\n\n", "buried-lede", 11},
+		{"metric in the second paragraph", "", lead + "Finance teams spend three days every month matching bank feeds by hand; in the beta they closed 70% faster.\n\n", "", 0},
+		{"excited opener", "", "Acme is thrilled to announce Ledger Sync. " + problem, "excited-opener", 5},
+		{"roadmap", "", lead + problem + "Payroll support is coming soon.\n\n", "roadmap-leakage", 9},
+		{"roadmap in an internal document", "---\ndoc_type: internal\n---\n", lead + problem + "Payroll support is coming soon.\n\n", "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := tt.docType + "# Acme Launches Ledger Sync\n\n## Press Release\n\n" + tt.pr + "## FAQ\n\nQ: Why?\nA: Speed.\n"
+			sections, err := Parse(strings.NewReader(doc))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			hits := sections.AntiPatterns()
+			if tt.want == "" {
+				if len(hits) > 0 {
+					t.Errorf("AntiPatterns() = %+v, want none", hits)
+				}
+				return
+			}
+			if len(hits) != 1 || hits[0].ID != tt.want {
+				t.Fatalf("AntiPatterns() = %+v, want only %s", hits, tt.want)
+			}
+			if hits[0].Line != tt.wantLine+strings.Count(tt.docType, "\n") {
+				t.Errorf("line = %d, want %d", hits[0].Line, tt.wantLine)
+			}
+		})
+	}
+}
+
+func TestScore_AntiPatternRules(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme is thrilled to announce Ledger Sync, which matches bank feeds by hand no more.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	findings := sections.Findings()
+	if len(findings) == 0 {
+		t.Fatal("no findings")
+	}
+	var found bool
+	for _, f := range findings {
+		found = found || f.RuleID == "antipattern-excited-opener"
+	}
+	if !found {
+		t.Errorf("findings = %+v, want antipattern-excited-opener", findings)
+	}
+	report := GenerateMarkdownReport(sections, sections.PRScore)
+	if !strings.Contains(report, "### \"We're excited\" opener (line 5)") || !strings.Contains(report, "**Fix:** Replace") {
+		t.Errorf("report does not explain the anti-pattern:\n%s", report)
+	}
+
+	sections.Rules = RulesVersion{Major: 3}
+	for _, issue := range Score(sections).QualityBreakdown.Issues {
+		if isAntiPatternIssue(issue) {
+			t.Errorf("%s reports %q", sections.Rules, issue)
+		}
+	}
+}
+
+func TestDetectBuriedLede_Reorder(t *testing.T) {
+	doc := "# Acme Launches Ledger Sync\n\n## Press Release\n\n" +
+		"Finance teams spend three days every month matching bank feeds by hand.\n\n" +
+		"Ledger Sync matches every entry overnight.\n\n" +
+		"Acme today launched Ledger Sync.\n\n" +
+		"Beta customers closed 70% faster.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	paragraphs := pressReleaseParagraphs(sections)
+	if _, detail, _ := detectBuriedLede(sections, paragraphs); detail != "the announcement is in paragraph 3, not the lead; reorder the paragraphs as 3, 1, 2" {
+		t.Errorf("detail = %q", detail)
+	}
+
+	paragraphs[0].Text = "Acme today launched Ledger Sync."
+	if _, detail, _ := detectBuriedLede(sections, paragraphs); detail != `the strongest metric ("70%") is in paragraph 4 and the lead has none; reorder the paragraphs as 4, 1, 2, 3` {
+		t.Errorf("detail = %q", detail)
+	}
+	sections.Rules = RulesVersion{Major: 3, Minor: 1}
+	if _, _, found := detectBuriedLede(sections, paragraphs); found {
+		t.Errorf("%s reports a buried metric", sections.Rules)
+	}
+}
@@ -0,0 +1,207 @@
+package parser
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+// Audience is the readership a PR-FAQ is written for. It selects which
+// jargon terms, sentence-length limits, and required FAQ questions apply.
+type Audience string
+
+// Supported audiences. AudienceGeneral keeps the original heuristics and
+// requires no particular FAQ questions.
+const (
+	AudienceGeneral    Audience = "general"
+	AudienceConsumer   Audience = "consumer"
+	AudienceEnterprise Audience = "enterprise"
+	AudienceDeveloper  Audience = "developer"
+	AudienceInternal   Audience = "internal"
+)
+
+// Audiences lists the accepted -audience values.
+var Audiences = []Audience{AudienceGeneral, AudienceConsumer, AudienceEnterprise, AudienceDeveloper, AudienceInternal}
+
+// ParseAudience parses an -audience value. The empty string is AudienceGeneral.
+func ParseAudience(s string) (Audience, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return AudienceGeneral, nil
+	}
+	for _, a := range Audiences {
+		if string(a) == s {
+			return a, nil
+		}
+	}
+	names := make([]string, len(Audiences))
+	for i, a := range Audiences {
+		names[i] = string(a)
+	}
+	return "", fmt.Errorf("unknown audience %q (want %s)", s, strings.Join(names, ", "))
+}
+
+// audienceProfile holds the heuristics that vary by audience.
+type audienceProfile struct {
+	jargon     []string // terms counted toward the jargon limit
+	maxJargon  int      // more distinct jargon terms than this costs a point
+	idealWords [2]int   // average sentence length that earns the readability points
+	longWords  int      // sentences longer than this count as overly long
+	faqTopics  []faqTopic
+}
+
+// faqTopic is a question the FAQ must answer for an audience. It is
+// answered when the FAQ mentions any of the keywords, matched by firstTerm,
+// or, with a TopicMatcher, when an FAQ question asks the same as question.
+type faqTopic struct {
+	message  string // issue raised when the topic is missing; one per catalog rule
+	keywords []string
+	question string // the question as a reader would ask it
+}
+
+// Required FAQ questions, shared between audiences.
+var (
+	faqPricing = faqTopic{"FAQ should say what it costs (pricing, plans, or free tier)",
+		[]string{"pric*", "cost*", "free", "subscription*", "plan", "plans", "$*"}, "How much does it cost?"}
+	faqAvailability = faqTopic{"FAQ should say when and where it is available",
+		[]string{"available", "availability", "launch*", "rollout", "region*", "countries", "sign up"}, "When and where will it be available?"}
+	faqPrivacy = faqTopic{"FAQ should explain what happens to customers' personal data",
+		[]string{"privacy", "personal data", "personal information", "data is stored", "share your data", "delet*"}, "What happens to my personal data?"}
+	faqSecurity = faqTopic{"FAQ should address security and compliance",
+		[]string{"security", "secure", "encrypt*", "complian*", "soc 2", "gdpr", "hipaa", "iso 27001"}, "Is it secure and compliant?"}
+	faqIntegration = faqTopic{"FAQ should explain how it integrates with existing systems",
+		[]string{"integrat*", "migrat*", "existing", "sso", "compatib*", "connector*"}, "How does it work with the systems we already use?"}
+	faqSupport = faqTopic{"FAQ should describe support and service levels",
+		[]string{"support*", "sla", "slas", "uptime", "service level*", "account manager*"}, "What support and service levels are offered?"}
+	faqAPI = faqTopic{"FAQ should describe the API, SDKs, or supported languages",
+		[]string{"api", "apis", "sdk", "sdks", "library", "libraries", "language*", "cli", "endpoint*"}, "Is there an API or SDK, and which languages are supported?"}
+	faqGettingStarted = faqTopic{"FAQ should tell developers how to get started",
+		[]string{"get started", "getting started", "quickstart", "quick start", "install*", "documentation", "docs"}, "How do I get started?"}
+	faqLicense = faqTopic{"FAQ should state the license or usage terms",
+		[]string{"licens*", "open source", "open-source", "terms of use", "terms of service"}, "What license or terms of use apply?"}
+	faqCost = faqTopic{"FAQ should estimate the cost and staffing to build it",
+		[]string{"cost*", "budget*", "headcount", "engineer*", "staffing", "resourc*", "invest*"}, "What will it cost to build, and how many people does it need?"}
+	faqRisks = faqTopic{"FAQ should name the biggest risks and how they are mitigated",
+		[]string{"risk*", "mitigat*", "what if", "fail*"}, "What are the biggest risks and how will we mitigate them?"}
+	faqSuccess = faqTopic{"FAQ should say how success will be measured",
+		[]string{"metric*", "measur*", "success*", "kpi", "kpis", "goal*"}, "How will we measure success?"}
+	faqDependencies = faqTopic{"FAQ should list dependencies on other teams or systems",
+		[]string{"depend*", "other teams", "prerequisite*", "requires", "blocked"}, "What does it depend on from other teams or systems?"}
+)
+
+// generalJargon is the original jargon list, used unless an audience narrows
+// or extends it. Terms are matched by containsTerm.
+var generalJargon = []string{"synergies*", "paradigm*", "leverage*", "ecosystem*", "scalable*", "turnkey*", "best-in-class*", "enterprise-grade*"}
+
+var audienceProfiles = map[Audience]audienceProfile{
+	AudienceGeneral: {
+		jargon: generalJargon, maxJargon: 3, idealWords: [2]int{15, 20}, longWords: 25,
+	},
+	// Consumers trip over technical terms sooner and need shorter sentences
+	AudienceConsumer: {
+		jargon:    append(append([]string{}, generalJargon...), "api", "apis", "sdk*", "backend*", "infrastructure*", "latency", "bandwidth", "integration*"),
+		maxJargon: 2, idealWords: [2]int{12, 18}, longWords: 22,
+		faqTopics: []faqTopic{faqPricing, faqAvailability, faqPrivacy},
+	},
+	// Business buyers expect "scalable" and "enterprise-grade"; they still glaze over at buzzwords
+	AudienceEnterprise: {
+		jargon:    []string{"synergies*", "paradigm*", "leverage*", "turnkey*", "best-in-class*", "holistic*", "value-add*", "move the needle"},
+		maxJargon: 3, idealWords: [2]int{15, 22}, longWords: 28,
+		faqTopics: []faqTopic{faqPricing, faqSecurity, faqIntegration, faqSupport},
+	},
+	// Developers use "scalable" and "ecosystem" literally; business buzzwords still cost trust
+	AudienceDeveloper: {
+		jargon:    []string{"synergies*", "paradigm*", "leverage*", "turnkey*", "best-in-class*", "enterprise-grade*", "value-add*", "holistic*"},
+		maxJargon: 2, idealWords: [2]int{12, 20}, longWords: 25,
+		faqTopics: []faqTopic{faqPricing, faqAPI, faqGettingStarted, faqLicense},
+	},
+	// Internal readers share context, so longer sentences are tolerated
+	AudienceInternal: {
+		jargon:    []string{"synergies*", "paradigm*", "best-in-class*", "turnkey*", "move the needle"},
+		maxJargon: 3, idealWords: [2]int{15, 25}, longWords: 30,
+		faqTopics: []faqTopic{faqCost, faqRisks, faqSuccess, faqDependencies},
+	},
+}
+
+// profile returns the heuristics for a, falling back to AudienceGeneral for
+// the zero value and unknown audiences.
+func (a Audience) profile() audienceProfile {
+	if p, ok := audienceProfiles[a]; ok {
+		return p
+	}
+	return audienceProfiles[AudienceGeneral]
+}
+
+// TopicMatcher decides which required questions an FAQ asks by meaning
+// rather than by keyword, e.g. by comparing embeddings, so "What's the
+// pricing?" answers "How much does it cost?". MatchTopics reports, for each
+// required question, whether any of the FAQ's questions asks it.
+type TopicMatcher interface {
+	MatchTopics(questions, required []string) ([]bool, error)
+}
+
+// scoreFAQTopics checks that the FAQ answers the questions the audience
+// expects. Missing questions are reported without costing points. An empty
+// FAQ is left to Validate.
+func scoreFAQTopics(faqs string, audience Audience, matcher TopicMatcher) analysis {
+	a := analysis{category: "Structure"}
+	topics := audience.profile().faqTopics
+	if faqs == "" || len(topics) == 0 {
+		return a
+	}
+
+	answered := 0
+	for i, ok := range topicsAnswered(faqs, topics, matcher) {
+		if !ok {
+			a.issue(topics[i].message)
+			continue
+		}
+		answered++
+	}
+	if answered == len(topics) {
+		a.strength(fmt.Sprintf("FAQ answers the questions a %s audience asks", audience))
+	}
+	return a
+}
+
+// topicsAnswered reports which topics the FAQ answers: by matcher when there
+// is one and the FAQ has questions to compare, otherwise, or when the
+// matcher fails, by keyword.
+func topicsAnswered(faqs string, topics []faqTopic, matcher TopicMatcher) []bool {
+	if questions := faqQuestions(faqs); matcher != nil && len(questions) > 0 {
+		required := make([]string, len(topics))
+		for i, t := range topics {
+			required[i] = t.question
+		}
+		answered, err := matcher.MatchTopics(questions, required)
+		if err == nil && len(answered) == len(topics) {
+			return answered
+		}
+		slog.Warn("semantic FAQ matching failed, matching keywords instead", "error", err)
+	}
+
+	faqsLower := strings.ToLower(faqs)
+	answered := make([]bool, len(topics))
+	for i, topic := range topics {
+		answered[i] = firstTerm(faqsLower, topic.keywords) != ""
+	}
+	return answered
+}
+
+// faqQuestionPrefix matches the markup and numbering before an FAQ question,
+// e.g. "## 3. ", "**Q:** ", or "Question 2) ".
+var faqQuestionPrefix = regexp.MustCompile(`^[#>*_\-\s]*(?:(?:Q|Question)\s*\d*\s*[:.)]|\d+[.)])?[*_\s]*`)
+
+// faqQuestions returns the questions of an FAQ: the lines that end with a
+// question mark, without their markup and numbering.
+func faqQuestions(faqs string) []string {
+	var questions []string
+	for _, line := range strings.Split(faqs, "\n") {
+		q := strings.TrimSpace(strings.TrimRight(faqQuestionPrefix.ReplaceAllString(strings.TrimSpace(line), ""), "*_ "))
+		if strings.HasSuffix(q, "?") {
+			questions = append(questions, q)
+		}
+	}
+	return questions
+}
@@ -0,0 +1,163 @@
+package parser
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseAudience(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Audience
+		wantErr bool
+	}{
+		{"", AudienceGeneral, false},
+		{"developer", AudienceDeveloper, false},
+		{" Enterprise ", AudienceEnterprise, false},
+		{"b2c", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseAudience(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseAudience(%q) = %q, %v; want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestScoreTone_Audience(t *testing.T) {
+	content := "Acme today launched a scalable build cache for the Go ecosystem. " +
+		"It runs on turnkey infrastructure with a small API. " +
+		"Teams leverage it in every CI pipeline."
+
+	tests := []struct {
+		audience   Audience
+		wantJargon bool
+	}{
+		{AudienceGeneral, true},    // scalable, ecosystem, turnkey, leverage
+		{AudienceDeveloper, false}, // only turnkey and leverage count
+		{AudienceConsumer, true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.audience), func(t *testing.T) {
+			a := scoreTone(content, tt.audience, ToneFormal)
+			got := strings.Contains(strings.Join(a.issues, "|"), "technical jargon")
+			if got != tt.wantJargon {
+				t.Errorf("jargon issue = %v, want %v (issues %q)", got, tt.wantJargon, a.issues)
+			}
+		})
+	}
+}
+
+func TestScoreFAQTopics(t *testing.T) {
+	faqs := "Q: How much does it cost?\nA: It is free for open-source projects under the MIT license.\n\n" +
+		"Q: How do I get started?\nA: Install the package and read the docs."
+
+	tests := []struct {
+		audience    Audience
+		wantMissing []string
+	}{
+		{AudienceGeneral, nil},
+		{AudienceDeveloper, []string{"API, SDKs"}},
+		{AudienceEnterprise, []string{"security", "integrates", "support"}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.audience), func(t *testing.T) {
+			a := scoreFAQTopics(faqs, tt.audience, nil)
+			if a.score != 0 {
+				t.Errorf("score = %d, want 0", a.score)
+			}
+			if len(a.issues) != len(tt.wantMissing) {
+				t.Fatalf("issues = %q, want %d", a.issues, len(tt.wantMissing))
+			}
+			for i, want := range tt.wantMissing {
+				if !strings.Contains(a.issues[i], want) {
+					t.Errorf("issue %d = %q, want it to mention %q", i, a.issues[i], want)
+				}
+				if ruleForMessage(a.issues[i]).ID == generalRule.ID {
+					t.Errorf("issue %q is not in the rule catalog", a.issues[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFAQQuestions(t *testing.T) {
+	faqs := "## 1. What's the pricing?\n**Q: Is there a free tier?**\nA: Yes, for one user.\n" +
+		"Question 3) Who is it for?\n- Does it work offline?\nIt works anywhere. Really?\n"
+	want := []string{"What's the pricing?", "Is there a free tier?", "Who is it for?", "Does it work offline?", "It works anywhere. Really?"}
+	if got := faqQuestions(faqs); !slices.Equal(got, want) {
+		t.Errorf("faqQuestions() = %q, want %q", got, want)
+	}
+}
+
+// fakeMatcher answers every MatchTopics call with answered and err.
+type fakeMatcher struct {
+	answered []bool
+	err      error
+	required []string
+}
+
+func (m *fakeMatcher) MatchTopics(_, required []string) ([]bool, error) {
+	m.required = required
+	return m.answered, m.err
+}
+
+func TestScoreFAQTopics_Matcher(t *testing.T) {
+	// No keyword of any consumer topic, but the matcher recognizes the questions
+	faqs := "Q: What will I pay per month?\nA: Five dollars.\n\nQ: Can I buy it in Canada?\nA: Yes, from June."
+	if a := scoreFAQTopics(faqs, AudienceConsumer, nil); len(a.issues) != 3 {
+		t.Fatalf("keyword issues = %q, want 3", a.issues)
+	}
+
+	m := &fakeMatcher{answered: []bool{true, true, false}}
+	a := scoreFAQTopics(faqs, AudienceConsumer, m)
+	if len(a.issues) != 1 || !strings.Contains(a.issues[0], "data") {
+		t.Errorf("matcher issues = %q, want only the privacy question missing", a.issues)
+	}
+	if len(m.required) != 3 || m.required[0] != faqPricing.question {
+		t.Errorf("matcher got required %q", m.required)
+	}
+
+	// A failing matcher falls back to keywords
+	m = &fakeMatcher{err: errors.New("no network")}
+	if a := scoreFAQTopics(faqs, AudienceConsumer, m); len(a.issues) != 3 {
+		t.Errorf("fallback issues = %q, want 3", a.issues)
+	}
+}
+
+func TestContainsTerm(t *testing.T) {
+	tests := []struct {
+		s, term string
+		want    bool
+	}{
+		{"a rapid rollout", "api", false},
+		{"a public api.", "api", true},
+		{"deep integrations", "integrat*", true},
+		{"reintegrate", "integrat*", false},
+		{"costs $5 a month", "$*", true},
+	}
+	for _, tt := range tests {
+		if got := containsTerm(tt.s, tt.term); got != tt.want {
+			t.Errorf("containsTerm(%q, %q) = %v, want %v", tt.s, tt.term, got, tt.want)
+		}
+	}
+}
+
+func TestScore_Audience(t *testing.T) {
+	doc := "# CloudSync\n\n## Press Release\n\nSEATTLE, WA — Aug 20, 2024 — Acme today launched CloudSync.\n\n" +
+		"## FAQ\n\n### Q: What does it cost?\nIt is free.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	sections.Audience = AudienceInternal
+	score := Score(sections)
+	if !strings.Contains(strings.Join(score.QualityBreakdown.Issues, "|"), "biggest risks") {
+		t.Errorf("issues = %q, want the internal audience's required risk question", score.QualityBreakdown.Issues)
+	}
+	if report := GenerateMarkdownReport(sections, score); !strings.Contains(report, "**Audience:** internal") {
+		t.Error("markdown report header does not name the audience")
+	}
+}
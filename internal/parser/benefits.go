@@ -0,0 +1,129 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// featureDumpMessage is the issue message of a paragraph of features with
+// no customer benefit, followed by ": " and the details.
+const featureDumpMessage = "Feature dump"
+
+// featureDumpRules is the first rules version with the feature dump check.
+var featureDumpRules = RulesVersion{Major: 5, Minor: 1}
+
+// minFeatureDump is how many feature statements make a paragraph with no
+// benefit a feature dump.
+const minFeatureDump = 2
+
+var (
+	// benefitPattern matches phrasing about what the customer gets.
+	benefitPattern = regexp.MustCompile(`(?i)\b(?:so (?:that )?(?:you|they|teams?|customers?|users?) can|lets? (?:you|them|customers?|users?|teams?)|helps?|saves?|saving|without|no longer|instead of|in (?:minutes|seconds|one click)|faster|less time|fewer|reduc(?:e|es|ing)|cuts?|eliminat(?:e|es|ing)|avoids?|never (?:again|have to))\b`)
+	// featurePattern matches phrasing about what the product is or has.
+	featurePattern = regexp.MustCompile(`(?i)\b(?:features?|includes?|offers?|supports?|built (?:on|with)|powered by|leverag(?:e|es|ing)|architecture|APIs?|algorithms?|integrates? with|modules?|engine|platform|technology|capabilit(?:y|ies))\b`)
+)
+
+// StatementKind is what a claim sentence describes: the product or what the
+// customer gets from it.
+type StatementKind string
+
+// Kinds of claim sentence.
+const (
+	// StatementFeature describes what the product is or has, as in "Ledger
+	// Sync includes bank feeds for 40 banks."
+	StatementFeature StatementKind = "feature"
+	// StatementBenefit describes what the customer gets, as in "Controllers
+	// close the books in hours instead of days."
+	StatementBenefit StatementKind = "benefit"
+)
+
+// Statement is a press release sentence classified as a feature statement or
+// a customer benefit.
+type Statement struct {
+	Text string
+	Line int // 1-based line the sentence starts on
+	Kind StatementKind
+}
+
+// classifyStatement returns the kind of claim a sentence makes, or "" for a
+// sentence that makes neither kind. A sentence that names a feature and what
+// it brings the customer is a benefit.
+func classifyStatement(sentence string) StatementKind {
+	switch {
+	case benefitPattern.MatchString(sentence):
+		return StatementBenefit
+	case featurePattern.MatchString(sentence):
+		return StatementFeature
+	}
+	return ""
+}
+
+// Statements returns the press release sentences that state a feature or a
+// customer benefit, in source order.
+func (s *SpecSections) Statements() []Statement {
+	var out []Statement
+	for _, p := range pressReleaseParagraphs(s) {
+		out = append(out, paragraphStatements(p)...)
+	}
+	return out
+}
+
+// paragraphStatements returns the claim sentences of a paragraph.
+func paragraphStatements(p Paragraph) []Statement {
+	var out []Statement
+	for _, sentence := range p.Sentences {
+		if kind := classifyStatement(sentence.Text); kind != "" {
+			out = append(out, Statement{Text: sentence.Text, Line: sentence.Position.Line, Kind: kind})
+		}
+	}
+	return out
+}
+
+// BenefitShare returns the share of statements that are customer benefits,
+// from 0 to 1, and false when there are none to compare.
+func BenefitShare(statements []Statement) (float64, bool) {
+	if len(statements) == 0 {
+		return 0, false
+	}
+	benefits := 0
+	for _, st := range statements {
+		if st.Kind == StatementBenefit {
+			benefits++
+		}
+	}
+	return float64(benefits) / float64(len(statements)), true
+}
+
+// benefitPrompt turns a feature statement into the start of its rewrite as a
+// benefit, for the writer to finish, e.g. "Ledger Sync includes bank feeds,
+// so <who> can <do what>."
+func benefitPrompt(feature string) string {
+	feature = strings.TrimRight(strings.TrimSpace(feature), ".!?")
+	return feature + ", so <who> can <do what>."
+}
+
+// scoreFeatureDumps reports each press release paragraph that states
+// several features and no customer benefit, with a prompt to rewrite its
+// first feature as a benefit. Findings cost no points; the Customer Focus
+// category already counts the statements.
+func scoreFeatureDumps(s *SpecSections) analysis {
+	a := analysis{category: "Customer Focus"}
+	for _, p := range pressReleaseParagraphs(s) {
+		var features []Statement
+		benefit := false
+		for _, st := range paragraphStatements(p) {
+			if st.Kind == StatementBenefit {
+				benefit = true
+				break
+			}
+			features = append(features, st)
+		}
+		if benefit || len(features) < minFeatureDump {
+			continue
+		}
+		a.issueAt(p.Span.Start, fmt.Sprintf("%s: %d feature statements and no customer benefit - say what each lets the customer do, e.g. %q",
+			featureDumpMessage, len(features), benefitPrompt(features[0].Text)))
+	}
+	return a
+}
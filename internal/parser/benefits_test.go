@@ -0,0 +1,103 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestClassifyStatement(t *testing.T) {
+	tests := []struct {
+		sentence string
+		want     StatementKind
+	}{
+		{"Ledger Sync includes bank feeds for 40 banks.", StatementFeature},
+		{"It is powered by a new matching engine.", StatementFeature},
+		{"Controllers close the books in hours instead of days.", StatementBenefit},
+		{"The engine saves finance teams 12 hours a week.", StatementBenefit},
+		{"Acme is based in Seattle.", ""},
+	}
+	for _, tt := range tests {
+		if got := classifyStatement(tt.sentence); got != tt.want {
+			t.Errorf("classifyStatement(%q) = %q, want %q", tt.sentence, got, tt.want)
+		}
+	}
+}
+
+func TestSpecSections_Statements(t *testing.T) {
+	sections, err := Parse(strings.NewReader(claimsDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got := sections.Statements()
+	if len(got) != 1 || got[0].Kind != StatementBenefit || got[0].Line != 5 {
+		t.Fatalf("Statements() = %+v, want one benefit at line 5", got)
+	}
+	if share, ok := BenefitShare(got); !ok || share != 1 {
+		t.Errorf("BenefitShare() = %v, %v, want 1, true", share, ok)
+	}
+	if _, ok := BenefitShare(nil); ok {
+		t.Error("BenefitShare(nil) ok = true, want false")
+	}
+}
+
+func TestScoreFeatureDumps(t *testing.T) {
+	lead := "Acme today launched Ledger Sync for finance teams.\n\n"
+	tests := []struct {
+		name     string
+		pr       string
+		wantLine int // line of the issue, 0 for none
+	}{
+		{"no features", lead, 0},
+		{"one feature", lead + "Ledger Sync includes bank feeds.\n\n", 0},
+		{"feature dump", lead + "Ledger Sync includes bank feeds. It is powered by a matching engine.\n\n", 7},
+		{"features with a benefit", lead + "Ledger Sync includes bank feeds. It is powered by a matching engine. Controllers close the books in hours instead of days.\n\n", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\n" + tt.pr + "## FAQ\n\nQ: Why?\nA: Speed.\n"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			a := scoreFeatureDumps(sections)
+			if tt.wantLine == 0 {
+				if len(a.issues) > 0 {
+					t.Errorf("issues = %q, want none", a.issues)
+				}
+				return
+			}
+			if len(a.issues) != 1 {
+				t.Fatalf("issues = %q, want one", a.issues)
+			}
+			if a.lines[0] != tt.wantLine {
+				t.Errorf("line = %d, want %d", a.lines[0], tt.wantLine)
+			}
+			want := featureDumpMessage + `: 2 feature statements and no customer benefit - say what each lets the customer do, e.g. "Ledger Sync includes bank feeds, so <who> can <do what>."`
+			if a.issues[0] != want {
+				t.Errorf("issue = %q, want %q", a.issues[0], want)
+			}
+		})
+	}
+}
+
+func TestScore_FeatureDumpRules(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\nLedger Sync includes bank feeds. It is powered by a matching engine.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	found := func() bool {
+		for _, f := range sections.Findings() {
+			if f.RuleID == "customer-feature-dump" {
+				return true
+			}
+		}
+		return false
+	}
+	if !found() {
+		t.Errorf("no customer-feature-dump finding under %s", CurrentRules)
+	}
+	sections.Rules = RulesVersion{Major: 5}
+	sections.PRScore = Score(sections)
+	if found() {
+		t.Errorf("customer-feature-dump finding under %s", sections.Rules)
+	}
+}
@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Boilerplate length limits, in words. Wire services expect the "About"
+// paragraph to be a short, standard description.
+const (
+	BoilerplateMinWords = 25
+	BoilerplateMaxWords = 100
+)
+
+// scoreBoilerplate checks the closing "About <Company>" paragraph for the
+// standard elements and length. It awards no points: scoreStructure already
+// awards the boilerplate's Structure points, so this check only adds findings.
+func scoreBoilerplate(content string) analysis {
+	a := analysis{category: "Structure"}
+
+	body := findBoilerplate(content)
+	if body == "" {
+		a.issue("No \"About <Company>\" boilerplate section found")
+		return a
+	}
+
+	elements := []struct {
+		found bool
+		issue string
+	}{
+		{boilerplateFoundingPattern.MatchString(body), "Boilerplate should state when the company was founded"},
+		{boilerplateHQPattern.MatchString(body), "Boilerplate should state where the company is headquartered"},
+		{boilerplateMissionPattern.MatchString(body), "Boilerplate should state the company's mission or what it does"},
+		{boilerplateURLPattern.MatchString(body), "Boilerplate should end with the company website"},
+		{mediaContactPattern.MatchString(content), "Add a media contact (name and email) after the boilerplate"},
+	}
+	complete := true
+	for _, e := range elements {
+		if !e.found {
+			a.issue(e.issue)
+			complete = false
+		}
+	}
+
+	switch words := len(strings.Fields(body)); {
+	case words > BoilerplateMaxWords:
+		a.issue(fmt.Sprintf("Boilerplate too long - keep it under %d words", BoilerplateMaxWords))
+	case words < BoilerplateMinWords:
+		a.issue(fmt.Sprintf("Boilerplate too short - aim for %d-%d words", BoilerplateMinWords, BoilerplateMaxWords))
+	default:
+		if complete {
+			a.strength("Boilerplate includes every standard element")
+		}
+	}
+	return a
+}
+
+// findBoilerplate returns the text of the "About <Company>" section: the
+// paragraph after an "About ..." line, or the paragraph that line starts.
+func findBoilerplate(content string) string {
+	paragraphs := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n")
+	for i, p := range paragraphs {
+		p = strings.TrimSpace(p)
+		first, rest, _ := strings.Cut(p, "\n")
+		if !boilerplateHeadingPattern.MatchString(strings.TrimSpace(first)) {
+			if boilerplateInlinePattern.MatchString(p) {
+				return p
+			}
+			continue
+		}
+		if rest = strings.TrimSpace(rest); rest != "" {
+			return rest
+		}
+		if i+1 < len(paragraphs) {
+			return strings.TrimSpace(paragraphs[i+1])
+		}
+	}
+	return ""
+}
+
+// BoilerplateInfo are the company facts a drafted boilerplate is built from.
+type BoilerplateInfo struct {
+	Company      string
+	Founded      string // year
+	Headquarters string // city, e.g. "Seattle, Washington"
+	Mission      string // completes "<Company> is on a mission to ..."
+	Description  string // optional further sentences, such as scale or customers
+	URL          string
+	ContactName  string
+	ContactEmail string
+	ContactPhone string // optional
+}
+
+// DraftBoilerplate drafts an "About <Company>" section with every element
+// the boilerplate check looks for. Missing facts become [placeholders] for
+// the writer to fill in.
+func DraftBoilerplate(info BoilerplateInfo) string {
+	company := orPlaceholder(info.Company, "company")
+	mission := strings.TrimSuffix(strings.TrimSpace(info.Mission), ".")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## About %s\n\n", company)
+	fmt.Fprintf(&b, "Founded in %s and headquartered in %s, %s is on a mission to %s.",
+		orPlaceholder(info.Founded, "founding year"), orPlaceholder(info.Headquarters, "headquarters city"),
+		company, orPlaceholder(mission, "mission"))
+	if d := strings.TrimSpace(info.Description); d != "" {
+		b.WriteString(" " + d)
+	}
+	fmt.Fprintf(&b, " Learn more at %s.\n\n", orPlaceholder(info.URL, "website"))
+
+	fmt.Fprintf(&b, "**Media Contact:** %s, %s", orPlaceholder(info.ContactName, "contact name"), orPlaceholder(info.ContactEmail, "contact email"))
+	if info.ContactPhone != "" {
+		b.WriteString(", " + info.ContactPhone)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+func orPlaceholder(value, name string) string {
+	if value = strings.TrimSpace(value); value != "" {
+		return value
+	}
+	return "[" + name + "]"
+}
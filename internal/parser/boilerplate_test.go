@@ -0,0 +1,92 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScoreBoilerplate(t *testing.T) {
+	const complete = `Acme today launched CloudSync.
+
+**About Acme**
+Founded in 2019, Acme helps small teams protect their data. Headquartered in Seattle, the company serves over 5,000 customers in 40 countries and has been recognized for its customer support. Learn more at www.acme.com.
+
+Media Contact: Jane Doe, press@acme.com`
+
+	tests := []struct {
+		name       string
+		content    string
+		wantIssues []string
+	}{
+		{name: "complete", content: complete},
+		{
+			name:       "missing",
+			content:    "Acme today launched CloudSync.",
+			wantIssues: []string{`No "About <Company>" boilerplate section found`},
+		},
+		{
+			name:    "heading with missing elements",
+			content: "Acme today launched CloudSync.\n\n### About Acme\n\nAcme makes backup software.",
+			wantIssues: []string{
+				"Boilerplate should state when the company was founded",
+				"Boilerplate should state where the company is headquartered",
+				"Boilerplate should state the company's mission or what it does",
+				"Boilerplate should end with the company website",
+				"Add a media contact (name and email) after the boilerplate",
+				"Boilerplate too short - aim for 25-100 words",
+			},
+		},
+		{
+			name:       "inline and too long",
+			content:    "About Acme: Founded in 2019 and based in Seattle, Acme helps teams. " + strings.Repeat("More words. ", 60) + "www.acme.com press@acme.com",
+			wantIssues: []string{"Boilerplate too long - keep it under 100 words"},
+		},
+		{
+			name:       "statistic is not a boilerplate",
+			content:    "About 40% of backups fail: CloudSync fixes that.\n\nMedia contact: press@acme.com",
+			wantIssues: []string{`No "About <Company>" boilerplate section found`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := scoreBoilerplate(tt.content)
+			if strings.Join(a.issues, "|") != strings.Join(tt.wantIssues, "|") {
+				t.Errorf("issues = %q, want %q", a.issues, tt.wantIssues)
+			}
+			if a.score != 0 {
+				t.Errorf("score = %d, want 0: the check adds findings only", a.score)
+			}
+			for _, issue := range a.issues {
+				if rule := ruleForMessage(issue); rule.ID == generalRule.ID {
+					t.Errorf("issue %q is not in the rule catalog", issue)
+				}
+			}
+		})
+	}
+}
+
+func TestDraftBoilerplate(t *testing.T) {
+	draft := DraftBoilerplate(BoilerplateInfo{
+		Company:      "Acme",
+		Founded:      "2019",
+		Headquarters: "Seattle, Washington",
+		Mission:      "make data loss a thing of the past for small teams.",
+		Description:  "More than 5,000 companies in 40 countries rely on Acme backups every day.",
+		URL:          "www.acme.com",
+		ContactName:  "Jane Doe",
+		ContactEmail: "press@acme.com",
+	})
+	if !strings.HasPrefix(draft, "## About Acme\n\nFounded in 2019 and headquartered in Seattle, Washington, Acme is on a mission to make data loss") {
+		t.Errorf("draft =\n%s", draft)
+	}
+	if a := scoreBoilerplate("Acme launches CloudSync.\n\n" + draft); len(a.issues) != 0 {
+		t.Errorf("drafted boilerplate has issues %q:\n%s", a.issues, draft)
+	}
+
+	placeholders := DraftBoilerplate(BoilerplateInfo{Company: "Acme"})
+	for _, want := range []string{"[founding year]", "[headquarters city]", "[mission]", "[website]", "[contact email]"} {
+		if !strings.Contains(placeholders, want) {
+			t.Errorf("draft missing placeholder %s:\n%s", want, placeholders)
+		}
+	}
+}
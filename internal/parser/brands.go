@@ -0,0 +1,149 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// brandMessage is the issue message of a brand name spelled other than its
+// canonical form, followed by ": " and the details.
+const brandMessage = "Inconsistent brand name"
+
+// brandRules is the first rules version with the brand name check.
+var brandRules = RulesVersion{Major: 5, Minor: 5}
+
+// reasonBrand is the rewrite reason for a sentence with a brand name variant.
+const reasonBrand = "brand name"
+
+// BrandVariant is a spelling or casing of a brand name other than its
+// canonical form, such as "PRFAQ validator" for "PR-FAQ Validator".
+type BrandVariant struct {
+	Canonical string
+	Variant   string
+	Lines     []int // 1-based lines of each use, in order
+}
+
+// brandName matches a canonical brand name and its variants.
+type brandName struct {
+	canonical string
+	pattern   *regexp.Regexp
+}
+
+// brandNames compiles the canonical names into patterns that match each
+// name whatever its casing, and with or without the spaces, hyphens, and
+// underscores between its words: "PR-FAQ Validator" matches "PRFAQ
+// validator" and "pr faq validator".
+func brandNames(canonical []string) []brandName {
+	var out []brandName
+	for _, name := range canonical {
+		words := strings.FieldsFunc(name, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+		if len(words) == 0 {
+			continue
+		}
+		for i, w := range words {
+			words[i] = regexp.QuoteMeta(w)
+		}
+		out = append(out, brandName{
+			canonical: strings.TrimSpace(name),
+			pattern:   regexp.MustCompile(`(?i)\b` + strings.Join(words, `[\s\-_]*`) + `\b`),
+		})
+	}
+	return out
+}
+
+// variants returns the spans of text that spell a brand name other than
+// its canonical form. A name that is part of a domain or an email address,
+// as in "acme.com", is left alone.
+func (b brandName) variants(text string) [][]int {
+	var out [][]int
+	for _, m := range b.pattern.FindAllStringIndex(text, -1) {
+		if text[m[0]:m[1]] == b.canonical || inAddress(text, m[0], m[1]) {
+			continue
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+// inAddress reports whether text[start:end] is part of a hostname, an email
+// address, or a path.
+func inAddress(text string, start, end int) bool {
+	if start > 0 && strings.ContainsRune("./@", rune(text[start-1])) {
+		return true
+	}
+	return end+1 < len(text) && text[end] == '.' && unicode.IsLetter(rune(text[end+1]))
+}
+
+// fixBrands replaces every variant of the brand names in text with its
+// canonical form.
+func fixBrands(text string, brands []brandName) string {
+	for _, b := range brands {
+		spans := b.variants(text)
+		for i := len(spans) - 1; i >= 0; i-- {
+			text = text[:spans[i][0]] + b.canonical + text[spans[i][1]:]
+		}
+	}
+	return text
+}
+
+// BrandVariants returns every variant of the document's brand names in the
+// headline and prose, grouped by spelling, in order of first use.
+func (s *SpecSections) BrandVariants() []BrandVariant {
+	brands := brandNames(s.BrandNames)
+	if len(brands) == 0 {
+		return nil
+	}
+	type line struct {
+		n    int
+		text string
+	}
+	var lines []line
+	if s.Title != "" {
+		lines = append(lines, line{s.Positions.Title, s.Title})
+	}
+	if s.Tree != nil {
+		for _, p := range treeParagraphs(s.Tree) {
+			if !isProse(p.Text) {
+				continue
+			}
+			for i, text := range strings.Split(p.Text, "\n") {
+				lines = append(lines, line{p.Span.Start + i, text})
+			}
+		}
+	}
+
+	var out []BrandVariant
+	index := map[string]int{}
+	for _, l := range lines {
+		for _, b := range brands {
+			for _, span := range b.variants(l.text) {
+				variant := l.text[span[0]:span[1]]
+				i, ok := index[variant]
+				if !ok {
+					i = len(out)
+					index[variant] = i
+					out = append(out, BrandVariant{Canonical: b.canonical, Variant: variant})
+				}
+				out[i].Lines = append(out[i].Lines, l.n)
+			}
+		}
+	}
+	return out
+}
+
+// scoreBrands reports each variant of the configured brand names at its
+// first use, with the canonical form to write instead. Findings cost no
+// points.
+func scoreBrands(s *SpecSections) analysis {
+	a := analysis{category: "Tone & Readability"}
+	for _, v := range s.BrandVariants() {
+		uses := "1 use"
+		if len(v.Lines) > 1 {
+			uses = fmt.Sprintf("%d uses", len(v.Lines))
+		}
+		a.issueAt(v.Lines[0], fmt.Sprintf("%s: %q (%s) - write %q", brandMessage, v.Variant, uses, v.Canonical))
+	}
+	return a
+}
@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+const brandsDoc = `# Acme Launches PRFAQ validator
+
+## Press Release
+
+Acme today launched the PR-FAQ Validator. The prfaq validator scores drafts in seconds.
+
+Download it at prfaq-validator.dev or email help@prfaq.validator.dev.
+
+## FAQ
+
+Q: Who built the PRFAQ validator?
+A: The ACME tools team.
+`
+
+func TestSpecSections_BrandVariants(t *testing.T) {
+	sections, err := Parse(strings.NewReader(brandsDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := sections.BrandVariants(); got != nil {
+		t.Errorf("BrandVariants() without brand names = %+v, want nil", got)
+	}
+
+	sections.BrandNames = []string{"PR-FAQ Validator", "Acme"}
+	want := []BrandVariant{
+		{"PR-FAQ Validator", "PRFAQ validator", []int{1, 11}},
+		{"PR-FAQ Validator", "prfaq validator", []int{5}},
+		{"Acme", "ACME", []int{12}},
+	}
+	got := sections.BrandVariants()
+	if len(got) != len(want) {
+		t.Fatalf("BrandVariants() = %+v, want %+v", got, want)
+	}
+	for i, w := range want {
+		if got[i].Canonical != w.Canonical || got[i].Variant != w.Variant || !slices.Equal(got[i].Lines, w.Lines) {
+			t.Errorf("BrandVariants()[%d] = %+v, want %+v", i, got[i], w)
+		}
+	}
+
+	a := scoreBrands(sections)
+	if len(a.issues) != 3 || a.issues[0] != brandMessage+`: "PRFAQ validator" (2 uses) - write "PR-FAQ Validator"` || a.lines[0] != 1 {
+		t.Errorf("issues = %q at %v", a.issues, a.lines)
+	}
+
+	rewrites := sections.Rewrites()
+	if len(rewrites) != 1 || rewrites[0].Reason != reasonBrand || rewrites[0].After != "The PR-FAQ Validator scores drafts in seconds." {
+		t.Errorf("Rewrites() = %+v, want the brand name fixed", rewrites)
+	}
+}
+
+func TestFixBrands(t *testing.T) {
+	brands := brandNames([]string{"Ledger Sync"})
+	tests := []struct{ in, want string }{
+		{"LedgerSync and ledger sync are one product.", "Ledger Sync and Ledger Sync are one product."},
+		{"Ledger Sync® is live.", "Ledger Sync® is live."},
+		{"Visit ledgersync.com.", "Visit ledgersync.com."},
+	}
+	for _, tt := range tests {
+		if got := fixBrands(tt.in, brands); got != tt.want {
+			t.Errorf("fixBrands(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// claimUnsupportedMessage is the issue message of a claim nothing outside
+// the press release backs up, followed by ": " and the claim.
+const claimUnsupportedMessage = "Claim not substantiated in the FAQ or metrics"
+
+// claimRules is the first rules version with the claims traceability check.
+var claimRules = RulesVersion{Major: 4, Minor: 2}
+
+// numberPattern matches the number in a metric, e.g. "1.5" in "$1.5M".
+var numberPattern = regexp.MustCompile(`\d+(?:,\d{3})*(?:\.\d+)?`)
+
+// Claim is a quantitative claim in the press release and where the rest of
+// the document substantiates it.
+type Claim struct {
+	Metric string // e.g. "40%"
+	Type   string // percentage, ratio, absolute, or score
+	Line   int    // 1-based press release line
+	// Section is the heading of the first section outside the press release
+	// that mentions the figure, and SectionLine the line of the mention.
+	// Both are zero when nothing substantiates the claim.
+	Section     string
+	SectionLine int
+}
+
+// Substantiated reports whether another section mentions the claim's figure.
+func (c Claim) Substantiated() bool {
+	return c.Section != ""
+}
+
+// evidence is a paragraph outside the press release that may substantiate
+// a claim.
+type evidence struct {
+	heading string
+	para    Paragraph
+}
+
+// Claims maps each distinct metric in the press release to the first
+// paragraph outside it that mentions the same figure: an FAQ answer, the
+// success metrics, or an appendix. Claims are in press release order.
+func (s *SpecSections) Claims() []Claim {
+	var support []evidence
+	if s.Tree != nil {
+		var walk func([]*Section)
+		walk = func(sections []*Section) {
+			for _, sec := range sections {
+				for _, p := range sec.Paragraphs {
+					if !s.Positions.PressRelease.contains(p.Span.Start) {
+						support = append(support, evidence{sec.Heading, p})
+					}
+				}
+				walk(sec.Subsections)
+			}
+		}
+		walk(s.Tree.Sections)
+	}
+
+	var claims []Claim
+	seen := map[string]bool{}
+	for _, p := range pressReleaseParagraphs(s) {
+		for _, sentence := range p.Sentences {
+			metrics, types := detectMetricsInText(sentence.Text)
+			for i, metric := range metrics {
+				key := strings.ToLower(metric)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				claim := Claim{Metric: metric, Type: types[i], Line: sentence.Position.Line}
+				claim.Section, claim.SectionLine = substantiate(metric, support)
+				claims = append(claims, claim)
+			}
+		}
+	}
+	return claims
+}
+
+// substantiate returns the heading and line of the first evidence that
+// mentions the metric, or its number when that has more than one digit.
+func substantiate(metric string, support []evidence) (string, int) {
+	patterns := []*regexp.Regexp{regexp.MustCompile(`(?i)` + regexp.QuoteMeta(metric))}
+	if n := numberPattern.FindString(metric); len(n) > 1 {
+		patterns = append(patterns, regexp.MustCompile(`(?:^|[^\d.,])`+regexp.QuoteMeta(n)+`(?:[^\d]|$)`))
+	}
+	for _, re := range patterns {
+		for _, e := range support {
+			for i, line := range strings.Split(e.para.Text, "\n") {
+				if re.MatchString(line) {
+					return e.heading, e.para.Span.Start + i
+				}
+			}
+		}
+	}
+	return "", 0
+}
+
+// scoreClaims reports every claim nothing outside the press release backs
+// up. Findings cost no points. A document that is only a press release has
+// nowhere to substantiate its claims, so it is not checked.
+func scoreClaims(s *SpecSections) analysis {
+	a := analysis{category: "Credibility"}
+	if s.FAQs == "" && s.Metrics == "" && len(s.Appendices) == 0 {
+		return a
+	}
+	for _, c := range s.Claims() {
+		if !c.Substantiated() {
+			a.issueAt(c.Line, fmt.Sprintf("%s: %q - explain where the figure comes from in the FAQ", claimUnsupportedMessage, c.Metric))
+		}
+	}
+	return a
+}
+
+// isClaimIssue reports whether an issue comes from the claims check, which
+// the report lists in the claims matrix.
+func isClaimIssue(issue string) bool {
+	head, _, _ := strings.Cut(issue, ": ")
+	return head == claimUnsupportedMessage
+}
+
+// writeClaims writes the claims traceability matrix.
+func writeClaims(report *strings.Builder, claims []Claim) {
+	if len(claims) == 0 {
+		return
+	}
+	unsupported := 0
+	for _, c := range claims {
+		if !c.Substantiated() {
+			unsupported++
+		}
+	}
+	report.WriteString("## 🔗 Claims Traceability\n\n")
+	fmt.Fprintf(report, "%d of %d quantitative claims are substantiated outside the press release.\n\n", len(claims)-unsupported, len(claims))
+	report.WriteString("| Claim | Line | Substantiated in |\n")
+	report.WriteString("|-------|------|------------------|\n")
+	for _, c := range claims {
+		where := "🔴 Not substantiated"
+		if c.Substantiated() {
+			where = fmt.Sprintf("🟢 %s (line %d)", c.Section, c.SectionLine)
+		}
+		fmt.Fprintf(report, "| %s | %d | %s |\n", strings.ReplaceAll(c.Metric, "|", `\|`), c.Line, where)
+	}
+	report.WriteString("\n")
+}
@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+const claimsDoc = `# Acme Launches Ledger Sync
+
+## Press Release
+
+Acme today launched Ledger Sync, which cuts month-end close time by 40% and saves 12 hours a week.
+
+Beta customers processed 3x more transactions.
+
+## FAQ
+
+Q: How did you measure the 40%?
+A: Across 30 beta customers, the close fell from 5 days to 3.
+
+## Success Metrics
+
+- Save finance teams 12 hours every week
+`
+
+func TestSpecSections_Claims(t *testing.T) {
+	sections, err := Parse(strings.NewReader(claimsDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	want := []Claim{
+		{Metric: "40%", Type: "percentage", Line: 5, Section: "FAQ", SectionLine: 11},
+		{Metric: "12 hours", Type: "absolute", Line: 5, Section: "Success Metrics", SectionLine: 16},
+		{Metric: "3x", Type: "ratio", Line: 7},
+	}
+	got := sections.Claims()
+	if len(got) != len(want) {
+		t.Fatalf("Claims() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Claims()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	findings := 0
+	for _, f := range sections.Findings() {
+		if f.RuleID == "claim-unsubstantiated" {
+			findings++
+			if f.Line != 7 || !strings.Contains(f.Message, `"3x"`) {
+				t.Errorf("finding = %+v, want 3x at line 7", f)
+			}
+		}
+	}
+	if findings != 1 {
+		t.Errorf("%d claim-unsubstantiated findings, want 1", findings)
+	}
+
+	report := GenerateMarkdownReport(sections, sections.PRScore)
+	for _, row := range []string{"| 40% | 5 | 🟢 FAQ (line 11) |", "| 3x | 7 | 🔴 Not substantiated |"} {
+		if !strings.Contains(report, row) {
+			t.Errorf("report lacks %q", row)
+		}
+	}
+}
+
+func TestScoreClaims_PressReleaseOnly(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\nLedger Sync cuts close time by 40%.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if a := scoreClaims(sections); len(a.issues) > 0 {
+		t.Errorf("issues = %q, want none without an FAQ", a.issues)
+	}
+}
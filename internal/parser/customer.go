@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Issue messages of the Customer Focus category, followed by ": " and the
+// details.
+const (
+	customerPerspectiveMessage = "Too little customer perspective"
+	customerBenefitMessage     = "Too few customer benefits"
+)
+
+// customerFocusRules is the first rules version with the Customer Focus
+// category.
+var customerFocusRules = RulesVersion{Major: 5}
+
+var (
+	// customerMentionPattern matches words that put the customer in the
+	// sentence: the reader addressed as "you", or customers named as such.
+	customerMentionPattern = regexp.MustCompile(`(?i)\b(?:you|your|yours|yourself|customers?|users?|buyers?|shoppers?|clients?|teams?|people|businesses)\b`)
+	// companyMentionPattern matches the company speaking of itself. "us" is
+	// lowercase only, so "US" the country is not counted.
+	companyMentionPattern = regexp.MustCompile(`\b(?:[Ww]e|[Oo]ur|[Oo]urs|us|[Oo]urselves)\b`)
+)
+
+// customerShareBands are the customer's shares, of customer and company
+// mentions or of benefit and feature statements, at or above which a half of
+// the category earns 5, 3, and 1 points.
+var customerShareBands = []struct {
+	share  float64
+	points int
+}{{0.6, 5}, {0.4, 3}, {0.01, 1}}
+
+// customerPoints returns the points for a customer share of part of total.
+func customerPoints(part, total int) int {
+	if total == 0 {
+		return 0
+	}
+	share := float64(part) / float64(total)
+	for _, b := range customerShareBands {
+		if share >= b.share {
+			return b.points
+		}
+	}
+	return 0
+}
+
+// scoreCustomerFocus measures how much of the press release is written from
+// the customer's perspective rather than the company's, out of 10 points:
+// half for the share of customer mentions ("you", "customers") against
+// company ones ("we", "our", the company's name), half for the share of
+// benefit statements ("saves", "so you can") against feature statements
+// ("includes", "powered by"). Mentions are counted outside quotes, where a
+// customer's "we" means the customer.
+func scoreCustomerFocus(content string) analysis {
+	a := analysis{category: "Customer Focus"}
+	prose := proseText(content)
+
+	narrative := stripQuotes(prose)
+	customer := len(customerMentionPattern.FindAllString(narrative, -1))
+	company := len(companyMentionPattern.FindAllString(narrative, -1))
+	for _, name := range companyNames(content) {
+		company += len(name.FindAllString(narrative, -1))
+	}
+	if points := customerPoints(customer, customer+company); points > 0 {
+		a.award(points, "customer perspective", fmt.Sprintf("%d customer mentions, %d company mentions", customer, company))
+	}
+	switch {
+	case customer == 0 && company == 0:
+		a.issue(customerPerspectiveMessage + ": the customer is never mentioned - say who the launch is for and what they get")
+	case customer <= company:
+		a.issue(fmt.Sprintf("%s: %d customer mentions against %d of the company - say what the customer gets, in their words", customerPerspectiveMessage, customer, company))
+	}
+
+	benefits, features := 0, 0
+	for _, sentence := range sentenceTerminatorPattern.Split(prose, -1) {
+		switch classifyStatement(sentence) {
+		case StatementBenefit:
+			benefits++
+		case StatementFeature:
+			features++
+		}
+	}
+	if points := customerPoints(benefits, benefits+features); points > 0 {
+		a.award(points, "benefit phrasing", fmt.Sprintf("%d benefit statements, %d feature statements", benefits, features))
+	}
+	switch {
+	case benefits == 0 && features == 0:
+		a.issue(customerBenefitMessage + ": no sentence says what the customer gets - say what the launch saves them or lets them do")
+	case benefits <= features:
+		a.issue(fmt.Sprintf("%s: %d feature statements against %d benefits - say what each feature lets the customer do", customerBenefitMessage, features, benefits))
+	}
+
+	if a.score == 10 {
+		a.strength("Written from the customer's perspective, in terms of benefits")
+	}
+	return a
+}
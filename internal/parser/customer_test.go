@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScoreCustomerFocus(t *testing.T) {
+	tests := []struct {
+		name   string
+		pr     string
+		score  int
+		issues []string // issue message heads
+	}{
+		{
+			"customer first",
+			"Acme today launched Ledger Sync, so finance teams can close the books in hours. " +
+				"Your bank feeds reconcile overnight, which saves you a day each month. " +
+				"Customers no longer match transactions by hand.",
+			10, nil,
+		},
+		{
+			"company first",
+			"Acme today launched Ledger Sync. We built it on our new reconciliation engine. " +
+				"Our platform includes an API and supports twelve banks. We are proud of our work.",
+			0, []string{customerPerspectiveMessage, customerBenefitMessage},
+		},
+		{
+			"neither",
+			"Ledger Sync is available today.",
+			0, []string{customerPerspectiveMessage, customerBenefitMessage},
+		},
+		{
+			"customer quote",
+			"Acme today launched Ledger Sync, which saves customers and their finance teams a day each month. " +
+				"\"We closed our books in hours,\" said Dana Lee, controller at Initech.",
+			10, nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := scoreCustomerFocus(tt.pr)
+			if a.score != tt.score {
+				t.Errorf("score = %d, want %d (trace %+v)", a.score, tt.score, a.trace)
+			}
+			if len(a.issues) != len(tt.issues) {
+				t.Fatalf("issues = %q, want %q", a.issues, tt.issues)
+			}
+			for i, issue := range a.issues {
+				if !strings.HasPrefix(issue, tt.issues[i]+": ") {
+					t.Errorf("issue = %q, want %q", issue, tt.issues[i])
+				}
+			}
+		})
+	}
+}
+
+func TestScore_CustomerFocusRules(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today launched Ledger Sync, which saves finance teams a day each month.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	hasCategory := func(score *PRScore) bool {
+		for _, c := range score.QualityBreakdown.Categories() {
+			if c.Name == "Customer Focus" {
+				return true
+			}
+		}
+		return false
+	}
+	if score := Score(sections); !hasCategory(score) || score.QualityBreakdown.CustomerFocusScore == 0 {
+		t.Errorf("no Customer Focus points under %s", CurrentRules)
+	}
+	sections.Rules = supportedRules[4]
+	if score := Score(sections); hasCategory(score) {
+		t.Errorf("Customer Focus category under %s", sections.Rules)
+	}
+}
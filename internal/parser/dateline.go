@@ -0,0 +1,211 @@
+package parser
+
+import (
+	_ "embed"
+	"regexp"
+	"strings"
+)
+
+// datelineLines is how many non-empty opening lines are searched for the
+// dateline, after headings and the release designation are skipped.
+const datelineLines = 4
+
+// countryUS is the country of the locations whose datelines use postal codes.
+const countryUS = "United States"
+
+// Dateline is the location that opens a press release, such as
+// "SEATTLE, WA —". Normalized is the canonical form downstream checks compare
+// against: the city in capitals, then the U.S. postal code or the state,
+// province, or country name.
+type Dateline struct {
+	Raw        string // location as written, e.g. "Seattle, Washington"
+	City       string // city as written
+	Region     string // state, province, or country as written; "" for a standalone city
+	State      string // two-letter postal code for U.S. datelines
+	Country    string // country name, "" when the location is not recognized
+	Normalized string // e.g. "SEATTLE, WA"
+	// Known is set when the region, or a standalone city, is in the bundled location list.
+	Known bool
+}
+
+// Found reports whether a dateline location was found.
+func (d Dateline) Found() bool {
+	return d.Raw != ""
+}
+
+// location is one entry of the bundled location list.
+type location struct {
+	kind    string // "state", "country", or "city"
+	name    string
+	code    string // postal code of a state, or of a city's state
+	country string
+}
+
+//go:embed locations.tsv
+var locationData string
+
+var (
+	// regions maps the names and abbreviations of states, provinces, and
+	// countries, as returned by locationKey, to their entry.
+	regions = map[string]location{}
+	// datelineCities are cities that may stand alone in a dateline, in capitals.
+	datelineCities = map[string]location{}
+)
+
+func init() {
+	for _, line := range strings.Split(locationData, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 4 {
+			panic("parser: malformed locations.tsv line: " + line)
+		}
+		loc := location{kind: fields[0], name: fields[1], country: fields[3]}
+		codes := strings.Split(fields[2], ",")
+		loc.code = codes[0]
+		if loc.kind == "city" {
+			datelineCities[loc.name] = loc
+			continue
+		}
+		regions[locationKey(loc.name)] = loc
+		for _, code := range codes {
+			if code != "" {
+				regions[locationKey(code)] = loc
+			}
+		}
+	}
+}
+
+// locationKey folds case and periods, so "WA", "Wash.", and "washington"
+// each find their state.
+func locationKey(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, ".", ""))
+}
+
+var (
+	// datelineLocationPattern matches "City, Region" at the start of a line or
+	// after a separator, so "Today, Acme" mid-sentence is not taken for one.
+	datelineLocationPattern = regexp.MustCompile(`(?:^|[—–(:.|]\s*|\s--?\s+)([A-Z][A-Za-z.'-]*(?:\s+[A-Z][A-Za-z.'-]*){0,3}),\s+([A-Z][A-Za-z.]*(?:\s+[A-Za-z][A-Za-z.]*){0,2})`)
+	// datelineSeparatorPattern matches what follows a dateline location: a
+	// dash, a wire service credit, or the date.
+	datelineSeparatorPattern = regexp.MustCompile(`^\s*(?:[—–(:|]|--?\s|\d|(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\s+\d)`)
+)
+
+// ParseDateline finds the dateline location in the opening lines of a press
+// release and normalizes it against the bundled location list. A location
+// whose region is not in the list is still returned, with Known unset, when
+// it is written in capitals and followed by a dateline separator.
+func ParseDateline(content string) Dateline {
+	seen := 0
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimSpace(strings.NewReplacer("*", "", "_", "", ">", "").Replace(line))
+		if line == "" || embargoPattern.MatchString(line) || immediateLoosePattern.MatchString(line) {
+			continue
+		}
+		if seen++; seen > datelineLines {
+			break
+		}
+		if d, ok := parseDatelineLine(line); ok {
+			return d
+		}
+	}
+	return Dateline{}
+}
+
+// parseDatelineLine looks for a standalone city or a "City, Region" location in one line.
+func parseDatelineLine(line string) (Dateline, bool) {
+	for name, loc := range datelineCities {
+		if rest, ok := strings.CutPrefix(line, name); ok && datelineSeparatorPattern.MatchString(rest) {
+			return Dateline{Raw: name, City: name, State: usState(loc), Country: loc.country, Normalized: name, Known: true}, true
+		}
+	}
+
+	for _, m := range datelineLocationPattern.FindAllStringSubmatchIndex(line, -1) {
+		city, regionText := line[m[2]:m[3]], line[m[4]:m[5]]
+		if region, loc, ok := lookupRegion(regionText); ok {
+			return newDateline(city, region, loc), true
+		}
+		if city == strings.ToUpper(city) && datelineSeparatorPattern.MatchString(line[m[5]:]) {
+			return Dateline{
+				Raw:        city + ", " + regionText,
+				City:       city,
+				Region:     regionText,
+				Normalized: city + ", " + regionText,
+			}, true
+		}
+	}
+	return Dateline{}, false
+}
+
+// lookupRegion matches the longest leading run of words in text, up to three,
+// against the location list, so "WA today announced" finds "WA".
+func lookupRegion(text string) (string, location, bool) {
+	words := strings.Fields(text)
+	for n := len(words); n > 0; n-- {
+		region := strings.TrimRight(strings.Join(words[:n], " "), ",")
+		if loc, ok := regions[locationKey(region)]; ok {
+			return region, loc, true
+		}
+	}
+	return "", location{}, false
+}
+
+// newDateline builds a recognized dateline. U.S. locations normalize to the
+// postal code; others to the full state, province, or country name.
+func newDateline(city, region string, loc location) Dateline {
+	d := Dateline{
+		Raw:     city + ", " + region,
+		City:    city,
+		Region:  region,
+		State:   usState(loc),
+		Country: loc.country,
+		Known:   true,
+	}
+	d.Normalized = strings.ToUpper(city) + ", " + loc.name
+	if d.State != "" {
+		d.Normalized = strings.ToUpper(city) + ", " + d.State
+	}
+	return d
+}
+
+// usState returns the postal code of a U.S. state or city, or "".
+func usState(loc location) string {
+	if loc.country != countryUS || loc.kind == "country" {
+		return ""
+	}
+	return loc.code
+}
+
+// scoreDateline checks the dateline against the location list and the
+// "CITY, ST" convention. Its findings cost no points: the date carries the
+// Release Date score, and dateline style varies between newsrooms.
+func scoreDateline(content string) analysis {
+	a := analysis{category: "Release Date"}
+	d := ParseDateline(content)
+	if !d.Found() {
+		return a
+	}
+	if !d.Known {
+		a.issue("Dateline location is not a recognized state, province, or country - check its spelling")
+		return a
+	}
+
+	standard := true
+	if d.State != "" && d.Region != "" && strings.TrimSuffix(d.Region, ".") != d.State {
+		a.issue("Use the two-letter postal code for the dateline state (e.g., 'SEATTLE, WA')")
+		standard = false
+	}
+	if d.City != strings.ToUpper(d.City) {
+		a.issue("Write the dateline city in capitals (e.g., 'SEATTLE, WA')")
+		standard = false
+	}
+	if standard {
+		a.strength("Follows standard press release dateline format")
+	}
+	return a
+}
@@ -0,0 +1,90 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDateline(t *testing.T) {
+	tests := []struct {
+		name           string
+		content        string
+		wantNormalized string
+		wantState      string
+		wantCountry    string
+		wantKnown      bool
+	}{
+		{"postal code", "SEATTLE, WA — Aug 20, 2024 — Acme today launched CloudSync.", "SEATTLE, WA", "WA", "United States", true},
+		{"full state name", "SEATTLE, WASHINGTON — Aug 20, 2024 — Acme today launched CloudSync.", "SEATTLE, WA", "WA", "United States", true},
+		{"AP abbreviation", "Seattle, Wash. (Business Wire) -- Acme today launched CloudSync.", "SEATTLE, WA", "WA", "United States", true},
+		{"bold markdown", "**Seattle, WA — August 12, 2025** — Acme today launched CloudSync.", "SEATTLE, WA", "WA", "United States", true},
+		{"date first", "Aug 20, 2024. Portland, OR. Acme today launched CloudSync.", "PORTLAND, OR", "OR", "United States", true},
+		{"multi-word", "SALT LAKE CITY, New Mexico - Acme today launched CloudSync.", "SALT LAKE CITY, NM", "NM", "United States", true},
+		{"province", "TORONTO, Ont. — Acme today launched CloudSync.", "TORONTO, Ontario", "", "Canada", true},
+		{"country", "MUNICH, Germany — Acme today launched CloudSync.", "MUNICH, Germany", "", "Germany", true},
+		{"standalone city", "NEW YORK — Acme today launched CloudSync.", "NEW YORK", "NY", "United States", true},
+		{"after designation", "FOR IMMEDIATE RELEASE\n\nLONDON (PRNewswire) — Acme today launched CloudSync.", "LONDON", "", "United Kingdom", true},
+		{"unknown region", "SPRINGFIELD, FREEDONIA — Acme today launched CloudSync.", "SPRINGFIELD, FREEDONIA", "", "", false},
+		{"company name", "ACME, INC. today launched CloudSync.", "", "", "", false},
+		{"mid-sentence comma", "Today, Acme launched CloudSync for teams.", "", "", "", false},
+		{"none", "Acme today launched CloudSync.", "", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := ParseDateline(tt.content)
+			if d.Normalized != tt.wantNormalized || d.State != tt.wantState || d.Country != tt.wantCountry || d.Known != tt.wantKnown {
+				t.Errorf("ParseDateline() = %+v, want Normalized %q, State %q, Country %q, Known %v",
+					d, tt.wantNormalized, tt.wantState, tt.wantCountry, tt.wantKnown)
+			}
+		})
+	}
+}
+
+func TestScoreDateline(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      string
+		wantIssue    string
+		wantStandard bool
+	}{
+		{"standard", "SEATTLE, WA — Acme today launched CloudSync.", "", true},
+		{"standalone", "NEW YORK — Acme today launched CloudSync.", "", true},
+		{"full state name", "SEATTLE, WASHINGTON — Acme today launched CloudSync.", "two-letter postal code", false},
+		{"AP abbreviation", "SEATTLE, Wash. — Acme today launched CloudSync.", "two-letter postal code", false},
+		{"lowercase city", "Seattle, WA — Acme today launched CloudSync.", "city in capitals", false},
+		{"country", "Munich, Germany — Acme today launched CloudSync.", "city in capitals", false},
+		{"unknown", "SPRINGFIELD, FREEDONIA — Acme today launched CloudSync.", "not a recognized", false},
+		{"missing", "Acme today launched CloudSync.", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := scoreDateline(tt.content)
+			if a.score != 0 {
+				t.Errorf("score = %d, want 0", a.score)
+			}
+			issues := strings.Join(a.issues, "|")
+			if (tt.wantIssue == "") != (issues == "") || !strings.Contains(issues, tt.wantIssue) {
+				t.Errorf("issues = %q, want one containing %q", a.issues, tt.wantIssue)
+			}
+			if standard := len(a.strengths) > 0; standard != tt.wantStandard {
+				t.Errorf("strengths = %q, want standard format %v", a.strengths, tt.wantStandard)
+			}
+			for _, issue := range a.issues {
+				if ruleForMessage(issue).ID == generalRule.ID {
+					t.Errorf("issue %q is not in the rule catalog", issue)
+				}
+			}
+		})
+	}
+}
+
+func TestParse_Dateline(t *testing.T) {
+	doc := "# CloudSync\n\n## Press Release\n\nSeattle, Washington — Aug 20, 2024 — Acme today launched CloudSync.\n\n## FAQ\n\n### Q: Why?\nBecause.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if sections.Dateline.Normalized != "SEATTLE, WA" {
+		t.Errorf("Dateline.Normalized = %q, want %q", sections.Dateline.Normalized, "SEATTLE, WA")
+	}
+}
@@ -0,0 +1,97 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Issue messages of the launch disclosure checks, followed by ": " and the
+// details.
+const (
+	pricingMissingMessage      = "Pricing not disclosed"
+	availabilityMissingMessage = "Availability not disclosed"
+)
+
+// disclosureRules is the first rules version with the launch disclosure
+// checks.
+var disclosureRules = RulesVersion{Major: 5, Minor: 2}
+
+// DisclosureKind is what a launch disclosure tells the reader.
+type DisclosureKind string
+
+// Kinds of launch disclosure. Pricing is one kind; the others together
+// disclose availability.
+const (
+	DisclosurePricing DisclosureKind = "pricing" // e.g. "$400 per month", "free", "contact sales"
+	DisclosureDate    DisclosureKind = "date"    // e.g. "available today", "starting March 4"
+	DisclosureRegion  DisclosureKind = "region"  // e.g. "in the United States and Canada"
+	DisclosureChannel DisclosureKind = "channel" // e.g. "sign up at acme.com", "in the App Store"
+)
+
+// DisclosureKinds lists the kinds of launch disclosure, in report order.
+var DisclosureKinds = []DisclosureKind{DisclosurePricing, DisclosureDate, DisclosureRegion, DisclosureChannel}
+
+// disclosurePatterns match each kind of launch disclosure.
+var disclosurePatterns = map[DisclosureKind]*regexp.Regexp{
+	DisclosurePricing: regexp.MustCompile(`(?i)(?:[$€£¥]\s?\d(?:[\d,.]*\d)?|\b\d+(?:\.\d+)?\s?(?:USD|EUR|GBP|dollars)\b|\b(?:free|no (?:additional )?(?:cost|charge)|at no (?:additional |extra )?(?:cost|charge)|contact (?:our )?sales|pric(?:e|ed|es|ing)|per (?:user|seat|month|year|transaction)|subscriptions? (?:start|cost)|costs?)\b)`),
+	DisclosureDate:    regexp.MustCompile(`(?i)\b(?:(?:is|are) (?:now |generally )?available (?:today|now|immediately)|available (?:today|now|immediately|(?:on|from|starting|beginning) (?:January|February|March|April|May|June|July|August|September|October|November|December|today|\d))|generally available|starting (?:today|(?:January|February|March|April|May|June|July|August|September|October|November|December) \d{1,2})|beginning (?:today|(?:January|February|March|April|May|June|July|August|September|October|November|December) \d{1,2})|now available)\b`),
+	DisclosureRegion:  regexp.MustCompile(`(?i)\b(?:in (?:the )?(?:United States|U\.S\.|US|Canada|Europe|EU|UK|United Kingdom|Japan|Australia|India|Germany|France|Brazil|Mexico|North America|Latin America|Asia Pacific|APAC|EMEA)|in \d+ (?:countries|regions|markets)|worldwide|globally|in all (?:AWS )?regions|(?:\w+-){2}\d)\b`),
+	DisclosureChannel: regexp.MustCompile(`(?i)(?:\b(?:sign up|signing up|download|install|get started|request access|join the waitlist|order|buy|purchase) (?:it |today |now )?(?:at|from|on|in|through|via)\b|\b(?:App Store|Google Play|Marketplace|console|our website)\b|\bat (?:https?://)?(?:www\.)?[a-z0-9-]+\.(?:com|io|dev|org|net|ai)\b)`),
+}
+
+// Disclosure is the first press release sentence that discloses one kind of
+// launch information.
+type Disclosure struct {
+	Kind DisclosureKind
+	Text string // the matched phrase
+	Line int    // 1-based line of the sentence
+}
+
+// Disclosures returns the first disclosure of each kind in the press
+// release, in DisclosureKinds order. Kinds the press release does not
+// disclose are left out.
+func (s *SpecSections) Disclosures() []Disclosure {
+	found := map[DisclosureKind]Disclosure{}
+	for _, p := range pressReleaseParagraphs(s) {
+		for _, sentence := range p.Sentences {
+			for _, kind := range DisclosureKinds {
+				if _, ok := found[kind]; ok {
+					continue
+				}
+				if m := disclosurePatterns[kind].FindString(sentence.Text); m != "" {
+					found[kind] = Disclosure{Kind: kind, Text: m, Line: sentence.Position.Line}
+				}
+			}
+		}
+	}
+	var out []Disclosure
+	for _, kind := range DisclosureKinds {
+		if d, ok := found[kind]; ok {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// scoreDisclosures flags a launch press release that does not say what the
+// product costs, or when, where, and how to get it. Findings cost no points.
+// Documents not meant for press distribution are not checked.
+func scoreDisclosures(s *SpecSections) analysis {
+	a := analysis{category: "5 Ws Coverage"}
+	paragraphs := pressReleaseParagraphs(s)
+	if !s.DocType.profile().media || len(paragraphs) == 0 {
+		return a
+	}
+	disclosed := map[DisclosureKind]bool{}
+	for _, d := range s.Disclosures() {
+		disclosed[d.Kind] = true
+	}
+	line := paragraphs[len(paragraphs)-1].Span.Start
+	if !disclosed[DisclosurePricing] {
+		a.issueAt(line, fmt.Sprintf("%s: the press release never says what it costs - give the price, or say it is free or to contact sales", pricingMissingMessage))
+	}
+	if !disclosed[DisclosureDate] && !disclosed[DisclosureRegion] && !disclosed[DisclosureChannel] {
+		a.issueAt(line, fmt.Sprintf("%s: the press release never says when, where, or how to get it - say when it is available, in which regions, and where to sign up", availabilityMissingMessage))
+	}
+	return a
+}
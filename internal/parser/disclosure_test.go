@@ -0,0 +1,101 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSpecSections_Disclosures(t *testing.T) {
+	tests := []struct {
+		name string
+		pr   string
+		want []Disclosure
+	}{
+		{"none", "Acme today launched Ledger Sync for finance teams.", nil},
+		{"price and date", "Ledger Sync is available today, starting at $400 per month.",
+			[]Disclosure{{DisclosurePricing, "$400", 5}, {DisclosureDate, "is available today", 5}}},
+		{"free", "Ledger Sync is free for teams of up to five.", []Disclosure{{DisclosurePricing, "free", 5}}},
+		{"contact sales", "Larger teams can contact sales.", []Disclosure{{DisclosurePricing, "contact sales", 5}}},
+		{"region and channel", "Ledger Sync launches in the United States.\n\nSign up at acme.com.",
+			[]Disclosure{{DisclosureRegion, "in the United States", 5}, {DisclosureChannel, "Sign up at", 7}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\n" + tt.pr + "\n"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got := sections.Disclosures()
+			if len(got) != len(tt.want) {
+				t.Fatalf("Disclosures() = %+v, want %+v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("Disclosures()[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestScoreDisclosures(t *testing.T) {
+	tests := []struct {
+		name    string
+		pr      string
+		docType DocType
+		want    []string // issue message heads
+	}{
+		{"both missing", "Acme today launched Ledger Sync.\n\nIt reconciles bank feeds overnight.", DocTypeExternal,
+			[]string{pricingMissingMessage, availabilityMissingMessage}},
+		{"pricing missing", "Acme today launched Ledger Sync.\n\nIt is available today.", DocTypeExternal,
+			[]string{pricingMissingMessage}},
+		{"availability missing", "Acme today launched Ledger Sync.\n\nIt costs $400 per month.", DocTypeExternal,
+			[]string{availabilityMissingMessage}},
+		{"both disclosed", "Acme today launched Ledger Sync.\n\nIt is available today at $400 per month.", DocTypeExternal, nil},
+		{"internal document", "Acme today launched Ledger Sync.", DocTypeInternal, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\n" + tt.pr + "\n\n## FAQ\n\nQ: Why?\nA: Speed.\n"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			sections.DocType = tt.docType
+			a := scoreDisclosures(sections)
+			if len(a.issues) != len(tt.want) {
+				t.Fatalf("issues = %q, want %q", a.issues, tt.want)
+			}
+			for i, issue := range a.issues {
+				if !strings.HasPrefix(issue, tt.want[i]+": ") {
+					t.Errorf("issue = %q, want %q", issue, tt.want[i])
+				}
+				if a.lines[i] != 7 {
+					t.Errorf("line = %d, want 7", a.lines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestScore_DisclosureRules(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today launched Ledger Sync.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	found := func() bool {
+		for _, f := range sections.Findings() {
+			if f.RuleID == "launch-pricing-missing" {
+				return f.Severity == SeverityError
+			}
+		}
+		return false
+	}
+	if !found() {
+		t.Errorf("no launch-pricing-missing error under %s", CurrentRules)
+	}
+	sections.Rules = RulesVersion{Major: 5, Minor: 1}
+	sections.PRScore = Score(sections)
+	if found() {
+		t.Errorf("launch-pricing-missing finding under %s", sections.Rules)
+	}
+}
@@ -0,0 +1,241 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DismissalStatus is why a finding no longer needs attention.
+type DismissalStatus string
+
+// Dismissal statuses.
+const (
+	// DismissWontFix marks a finding as an accepted trade-off that will not
+	// be fixed.
+	DismissWontFix DismissalStatus = "wont-fix"
+	// DismissAcknowledged marks a finding as seen and tracked elsewhere.
+	DismissAcknowledged DismissalStatus = "acknowledged"
+)
+
+// DismissalStatuses lists the dismissal statuses.
+var DismissalStatuses = []DismissalStatus{DismissWontFix, DismissAcknowledged}
+
+// ParseDismissalStatus parses a dismissal status such as "wont-fix".
+// Spaces and underscores may stand in for the hyphen, and "won't fix" is
+// accepted too.
+func ParseDismissalStatus(s string) (DismissalStatus, error) {
+	norm := strings.NewReplacer(" ", "-", "_", "-", "'", "").Replace(strings.ToLower(strings.TrimSpace(s)))
+	for _, status := range DismissalStatuses {
+		if string(status) == norm {
+			return status, nil
+		}
+	}
+	names := make([]string, len(DismissalStatuses))
+	for i, status := range DismissalStatuses {
+		names[i] = string(status)
+	}
+	return "", fmt.Errorf("unknown dismissal status %q (want %s)", s, strings.Join(names, ", "))
+}
+
+// Label is the status as shown to readers: "Won't fix" or "Acknowledged".
+func (d DismissalStatus) Label() string {
+	if d == DismissWontFix {
+		return "Won't fix"
+	}
+	return "Acknowledged"
+}
+
+// Dismissal marks the findings of a rule, or one finding of it, as no
+// longer needing attention. It matches findings by rule and message rather
+// than line, so it holds while the document is edited around them.
+type Dismissal struct {
+	Rule string `yaml:"rule"`
+	// Message is the finding's message; "" dismisses every finding of Rule.
+	Message string          `yaml:"message,omitempty"`
+	Status  DismissalStatus `yaml:"status"`
+	Reason  string          `yaml:"reason,omitempty"`
+	Date    string          `yaml:"date,omitempty"`
+}
+
+// dismissalFields are the keys of a dismissals sidecar file.
+type dismissalFields struct {
+	Dismissed []Dismissal `yaml:"dismissed"`
+}
+
+// matches reports whether d dismisses the issue with message.
+func (d Dismissal) matches(message string) bool {
+	return d.Rule == ruleForMessage(message).ID && (d.Message == "" || d.Message == message)
+}
+
+// DismissedFinding is a finding a dismissal keeps out of the findings.
+type DismissedFinding struct {
+	Finding
+	Dismissal Dismissal
+}
+
+// DismissalsFile returns the path of the dismissals sidecar of the document
+// at path: docs/launch.md has docs/launch.dismissed.yaml.
+func DismissalsFile(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ".dismissed.yaml"
+}
+
+// ReadDismissals reads the dismissals sidecar of the document at path. A
+// document without one has no dismissals.
+func ReadDismissals(path string) ([]Dismissal, error) {
+	sidecar := DismissalsFile(path)
+	data, err := os.ReadFile(sidecar) //nolint:gosec // derived from the user-provided document path
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRead, err)
+	}
+	var f dismissalFields
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrDismissals, sidecar, err)
+	}
+	for i, d := range f.Dismissed {
+		if strings.TrimSpace(d.Rule) == "" {
+			return nil, fmt.Errorf("%w: %s: dismissal without a rule", ErrDismissals, sidecar)
+		}
+		status, err := ParseDismissalStatus(string(d.Status))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrDismissals, sidecar, err)
+		}
+		f.Dismissed[i].Status = status
+	}
+	return f.Dismissed, nil
+}
+
+// WriteDismissals writes dismissals to the dismissals sidecar of the
+// document at path, or removes the sidecar when there are none.
+func WriteDismissals(path string, dismissals []Dismissal) error {
+	sidecar := DismissalsFile(path)
+	if len(dismissals) == 0 {
+		if err := os.Remove(sidecar); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s: %w", sidecar, err)
+		}
+		return nil
+	}
+	data, err := yaml.Marshal(dismissalFields{Dismissed: dismissals})
+	if err != nil {
+		return fmt.Errorf("failed to encode dismissals: %w", err)
+	}
+	if err := os.WriteFile(sidecar, data, 0o644); err != nil { //nolint:gosec // a sidecar meant to be committed with the document
+		return fmt.Errorf("failed to write %s: %w", sidecar, err)
+	}
+	return nil
+}
+
+// Dismiss returns dismissals with d added, replacing a dismissal of the
+// same rule and message.
+func Dismiss(dismissals []Dismissal, d Dismissal) []Dismissal {
+	dismissals = Undismiss(dismissals, d.Rule, d.Message)
+	return append(dismissals, d)
+}
+
+// Undismiss returns dismissals without the dismissal of rule and message.
+func Undismiss(dismissals []Dismissal, rule, message string) []Dismissal {
+	return slices.DeleteFunc(slices.Clone(dismissals), func(d Dismissal) bool {
+		return d.Rule == rule && d.Message == message
+	})
+}
+
+// applyDismissalsFile reads the dismissals sidecar of the document at
+// path, if there is one.
+func (s *SpecSections) applyDismissalsFile(path string) error {
+	dismissals, err := ReadDismissals(path)
+	if err != nil {
+		return err
+	}
+	s.Dismissals = dismissals
+	return nil
+}
+
+// dismissal returns the dismissal of the issue with message, if any.
+func (s *SpecSections) dismissal(message string) (Dismissal, bool) {
+	// The last dismissal wins, as the one added most recently
+	for i := len(s.Dismissals) - 1; i >= 0; i-- {
+		if s.Dismissals[i].matches(message) {
+			return s.Dismissals[i], true
+		}
+	}
+	return Dismissal{}, false
+}
+
+// dismiss moves the issues of b that sections dismisses from Issues to
+// Dismissed, keeping their lines.
+func (b *PRQualityBreakdown) dismiss(sections *SpecSections) {
+	if len(sections.Dismissals) == 0 {
+		return
+	}
+	var issues []string
+	lines := map[int]int{}
+	for i, issue := range b.Issues {
+		line, ok := b.IssueLines[i]
+		if _, dismissed := sections.dismissal(issue); dismissed {
+			if ok {
+				if b.DismissedLines == nil {
+					b.DismissedLines = map[int]int{}
+				}
+				b.DismissedLines[len(b.Dismissed)] = line
+			}
+			b.Dismissed = append(b.Dismissed, issue)
+			continue
+		}
+		if ok {
+			lines[len(issues)] = line
+		}
+		issues = append(issues, issue)
+	}
+	b.Issues = issues
+	b.IssueLines = nil
+	if len(lines) > 0 {
+		b.IssueLines = lines
+	}
+}
+
+// DismissedFindings returns the findings the dismissals sidecar keeps out of
+// Findings, in the same order, each with its dismissal.
+func (s *SpecSections) DismissedFindings() []DismissedFinding {
+	if s.PRScore == nil {
+		return nil
+	}
+	b := s.PRScore.QualityBreakdown
+	var dismissed []DismissedFinding
+	for _, f := range s.locate(b.Dismissed, b.DismissedLines) {
+		d, _ := s.dismissal(f.Message)
+		dismissed = append(dismissed, DismissedFinding{Finding: f.Finding, Dismissal: d})
+	}
+	return dismissed
+}
+
+// undismissedAntiPatterns is AntiPatterns without the dismissed ones.
+func (s *SpecSections) undismissedAntiPatterns() []AntiPatternHit {
+	return slices.DeleteFunc(s.AntiPatterns(), func(hit AntiPatternHit) bool {
+		_, dismissed := s.dismissal(hit.Name + ": " + hit.Detail)
+		return dismissed
+	})
+}
+
+// writeDismissed writes the report's dismissed findings section.
+func writeDismissed(report *strings.Builder, dismissed []DismissedFinding) {
+	if len(dismissed) == 0 {
+		return
+	}
+	report.WriteString("## 🔕 Dismissed Findings\n\n")
+	report.WriteString("These findings were marked as won't fix or acknowledged, and are not listed above.\n\n")
+	report.WriteString("| Line | Status | Finding | Reason |\n")
+	report.WriteString("|------|--------|---------|--------|\n")
+	for _, d := range dismissed {
+		fmt.Fprintf(report, "| %d | %s | %s | %s |\n", d.Line, d.Dismissal.Status.Label(), cellText(d.Message), cellText(d.Dismissal.Reason))
+	}
+	report.WriteString("\n")
+}
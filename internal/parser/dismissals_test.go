@@ -0,0 +1,138 @@
+package parser
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseDismissalStatus(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    DismissalStatus
+		wantErr bool
+	}{
+		{"wont-fix", DismissWontFix, false},
+		{"Won't fix", DismissWontFix, false},
+		{"wont_fix", DismissWontFix, false},
+		{" Acknowledged ", DismissAcknowledged, false},
+		{"ignored", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDismissalStatus(tt.in)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseDismissalStatus(%q) = %q, %v, want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestWriteDismissals(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "launch.md")
+	ds := Dismiss(nil, Dismissal{Rule: "headline-too-short", Status: DismissAcknowledged})
+	ds = Dismiss(ds, Dismissal{Rule: "hook-no-metrics", Message: "Hook lacks specific metrics or outcomes", Status: DismissWontFix, Reason: "Metrics | later"})
+	// Dismissing again replaces the earlier dismissal
+	ds = Dismiss(ds, Dismissal{Rule: "headline-too-short", Status: DismissWontFix, Date: "2026-03-02"})
+	if err := WriteDismissals(path, ds); err != nil {
+		t.Fatalf("WriteDismissals() error = %v", err)
+	}
+
+	got, err := ReadDismissals(path)
+	if err != nil {
+		t.Fatalf("ReadDismissals() error = %v", err)
+	}
+	want := []Dismissal{
+		{Rule: "hook-no-metrics", Message: "Hook lacks specific metrics or outcomes", Status: DismissWontFix, Reason: "Metrics | later"},
+		{Rule: "headline-too-short", Status: DismissWontFix, Date: "2026-03-02"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadDismissals() = %+v, want %+v", got, want)
+	}
+
+	// Without dismissals the sidecar is removed
+	if err := WriteDismissals(path, Undismiss(Undismiss(got, "headline-too-short", ""), "hook-no-metrics", "Hook lacks specific metrics or outcomes")); err != nil {
+		t.Fatalf("WriteDismissals() error = %v", err)
+	}
+	if _, err := os.Stat(DismissalsFile(path)); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("sidecar still exists: %v", err)
+	}
+}
+
+const dismissalDoc = `# Acme Launches Ledger Sync
+
+## Press Release
+
+Acme today launched Ledger Sync.
+`
+
+func TestParseSource_Dismissals(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "launch.md")
+
+	before, err := ParseSource(path, []byte(dismissalDoc))
+	if err != nil {
+		t.Fatalf("ParseSource() error = %v", err)
+	}
+	var target Finding
+	for _, f := range before.Findings() {
+		if f.RuleID == "headline-too-short" {
+			target = f
+		}
+	}
+	if target.RuleID == "" {
+		t.Fatal("document has no headline-too-short finding")
+	}
+
+	sidecar := "dismissed:\n  - rule: headline-too-short\n    status: Won't fix\n    reason: Brand name | house style\n"
+	if err := os.WriteFile(DismissalsFile(path), []byte(sidecar), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	sections, err := ParseSource(path, []byte(dismissalDoc))
+	if err != nil {
+		t.Fatalf("ParseSource() error = %v", err)
+	}
+
+	if slices.ContainsFunc(sections.Findings(), func(f Finding) bool { return f.RuleID == "headline-too-short" }) {
+		t.Error("Findings() still reports the dismissed finding")
+	}
+	if got, want := len(sections.Findings()), len(before.Findings())-1; got != want {
+		t.Errorf("len(Findings()) = %d, want %d", got, want)
+	}
+	dismissed := sections.DismissedFindings()
+	if len(dismissed) != 1 || dismissed[0].Finding != target || dismissed[0].Dismissal.Status != DismissWontFix {
+		t.Errorf("DismissedFindings() = %+v, want %+v won't fix", dismissed, target)
+	}
+	if sections.PRScore.OverallScore != before.PRScore.OverallScore {
+		t.Errorf("OverallScore = %d, want it unchanged at %d", sections.PRScore.OverallScore, before.PRScore.OverallScore)
+	}
+
+	report := GenerateMarkdownReport(sections, sections.PRScore)
+	for _, want := range []string{"## 🔕 Dismissed Findings", "| Won't fix | " + target.Message + ` | Brand name \| house style |`} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report lacks %q", want)
+		}
+	}
+
+	if deps := sections.Dependencies(path); !slices.Contains(deps, DismissalsFile(path)) {
+		t.Errorf("Dependencies() = %v, want it to include %s", deps, DismissalsFile(path))
+	}
+}
+
+func TestParseSource_InvalidDismissals(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "launch.md")
+	for name, sidecar := range map[string]string{
+		"unknown status": "dismissed:\n  - rule: headline-too-short\n    status: ignored\n",
+		"no rule":        "dismissed:\n  - status: wont-fix\n",
+		"not yaml":       "dismissed: [\n",
+	} {
+		if err := os.WriteFile(DismissalsFile(path), []byte(sidecar), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ParseSource(path, []byte("# Doc\n")); !errors.Is(err, ErrDismissals) {
+			t.Errorf("%s: error = %v, want %v", name, err, ErrDismissals)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package parser
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DocType is the kind of document a PR-FAQ is. It selects the required
+// sections, the category weights of the overall score, and whether the
+// press-distribution checks (release date, dateline, boilerplate) apply.
+type DocType string
+
+// Supported document types. DocTypeExternal, a public launch announcement,
+// keeps the original scoring.
+const (
+	DocTypeExternal DocType = "external"
+	DocTypeInternal DocType = "internal"
+	DocTypeDesign   DocType = "design"
+)
+
+// DocTypes lists the accepted -doc-type and front matter doc_type values.
+var DocTypes = []DocType{DocTypeExternal, DocTypeInternal, DocTypeDesign}
+
+// ParseDocType parses a document type name. The empty string is DocTypeExternal.
+func ParseDocType(s string) (DocType, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return DocTypeExternal, nil
+	}
+	for _, t := range DocTypes {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	names := make([]string, len(DocTypes))
+	for i, t := range DocTypes {
+		names[i] = string(t)
+	}
+	return "", fmt.Errorf("unknown document type %q (want %s)", s, strings.Join(names, ", "))
+}
+
+// Weights scale breakdown categories in the overall score, by category
+// name; unlisted categories weigh 1.
+type Weights map[string]float64
+
+// docTypeProfile holds the scoring that varies by document type.
+type docTypeProfile struct {
+	// media is set for documents meant for press distribution. Without it the
+	// release date, dateline, and boilerplate checks are skipped and their
+	// points awarded, so an internal document is not penalized for lacking them.
+	media bool
+	// weights scale categories in the overall score.
+	weights Weights
+	// required are the ReviewSections keys, besides the press release, the
+	// document must have.
+	required []string
+}
+
+var docTypeProfiles = map[DocType]docTypeProfile{
+	DocTypeExternal: {media: true},
+	// Initiatives are pitched to leadership: the what and why outweigh the hook and customer quotes
+	DocTypeInternal: {
+		weights:  Weights{"Newsworthy Hook": 0.5, "Quote Quality": 0.5, "5 Ws Coverage": 1.5},
+		required: []string{"faq", "metrics"},
+	},
+	// Design reviews read for clear reasoning rather than launch polish
+	DocTypeDesign: {
+		weights:  Weights{"Newsworthy Hook": 0.5, "Quote Quality": 0.5, "Fluff Avoidance": 0.5, "5 Ws Coverage": 1.5, "Structure": 1.5},
+		required: []string{"faq", "metrics"},
+	},
+}
+
+// profile returns the scoring for t, falling back to DocTypeExternal for
+// the zero value and unknown types.
+func (t DocType) profile() docTypeProfile {
+	if p, ok := docTypeProfiles[t]; ok {
+		return p
+	}
+	return docTypeProfiles[DocTypeExternal]
+}
+
+// weight returns the overall-score weight of a breakdown category.
+func (p docTypeProfile) weight(category string) float64 {
+	if w, ok := p.weights[category]; ok {
+		return w
+	}
+	return 1
+}
+
+// WeightNote describes the category weights of the document type, e.g.
+// "5 Ws Coverage ×1.5, Newsworthy Hook ×0.5". It is "" when every category
+// weighs 1.
+func (t DocType) WeightNote() string {
+	return weightNote(t.profile().weights)
+}
+
+// weightNote lists the weights other than 1 in name order.
+func weightNote(weights Weights) string {
+	names := make([]string, 0, len(weights))
+	for name, w := range weights {
+		if w != 1 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		names[i] = fmt.Sprintf("%s ×%g", name, weights[name])
+	}
+	return strings.Join(names, ", ")
+}
+
+// MaxWeight is the largest category weight CheckWeights accepts.
+const MaxWeight = 3
+
+// CheckWeights validates category weights, such as those from a config file:
+// each names a category of the overall score and is between 0 and MaxWeight.
+func CheckWeights(weights Weights) error {
+	for name, w := range weights {
+		if !isWeighted(name) {
+			return fmt.Errorf("%w: unknown category %q", ErrWeights, name)
+		}
+		if w < 0 || w > MaxWeight || math.IsNaN(w) {
+			return fmt.Errorf("%w: %s weight %g is outside 0-%d", ErrWeights, name, w, MaxWeight)
+		}
+	}
+	return nil
+}
+
+// isWeighted reports whether category counts toward the overall score.
+// Credibility mirrors Tone & Readability and does not.
+func isWeighted(category string) bool {
+	for _, c := range AllCategories() {
+		if c.Name == category {
+			return category != "Credibility"
+		}
+	}
+	return false
+}
+
+// withWeights returns the document type's weights overridden by weights.
+func (t DocType) withWeights(weights Weights) Weights {
+	merged := Weights{}
+	for name, w := range t.profile().weights {
+		merged[name] = w
+	}
+	for name, w := range weights {
+		merged[name] = w
+	}
+	return merged
+}
+
+// weightedTotal is the overall score: the weighted category scores, rescaled
+// so a perfect document still reaches the unweighted maximum. Credibility
+// mirrors Tone & Readability and is not counted.
+func (p docTypeProfile) weightedTotal(categories []CategoryScore) int {
+	var weighted, weightedMax, maxTotal float64
+	for _, c := range categories {
+		if c.Name == "Credibility" {
+			continue
+		}
+		w := p.weight(c.Name)
+		weighted += w * float64(c.Score)
+		weightedMax += w * float64(c.Max)
+		maxTotal += float64(c.Max)
+	}
+	if weightedMax == 0 {
+		return 0
+	}
+	return int(math.Round(weighted * maxTotal / weightedMax))
+}
+
+// notRequired stands in for a press-distribution analyzer on documents that
+// are not press releases: it awards the category's points without checking.
+func notRequired(category string, points int, docType DocType) analysis {
+	a := analysis{category: category}
+	a.award(points, "not required for "+string(docType)+" documents", "")
+	return a
+}
+
+// scoreRequiredSections reports the sections the document type requires
+// that are missing. Missing sections are findings and cost no points.
+func scoreRequiredSections(s *SpecSections) analysis {
+	a := analysis{category: "Structure"}
+	for _, key := range s.DocType.profile().required {
+		if s.Content(key) != "" {
+			continue
+		}
+		switch key {
+		case "faq":
+			a.issue("Add a FAQ section - this document type requires one")
+		case "metrics":
+			a.issue("Add a Success Metrics section - this document type requires one")
+		}
+	}
+	return a
+}
+
+// frontMatter is the YAML block some PR-FAQs open with, between "---" lines.
+type frontMatter struct {
+	DocType string `yaml:"doc_type"`
+	Tone    string `yaml:"tone"`
+
+	reviewFields `yaml:",inline"`
+}
+
+// parseFrontMatter reads the front matter at the top of lines, if any, and
+// returns how many lines it spans including its delimiters.
+func parseFrontMatter(lines []string) (frontMatter, int, error) {
+	var fm frontMatter
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return fm, 0, nil
+	}
+	for i := 1; i < len(lines); i++ {
+		if end := strings.TrimSpace(lines[i]); end != "---" && end != "..." {
+			continue
+		}
+		if err := yaml.Unmarshal([]byte(strings.Join(lines[1:i], "\n")), &fm); err != nil {
+			return fm, 0, fmt.Errorf("%w: %w", ErrFrontMatter, err)
+		}
+		return fm, i + 1, nil
+	}
+	// An opening "---" without a closing one is a horizontal rule, not front matter
+	return fm, 0, nil
+}
@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseDocType(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    DocType
+		wantErr bool
+	}{
+		{"", DocTypeExternal, false},
+		{"internal", DocTypeInternal, false},
+		{" Design ", DocTypeDesign, false},
+		{"memo", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDocType(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseDocType(%q) = %q, %v; want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestParseFrontMatter(t *testing.T) {
+	tests := []struct {
+		name      string
+		lines     []string
+		wantType  string
+		wantLines int
+		wantErr   bool
+	}{
+		{"none", []string{"# Title"}, "", 0, false},
+		{"doc type", []string{"---", "doc_type: design", "owner: infra", "---", "# Title"}, "design", 4, false},
+		{"dots close", []string{"---", "doc_type: internal", "...", "# Title"}, "internal", 3, false},
+		{"unterminated rule", []string{"---", "# Title"}, "", 0, false},
+		{"malformed", []string{"---", "doc_type: [internal", "---"}, "", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, n, err := parseFrontMatter(tt.lines)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrFrontMatter) {
+				t.Errorf("error = %v, want ErrFrontMatter", err)
+			}
+			if fm.DocType != tt.wantType || n != tt.wantLines {
+				t.Errorf("parseFrontMatter() = %q, %d lines; want %q, %d", fm.DocType, n, tt.wantType, tt.wantLines)
+			}
+		})
+	}
+}
+
+func TestWeightedTotal(t *testing.T) {
+	b := PRQualityBreakdown{HeadlineScore: 8, HookScore: 6, ReleaseDateScore: 5, FiveWsScore: 12,
+		CredibilityScore: 7, StructureScore: 9, ToneScore: 7, FluffScore: 10, QuoteScore: 3}
+	unweighted := 8 + 6 + 5 + 12 + 9 + 7 + 10 + 3
+
+	if got := DocTypeExternal.profile().weightedTotal(b.Categories()); got != unweighted {
+		t.Errorf("external total = %d, want the unweighted sum %d", got, unweighted)
+	}
+	// A weak hook and few quotes matter less for internal documents
+	if got := DocTypeInternal.profile().weightedTotal(b.Categories()); got <= unweighted {
+		t.Errorf("internal total = %d, want more than %d", got, unweighted)
+	}
+
+	perfect := PRQualityBreakdown{HeadlineScore: 10, HookScore: 15, ReleaseDateScore: 5, FiveWsScore: 15,
+		CredibilityScore: 10, StructureScore: 10, ToneScore: 10, FluffScore: 10, QuoteScore: 15}
+	for _, dt := range DocTypes {
+		if got := dt.profile().weightedTotal(perfect.Categories()); got != 90 {
+			t.Errorf("%s total of a perfect breakdown = %d, want 90", dt, got)
+		}
+	}
+}
+
+func TestParse_DocType(t *testing.T) {
+	doc := "---\ndoc_type: internal\n---\n# Ledger Sync\n\n## Press Release\n\n" +
+		"Acme today announced Ledger Sync, which cuts month-end close from 5 days to 2.\n\n" +
+		"Finance teams spend a week reconciling ledgers by hand.\n\n" +
+		"Ledger Sync matches entries automatically.\n\n" +
+		"## FAQ\n\n### Q: What does it cost?\nTwo engineers for a quarter.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if sections.DocType != DocTypeInternal {
+		t.Fatalf("DocType = %q, want %q", sections.DocType, DocTypeInternal)
+	}
+	if sections.Positions.Title != 4 {
+		t.Errorf("Positions.Title = %d, want 4 (front matter lines still count)", sections.Positions.Title)
+	}
+
+	score := sections.PRScore
+	if score.QualityBreakdown.ReleaseDateScore != 5 {
+		t.Errorf("ReleaseDateScore = %d, want 5 for a document without a release date", score.QualityBreakdown.ReleaseDateScore)
+	}
+	issues := strings.Join(score.QualityBreakdown.Issues, "|")
+	for _, unwanted := range []string{"release date", "boilerplate", "dateline"} {
+		if strings.Contains(strings.ToLower(issues), unwanted) {
+			t.Errorf("issues = %q, want no %s findings for an internal document", score.QualityBreakdown.Issues, unwanted)
+		}
+	}
+	if !strings.Contains(issues, "Success Metrics section") {
+		t.Errorf("issues = %q, want the required Success Metrics section", score.QualityBreakdown.Issues)
+	}
+	if !strings.Contains(Explain(score), "Weighted for internal documents") {
+		t.Error("Explain() does not show the document type weights")
+	}
+
+	_, err = Parse(strings.NewReader("---\ndoc_type: memo\n---\n# Title\n"))
+	if !errors.Is(err, ErrFrontMatter) {
+		t.Errorf("Parse() with unknown doc_type error = %v, want ErrFrontMatter", err)
+	}
+}
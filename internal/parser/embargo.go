@@ -0,0 +1,159 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Release designation kinds.
+const (
+	ReleaseImmediate = "immediate"
+	ReleaseEmbargo   = "embargo"
+)
+
+// designationLines is how many non-empty opening lines are searched for a
+// release designation; it belongs above the dateline.
+const designationLines = 8
+
+// timeNow is replaced in tests to check embargo dates deterministically.
+var timeNow = time.Now
+
+// ReleaseDesignation is the "FOR IMMEDIATE RELEASE" or "EMBARGOED UNTIL ..."
+// line at the top of a press release.
+type ReleaseDesignation struct {
+	Kind  string    // ReleaseImmediate, ReleaseEmbargo, or "" when there is none
+	Line  string    // the designation line as written
+	Until time.Time // embargo date; zero when it is missing or unreadable
+	// Conflict is set when both kinds of designation were found.
+	Conflict bool
+}
+
+// Note summarizes the designation for reports, flagging a stale embargo or
+// conflicting lines. It is "" when the press release has no designation.
+func (d ReleaseDesignation) Note() string {
+	var note string
+	switch {
+	case d.Kind == ReleaseImmediate:
+		note = "For immediate release"
+	case d.Kind == ReleaseEmbargo && d.Until.IsZero():
+		note = "Embargoed (no readable date)"
+	case d.Kind == ReleaseEmbargo:
+		note = "Embargoed until " + d.Until.Format("January 2, 2006")
+		if d.Until.Before(truncateDay(timeNow())) {
+			note += " ⚠️ date has passed"
+		}
+	}
+	if d.Conflict {
+		note += " ⚠️ conflicting release designations"
+	}
+	return note
+}
+
+var (
+	immediateStrictPattern = regexp.MustCompile(`^FOR IMMEDIATE RELEASE:?$`)
+	immediateLoosePattern  = regexp.MustCompile(`(?i)\b(?:for\s+)?immediate(?:ly)?\s+release\b|\brelease\s+immediately\b`)
+	embargoPattern         = regexp.MustCompile(`(?i)\bembargo(?:ed)?\b`)
+	embargoTimePattern     = regexp.MustCompile(`(?i)\b\d{1,2}(?::\d{2})?\s*(?:a\.?m\.?|p\.?m\.?)|\b\d{1,2}:\d{2}\b`)
+	embargoZonePattern     = regexp.MustCompile(`\b(?:[ECMP][SD]?T|UTC|GMT|BST|CET|CEST|IST|JST|AEST|AEDT|UTC[+-]\d{1,2})\b`)
+	weekdayPrefixPattern   = regexp.MustCompile(`(?i)^(?:monday|tuesday|wednesday|thursday|friday|saturday|sunday),?\s+`)
+)
+
+// designationDateLayouts parse dates matched by releaseDatePatterns once
+// commas and weekdays are removed.
+var designationDateLayouts = []string{
+	"January 2 2006", "Jan 2 2006", "2 January 2006", "2 Jan 2006", "1/2/2006", "1-2-2006", "2006-1-2",
+}
+
+// DetectReleaseDesignation finds the release designation in the opening
+// lines of a press release.
+func DetectReleaseDesignation(content string) ReleaseDesignation {
+	var d ReleaseDesignation
+	seen := 0
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(strings.Trim(strings.TrimSpace(line), "#*_>"))
+		if line == "" {
+			continue
+		}
+		if seen++; seen > designationLines {
+			break
+		}
+
+		kind := ""
+		switch {
+		case embargoPattern.MatchString(line):
+			kind = ReleaseEmbargo
+		case immediateLoosePattern.MatchString(line):
+			kind = ReleaseImmediate
+		default:
+			continue
+		}
+		if d.Kind != "" {
+			d.Conflict = d.Conflict || d.Kind != kind
+			continue
+		}
+		d.Kind, d.Line = kind, line
+		if kind == ReleaseEmbargo {
+			d.Until = parseDesignationDate(line)
+		}
+	}
+	return d
+}
+
+// parseDesignationDate returns the first date in line, or the zero time.
+func parseDesignationDate(line string) time.Time {
+	date := firstMatch(releaseDatePatterns, line)
+	if date == "" {
+		return time.Time{}
+	}
+	date = weekdayPrefixPattern.ReplaceAllString(date, "")
+	date = strings.Join(strings.Fields(strings.ReplaceAll(date, ",", " ")), " ")
+	for _, layout := range designationDateLayouts {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// scoreReleaseDesignation checks the format of the release designation and
+// that an embargo has not already lifted. Each problem costs a point of the
+// Release Date score; a press release without a designation is not penalized.
+func scoreReleaseDesignation(content string) analysis {
+	a := analysis{category: "Release Date"}
+	d := DetectReleaseDesignation(content)
+
+	if d.Conflict {
+		a.award(-1, "conflicting release designations", d.Line)
+		a.issue("Both an embargo and an immediate-release line were found - keep one")
+	}
+
+	switch d.Kind {
+	case ReleaseImmediate:
+		if immediateStrictPattern.MatchString(d.Line) {
+			a.strength("Uses the standard FOR IMMEDIATE RELEASE designation")
+			return a
+		}
+		a.award(-1, "nonstandard immediate-release line", d.Line)
+		a.issue("Write the immediate-release line as \"FOR IMMEDIATE RELEASE\"")
+	case ReleaseEmbargo:
+		if d.Until.IsZero() || !embargoTimePattern.MatchString(d.Line) || !embargoZonePattern.MatchString(d.Line) {
+			a.award(-1, "incomplete embargo line", d.Line)
+			a.issue("Embargo line should give a date, time, and time zone (e.g., 'EMBARGOED UNTIL Aug 20, 2024, 9:00 a.m. ET')")
+		}
+		if today := truncateDay(timeNow()); !d.Until.IsZero() && d.Until.Before(today) {
+			a.award(-1, "embargo date has passed", d.Until.Format("Jan 2, 2006"))
+			a.issue("Embargo date has already passed - update it or switch to FOR IMMEDIATE RELEASE")
+		} else if !d.Until.IsZero() {
+			a.strength("Embargo date is in the future")
+		}
+	}
+	return a
+}
+
+// truncateDay returns midnight UTC of t's calendar day, matching how
+// designation dates are parsed.
+func truncateDay(t time.Time) time.Time {
+	y, m, day := t.Date()
+	return time.Date(y, m, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,93 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScoreReleaseDesignation(t *testing.T) {
+	old := timeNow
+	timeNow = func() time.Time { return time.Date(2026, time.March, 10, 15, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { timeNow = old })
+
+	tests := []struct {
+		name      string
+		content   string
+		wantKind  string
+		wantScore int
+		wantIssue string
+	}{
+		{"none", "SEATTLE, WA - Acme today launched CloudSync.", "", 0, ""},
+		{"immediate", "**FOR IMMEDIATE RELEASE**\n\nSEATTLE, WA - Acme today launched CloudSync.", ReleaseImmediate, 0, ""},
+		{"immediate lowercase", "For immediate release\n\nAcme today launched CloudSync.", ReleaseImmediate, -1, "FOR IMMEDIATE RELEASE\""},
+		{"embargo complete", "EMBARGOED UNTIL March 12, 2026, 9:00 a.m. ET\n\nAcme today launched CloudSync.", ReleaseEmbargo, 0, ""},
+		{"embargo today", "EMBARGOED UNTIL 2026-03-10 at 17:00 UTC\n\nAcme today launched CloudSync.", ReleaseEmbargo, 0, ""},
+		{"embargo without time", "EMBARGOED UNTIL March 12, 2026\n\nAcme today launched CloudSync.", ReleaseEmbargo, -1, "date, time, and time zone"},
+		{"embargo passed", "Embargoed until Monday, March 2, 2026, 9:00 a.m. PT\n\nAcme today launched CloudSync.", ReleaseEmbargo, -1, "already passed"},
+		{"conflict", "FOR IMMEDIATE RELEASE\nEMBARGOED UNTIL March 12, 2026, 9:00 a.m. ET\n\nAcme today launched CloudSync.", ReleaseImmediate, -1, "keep one"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if d := DetectReleaseDesignation(tt.content); d.Kind != tt.wantKind {
+				t.Errorf("Kind = %q, want %q", d.Kind, tt.wantKind)
+			}
+			a := scoreReleaseDesignation(tt.content)
+			if a.score != tt.wantScore {
+				t.Errorf("score = %d, want %d (issues %q)", a.score, tt.wantScore, a.issues)
+			}
+			issues := strings.Join(a.issues, "|")
+			if (tt.wantIssue == "") != (issues == "") || !strings.Contains(issues, tt.wantIssue) {
+				t.Errorf("issues = %q, want one containing %q", a.issues, tt.wantIssue)
+			}
+			for _, issue := range a.issues {
+				if ruleForMessage(issue).ID == generalRule.ID {
+					t.Errorf("issue %q is not in the rule catalog", issue)
+				}
+			}
+		})
+	}
+}
+
+func TestReleaseDesignation_Note(t *testing.T) {
+	old := timeNow
+	timeNow = func() time.Time { return time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { timeNow = old })
+
+	tests := []struct {
+		d    ReleaseDesignation
+		want string
+	}{
+		{ReleaseDesignation{}, ""},
+		{ReleaseDesignation{Kind: ReleaseImmediate}, "For immediate release"},
+		{ReleaseDesignation{Kind: ReleaseEmbargo}, "Embargoed (no readable date)"},
+		{ReleaseDesignation{Kind: ReleaseEmbargo, Until: time.Date(2026, time.March, 12, 0, 0, 0, 0, time.UTC)}, "Embargoed until March 12, 2026"},
+		{ReleaseDesignation{Kind: ReleaseEmbargo, Until: time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)}, "Embargoed until March 2, 2026 ⚠️ date has passed"},
+		{ReleaseDesignation{Kind: ReleaseImmediate, Conflict: true}, "For immediate release ⚠️ conflicting release designations"},
+	}
+	for _, tt := range tests {
+		if got := tt.d.Note(); got != tt.want {
+			t.Errorf("Note() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestScoreReleaseDate_DesignationDeduction(t *testing.T) {
+	a := scoreReleaseDate("For immediate release\n\nAug 20, 2024. Seattle, WA. Acme today launched CloudSync.")
+	if a.score != 4 {
+		t.Errorf("score = %d, want 5 for the date less 1 for the designation", a.score)
+	}
+
+	// Deductions never take the category below zero
+	a = scoreReleaseDate("For immediate release\n\nAcme launched CloudSync.")
+	if a.score != 0 {
+		t.Errorf("score = %d, want 0", a.score)
+	}
+	var sum int
+	for _, e := range a.trace {
+		sum += e.Delta
+	}
+	if sum != a.score {
+		t.Errorf("trace sums to %d, want the score %d", sum, a.score)
+	}
+}
@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrRead is returned when the document cannot be opened or read.
+	ErrRead = errors.New("failed to read document")
+	// ErrNoPressRelease is returned by Validate when no press release was found.
+	ErrNoPressRelease = errors.New("no press release section found")
+	// ErrSectionEmpty is returned by Validate for a section heading with no content.
+	// The wrapping error names the section.
+	ErrSectionEmpty = errors.New("section is empty")
+	// ErrFrontMatter is returned by Parse when the YAML front matter is malformed
+	// or names an unknown document type.
+	ErrFrontMatter = errors.New("invalid front matter")
+	// ErrManifest is returned by ReadFiles and ParseFiles when a manifest is
+	// malformed or lists no files.
+	ErrManifest = errors.New("invalid manifest")
+	// ErrInclude is returned when an include directive names a file that
+	// cannot be read, or includes form a cycle.
+	ErrInclude = errors.New("invalid include")
+	// ErrReview is returned when a review sidecar file is malformed or names
+	// an unknown review status.
+	ErrReview = errors.New("invalid review file")
+	// ErrDismissals is returned when a dismissals sidecar file is malformed
+	// or names an unknown status.
+	ErrDismissals = errors.New("invalid dismissals file")
+	// ErrWeights is returned by CheckWeights for a weight of an unknown
+	// category or out of range.
+	ErrWeights = errors.New("invalid category weights")
+	// ErrScoring is returned for an unknown scoring mode or a severity cost
+	// that is unknown or out of range.
+	ErrScoring = errors.New("invalid scoring settings")
+	// ErrCodenames is returned by CheckCodenames for a codename pattern that
+	// is not a regular expression.
+	ErrCodenames = errors.New("invalid codename pattern")
+)
+
+// Validate reports structural problems that make the scores meaningless:
+// a missing press release, or a press release or FAQ heading with nothing
+// under it. Multiple problems are joined; test them with errors.Is.
+func (s *SpecSections) Validate() error {
+	return s.ValidateSections(nil)
+}
+
+// ValidateSections is Validate restricted to the selected sections, so a
+// document being revised section by section is not rejected for the others.
+func (s *SpecSections) ValidateSections(selected SectionSet) error {
+	var errs []error
+	switch {
+	case !selected.Has("pr"), s.PressRelease != "":
+	case s.headings.pressRelease:
+		errs = append(errs, fmt.Errorf("%w: Press Release", ErrSectionEmpty))
+	default:
+		errs = append(errs, ErrNoPressRelease)
+	}
+
+	if selected.Has("faq") && s.headings.faqs && s.FAQs == "" {
+		errs = append(errs, fmt.Errorf("%w: FAQs", ErrSectionEmpty))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		wantErr []error
+	}{
+		{
+			name: "complete document",
+			doc:  "# Title\n\n## Press Release\n\nWe launched.\n\n## FAQ\n\nQ: Why?\nA: Because.\n",
+		},
+		{
+			name: "press release without FAQ",
+			doc:  "# Title\n\n## Press Release\n\nWe launched.\n",
+		},
+		{
+			name:    "no press release",
+			doc:     "# Title\n\n## FAQ\n\nQ: Why?\nA: Because.\n",
+			wantErr: []error{ErrNoPressRelease},
+		},
+		{
+			name:    "empty press release heading",
+			doc:     "# Title\n\n## Press Release\n\n## FAQ\n\nQ: Why?\nA: Because.\n",
+			wantErr: []error{ErrSectionEmpty},
+		},
+		{
+			name:    "empty FAQ heading",
+			doc:     "# Title\n\n## Press Release\n\nWe launched.\n\n## FAQ\n",
+			wantErr: []error{ErrSectionEmpty},
+		},
+		{
+			name:    "both problems",
+			doc:     "# Title\n\n## FAQ\n\n",
+			wantErr: []error{ErrNoPressRelease, ErrSectionEmpty},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sections, err := Parse(strings.NewReader(tt.doc))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			err = sections.Validate()
+			if len(tt.wantErr) == 0 && err != nil {
+				t.Fatalf("Validate() error = %v, want nil", err)
+			}
+			for _, want := range tt.wantErr {
+				if !errors.Is(err, want) {
+					t.Errorf("Validate() error = %v, want %v", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSections(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Title\n\n## FAQ\n\nQ: Why?\nA: Because.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	faqOnly, err := ParseSectionSet("faq")
+	if err != nil {
+		t.Fatalf("ParseSectionSet() error = %v", err)
+	}
+
+	if err := sections.ValidateSections(faqOnly); err != nil {
+		t.Errorf("ValidateSections(faq) error = %v, want nil without a press release", err)
+	}
+	if err := sections.ValidateSections(nil); !errors.Is(err, ErrNoPressRelease) {
+		t.Errorf("ValidateSections(nil) error = %v, want ErrNoPressRelease", err)
+	}
+}
+
+func TestParseSectionSet(t *testing.T) {
+	tests := []struct {
+		list    string
+		want    []string
+		wantErr bool
+	}{
+		{list: DefaultSections, want: []string{"pr", "faq"}},
+		{list: " FAQ, metrics ", want: []string{"faq", "metrics"}},
+		{list: "pr,press", wantErr: true},
+		{list: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.list, func(t *testing.T) {
+			set, err := ParseSectionSet(tt.list)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSectionSet(%q) error = %v, wantErr %v", tt.list, err, tt.wantErr)
+			}
+			if len(set) != len(tt.want) {
+				t.Errorf("ParseSectionSet(%q) = %v, want %v", tt.list, set, tt.want)
+			}
+			for _, key := range tt.want {
+				if !set.Has(key) {
+					t.Errorf("ParseSectionSet(%q) is missing %q", tt.list, key)
+				}
+			}
+		})
+	}
+}
+
+func TestParsePRFAQ_ErrRead(t *testing.T) {
+	_, err := ParsePRFAQ(filepath.Join(t.TempDir(), "missing.md"))
+	if !errors.Is(err, ErrRead) {
+		t.Errorf("ParsePRFAQ() error = %v, want ErrRead", err)
+	}
+}
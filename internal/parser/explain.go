@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScoreEvent is one point award or deduction made by a scoring rule.
+type ScoreEvent struct {
+	Category string // breakdown category, matching CategoryScore.Name
+	Rule     string // what the rule rewards or penalizes
+	Delta    int    // points added; negative for deductions
+	Detail   string // matched text or measured value, empty if none
+}
+
+// String formats the event as "+3 headline length optimal (62 chars, 9 words)".
+func (e ScoreEvent) String() string {
+	s := fmt.Sprintf("%+d %s", e.Delta, e.Rule)
+	if e.Detail != "" {
+		s += " (" + e.Detail + ")"
+	}
+	return s
+}
+
+// Explain renders the rule trace behind a score, grouped by category in
+// report order, so every category total can be traced to its rules.
+func Explain(prScore *PRScore) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Overall Score: %d/100\n", prScore.OverallScore)
+	switch {
+	case prScore.Severity != nil:
+		fmt.Fprintf(&b, "Scored by finding severity: %s; the categories below do not add up to it\n", prScore.Severity.Note())
+	case prScore.Weights != nil:
+		if note := weightNote(prScore.Weights); note != "" {
+			fmt.Fprintf(&b, "Category weights: %s\n", note)
+		}
+	case prScore.DocType.WeightNote() != "":
+		fmt.Fprintf(&b, "Weighted for %s documents: %s\n", prScore.DocType, prScore.DocType.WeightNote())
+	}
+
+	breakdown := prScore.QualityBreakdown
+	for _, category := range breakdown.Categories() {
+		fmt.Fprintf(&b, "\n%s: %d/%d\n", category.Name, category.Score, category.Max)
+		if category.Name == "Credibility" {
+			b.WriteString("  mirrors Tone & Readability; not added to the overall score\n")
+			continue
+		}
+
+		events := 0
+		for _, e := range breakdown.Trace {
+			if e.Category == category.Name {
+				b.WriteString("  " + e.String() + "\n")
+				events++
+			}
+		}
+		if events == 0 {
+			b.WriteString("  no points awarded\n")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScoreEvent_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		event ScoreEvent
+		want  string
+	}{
+		{
+			name:  "award with detail",
+			event: ScoreEvent{Rule: "headline length optimal", Delta: 3, Detail: "62 chars, 9 words"},
+			want:  "+3 headline length optimal (62 chars, 9 words)",
+		},
+		{
+			name:  "deduction without detail",
+			event: ScoreEvent{Rule: "no supporting data", Delta: -1},
+			want:  "-1 no supporting data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.event.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrace_SumsToCategoryScores(t *testing.T) {
+	files := []string{"example_prfaq_1.md", "example_prfaq_2.txt", "example_prfaq_3.md", "example_prfaq_4.md"}
+
+	for _, name := range files {
+		t.Run(name, func(t *testing.T) {
+			sections, err := ParsePRFAQ("../../testdata/" + name)
+			if err != nil {
+				t.Fatalf("ParsePRFAQ() error = %v", err)
+			}
+			breakdown := sections.PRScore.QualityBreakdown
+
+			sums := make(map[string]int)
+			for _, e := range breakdown.Trace {
+				sums[e.Category] += e.Delta
+			}
+			for _, c := range breakdown.Categories() {
+				if c.Name == "Credibility" {
+					continue // mirrors Tone & Readability
+				}
+				if sums[c.Name] != c.Score {
+					t.Errorf("%s: trace sums to %d, score is %d", c.Name, sums[c.Name], c.Score)
+				}
+			}
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	score := comprehensivePRAnalysis(`SEATTLE, WA - November 20, 2025 - Acme today announced Ledger Sync.
+
+It works.
+
+About Acme: founded in 2010.`, "Acme Launches Ledger Sync", 0, scoring{})
+
+	got := Explain(score)
+	for _, want := range []string{
+		"Headline Quality: 4/10\n  +2 strong action verb (launches)\n  +2 no generic marketing language\n",
+		"Release Date: 5/5\n  +5 release date in opening lines (November 20, 2025)\n",
+		"Credibility: ",
+		"Quote Quality: 0/15\n  no points awarded\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Explain() missing %q\n%s", want, got)
+		}
+	}
+}
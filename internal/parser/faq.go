@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// FAQPair is a question of the FAQ and its answer.
+type FAQPair struct {
+	Question string // without its markup and numbering
+	Answer   string // "" when the question has no answer yet
+}
+
+// faqAnswerLabel matches the label before an FAQ answer, e.g. "**A:** " or
+// "Answer: ".
+var faqAnswerLabel = regexp.MustCompile(`^[>*_\s]*(?:A|Answer)\s*[:.)][*_\s]*`)
+
+// FAQPairs returns the questions of the FAQ in order, each with the lines
+// up to the next question as its answer, without the "A:" label. Questions
+// are found like the required FAQ questions are: the lines that end with a
+// question mark.
+func (s *SpecSections) FAQPairs() []FAQPair {
+	var pairs []FAQPair
+	var answer []string
+	flush := func() {
+		if len(pairs) > 0 {
+			pairs[len(pairs)-1].Answer = strings.TrimSpace(strings.Join(answer, "\n"))
+		}
+		answer = nil
+	}
+	for _, line := range strings.Split(s.FAQs, "\n") {
+		line = strings.TrimSpace(line)
+		q := strings.TrimSpace(strings.TrimRight(faqQuestionPrefix.ReplaceAllString(line, ""), "*_ "))
+		if strings.HasSuffix(q, "?") {
+			flush()
+			pairs = append(pairs, FAQPair{Question: q})
+			continue
+		}
+		if len(pairs) == 0 {
+			continue
+		}
+		if len(answer) == 0 {
+			line = faqAnswerLabel.ReplaceAllString(line, "")
+			if line == "" {
+				continue
+			}
+		}
+		answer = append(answer, line)
+	}
+	flush()
+	return pairs
+}
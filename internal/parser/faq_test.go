@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFAQPairs(t *testing.T) {
+	tests := []struct {
+		name string
+		faqs string
+		want []FAQPair
+	}{
+		{
+			name: "bold Q and A lines",
+			faqs: "**Q: Is it free?**  \nA: Yes, under the MIT license.\n\n**Q: Does it upload documents?**\nA: No.\nEverything runs locally.",
+			want: []FAQPair{
+				{Question: "Is it free?", Answer: "Yes, under the MIT license."},
+				{Question: "Does it upload documents?", Answer: "No.\nEverything runs locally."},
+			},
+		},
+		{
+			name: "numbered headings",
+			faqs: "Intro text.\n\n## 1. Who is it for?\n\nSmall finance teams.\n\n## 2. What does it cost?\n",
+			want: []FAQPair{
+				{Question: "Who is it for?", Answer: "Small finance teams."},
+				{Question: "What does it cost?"},
+			},
+		},
+		{name: "no questions", faqs: "Nothing to ask."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &SpecSections{FAQs: tt.faqs}
+			if got := s.FAQPairs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FAQPairs() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
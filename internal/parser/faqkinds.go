@@ -0,0 +1,159 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Issue messages of the internal and external FAQ checks, followed by ": "
+// and the details.
+const (
+	faqMisplacedMessage = "Internal question in the external FAQ"
+	faqJargonMessage    = "Jargon in the external FAQ"
+)
+
+// faqKindRules is the rules version that checks internal and external FAQs
+// separately.
+var faqKindRules = RulesVersion{Major: 5, Minor: 7}
+
+// faqHeading is who an FAQ heading says the FAQ is for.
+type faqHeading int
+
+const (
+	faqUnlabeled faqHeading = iota // "FAQ": external beside an internal FAQ
+	faqExternal                    // "Customer FAQ", "External FAQ"
+	faqInternal                    // "Internal FAQ", "Stakeholder FAQ"
+)
+
+// Words in an FAQ heading that label the FAQ, matched by firstTerm.
+var (
+	internalFAQHeadings = []string{"internal", "stakeholder*", "team", "business", "leadership"}
+	externalFAQHeadings = []string{"external", "customer*", "public", "user*", "client*"}
+)
+
+// faqHeadingOf returns who an FAQ heading says the FAQ is for.
+func faqHeadingOf(header string) faqHeading {
+	header = strings.ToLower(header)
+	switch {
+	case firstTerm(header, internalFAQHeadings) != "":
+		return faqInternal
+	case firstTerm(header, externalFAQHeadings) != "":
+		return faqExternal
+	}
+	return faqUnlabeled
+}
+
+// kind indexes the FAQ text the heading's section adds to: 0 for the
+// external FAQ, 1 for the internal one.
+func (h faqHeading) kind() int {
+	if h == faqInternal {
+		return 1
+	}
+	return 0
+}
+
+// faqAlternatives is asked of internal FAQs only: reviewers want to know
+// the team weighed other options, customers do not.
+var faqAlternatives = faqTopic{"FAQ should say which alternatives were considered and why they were rejected",
+	[]string{"alternative*", "considered", "instead of", "build vs*", "build or buy", "options", "trade-off*", "tradeoff*"}, "What alternatives did we consider, and why did we reject them?"}
+
+// internalFAQTopics are the questions every internal FAQ must answer.
+var internalFAQTopics = []faqTopic{faqCost, faqRisks, faqAlternatives, faqSuccess, faqDependencies}
+
+// internalQuestionTerms mark a question as one for the team rather than for
+// customers, matched by firstTerm against the lowercased question.
+var internalQuestionTerms = []string{
+	"headcount", "staffing", "budget*", "cost to build", "cost us", "investment", "roi", "margin*", "opportunity cost",
+	"alternative*", "build vs*", "build or buy", "other teams", "what risks", "biggest risk*", "major risk*", "key risk*",
+	"mitigat*", "why now", "p&l", "business case",
+}
+
+// externalFAQTopics are the questions an external FAQ must answer: the
+// audience's, or pricing and availability when the audience has none for
+// customers.
+func externalFAQTopics(audience Audience) []faqTopic {
+	if topics := audience.profile().faqTopics; audience != AudienceInternal && len(topics) > 0 {
+		return topics
+	}
+	return []faqTopic{faqPricing, faqAvailability}
+}
+
+// separateFAQs reports whether the document has an internal FAQ or an
+// external FAQ labeled as one, which are then checked separately.
+func (s *SpecSections) separateFAQs() bool {
+	return s.InternalFAQs != "" || s.ExternalFAQs != ""
+}
+
+// scoreFAQChecklists checks the required FAQ questions: of the whole FAQ
+// for the audience, or, from rules/v5.7 in a document that separates them,
+// the internal FAQ against the internal checklist and the external FAQ
+// against the customer one. Findings cost no points.
+func scoreFAQChecklists(s *SpecSections) analysis {
+	if s.ScoringRules().less(faqKindRules) || !s.separateFAQs() {
+		return scoreFAQTopics(s.FAQs, s.Audience, s.TopicMatcher)
+	}
+
+	a := analysis{category: "Structure"}
+	check := func(faqs string, span LineSpan, topics []faqTopic, kind string) {
+		if faqs == "" {
+			return
+		}
+		missing := 0
+		for i, ok := range topicsAnswered(faqs, topics, s.TopicMatcher) {
+			if !ok {
+				missing++
+				issueNear(&a, span.Start, topics[i].message)
+			}
+		}
+		if missing == 0 {
+			a.strength(fmt.Sprintf("%s FAQ answers the questions its readers ask", kind))
+		}
+	}
+	check(s.InternalFAQs, s.Positions.InternalFAQs, internalFAQTopics, "Internal")
+	check(s.ExternalFAQs, s.Positions.ExternalFAQs, externalFAQTopics(s.Audience), "External")
+	return a
+}
+
+// scoreExternalFAQ holds an external FAQ to the customer's view: questions
+// about what the launch costs the company, its risks, or the alternatives
+// the team weighed belong in the internal FAQ, and jargon the audience does
+// not use is reported. The internal FAQ is exempt. Findings cost no points.
+func scoreExternalFAQ(s *SpecSections) analysis {
+	a := analysis{category: "Tone & Readability"}
+	if s.ExternalFAQs == "" {
+		return a
+	}
+	line := s.Positions.ExternalFAQs.Start
+
+	for _, q := range faqQuestions(s.ExternalFAQs) {
+		if term := firstTerm(strings.ToLower(q), internalQuestionTerms); term != "" {
+			issueNear(&a, line, fmt.Sprintf("%s: %q (%s) - move it to the internal FAQ", faqMisplacedMessage, q, term))
+		}
+	}
+
+	jargon := generalJargon
+	if s.Audience != AudienceInternal {
+		jargon = s.Audience.profile().jargon
+	}
+	text := strings.ToLower(s.ExternalFAQs)
+	var found []string
+	for _, term := range jargon {
+		if containsTerm(text, term) {
+			found = append(found, strings.TrimSuffix(term, "*"))
+		}
+	}
+	if len(found) > 0 {
+		issueNear(&a, line, fmt.Sprintf("%s: %s - write for customers", faqJargonMessage, strings.Join(found, ", ")))
+	}
+	return a
+}
+
+// issueNear records an issue at line, or at the rule's anchor when the line
+// is unknown, as it is for sections not parsed from a file.
+func issueNear(a *analysis, line int, msg string) {
+	if line > 0 {
+		a.issueAt(line, msg)
+		return
+	}
+	a.issue(msg)
+}
@@ -0,0 +1,108 @@
+package parser
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFAQHeadingOf(t *testing.T) {
+	tests := []struct {
+		header string
+		want   faqHeading
+	}{
+		{"FAQ", faqUnlabeled},
+		{"Frequently Asked Questions", faqUnlabeled},
+		{"Internal FAQ", faqInternal},
+		{"Stakeholder Questions", faqInternal},
+		{"Customer FAQ", faqExternal},
+		{"External FAQs", faqExternal},
+	}
+	for _, tt := range tests {
+		if got := faqHeadingOf(tt.header); got != tt.want {
+			t.Errorf("faqHeadingOf(%q) = %d, want %d", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestParse_SeparateFAQs(t *testing.T) {
+	doc := "# Launch\n\n## Press Release\n\nToday we launch.\n\n## FAQ\n\nQ: How much does it cost?\nA: $5 a month.\n\n## Internal FAQ\n\nQ: What are the risks?\nA: Adoption.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(sections.ExternalFAQs, "How much") || strings.Contains(sections.ExternalFAQs, "risks") {
+		t.Errorf("ExternalFAQs = %q, want the customer FAQ only", sections.ExternalFAQs)
+	}
+	if !strings.Contains(sections.InternalFAQs, "risks") || strings.Contains(sections.InternalFAQs, "How much") {
+		t.Errorf("InternalFAQs = %q, want the internal FAQ only", sections.InternalFAQs)
+	}
+	if !strings.Contains(sections.FAQs, "How much") || !strings.Contains(sections.FAQs, "risks") {
+		t.Errorf("FAQs = %q, want both", sections.FAQs)
+	}
+	if sections.Positions.ExternalFAQs.Start != 9 || sections.Positions.InternalFAQs.Start != 14 {
+		t.Errorf("positions = %+v", sections.Positions)
+	}
+
+	// A lone unlabeled FAQ answers everyone
+	sections, err = Parse(strings.NewReader("# Launch\n\n## Press Release\n\nToday.\n\n## FAQ\n\nQ: Is it free?\nA: Yes.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sections.ExternalFAQs != "" || sections.InternalFAQs != "" || sections.Positions.ExternalFAQs != (LineSpan{}) {
+		t.Errorf("lone FAQ split into %q and %q", sections.ExternalFAQs, sections.InternalFAQs)
+	}
+}
+
+func TestScoreFAQChecklists(t *testing.T) {
+	s := &SpecSections{
+		FAQs:         "both",
+		ExternalFAQs: "Q: How much does it cost?\nA: $5 a month.\n\nQ: When is it available?\nA: Today.",
+		InternalFAQs: "Q: What are the risks?\nA: Adoption; we mitigate with a pilot.",
+		Audience:     AudienceConsumer,
+		Positions:    SectionPositions{InternalFAQs: LineSpan{Start: 20, End: 22}},
+	}
+	a := scoreFAQChecklists(s)
+	for _, want := range []string{faqCost.message, faqAlternatives.message, faqSuccess.message, faqDependencies.message, faqPrivacy.message} {
+		if !slices.Contains(a.issues, want) {
+			t.Errorf("issues = %q, want %q", a.issues, want)
+		}
+	}
+	if slices.Contains(a.issues, faqRisks.message) || slices.Contains(a.issues, faqPricing.message) {
+		t.Errorf("issues = %q, want answered topics left out", a.issues)
+	}
+	if a.lines[0] != 20 {
+		t.Errorf("lines = %v, want internal findings at the internal FAQ", a.lines)
+	}
+
+	// Older rules check the whole FAQ for the audience
+	s.Rules = RulesVersion{Major: 5, Minor: 6}
+	s.FAQs = s.ExternalFAQs + "\n\n" + s.InternalFAQs
+	if a := scoreFAQChecklists(s); slices.Contains(a.issues, faqCost.message) {
+		t.Errorf("rules/v5.6 issues = %q, want the audience checklist", a.issues)
+	}
+}
+
+func TestScoreExternalFAQ(t *testing.T) {
+	s := &SpecSections{
+		ExternalFAQs: "Q: How much does it cost?\nA: $5 a month.\n\nQ: What is the headcount for this?\nA: Four engineers.\n\nQ: Does it sync?\nA: Yes, via our API and SDK.",
+		InternalFAQs: "Q: Why not use the SDK we have?\nA: Its API leaks.",
+		Audience:     AudienceConsumer,
+		Positions:    SectionPositions{ExternalFAQs: LineSpan{Start: 9, End: 18}},
+	}
+	a := scoreExternalFAQ(s)
+	want := []string{
+		faqMisplacedMessage + `: "What is the headcount for this?" (headcount) - move it to the internal FAQ`,
+		faqJargonMessage + ": api, sdk - write for customers",
+	}
+	if got := strings.Join(a.issues, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("issues = %q, want %q", a.issues, want)
+	}
+	if a.lines[0] != 9 || a.lines[1] != 9 {
+		t.Errorf("lines = %v, want the external FAQ", a.lines)
+	}
+
+	if a := scoreExternalFAQ(&SpecSections{InternalFAQs: s.InternalFAQs}); len(a.issues) != 0 {
+		t.Errorf("internal-only issues = %q, want none", a.issues)
+	}
+}
@@ -0,0 +1,591 @@
+package parser
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Severity ranks how much a finding matters.
+type Severity string
+
+const (
+	// SeverityError marks findings that make a press release unusable as-is.
+	SeverityError Severity = "error"
+	// SeverityWarning marks findings that noticeably weaken the document.
+	SeverityWarning Severity = "warning"
+	// SeverityInfo marks optional polish suggestions.
+	SeverityInfo Severity = "info"
+)
+
+// Severities lists the severities from most to least serious.
+var Severities = []Severity{SeverityError, SeverityWarning, SeverityInfo}
+
+// ParseSeverity parses a severity name.
+func ParseSeverity(s string) (Severity, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	names := make([]string, len(Severities))
+	for i, sev := range Severities {
+		if string(sev) == s {
+			return sev, nil
+		}
+		names[i] = string(sev)
+	}
+	return "", fmt.Errorf("unknown severity %q (want %s)", s, strings.Join(names, ", "))
+}
+
+// anchor describes where in the document a rule's findings are reported.
+type anchor int
+
+const (
+	anchorPressRelease anchor = iota // first line of the press release
+	anchorTitle                      // the title heading
+	anchorFAQ                        // first line of the FAQ
+)
+
+// Rule describes a single scoring check and the issue message it produces.
+type Rule struct {
+	ID          string
+	Category    string
+	Severity    Severity
+	Message     string
+	Explanation string
+	anchor      anchor
+}
+
+// Finding is an issue raised by a rule, tied to a source location.
+type Finding struct {
+	RuleID   string
+	Category string
+	Severity Severity
+	Message  string
+	Line     int // 1-based; 0 when the location is unknown
+	Column   int // 1-based
+	// File is the file Line is in, for a document assembled from several
+	// files; "" otherwise.
+	File string
+}
+
+// Rules is the catalog of every rule the analyzers can report, in scoring order.
+var Rules = append([]Rule{
+	{ID: "headline-missing", Category: "Headline Quality", Severity: SeverityError, anchor: anchorTitle,
+		Message:     "Missing headline/title",
+		Explanation: "Every PR-FAQ needs an H1 headline; it is the first (and often only) thing a reader sees."},
+	{ID: "headline-too-long", Category: "Headline Quality", Severity: SeverityWarning, anchor: anchorTitle,
+		Message:     "Headline too long (reduces scannability)",
+		Explanation: "Headlines over 100 characters or 15 words are hard to scan. Aim for 6-12 words and 50-80 characters."},
+	{ID: "headline-too-short", Category: "Headline Quality", Severity: SeverityWarning, anchor: anchorTitle,
+		Message:     "Headline too short (lacks specificity)",
+		Explanation: "Headlines under 30 characters or 4 words rarely say what is new. Aim for 6-12 words and 50-80 characters."},
+	{ID: "headline-weak-verb", Category: "Headline Quality", Severity: SeverityInfo, anchor: anchorTitle,
+		Message:     "Consider using stronger action verbs",
+		Explanation: "Headlines score higher with an action verb such as launches, announces, reduces, or improves."},
+	{ID: "headline-no-metrics", Category: "Headline Quality", Severity: SeverityInfo, anchor: anchorTitle,
+		Message:     "Consider adding specific metrics to the headline",
+		Explanation: "A number, percentage, or multiplier in the headline makes the outcome concrete."},
+	{ID: "headline-generic-language", Category: "Headline Quality", Severity: SeverityWarning, anchor: anchorTitle,
+		Message:     "Avoid generic marketing language in headlines",
+		Explanation: "Words like new, innovative, cutting-edge, or leading add no information and cost headline points."},
+	{ID: "hook-no-content", Category: "Newsworthy Hook", Severity: SeverityError,
+		Message:     "No content to analyze",
+		Explanation: "The press release section is empty, so no opening hook could be found."},
+	{ID: "hook-missing", Category: "Newsworthy Hook", Severity: SeverityError,
+		Message:     "Missing opening hook",
+		Explanation: "The first paragraph of the press release is the hook; it is blank."},
+	{ID: "hook-not-timely", Category: "Newsworthy Hook", Severity: SeverityWarning,
+		Message:     "Hook lacks immediate timeliness",
+		Explanation: "The opening should signal news: today, announces, launched, now available."},
+	{ID: "hook-no-metrics", Category: "Newsworthy Hook", Severity: SeverityWarning,
+		Message:     "Hook lacks specific metrics or outcomes",
+		Explanation: "The opening paragraph earns the most points when it states a measurable outcome (e.g. 40%, 3x)."},
+	{ID: "hook-no-problem", Category: "Newsworthy Hook", Severity: SeverityWarning,
+		Message:     "Hook doesn't clearly address a problem or need",
+		Explanation: "The opening should say what the launch solves, reduces, improves, or automates."},
+	{ID: "hook-unclear-actor", Category: "Newsworthy Hook", Severity: SeverityWarning,
+		Message:     "First sentence should clearly identify who is doing what",
+		Explanation: "The first sentence should name the company and the action: \"Acme, Inc. today announced ...\"."},
+	{ID: "hook-fluff", Category: "Newsworthy Hook", Severity: SeverityWarning,
+		Message:     "Hook contains marketing fluff - focus on concrete value",
+		Explanation: "Emotional or hyperbolic words (excited, thrilled, revolutionary) in the opening cost hook points."},
+	{ID: "release-date-missing", Category: "Release Date", Severity: SeverityError,
+		Message:     "Missing release date in opening lines",
+		Explanation: "A release date must appear in the first 200 characters of the press release."},
+	{ID: "release-date-dateline", Category: "Release Date", Severity: SeverityInfo,
+		Message:     "Add date and location (e.g., 'Aug 20, 2024. Seattle, WA.')",
+		Explanation: "Standard datelines open with the city, state, and date of the announcement."},
+	{ID: "release-dateline-unknown", Category: "Release Date", Severity: SeverityWarning,
+		Message:     "Dateline location is not a recognized state, province, or country - check its spelling",
+		Explanation: "The dateline region was not found in the bundled list of U.S. states, Canadian provinces, and countries."},
+	{ID: "release-dateline-state", Category: "Release Date", Severity: SeverityInfo,
+		Message:     "Use the two-letter postal code for the dateline state (e.g., 'SEATTLE, WA')",
+		Explanation: "Spelling the state out in one release and abbreviating it in the next reads as inconsistent; postal codes are the common wire format."},
+	{ID: "release-dateline-city", Category: "Release Date", Severity: SeverityInfo,
+		Message:     "Write the dateline city in capitals (e.g., 'SEATTLE, WA')",
+		Explanation: "Newswire datelines set the city in capitals so the location stands apart from the lead sentence."},
+	{ID: "release-designation-conflict", Category: "Release Date", Severity: SeverityError,
+		Message:     "Both an embargo and an immediate-release line were found - keep one",
+		Explanation: "A press release is either embargoed or for immediate release; both lines leave journalists guessing."},
+	{ID: "release-immediate-format", Category: "Release Date", Severity: SeverityWarning,
+		Message:     "Write the immediate-release line as \"FOR IMMEDIATE RELEASE\"",
+		Explanation: "Wire services expect the exact, capitalized phrase on its own line above the dateline."},
+	{ID: "release-embargo-format", Category: "Release Date", Severity: SeverityWarning,
+		Message:     "Embargo line should give a date, time, and time zone (e.g., 'EMBARGOED UNTIL Aug 20, 2024, 9:00 a.m. ET')",
+		Explanation: "An embargo without an exact time and time zone cannot be honored reliably."},
+	{ID: "release-embargo-past", Category: "Release Date", Severity: SeverityError,
+		Message:     "Embargo date has already passed - update it or switch to FOR IMMEDIATE RELEASE",
+		Explanation: "The embargo lifts on a date before today, so the designation is stale."},
+	{ID: "five-ws-who", Category: "5 Ws Coverage", Severity: SeverityWarning,
+		Message:     "WHO: Company/organization not clearly identified in lead",
+		Explanation: "The lead paragraphs should name the organization making the announcement."},
+	{ID: "five-ws-what", Category: "5 Ws Coverage", Severity: SeverityWarning,
+		Message:     "WHAT: Action or offering not clearly described",
+		Explanation: "The lead paragraphs should say what is being launched, introduced, or released."},
+	{ID: "five-ws-when", Category: "5 Ws Coverage", Severity: SeverityWarning,
+		Message:     "WHEN: Timing or date not specified",
+		Explanation: "The lead paragraphs should say when the announcement takes effect."},
+	{ID: "five-ws-where", Category: "5 Ws Coverage", Severity: SeverityInfo,
+		Message:     "WHERE: Location or market context could be clearer",
+		Explanation: "Mention a location (City, ST) or the market the launch targets."},
+	{ID: "five-ws-why", Category: "5 Ws Coverage", Severity: SeverityWarning,
+		Message:     "WHY: Reason or benefit not clearly explained",
+		Explanation: "The lead paragraphs should explain why this matters: what it enables or helps customers do."},
+	{ID: "launch-pricing-missing", Category: "5 Ws Coverage", Severity: SeverityError,
+		Message:     pricingMissingMessage,
+		Explanation: "Readers of a launch announcement decide on price first. State it (\"starting at $400 per month\"), or say the product is free or to contact sales. Checked for external documents."},
+	{ID: "launch-availability-missing", Category: "5 Ws Coverage", Severity: SeverityError,
+		Message:     availabilityMissingMessage,
+		Explanation: "A launch announcement should say when the product is available (\"available today\", \"starting March 4\"), where (\"in the United States and Canada\"), and how to get it (\"sign up at acme.com\"). Any one of them counts as a disclosure. Checked for external documents."},
+	{ID: "structure-too-short", Category: "Structure", Severity: SeverityWarning,
+		Message:     "Press release too short for proper structure analysis",
+		Explanation: "A press release needs at least three paragraphs: lead, supporting details, and boilerplate."},
+	{ID: "structure-lead-too-long", Category: "Structure", Severity: SeverityWarning,
+		Message:     "Lead paragraph too long - should be concise",
+		Explanation: "Lead paragraphs over 60 words bury the news. Aim for 25-50 words."},
+	{ID: "structure-lead-too-brief", Category: "Structure", Severity: SeverityWarning,
+		Message:     "Lead paragraph too brief - lacks key details",
+		Explanation: "Lead paragraphs under 20 words rarely cover the essentials. Aim for 25-50 words."},
+	{ID: "structure-no-supporting-details", Category: "Structure", Severity: SeverityWarning,
+		Message:     "Middle content lacks supporting details",
+		Explanation: "Middle paragraphs should add context, customer detail, or attribution (\"according to\")."},
+	{ID: "structure-no-boilerplate", Category: "Structure", Severity: SeverityWarning,
+		Message:     "Missing company boilerplate information",
+		Explanation: "The last paragraph should be an \"About <Company>\" boilerplate."},
+	{ID: "structure-no-transitions", Category: "Structure", Severity: SeverityInfo,
+		Message:     "Consider adding transitions between sections",
+		Explanation: "Longer releases read better with transitions such as additionally, however, or as a result."},
+	{ID: "doctype-missing-faq", Category: "Structure", Severity: SeverityWarning,
+		Message:     "Add a FAQ section - this document type requires one",
+		Explanation: "Internal and design PR-FAQs are reviewed through their FAQ; set with doc_type in the front matter or -doc-type."},
+	{ID: "doctype-missing-metrics", Category: "Structure", Severity: SeverityWarning,
+		Message:     "Add a Success Metrics section - this document type requires one",
+		Explanation: "Internal and design PR-FAQs must say how success will be measured; set with doc_type in the front matter or -doc-type."},
+	{ID: "faq-pricing", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqPricing.message,
+		Explanation: "Buyers decide on price; say what it costs, or that it is free. Checked with -audience consumer, enterprise, or developer, and in a separate external FAQ."},
+	{ID: "faq-availability", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqAvailability.message,
+		Explanation: "Consumers need to know when and where they can get it. Checked with -audience consumer, and in a separate external FAQ."},
+	{ID: "faq-privacy", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqPrivacy.message,
+		Explanation: "Consumers ask what is collected, where it is stored, and how to delete it. Checked with -audience consumer, and in a separate external FAQ."},
+	{ID: "faq-security", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqSecurity.message,
+		Explanation: "Enterprise buyers screen for security and compliance (SOC 2, GDPR, HIPAA) before anything else. Checked with -audience enterprise, and in a separate external FAQ."},
+	{ID: "faq-integration", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqIntegration.message,
+		Explanation: "Enterprise buyers need to know how it fits the systems they already run. Checked with -audience enterprise, and in a separate external FAQ."},
+	{ID: "faq-support", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqSupport.message,
+		Explanation: "Enterprise buyers expect support channels and service levels. Checked with -audience enterprise, and in a separate external FAQ."},
+	{ID: "faq-api", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqAPI.message,
+		Explanation: "Developers look for the API surface, SDKs, and languages first. Checked with -audience developer, and in a separate external FAQ."},
+	{ID: "faq-getting-started", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqGettingStarted.message,
+		Explanation: "Developers want to know how to install it and where the documentation is. Checked with -audience developer, and in a separate external FAQ."},
+	{ID: "faq-license", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqLicense.message,
+		Explanation: "Developers check the license or terms before adopting a tool. Checked with -audience developer, and in a separate external FAQ."},
+	{ID: "faq-cost", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqCost.message,
+		Explanation: "Internal proposals are judged on what they cost to build and run. Checked with -audience internal, and in a separate internal FAQ."},
+	{ID: "faq-risks", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqRisks.message,
+		Explanation: "Internal reviewers expect the biggest risks and their mitigations up front. Checked with -audience internal, and in a separate internal FAQ."},
+	{ID: "faq-success-metrics", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqSuccess.message,
+		Explanation: "Internal proposals should say how success will be measured. Checked with -audience internal, and in a separate internal FAQ."},
+	{ID: "faq-dependencies", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqDependencies.message,
+		Explanation: "Internal proposals should call out what they need from other teams. Checked with -audience internal, and in a separate internal FAQ."},
+	{ID: "faq-alternatives", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqAlternatives.message,
+		Explanation: "Reviewers want to see the options the team weighed, such as building, buying, or doing nothing, and why this one won. Checked in a separate internal FAQ."},
+	{ID: "faq-out-of-scope", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     scopeMissingMessage,
+		Explanation: "Saying what the team is not doing shows the trade-offs were made on purpose and stops reviewers assuming everything is in. Add a question such as \"What are we not doing?\" and list what is out of scope, and why. With -semantic, a question that asks the same in other words, such as \"Which features did we cut from v1?\", counts."},
+	{ID: "faq-out-of-scope-thin", Category: "Structure", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     scopeThinMessage,
+		Explanation: "The FAQ asks what is out of scope, but the answer is a placeholder, \"None\", or under eight words. Name what is excluded, such as platforms, customers, or features, and why it waits."},
+	{ID: "faq-external-internal-question", Category: "Tone & Readability", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqMisplacedMessage,
+		Explanation: "Questions about headcount, budget, margins, risks, or the alternatives the team weighed are for reviewers, not customers. Move them to the internal FAQ. Checked when the document has an internal FAQ or labels its external one."},
+	{ID: "faq-external-jargon", Category: "Tone & Readability", Severity: SeverityWarning, anchor: anchorFAQ,
+		Message:     faqJargonMessage,
+		Explanation: "The external FAQ is read by customers; say it in their words. The internal FAQ may use the team's vocabulary and is not checked."},
+	{ID: "tone-long-sentences", Category: "Tone & Readability", Severity: SeverityWarning,
+		Message:     "Sentences too long - break into shorter, clearer statements",
+		Explanation: "Average sentence length above 25 words hurts readability. Aim for 15-20. Limits vary with -audience."},
+	{ID: "tone-many-long-sentences", Category: "Tone & Readability", Severity: SeverityWarning,
+		Message:     "Too many overly long sentences - impacts readability",
+		Explanation: "More than a third of sentences exceed 25 words (22 for consumers, 28 for enterprise, 30 for internal readers)."},
+	{ID: "tone-passive-voice", Category: "Tone & Readability", Severity: SeverityWarning,
+		Message:     "Overuse of passive voice - use active voice for clarity",
+		Explanation: "Passive constructions (has been, will be, is being) appear in more than a quarter of sentences."},
+	{ID: "tone-jargon", Category: "Tone & Readability", Severity: SeverityWarning,
+		Message:     "Too much technical jargon - write for broader audience",
+		Explanation: "More than three jargon terms (synergies, paradigm, leverage, ...) were found. The list and limit vary with -audience."},
+	{ID: "tone-generic-quotes", Category: "Tone & Readability", Severity: SeverityWarning,
+		Message:     "Too many generic 'excited' quotes - add substantive insights",
+		Explanation: "At least half the quotes are emotional reactions rather than insight."},
+	{ID: "tone-impersonal", Category: "Tone & Readability", Severity: SeverityInfo,
+		Message:     msgToneImpersonal,
+		Explanation: "A conversational document talks to its reader. Checked with tone: conversational in the front matter, the config, or -tone."},
+	{ID: "tone-stiff", Category: "Tone & Readability", Severity: SeverityWarning,
+		Message:     msgToneStiff,
+		Explanation: "Phrases such as hereby, pursuant to, utilize, and in order to read as legalese. Checked with tone: conversational."},
+	{ID: "tone-vague", Category: "Tone & Readability", Severity: SeverityWarning,
+		Message:     msgToneVague,
+		Explanation: "No measurements (200 ms, 5 GB, 99.9%) were found. Checked with tone: technical in the front matter, the config, or -tone."},
+	{ID: "tone-marketing", Category: "Tone & Readability", Severity: SeverityWarning,
+		Message:     msgToneMarketing,
+		Explanation: "Words such as seamless, effortless, and blazing fast say nothing a reader can verify. Checked with tone: technical."},
+	{ID: "tone-hedging", Category: "Tone & Readability", Severity: SeverityWarning,
+		Message:     hedgingMessage,
+		Explanation: "Phrases such as we hope, we believe this may, and should be able to tell readers the company is unsure of its own product. Each hedge is reported at its line; one costs a Tone & Readability point, and more than two cost two. The severity can be set with hedging.severity in the config."},
+	{ID: "fluff-excessive-hype", Category: "Fluff Avoidance", Severity: SeverityError,
+		Message:     "Excessive hyperbolic language reduces credibility",
+		Explanation: "More than three hype words (revolutionary, groundbreaking, world-class, ...) were found."},
+	{ID: "fluff-promotional", Category: "Fluff Avoidance", Severity: SeverityWarning,
+		Message:     "Consider reducing promotional adjectives",
+		Explanation: "Two or three hype words were found; replace them with specifics."},
+	{ID: "fluff-emotional-quotes", Category: "Fluff Avoidance", Severity: SeverityWarning,
+		Message:     "Most quotes are generic emotional responses",
+		Explanation: "Over 70% of quotes express emotion (excited, thrilled, proud) rather than substance."},
+	{ID: "fluff-some-emotional-quotes", Category: "Fluff Avoidance", Severity: SeverityInfo,
+		Message:     "Some quotes lack substantive content",
+		Explanation: "Between 30% and 70% of quotes express emotion rather than substance."},
+	{ID: "fluff-vague-claims", Category: "Fluff Avoidance", Severity: SeverityWarning,
+		Message:     "Vague benefit claims need specific proof points",
+		Explanation: "Phrases like seamless integration or enhanced productivity need numbers behind them."},
+	{ID: "fluff-no-proof", Category: "Fluff Avoidance", Severity: SeverityWarning,
+		Message:     "Claims would be stronger with supporting data",
+		Explanation: "No percentages, multipliers, or cited evidence were found to back the claims."},
+	{ID: "quotes-too-many", Category: "Quote Quality", Severity: SeverityInfo,
+		Message:     "Consider reducing quotes - press releases work best with 3-4 focused customer testimonials",
+		Explanation: "More than four quotes dilute the customer evidence."},
+	{ID: "quotes-company-only", Category: "Quote Quality", Severity: SeverityWarning,
+		Message:     quoteCompanyMessage,
+		Explanation: "A quote from the company's own executives is not evidence that customers want the product. Without a customer, partner, or analyst quote, Quote Quality is capped at 10 of 15 points."},
+	{ID: "quotes-late", Category: "Quote Quality", Severity: SeverityInfo,
+		Message:     quoteLateMessage,
+		Explanation: "Reporters look for a quote early. After the lead and a paragraph of supporting detail, a quote in paragraph 2 or 3 gives the news a human voice; the message says where the first quote is."},
+	{ID: "quotes-crowded", Category: "Quote Quality", Severity: SeverityInfo,
+		Message:     quoteCrowdedMessage,
+		Explanation: "Two speakers in one paragraph blur who said what. Give each quote its own paragraph; a single quote broken by its attribution is fine."},
+	{ID: "quotes-heavy", Category: "Quote Quality", Severity: SeverityWarning,
+		Message:     quoteHeavyMessage,
+		Explanation: "When more than 40% of the press release is inside quotes, the facts are told through opinion. State the news directly and keep quotes for perspective."},
+	{ID: "customer-perspective", Category: "Customer Focus", Severity: SeverityWarning,
+		Message:     customerPerspectiveMessage,
+		Explanation: "A PR-FAQ works backwards from the customer, so the press release should talk about them (\"you\", \"customers\", \"finance teams\") more than about the company (\"we\", \"our\", its name). Rewrite company-centered sentences around what the customer can now do."},
+	{ID: "customer-few-benefits", Category: "Customer Focus", Severity: SeverityWarning,
+		Message:     customerBenefitMessage,
+		Explanation: "Sentences about what the product has (\"includes\", \"powered by\", \"supports\") are at least as many as sentences about what the customer gets (\"saves\", \"so you can\", \"without\"), or there are none of either. Follow each feature with the benefit it brings."},
+	{ID: "customer-feature-dump", Category: "Customer Focus", Severity: SeverityWarning,
+		Message:     featureDumpMessage,
+		Explanation: "A paragraph that lists what the product has, sentence after sentence, without saying what any of it does for the customer reads like a spec sheet. Rewrite each feature as a benefit: \"Ledger Sync includes bank feeds\" becomes \"Ledger Sync pulls in bank feeds, so controllers close the books without retyping statements.\""},
+	{ID: "problem-unquantified", Category: "Credibility", Severity: SeverityWarning,
+		Message:     problemUnquantifiedMessage,
+		Explanation: "Saying the problem is tedious or costly asks the reader to take it on faith. Quantify it in the lead or second paragraph, in the sentence that states it: \"Finance teams spend 12 hours a week matching bank feeds by hand.\""},
+	{ID: "legal-guarantee", Category: "Credibility", Severity: SeverityWarning,
+		Message:     legalGuaranteeMessage,
+		Explanation: "Absolute promises (\"guaranteed\", \"100% secure\", \"never fails\", \"risk-free\") can be read as warranties. Say what the product does and how well, with a figure. Reported as an error with -legal-strict."},
+	{ID: "legal-superiority", Category: "Credibility", Severity: SeverityWarning,
+		Message:     legalSuperiorityMessage,
+		Explanation: "Comparative claims (\"the best\", \"#1\", \"the only\", \"industry-leading\") need substantiation to stand up to a competitor's challenge. Give the figure or cite the benchmark, study, or survey in the same sentence. Reported as an error with -legal-strict."},
+	{ID: "legal-trademark", Category: "Credibility", Severity: SeverityWarning,
+		Message:     legalTrademarkMessage,
+		Explanation: "The first use of a registered trademark in the press release should carry ®. Marks are listed under compliance.registered_marks in the config. Reported as an error with -legal-strict."},
+	{ID: "legal-phrase", Category: "Credibility", Severity: SeverityWarning,
+		Message:     legalPhraseMessage,
+		Explanation: "The phrase is on the legal screening list in the config, compliance.legal_phrases, such as \"certified\" or \"HIPAA compliant\". Check it with legal review, or rephrase. Reported as an error with -legal-strict."},
+	{ID: "leakage-cost", Category: "Credibility", Severity: SeverityError,
+		Message:     leakCostMessage,
+		Explanation: "The press release and external FAQ are read by customers and competitors. What the launch costs the company to build or run and its margins belong in the internal FAQ."},
+	{ID: "leakage-codename", Category: "Credibility", Severity: SeverityError,
+		Message:     leakCodenameMessage,
+		Explanation: "Internal codenames, matched by the patterns under compliance.codename_patterns in the config, mean nothing to customers and reveal unannounced work. Use the product name customers will see."},
+	{ID: "leakage-strategy", Category: "Credibility", Severity: SeverityError,
+		Message:     leakStrategyMessage,
+		Explanation: "How the company means to undercut competitors, build a moat, or lock customers in is for reviewers, not customers. Say what customers get, and keep the strategy in the internal FAQ."},
+	{ID: "leakage-staffing", Category: "Credibility", Severity: SeverityError,
+		Message:     leakStaffingMessage,
+		Explanation: "Headcount, team size, and effort such as \"12 engineers\" or \"six engineer-months\" are internal details. Move them to the internal FAQ."},
+	{ID: "brand-name-variant", Category: "Tone & Readability", Severity: SeverityWarning,
+		Message:     brandMessage,
+		Explanation: "A product or company name should be spelled and cased one way throughout, as listed under compliance.brand_names in the config: \"PR-FAQ Validator\", not \"PRFAQ validator\". Variants in the press release also get a suggested rewrite."},
+	{ID: "forward-looking-no-safe-harbor", Category: "Credibility", Severity: SeverityError,
+		Message:     safeHarborMessage,
+		Explanation: "A public company's statements about plans and expectations (expects to, plans to, will launch, later this year) need cautionary language that actual results may differ, as the Private Securities Litigation Reform Act safe harbor requires. Checked with compliance.public_company in the config."},
+	{ID: "forward-looking-as-fact", Category: "Credibility", Severity: SeverityWarning,
+		Message:     futureAsFactMessage,
+		Explanation: "A sentence such as \"Ledger Sync supports payroll in Q3\" promises a future capability as if it shipped today. Say it will be available, move it to the FAQ, or leave it out."},
+	{ID: "claim-unsubstantiated", Category: "Credibility", Severity: SeverityWarning,
+		Message:     claimUnsupportedMessage,
+		Explanation: "Every figure in the press release should be explained in the FAQ, the success metrics, or an appendix: how it was measured, and for whom. The figure, or its number, was not found outside the press release. The markdown report maps each claim to where it is substantiated."},
+	{ID: "flow-missing-stage", Category: "Structure", Severity: SeverityWarning,
+		Message:     flowMissingMessage,
+		Explanation: "A press release tells a story: the customer's problem, the solution, evidence that it works, and how to get it. The narrative flow check (-flow) found no paragraph for the named stage."},
+	{ID: "flow-order", Category: "Structure", Severity: SeverityInfo,
+		Message:     flowOrderMessage,
+		Explanation: "The paragraph tells its stage of the story before the reader has what it builds on, such as pricing before the problem. Move it after the stage it depends on. Reported by the narrative flow check (-flow)."},
+	{ID: "flow-gap", Category: "Structure", Severity: SeverityInfo,
+		Message:     flowGapMessage,
+		Explanation: "The reader has to supply a step between the paragraph and the one before it, such as how the solution fixes the problem just described. Add the missing link. Reported by the narrative flow check (-flow)."},
+	{ID: "flow-non-sequitur", Category: "Structure", Severity: SeverityWarning,
+		Message:     flowNonSequiturMessage,
+		Explanation: "The paragraph does not follow from the one before it. Connect it to the story, move it, or leave it out. Reported by the narrative flow check (-flow)."},
+	{ID: "order-sections", Category: "Structure", Severity: SeverityWarning, anchor: anchorTitle,
+		Message:     orderSectionsMessage,
+		Explanation: "A PR-FAQ reads Press Release, External FAQ, Internal FAQ, then appendices, so reviewers meet the customer story before the internal detail. The message says which section to move."},
+	{ID: "order-quote-lead", Category: "Structure", Severity: SeverityWarning,
+		Message:     orderQuoteLeadMessage,
+		Explanation: "Inverted-pyramid releases open with the news: who, what, when, and why. Quotes support the story after the facts are on the table."},
+	{ID: "order-details-late", Category: "Structure", Severity: SeverityInfo,
+		Message:     orderDetailsLateMessage,
+		Explanation: "Readers stop early, so the supporting details belong right after the lead and the quotes after them, not the other way around."},
+	{ID: "order-boilerplate-not-last", Category: "Structure", Severity: SeverityWarning,
+		Message:     orderBoilerplateMessage,
+		Explanation: "The \"About <Company>\" boilerplate and media contact end a press release; news after them is easy to miss and confuses wire services."},
+	{ID: "heading-multiple-h1", Category: "Structure", Severity: SeverityWarning, anchor: anchorTitle,
+		Message:     headingMultipleH1Message,
+		Explanation: "The H1 is the document title. A second H1 starts what looks like a new document, and the sections under it may be assigned to the wrong part of the PR-FAQ."},
+	{ID: "heading-level-jump", Category: "Structure", Severity: SeverityWarning, anchor: anchorTitle,
+		Message:     headingJumpMessage,
+		Explanation: "Heading levels should go down one at a time (H1, H2, H3). A jump such as H1 to H4 breaks the outline that sections are detected from."},
+	{ID: "heading-duplicate", Category: "Structure", Severity: SeverityWarning, anchor: anchorTitle,
+		Message:     headingDuplicateMessage,
+		Explanation: "Two sections with the same title at the same level are ambiguous: only one of them is kept when sections are matched by name."},
+	{ID: "image-missing-file", Category: "Structure", Severity: SeverityWarning,
+		Message:     imageMissingFileMessage,
+		Explanation: "A local image that does not exist renders as a broken link. Check the path, which is relative to the document, and that the file is committed."},
+	{ID: "image-missing-alt", Category: "Structure", Severity: SeverityWarning,
+		Message:     imageMissingAltMessage,
+		Explanation: "Alt text is what screen readers announce and what plain-text copies keep. Say what the image shows, e.g. ![Checkout time fell from 40s to 8s](checkout.png)."},
+	{ID: "image-in-press-release", Category: "Structure", Severity: SeverityInfo,
+		Message:     imagePressReleaseMessage,
+		Explanation: "Press releases travel as plain text through wire services and email, which drop images. The release must make its point without the figure; keep diagrams in the FAQ or an appendix."},
+	{ID: "boilerplate-missing", Category: "Structure", Severity: SeverityInfo,
+		Message:     "No \"About <Company>\" boilerplate section found",
+		Explanation: "Close the press release with an \"About <Company>\" line followed by the standard company description."},
+	{ID: "boilerplate-no-founding", Category: "Structure", Severity: SeverityInfo,
+		Message:     "Boilerplate should state when the company was founded",
+		Explanation: "Standard boilerplate includes the founding year, e.g. \"Founded in 2019, ...\"."},
+	{ID: "boilerplate-no-headquarters", Category: "Structure", Severity: SeverityInfo,
+		Message:     "Boilerplate should state where the company is headquartered",
+		Explanation: "Standard boilerplate includes the headquarters, e.g. \"headquartered in Seattle\" or \"based in Seattle\"."},
+	{ID: "boilerplate-no-mission", Category: "Structure", Severity: SeverityInfo,
+		Message:     "Boilerplate should state the company's mission or what it does",
+		Explanation: "Say in one sentence what the company does or is committed to."},
+	{ID: "boilerplate-no-url", Category: "Structure", Severity: SeverityInfo,
+		Message:     "Boilerplate should end with the company website",
+		Explanation: "Journalists look for the website at the end of the boilerplate, e.g. \"Learn more at www.example.com.\""},
+	{ID: "boilerplate-no-media-contact", Category: "Structure", Severity: SeverityInfo,
+		Message:     "Add a media contact (name and email) after the boilerplate",
+		Explanation: "No \"Media Contact\" line or email address was found in the press release."},
+	{ID: "boilerplate-too-long", Category: "Structure", Severity: SeverityWarning,
+		Message:     fmt.Sprintf("Boilerplate too long - keep it under %d words", BoilerplateMaxWords),
+		Explanation: "Wire services and journalists expect a short, standard company description."},
+	{ID: "boilerplate-too-short", Category: "Structure", Severity: SeverityInfo,
+		Message:     fmt.Sprintf("Boilerplate too short - aim for %d-%d words", BoilerplateMinWords, BoilerplateMaxWords),
+		Explanation: "A one-line boilerplate rarely covers founding, headquarters, mission, and website."},
+}, antiPatternCatalog()...)
+
+// generalRule covers issue messages that are not in the catalog.
+var generalRule = Rule{ID: "general", Category: "General", Severity: SeverityWarning}
+
+var rulesByMessage = func() map[string]Rule {
+	m := make(map[string]Rule, len(Rules))
+	for _, rule := range Rules {
+		m[rule.Message] = rule
+	}
+	return m
+}()
+
+// LookupRule returns the catalog rule with the given ID.
+func LookupRule(id string) (Rule, bool) {
+	for _, rule := range Rules {
+		if rule.ID == id {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
+
+// ruleForMessage maps an analyzer issue message back to its catalog rule.
+// A message may add details after ": ", as in "Sections out of PR-FAQ order:
+// move ...".
+func ruleForMessage(message string) Rule {
+	if rule, ok := rulesByMessage[message]; ok {
+		return rule
+	}
+	if head, _, ok := strings.Cut(message, ": "); ok {
+		if rule, ok := rulesByMessage[head]; ok {
+			return rule
+		}
+	}
+	rule := generalRule
+	rule.Message = message
+	return rule
+}
+
+// Findings converts the scored issues into rule-tagged findings with source
+// positions, ordered by severity, then category in report order, then
+// position in the document, so the same input always lists them the same way.
+func (s *SpecSections) Findings() []Finding {
+	return s.findingsOf(s.PRScore)
+}
+
+// findingsOf is Findings for the issues of score.
+func (s *SpecSections) findingsOf(score *PRScore) []Finding {
+	all := s.locatedFindings(score)
+	if all == nil {
+		return nil
+	}
+	findings := make([]Finding, len(all))
+	for i, f := range all {
+		findings[i] = f.Finding
+	}
+	return findings
+}
+
+// locatedFinding is a finding with its line in the assembled document.
+type locatedFinding struct {
+	Finding
+	position int // line in the assembled document, which orders files too
+	// anchored is set for a finding about the whole document or a section,
+	// reported at a line such as the title's, rather than about its line.
+	anchored bool
+}
+
+// locatedFindings is findingsOf with each finding's position.
+func (s *SpecSections) locatedFindings(score *PRScore) []locatedFinding {
+	if score == nil {
+		return nil
+	}
+	return s.locate(score.QualityBreakdown.Issues, score.QualityBreakdown.IssueLines)
+}
+
+// locate is locatedFindings for issues, with the lines of those about one
+// line in lines.
+func (s *SpecSections) locate(issues []string, lines map[int]int) []locatedFinding {
+	all := make([]locatedFinding, 0, len(issues))
+	for i, issue := range issues {
+		rule := ruleForMessage(issue)
+		if rule.Message == hedgingMessage && s.HedgeSeverity != "" {
+			rule.Severity = s.HedgeSeverity
+		}
+		if s.Legal.Strict && slices.Contains(legalMessages, rule.Message) {
+			rule.Severity = SeverityError
+		}
+		line, ok := lines[i]
+		if !ok {
+			line = s.anchorLine(rule.anchor)
+		}
+		file, fileLine := s.Locate(line)
+		all = append(all, locatedFinding{Finding{
+			RuleID:   rule.ID,
+			Category: rule.Category,
+			Severity: rule.Severity,
+			Message:  issue,
+			Line:     fileLine,
+			Column:   1,
+			File:     file,
+		}, line, !ok})
+	}
+	slices.SortStableFunc(all, func(a, b locatedFinding) int {
+		return cmp.Or(
+			cmp.Compare(severityRank(a.Severity), severityRank(b.Severity)),
+			cmp.Compare(categoryRank(a.Category), categoryRank(b.Category)),
+			cmp.Compare(a.Category, b.Category),
+			cmp.Compare(a.position, b.position),
+		)
+	})
+	return all
+}
+
+// severityRank orders severities from most to least serious.
+func severityRank(sev Severity) int {
+	if i := slices.Index(Severities, sev); i >= 0 {
+		return i
+	}
+	return len(Severities)
+}
+
+// categoryRank orders categories as the quality breakdown lists them, with
+// categories outside it, such as General, after.
+func categoryRank(category string) int {
+	categories := AllCategories()
+	for i, c := range categories {
+		if c.Name == category {
+			return i
+		}
+	}
+	return len(categories)
+}
+
+// anchorLine resolves an anchor to a source line, falling back to the title or line 1.
+func (s *SpecSections) anchorLine(a anchor) int {
+	var line int
+	switch a {
+	case anchorTitle:
+		line = s.Positions.Title
+	case anchorPressRelease:
+		line = s.Positions.PressRelease.Start
+	case anchorFAQ:
+		line = s.Positions.FAQs.Start
+	}
+	if line == 0 {
+		line = s.Positions.Title
+	}
+	if line == 0 {
+		line = 1
+	}
+	return line
+}
+
+// SectionAt returns the name of the key section ("Press Release" or "FAQs")
+// containing the given 1-based line, or "" if the line is outside both.
+func (s *SpecSections) SectionAt(line int) string {
+	switch {
+	case s.Positions.PressRelease.contains(line):
+		return "Press Release"
+	case s.Positions.FAQs.contains(line):
+		return "FAQs"
+	}
+	return ""
+}
+
+func (span LineSpan) contains(line int) bool {
+	return span.Start > 0 && line >= span.Start && line <= span.End
+}
+
+// FormatRuleHelp renders a rule as a short markdown explanation.
+func FormatRuleHelp(rule Rule) string {
+	var b strings.Builder
+	b.WriteString("**" + rule.ID + "** (" + string(rule.Severity) + ", " + rule.Category + ")\n\n")
+	b.WriteString(rule.Explanation)
+	return b.String()
+}
@@ -0,0 +1,221 @@
+package parser
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+const findingsDoc = `# Short
+
+## Press Release
+
+We made a thing.
+
+It is revolutionary, groundbreaking, world-class and cutting-edge.
+
+About us.
+
+## FAQ
+
+Q: What?
+A: A thing.
+`
+
+func TestRulesCatalog(t *testing.T) {
+	ids := make(map[string]bool)
+	messages := make(map[string]bool)
+	for _, rule := range Rules {
+		if rule.ID == "" || rule.Message == "" || rule.Explanation == "" || rule.Category == "" {
+			t.Errorf("rule %+v has empty fields", rule)
+		}
+		if ids[rule.ID] {
+			t.Errorf("duplicate rule ID %q", rule.ID)
+		}
+		if messages[rule.Message] {
+			t.Errorf("duplicate rule message %q", rule.Message)
+		}
+		ids[rule.ID] = true
+		messages[rule.Message] = true
+	}
+}
+
+func TestParse_Positions(t *testing.T) {
+	sections, err := Parse(strings.NewReader(findingsDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if sections.Positions.Title != 1 {
+		t.Errorf("Title line = %d, want 1", sections.Positions.Title)
+	}
+	if want := (LineSpan{Start: 5, End: 9}); sections.Positions.PressRelease != want {
+		t.Errorf("PressRelease span = %+v, want %+v", sections.Positions.PressRelease, want)
+	}
+	if want := (LineSpan{Start: 13, End: 14}); sections.Positions.FAQs != want {
+		t.Errorf("FAQs span = %+v, want %+v", sections.Positions.FAQs, want)
+	}
+}
+
+func TestFindings(t *testing.T) {
+	sections, err := Parse(strings.NewReader(findingsDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	findings := sections.Findings()
+	if len(findings) != len(sections.PRScore.QualityBreakdown.Issues) {
+		t.Fatalf("got %d findings for %d issues", len(findings), len(sections.PRScore.QualityBreakdown.Issues))
+	}
+
+	byRule := make(map[string]Finding)
+	for _, f := range findings {
+		byRule[f.RuleID] = f
+	}
+
+	headline, ok := byRule["headline-too-short"]
+	if !ok {
+		t.Fatal("expected headline-too-short finding")
+	}
+	if headline.Line != 1 || headline.Severity != SeverityWarning {
+		t.Errorf("headline finding = %+v, want line 1 warning", headline)
+	}
+
+	hype, ok := byRule["fluff-excessive-hype"]
+	if !ok {
+		t.Fatal("expected fluff-excessive-hype finding")
+	}
+	if hype.Line != 5 || hype.Severity != SeverityError {
+		t.Errorf("hype finding = %+v, want line 5 error", hype)
+	}
+}
+
+func TestFindings_Order(t *testing.T) {
+	for _, name := range []string{"example_prfaq_1.md", "example_prfaq_2.txt", "example_prfaq_3.md"} {
+		sections, err := ParsePRFAQ("../../testdata/" + name)
+		if err != nil {
+			t.Fatalf("ParsePRFAQ(%s) error = %v", name, err)
+		}
+		findings := sections.Findings()
+		for i := 1; i < len(findings); i++ {
+			prev, f := findings[i-1], findings[i]
+			switch {
+			case severityRank(prev.Severity) != severityRank(f.Severity):
+				if severityRank(prev.Severity) > severityRank(f.Severity) {
+					t.Errorf("%s: %s finding %q before %s %q", name, prev.Severity, prev.Message, f.Severity, f.Message)
+				}
+			case prev.Category != f.Category:
+				if categoryRank(prev.Category) > categoryRank(f.Category) {
+					t.Errorf("%s: %s finding %q before %s %q", name, prev.Category, prev.Message, f.Category, f.Message)
+				}
+			case prev.Line > f.Line:
+				t.Errorf("%s: line %d finding %q before line %d %q", name, prev.Line, prev.Message, f.Line, f.Message)
+			}
+		}
+		if again := sections.Findings(); !slices.Equal(findings, again) {
+			t.Errorf("%s: Findings() order differs between calls", name)
+		}
+	}
+}
+
+func TestFindings_NoScore(t *testing.T) {
+	sections := &SpecSections{}
+	if got := sections.Findings(); got != nil {
+		t.Errorf("Findings() = %v, want nil", got)
+	}
+}
+
+func TestRuleForMessage_Unknown(t *testing.T) {
+	rule := ruleForMessage("something new")
+	if rule.ID != "general" || rule.Message != "something new" {
+		t.Errorf("ruleForMessage() = %+v", rule)
+	}
+}
+
+func TestRuleForMessage_Detail(t *testing.T) {
+	rule := ruleForMessage(orderSectionsMessage + `: move "FAQ" (External FAQ) before "Appendix" (Appendix)`)
+	if rule.ID != "order-sections" {
+		t.Errorf("ruleForMessage() = %+v, want order-sections", rule)
+	}
+	if rule := ruleForMessage("WHO: nobody"); rule.ID != "general" {
+		t.Errorf("ruleForMessage() = %+v, want general for an unknown prefix", rule)
+	}
+}
+
+func TestSectionAt(t *testing.T) {
+	sections, err := Parse(strings.NewReader(findingsDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	tests := []struct {
+		line int
+		want string
+	}{
+		{1, ""},
+		{5, "Press Release"},
+		{9, "Press Release"},
+		{13, "FAQs"},
+		{20, ""},
+	}
+	for _, tt := range tests {
+		if got := sections.SectionAt(tt.line); got != tt.want {
+			t.Errorf("SectionAt(%d) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestLookupRuleAndHelp(t *testing.T) {
+	rule, ok := LookupRule("five-ws-why")
+	if !ok {
+		t.Fatal("expected five-ws-why rule")
+	}
+	help := FormatRuleHelp(rule)
+	if !strings.Contains(help, "five-ws-why") || !strings.Contains(help, rule.Explanation) {
+		t.Errorf("FormatRuleHelp() = %q", help)
+	}
+	if _, ok := LookupRule("nope"); ok {
+		t.Error("LookupRule(nope) should fail")
+	}
+}
+
+func TestCategories(t *testing.T) {
+	b := PRQualityBreakdown{HeadlineScore: 7, QuoteScore: 12}
+	categories := b.Categories()
+
+	total := 0
+	for _, c := range categories {
+		total += c.Max
+	}
+	if total != 100 {
+		t.Errorf("category maxima sum to %d, want 100", total)
+	}
+	if categories[0].Name != "Headline Quality" || categories[0].Score != 7 {
+		t.Errorf("first category = %+v", categories[0])
+	}
+	if last := categories[len(categories)-1]; last.Name != "Quote Quality" || last.Score != 12 {
+		t.Errorf("last category = %+v", last)
+	}
+
+	all := AllCategories()
+	if len(all) != len(categories)+1 || all[len(all)-1].Name != "Customer Focus" {
+		t.Errorf("AllCategories() = %+v, want the breakdown's and Customer Focus", all)
+	}
+	if got := (&SpecSections{Rules: RulesVersion{Major: 4}}).Categories(); len(got) != len(categories) {
+		t.Errorf("Categories() under rules/v4 = %+v, want no Customer Focus", got)
+	}
+	if got := (&SpecSections{}).Categories(); len(got) != len(all) {
+		t.Errorf("Categories() under the current rules = %+v, want %d", got, len(all))
+	}
+
+	// Every rule category except the catch-all must be a breakdown category
+	names := make(map[string]bool)
+	for _, c := range all {
+		names[c.Name] = true
+	}
+	for _, rule := range Rules {
+		if !names[rule.Category] {
+			t.Errorf("rule %s has unknown category %q", rule.ID, rule.Category)
+		}
+	}
+}
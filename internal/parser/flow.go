@@ -0,0 +1,103 @@
+package parser
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+)
+
+// Issue messages of the narrative flow check, followed by ": " and the
+// details.
+const (
+	flowMissingMessage     = "Story stage missing"
+	flowOrderMessage       = "Story stage out of order"
+	flowGapMessage         = "Narrative gap"
+	flowNonSequiturMessage = "Non sequitur"
+)
+
+// FlowStages are the stages of a press release's story, in the order a
+// reader should meet them.
+var FlowStages = []string{"problem", "solution", "evidence", "availability"}
+
+// FlowKind is the kind of break in a press release's story.
+type FlowKind string
+
+// Kinds of flow issue.
+const (
+	// FlowMissing is a story stage the press release never reaches.
+	FlowMissing FlowKind = "missing"
+	// FlowOrder is a paragraph that tells a stage before the one it needs,
+	// such as availability before the problem.
+	FlowOrder FlowKind = "order"
+	// FlowGap is a step the reader must supply between a paragraph and the
+	// one before it.
+	FlowGap FlowKind = "gap"
+	// FlowNonSequitur is a paragraph that does not follow from the one
+	// before it.
+	FlowNonSequitur FlowKind = "non-sequitur"
+)
+
+// FlowKinds lists the kinds of flow issue.
+var FlowKinds = []FlowKind{FlowMissing, FlowOrder, FlowGap, FlowNonSequitur}
+
+// flowMessages are the issue messages of each kind of flow issue.
+var flowMessages = map[FlowKind]string{
+	FlowMissing:     flowMissingMessage,
+	FlowOrder:       flowOrderMessage,
+	FlowGap:         flowGapMessage,
+	FlowNonSequitur: flowNonSequiturMessage,
+}
+
+// FlowIssue is a break in the story a press release tells.
+type FlowIssue struct {
+	Kind FlowKind
+	// Paragraph is the 1-based press release paragraph the break is at;
+	// 0 for a missing stage, which is about the whole press release.
+	Paragraph int
+	Stage     string // one of FlowStages, for FlowMissing and FlowOrder
+	Detail    string // what is wrong, in a sentence
+}
+
+// FlowChecker judges whether press release paragraphs tell a coherent story,
+// from problem to solution to evidence to availability, e.g. by asking an
+// AI model. See package flow.
+type FlowChecker interface {
+	CheckFlow(paragraphs []string) ([]FlowIssue, error)
+}
+
+// scoreFlow reports the breaks the flow checker finds in the press release
+// story, each at its paragraph. Findings cost no points. When the checker
+// fails, the check is skipped with a warning.
+func scoreFlow(s *SpecSections) analysis {
+	a := analysis{category: "Structure"}
+	paragraphs := pressReleaseParagraphs(s)
+	if s.FlowChecker == nil || len(paragraphs) == 0 {
+		return a
+	}
+	texts := make([]string, len(paragraphs))
+	for i, p := range paragraphs {
+		texts[i] = p.Text
+	}
+	issues, err := s.FlowChecker.CheckFlow(texts)
+	if err != nil {
+		slog.Warn("narrative flow check failed, skipping it", "error", err)
+		return a
+	}
+	for _, issue := range issues {
+		message, ok := flowMessages[issue.Kind]
+		if !ok || issue.Paragraph < 0 || issue.Paragraph > len(paragraphs) {
+			continue
+		}
+		if slices.Contains(FlowStages, issue.Stage) {
+			message = fmt.Sprintf("%s: %s - %s", message, issue.Stage, issue.Detail)
+		} else {
+			message = fmt.Sprintf("%s: %s", message, issue.Detail)
+		}
+		if issue.Paragraph == 0 {
+			a.issue(message)
+			continue
+		}
+		a.issueAt(paragraphs[issue.Paragraph-1].Span.Start, message)
+	}
+	return a
+}
@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// flowFunc adapts a function to FlowChecker.
+type flowFunc func(paragraphs []string) ([]FlowIssue, error)
+
+func (f flowFunc) CheckFlow(paragraphs []string) ([]FlowIssue, error) { return f(paragraphs) }
+
+func TestScoreFlow(t *testing.T) {
+	doc := "# Acme Launches Ledger Sync\n\n## Press Release\n\n" +
+		"Acme today launched Ledger Sync, which reconciles bank feeds overnight.\n\n" +
+		"Acme was founded in 2009.\n\n" +
+		"Ledger Sync is available today for $49 a month.\n"
+	issues := []FlowIssue{
+		{Kind: FlowMissing, Stage: "evidence", Detail: "nothing shows that it works"},
+		{Kind: FlowNonSequitur, Paragraph: 2, Detail: "the founding year does not follow from the launch"},
+		{Kind: FlowGap, Paragraph: 9, Detail: "out of range"},
+		{Kind: "tangent", Paragraph: 1, Detail: "unknown kind"},
+	}
+	tests := []struct {
+		name    string
+		checker FlowChecker
+		want    []string // issue messages
+		lines   map[int]int
+	}{
+		{"no checker", nil, nil, nil},
+		{"issues", flowFunc(func(paragraphs []string) ([]FlowIssue, error) {
+			if len(paragraphs) != 3 || !strings.HasPrefix(paragraphs[1], "Acme was founded") {
+				t.Errorf("paragraphs = %q", paragraphs)
+			}
+			return issues, nil
+		}), []string{
+			"Story stage missing: evidence - nothing shows that it works",
+			"Non sequitur: the founding year does not follow from the launch",
+		}, map[int]int{1: 7}},
+		{"checker fails", flowFunc(func([]string) ([]FlowIssue, error) {
+			return nil, errors.New("no network")
+		}), nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sections, err := Parse(strings.NewReader(doc))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			sections.FlowChecker = tt.checker
+			a := scoreFlow(sections)
+			if strings.Join(a.issues, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("issues = %q, want %q", a.issues, tt.want)
+			}
+			for i, line := range tt.lines {
+				if a.lines[i] != line {
+					t.Errorf("line of issue %d = %d, want %d", i, a.lines[i], line)
+				}
+			}
+		})
+	}
+}
+
+func TestScore_FlowFindings(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today launched Ledger Sync.\n\nAcme was founded in 2009.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	sections.FlowChecker = flowFunc(func([]string) ([]FlowIssue, error) {
+		return []FlowIssue{{Kind: FlowNonSequitur, Paragraph: 2, Detail: "unrelated"}}, nil
+	})
+	sections.PRScore = Score(sections)
+	for _, f := range sections.Findings() {
+		if f.RuleID == "flow-non-sequitur" {
+			if f.Line != 7 {
+				t.Errorf("flow-non-sequitur at line %d, want 7", f.Line)
+			}
+			return
+		}
+	}
+	t.Error("no flow-non-sequitur finding")
+}
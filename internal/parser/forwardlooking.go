@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Issue messages of the forward-looking statement checks, followed by ": "
+// and the details.
+const (
+	safeHarborMessage   = "Forward-looking statements need a safe-harbor statement"
+	futureAsFactMessage = "Future capability stated as present fact"
+)
+
+// forwardLookingRules is the first rules version with the forward-looking
+// statement checks.
+var forwardLookingRules = RulesVersion{Major: 4, Minor: 1}
+
+var (
+	// forwardLookingPattern matches statements about the company's plans
+	// and expectations.
+	forwardLookingPattern = regexp.MustCompile(`(?i)\b(?:expects? to|(?:is|are) expected to|plans? to|intends? to|anticipates?|aims? to|going to|(?:is|are) scheduled to|will (?:add|support|expand|introduce|launch|release|roll out|become available|offer|include)|coming soon|later this year|next (?:year|quarter|release)|in the coming (?:weeks|months|quarters|year)|roadmap)\b`)
+	// futureTimePattern matches a time after launch, such as "in Q3" or
+	// "later this year".
+	futureTimePattern = regexp.MustCompile(`(?i)\b(?:later this (?:year|quarter|month)|next (?:year|quarter|month|release)|in the coming (?:weeks|months|quarters|year)|(?:in|by) (?:Q[1-4]|H[12])\b|by the end of (?:the|this) (?:year|quarter)|coming soon)`)
+	// futureVerbPattern matches the verbs that put a sentence in the future.
+	futureVerbPattern = regexp.MustCompile(`(?i)\b(?:will|shall|plans?|expects?|expected|intends?|anticipates?|scheduled|going to|coming|upcoming|aims?)\b`)
+	// presentCapabilityPattern matches a present-tense claim of what the
+	// product does.
+	presentCapabilityPattern = regexp.MustCompile(`(?i)\b(?:supports|includes|offers|provides|integrates|connects|works|lets|enables|handles|delivers|is available|are available|is live|now)\b`)
+	// safeHarborPattern matches cautionary language for forward-looking
+	// statements.
+	safeHarborPattern = regexp.MustCompile(`(?i)\b(?:forward-looking statements?|safe harbor|private securities litigation reform act|actual results (?:may|could|might) differ)\b`)
+)
+
+// scoreForwardLooking flags future capabilities described in the present
+// tense, as in "Ledger Sync supports payroll in Q3", and, for a public
+// company, forward-looking statements without a safe-harbor statement
+// anywhere in the document. Findings cost no points.
+func scoreForwardLooking(s *SpecSections) analysis {
+	a := analysis{category: "Credibility"}
+	var statements []string
+	firstLine := 0
+	for _, p := range pressReleaseParagraphs(s) {
+		for _, sentence := range p.Sentences {
+			if m := forwardLookingPattern.FindString(sentence.Text); m != "" {
+				statements = append(statements, m)
+				if firstLine == 0 {
+					firstLine = sentence.Position.Line
+				}
+			}
+			when := futureTimePattern.FindString(sentence.Text)
+			if when != "" && presentCapabilityPattern.MatchString(sentence.Text) && !futureVerbPattern.MatchString(sentence.Text) {
+				a.issueAt(sentence.Position.Line, fmt.Sprintf("%s: %q is after launch; say it will be available then, or leave it out", futureAsFactMessage, when))
+			}
+		}
+	}
+
+	if !s.PublicCompany || len(statements) == 0 || hasSafeHarbor(s) {
+		return a
+	}
+	a.issueAt(firstLine, fmt.Sprintf("%s: %d found, starting with %q; add a cautionary note that actual results may differ", safeHarborMessage, len(statements), statements[0]))
+	return a
+}
+
+// hasSafeHarbor reports whether any paragraph of the document carries
+// safe-harbor language.
+func hasSafeHarbor(s *SpecSections) bool {
+	if s.Tree == nil {
+		return safeHarborPattern.MatchString(s.PressRelease)
+	}
+	for _, p := range treeParagraphs(s.Tree) {
+		if safeHarborPattern.MatchString(p.Text) {
+			return true
+		}
+	}
+	return false
+}
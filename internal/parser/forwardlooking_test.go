@@ -0,0 +1,69 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScoreForwardLooking(t *testing.T) {
+	lead := "Acme today launched Ledger Sync, which reconciles bank feeds overnight.\n\n"
+	tests := []struct {
+		name     string
+		pr       string
+		public   bool
+		want     []string // issue message heads
+		wantLine int      // line of the first issue
+	}{
+		{"present fact", lead, true, nil, 0},
+		{"future as fact", lead + "Ledger Sync supports payroll in Q3.\n\n", false, []string{futureAsFactMessage}, 7},
+		{"future said as future", lead + "Ledger Sync will support payroll in Q3.\n\n", false, nil, 0},
+		{"private company", lead + "Acme expects to add payroll next year.\n\n", false, nil, 0},
+		{"public company", lead + "Acme expects to add payroll next year.\n\n", true, []string{safeHarborMessage}, 7},
+		{"public company with safe harbor", lead + "Acme expects to add payroll next year.\n\n" +
+			"This release contains forward-looking statements. Actual results may differ materially.\n\n", true, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\n" + tt.pr + "## FAQ\n\nQ: Why?\nA: Speed.\n"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			sections.PublicCompany = tt.public
+			a := scoreForwardLooking(sections)
+			if len(a.issues) != len(tt.want) {
+				t.Fatalf("issues = %q, want %q", a.issues, tt.want)
+			}
+			for i, issue := range a.issues {
+				if !strings.HasPrefix(issue, tt.want[i]+": ") {
+					t.Errorf("issue = %q, want %q", issue, tt.want[i])
+				}
+			}
+			if len(a.issues) > 0 && a.lines[0] != tt.wantLine {
+				t.Errorf("line = %d, want %d", a.lines[0], tt.wantLine)
+			}
+		})
+	}
+}
+
+func TestScore_ForwardLookingRules(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today launched Ledger Sync. It supports payroll in Q3.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	found := func() bool {
+		for _, f := range sections.Findings() {
+			if f.RuleID == "forward-looking-as-fact" {
+				return true
+			}
+		}
+		return false
+	}
+	if !found() {
+		t.Errorf("no forward-looking-as-fact finding under %s", CurrentRules)
+	}
+	sections.Rules = RulesVersion{Major: 4}
+	sections.PRScore = Score(sections)
+	if found() {
+		t.Errorf("forward-looking-as-fact finding under %s", sections.Rules)
+	}
+}
@@ -0,0 +1,67 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// maxGlossaryTerms caps the terms GlossaryTerms returns, so a long document
+// does not turn into a dictionary.
+const maxGlossaryTerms = 20
+
+var (
+	// announcedProductPattern captures the name after an announcement verb,
+	// e.g. "Ledger Sync" in "today announced Ledger Sync, a service".
+	announcedProductPattern = regexp.MustCompile(`\b(?:[Aa]nnounce[sd]?|[Ll]aunch(?:es|ed)?|[Ii]ntroduce[sd]?|[Uu]nveil(?:s|ed)?|[Rr]elease[sd]?)\s+((?:[A-Z][\w.+-]*)(?:\s+[A-Z0-9][\w.+-]*){0,3})`)
+	// camelCasePattern matches product names such as "CloudSync" or "QuickBooks".
+	camelCasePattern = regexp.MustCompile(`\b[A-Z][a-z]+[A-Z][A-Za-z]*\b`)
+	// acronymPattern matches abbreviations with at least two capitals, such
+	// as "SOC", "SLA", "EC2", or "SDKs".
+	acronymPattern = regexp.MustCompile(`\b[A-Z][A-Z0-9&]*[A-Z][A-Z0-9&]*s?\b`)
+	// romanNumeralPattern matches numerals such as the "II" of "SOC 2 Type II".
+	romanNumeralPattern = regexp.MustCompile(`^[IVX]+$`)
+)
+
+// commonAcronyms are abbreviations every reader of a PR-FAQ knows.
+var commonAcronyms = map[string]bool{
+	"FAQ": true, "FAQs": true, "PR": true, "CEO": true, "CTO": true, "CFO": true, "COO": true, "VP": true,
+	"US": true, "USA": true, "UK": true, "EU": true, "AM": true, "PM": true, "TODO": true, "TBD": true, "OK": true,
+}
+
+// GlossaryTerms returns the terms a reviewer new to the domain may need
+// defined: the announced product names, other product-style names, and
+// acronyms, in that order, at most maxGlossaryTerms. Common abbreviations
+// and the dateline location are left out.
+func GlossaryTerms(s *SpecSections) []string {
+	text := s.PressRelease + "\n\n" + s.FAQs + "\n\n" + s.Metrics
+	skip := map[string]bool{}
+	for _, w := range strings.FieldsFunc(s.Dateline.Normalized, func(r rune) bool { return r == ',' || r == ' ' }) {
+		skip[w] = true
+	}
+
+	var terms []string
+	add := func(term string) {
+		term = strings.TrimRight(term, ".-")
+		if term != "" && !skip[term] && len(terms) < maxGlossaryTerms {
+			skip[term] = true
+			terms = append(terms, term)
+		}
+	}
+
+	products := announcedProductPattern.FindAllStringSubmatch(text, -1)
+	if len(products) == 0 {
+		products = announcedProductPattern.FindAllStringSubmatch(s.Title, 1)
+	}
+	for _, m := range products {
+		add(m[1])
+	}
+	for _, m := range camelCasePattern.FindAllString(text, -1) {
+		add(m)
+	}
+	for _, m := range acronymPattern.FindAllString(text, -1) {
+		if len(m) <= 6 && !commonAcronyms[m] && !romanNumeralPattern.MatchString(m) {
+			add(m)
+		}
+	}
+	return terms
+}
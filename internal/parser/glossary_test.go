@@ -0,0 +1,31 @@
+package parser
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGlossaryTerms(t *testing.T) {
+	doc := "# Acme Launches CloudSync Pro\n\n## Press Release\n\n" +
+		"**SEATTLE, WA - March 3, 2026** - Acme today announced CloudSync Pro, which syncs QuickBooks and NetSuite ledgers over SFTP.\n\n" +
+		"The CEO said it meets SOC 2 Type II and every SLA in the FAQ.\n\n" +
+		"## FAQ\n\nQ: Does it support SFTP and EC2?\nA: Yes, in the US and EU.\n"
+	s, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	want := []string{"CloudSync Pro", "CloudSync", "QuickBooks", "NetSuite", "SFTP", "SOC", "SLA", "EC2"}
+	if got := GlossaryTerms(s); !slices.Equal(got, want) {
+		t.Errorf("GlossaryTerms() = %q, want %q", got, want)
+	}
+
+	// Without an announcement in the body, the headline names the product
+	s, err = Parse(strings.NewReader("# Acme Launches Ledger Sync, Cutting Close Time\n\n## Press Release\n\nFinance teams close faster.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := GlossaryTerms(s); !slices.Equal(got, []string{"Ledger Sync"}) {
+		t.Errorf("GlossaryTerms() from the headline = %q", got)
+	}
+}
@@ -0,0 +1,74 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Issue messages of the heading checks, each followed by ": " and the
+// heading at fault.
+const (
+	headingMultipleH1Message = "More than one H1 heading"
+	headingJumpMessage       = "Heading level skipped"
+	headingDuplicateMessage  = "Duplicate section title"
+)
+
+// outlineHeading is a heading of the document outline.
+type outlineHeading struct {
+	text  string
+	level int
+	line  int
+}
+
+// scoreHeadings lints the heading hierarchy: a single H1, levels that go down
+// one at a time, and no repeated titles at the same level. Each finding is
+// reported on the heading's line and costs no points.
+func scoreHeadings(tree *DocumentTree) analysis {
+	a := analysis{category: "Structure"}
+	if tree == nil {
+		return a
+	}
+
+	seen := map[string]int{} // level and lowercased title to first line
+	h1, prev := 0, 0
+	for _, h := range headings(tree) {
+		if h.level == 1 {
+			if h1++; h1 > 1 {
+				a.issueAt(h.line, fmt.Sprintf("%s: %q - keep one H1 for the title and make this H2", headingMultipleH1Message, h.text))
+			}
+		}
+		if prev > 0 && h.level > prev+1 {
+			a.issueAt(h.line, fmt.Sprintf("%s: %q goes from H%d to H%d - make it H%d", headingJumpMessage, h.text, prev, h.level, prev+1))
+		}
+		prev = h.level
+
+		key := fmt.Sprintf("%d %s", h.level, strings.ToLower(h.text))
+		if first, ok := seen[key]; ok {
+			a.issueAt(h.line, fmt.Sprintf("%s: %q repeats the H%d on line %d - rename or merge them", headingDuplicateMessage, h.text, h.level, first))
+			continue
+		}
+		seen[key] = h.line
+	}
+	return a
+}
+
+// headings lists every heading of the tree, including the title, in source order.
+func headings(tree *DocumentTree) []outlineHeading {
+	var out []outlineHeading
+	var walk func([]*Section)
+	walk = func(sections []*Section) {
+		for _, s := range sections {
+			out = append(out, outlineHeading{text: s.Heading, level: s.Level, line: s.Line})
+			walk(s.Subsections)
+		}
+	}
+	walk(tree.Sections)
+
+	// The title is a section only when it is also a section name such as "Press Release"
+	if tree.TitleLine > 0 && !isCommonHeader(tree.Title) {
+		out = append(out, outlineHeading{text: tree.Title, level: 1, line: tree.TitleLine})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].line < out[j].line })
+	return out
+}
@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScoreHeadings(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		want  []string
+		lines []int
+	}{
+		{
+			name: "clean hierarchy",
+			doc:  "# Title\n\n## Press Release\n\n### Details\n\n## FAQ\n\n### Pricing\n",
+		},
+		{
+			name:  "level jump",
+			doc:   "# Title\n\n#### Pricing\n\n##### Tiers\n",
+			want:  []string{headingJumpMessage + `: "Pricing" goes from H1 to H4 - make it H2`},
+			lines: []int{3},
+		},
+		{
+			name:  "second H1",
+			doc:   "# Title\n\n## Press Release\n\n# FAQ\n",
+			want:  []string{headingMultipleH1Message + `: "FAQ" - keep one H1 for the title and make this H2`},
+			lines: []int{5},
+		},
+		{
+			name:  "duplicate title",
+			doc:   "# Title\n\n## FAQ\n\n### Pricing\n\n## faq\n\n### Support\n",
+			want:  []string{headingDuplicateMessage + `: "faq" repeats the H2 on line 3 - rename or merge them`},
+			lines: []int{7},
+		},
+		{
+			name: "same title at another level",
+			doc:  "# Pricing\n\n## Pricing\n",
+		},
+		{
+			name: "title that is also a section",
+			doc:  "# Press Release\n\nNews.\n\n## FAQ\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := scoreHeadings(buildTree(strings.Split(tt.doc, "\n")))
+			if strings.Join(a.issues, "\n") != strings.Join(tt.want, "\n") {
+				t.Fatalf("issues = %q, want %q", a.issues, tt.want)
+			}
+			for i, line := range tt.lines {
+				if a.lines[i] != line {
+					t.Errorf("issue %d on line %d, want %d", i, a.lines[i], line)
+				}
+			}
+		})
+	}
+}
+
+func TestFindings_IssueLines(t *testing.T) {
+	doc := "# Acme Launches Ledger Sync\n\n## Press Release\n\nSEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync.\n\n#### Pricing\n\nFree.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	for _, f := range sections.Findings() {
+		if f.RuleID == "heading-level-jump" {
+			if f.Line != 7 {
+				t.Errorf("heading-level-jump on line %d, want 7", f.Line)
+			}
+			return
+		}
+	}
+	t.Error("Findings() has no heading-level-jump finding")
+}
@@ -0,0 +1,33 @@
+package parser
+
+import "sort"
+
+// HeadlineMax is the most points a headline can score.
+const HeadlineMax = 10
+
+// HeadlineCandidate is a headline scored by the Headline Quality rules.
+type HeadlineCandidate struct {
+	Headline string
+	Score    int      // 0-HeadlineMax
+	Issues   []string // what cost it points
+	Current  bool     // the document's own headline
+}
+
+// RankHeadlines scores the current headline and the alternatives and orders
+// them best first. Ties keep the current headline ahead, then the order given.
+func RankHeadlines(current string, alternatives []string) []HeadlineCandidate {
+	candidates := make([]HeadlineCandidate, 0, len(alternatives)+1)
+	add := func(headline string, isCurrent bool) {
+		score, issues, _ := analyzeHeadlineQuality(headline)
+		candidates = append(candidates, HeadlineCandidate{Headline: headline, Score: score, Issues: issues, Current: isCurrent})
+	}
+
+	add(current, true)
+	for _, h := range alternatives {
+		if h != current {
+			add(h, false)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Score > candidates[j].Score })
+	return candidates
+}
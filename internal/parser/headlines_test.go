@@ -0,0 +1,37 @@
+package parser
+
+import "testing"
+
+func TestRankHeadlines(t *testing.T) {
+	current := "New Product"
+	ranked := RankHeadlines(current, []string{
+		"Acme Launches CloudSync, Cutting Backup Time by 80% for Small Teams",
+		current,
+		"Acme Announces a Robust Backup Service",
+	})
+
+	if len(ranked) != 3 {
+		t.Fatalf("got %d candidates, want the current headline and 2 alternatives", len(ranked))
+	}
+	if ranked[0].Headline != "Acme Launches CloudSync, Cutting Backup Time by 80% for Small Teams" || ranked[0].Score != HeadlineMax {
+		t.Errorf("best = %+v, want the specific headline with full marks", ranked[0])
+	}
+	for i := 1; i < len(ranked); i++ {
+		if ranked[i].Score > ranked[i-1].Score {
+			t.Errorf("candidates not ordered by score: %+v", ranked)
+		}
+	}
+
+	var currents int
+	for _, c := range ranked {
+		if c.Current {
+			currents++
+			if c.Headline != current || len(c.Issues) == 0 {
+				t.Errorf("current = %+v, want %q with issues", c, current)
+			}
+		}
+	}
+	if currents != 1 {
+		t.Errorf("got %d current headlines, want 1", currents)
+	}
+}
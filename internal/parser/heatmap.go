@@ -0,0 +1,83 @@
+package parser
+
+import "fmt"
+
+// Heat levels of a paragraph, indexes into HeatLevels.
+const (
+	HeatNone = iota
+	HeatLow
+	HeatMedium
+	HeatHigh
+)
+
+// HeatLevels names the heat levels from coolest to hottest.
+var HeatLevels = []string{"none", "low", "medium", "high"}
+
+// heatWeights weigh findings by severity in a paragraph's heat.
+var heatWeights = map[Severity]int{SeverityError: 3, SeverityWarning: 2, SeverityInfo: 1}
+
+// ParagraphHeat is a press release paragraph and the findings reported at
+// its lines.
+type ParagraphHeat struct {
+	Span     LineSpan
+	Text     string
+	Findings []Finding
+	Heat     int // findings weighted by severity: errors 3, warnings 2, info 1
+}
+
+// Level returns the paragraph's heat level: HeatNone without findings,
+// HeatLow for a heat up to 2, such as one warning, HeatMedium up to 5, and
+// HeatHigh above.
+func (p ParagraphHeat) Level() int {
+	switch {
+	case p.Heat == 0:
+		return HeatNone
+	case p.Heat <= 2:
+		return HeatLow
+	case p.Heat <= 5:
+		return HeatMedium
+	default:
+		return HeatHigh
+	}
+}
+
+// Label describes the paragraph's lines, heat level, and finding count, as
+// in "Lines 7-8 · medium · 2 findings".
+func (p ParagraphHeat) Label() string {
+	lines := fmt.Sprintf("Line %d", p.Span.Start)
+	if p.Span.End > p.Span.Start {
+		lines = fmt.Sprintf("Lines %d-%d", p.Span.Start, p.Span.End)
+	}
+	findings := "1 finding"
+	if len(p.Findings) != 1 {
+		findings = fmt.Sprintf("%d findings", len(p.Findings))
+	}
+	return fmt.Sprintf("%s · %s · %s", lines, HeatLevels[p.Level()], findings)
+}
+
+// Heatmap returns the press release paragraphs, in order, each with the
+// findings reported at its lines. Findings about the whole document or a
+// section, such as a missing dateline, belong to no paragraph.
+func (s *SpecSections) Heatmap() []ParagraphHeat {
+	paragraphs := pressReleaseParagraphs(s)
+	if len(paragraphs) == 0 {
+		return nil
+	}
+	heat := make([]ParagraphHeat, len(paragraphs))
+	for i, p := range paragraphs {
+		heat[i] = ParagraphHeat{Span: p.Span, Text: p.Text}
+	}
+	for _, f := range s.locatedFindings(s.PRScore) {
+		if f.anchored {
+			continue
+		}
+		for i := range heat {
+			if f.position >= heat[i].Span.Start && f.position <= heat[i].Span.End {
+				heat[i].Findings = append(heat[i].Findings, f.Finding)
+				heat[i].Heat += heatWeights[f.Severity]
+				break
+			}
+		}
+	}
+	return heat
+}
@@ -0,0 +1,69 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParagraphHeat_Level(t *testing.T) {
+	tests := []struct {
+		heat int
+		want int
+	}{
+		{0, HeatNone},
+		{1, HeatLow},
+		{2, HeatLow},
+		{3, HeatMedium},
+		{5, HeatMedium},
+		{6, HeatHigh},
+	}
+	for _, tt := range tests {
+		if got := (ParagraphHeat{Heat: tt.heat}).Level(); got != tt.want {
+			t.Errorf("Level() for heat %d = %s, want %s", tt.heat, HeatLevels[got], HeatLevels[tt.want])
+		}
+	}
+}
+
+func TestSpecSections_Heatmap(t *testing.T) {
+	doc := "# Acme Launches Ledger Sync\n\n## Press Release\n\n" +
+		"SEATTLE, March 3, 2025 — Acme today launched Ledger Sync, which reconciles bank feeds overnight for finance teams. It is free and available today.\n\n" +
+		"Ledger Sync may possibly help somewhat.\n\n" +
+		"Ledger Sync supports payroll in Q3.\n\n" +
+		"## FAQ\n\nQ: Why?\nA: Speed.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	heatmap := sections.Heatmap()
+	want := []struct {
+		start int
+		rules []string
+	}{
+		{5, []string{"antipattern-solution-without-problem"}},
+		{7, []string{"tone-hedging"}},
+		{9, []string{"antipattern-roadmap-leakage", "forward-looking-as-fact"}},
+	}
+	if len(heatmap) != len(want) {
+		t.Fatalf("Heatmap() has %d paragraphs, want %d", len(heatmap), len(want))
+	}
+	for i, w := range want {
+		p := heatmap[i]
+		if p.Span.Start != w.start {
+			t.Errorf("paragraph %d starts at line %d, want %d", i, p.Span.Start, w.start)
+		}
+		var rules []string
+		for _, f := range p.Findings {
+			rules = append(rules, f.RuleID)
+		}
+		if strings.Join(rules, ",") != strings.Join(w.rules, ",") {
+			t.Errorf("paragraph %d findings = %v, want %v", i, rules, w.rules)
+		}
+	}
+	if got := heatmap[2].Label(); got != "Line 9 · medium · 2 findings" {
+		t.Errorf("paragraph 2 Label() = %q", got)
+	}
+
+	if got := (&SpecSections{}).Heatmap(); got != nil {
+		t.Errorf("Heatmap() without a press release = %v, want nil", got)
+	}
+}
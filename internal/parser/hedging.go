@@ -0,0 +1,49 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// hedgingMessage is the issue message of a hedge, followed by ": " and the
+// hedging phrase.
+const hedgingMessage = "Hedging language undermines confidence"
+
+// hedgingRules is the first rules version that deducts Tone & Readability
+// points for hedging.
+var hedgingRules = RulesVersion{Major: 4}
+
+// maxHedges is the most hedges a press release may have before it loses a
+// second Tone & Readability point.
+const maxHedges = 2
+
+// hedgePattern matches phrases that hedge a claim, as in "we hope" or "we
+// believe this may". A bare "may" or "could" is left alone: "available in
+// May" and "customers could already export" are not hedges.
+var hedgePattern = regexp.MustCompile(`(?i)\b(?:(?:we|i) (?:hope|think|feel)|hopefully|we believe (?:\w+ ){0,2}(?:may|might|could)|(?:should|may|might) be able to|(?:may|might|could) (?:potentially|possibly|help)|potentially|possibly|perhaps|arguably|somewhat|to some (?:extent|degree)|in theory)\b`)
+
+// scoreHedgingTone deducts a Tone & Readability point when the press release
+// hedges, and a second when it hedges more than maxHedges times.
+func scoreHedgingTone(content string, a *analysis) {
+	hedges := hedgePattern.FindAllString(content, -1)
+	switch {
+	case len(hedges) > maxHedges:
+		a.award(-2, "hedging language", fmt.Sprintf("%d hedges", len(hedges)))
+	case len(hedges) > 0:
+		a.award(-1, "hedging language", fmt.Sprintf("%q", hedges[0]))
+	}
+}
+
+// scoreHedging reports every press release sentence that hedges, at its
+// line. The points are deducted by scoreHedgingTone.
+func scoreHedging(s *SpecSections) analysis {
+	a := analysis{category: "Tone & Readability"}
+	for _, p := range pressReleaseParagraphs(s) {
+		for _, sentence := range p.Sentences {
+			if m := hedgePattern.FindString(sentence.Text); m != "" {
+				a.issueAt(sentence.Position.Line, fmt.Sprintf("%s: %q - state the claim, or the evidence for it", hedgingMessage, m))
+			}
+		}
+	}
+	return a
+}
@@ -0,0 +1,79 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHedgePattern(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"We hope customers will love it.", "We hope"},
+		{"We believe this may cut costs.", "We believe this may"},
+		{"Teams should be able to close in a day.", "should be able to"},
+		{"It could potentially save hours.", "could potentially"},
+		{"Ledger Sync may help finance teams.", "may help"},
+		{"Hopefully it ships soon.", "Hopefully"},
+		{"Ledger Sync is available in May.", ""},
+		{"Customers could already export to CSV.", ""},
+		{"We believe in customers.", ""},
+	}
+	for _, tt := range tests {
+		if got := hedgePattern.FindString(tt.text); got != tt.want {
+			t.Errorf("hedgePattern.FindString(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestScore_Hedging(t *testing.T) {
+	doc := "# Acme Launches Ledger Sync\n\n## Press Release\n\n" +
+		"Acme today launched Ledger Sync, which reconciles bank feeds overnight.\n\n" +
+		"We hope finance teams will close faster. They should be able to skip manual matching.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	var lines []int
+	for _, f := range sections.Findings() {
+		if f.RuleID == "tone-hedging" {
+			if f.Severity != SeverityWarning {
+				t.Errorf("severity = %s, want warning", f.Severity)
+			}
+			lines = append(lines, f.Line)
+		}
+	}
+	if len(lines) != 2 || lines[0] != 7 || lines[1] != 7 {
+		t.Errorf("hedging findings at lines %v, want 7 and 7", lines)
+	}
+
+	sections.HedgeSeverity = SeverityInfo
+	for _, f := range sections.Findings() {
+		if f.RuleID == "tone-hedging" && f.Severity != SeverityInfo {
+			t.Errorf("severity = %s with HedgeSeverity info", f.Severity)
+		}
+	}
+
+	current := sections.PRScore.QualityBreakdown.ToneScore
+	sections.Rules = supportedRules[3]
+	pinned := Score(sections)
+	if current != pinned.QualityBreakdown.ToneScore-1 {
+		t.Errorf("Tone & Readability = %d, want one less than %d under %s", current, pinned.QualityBreakdown.ToneScore, sections.Rules)
+	}
+	for _, issue := range pinned.QualityBreakdown.Issues {
+		if strings.HasPrefix(issue, hedgingMessage) {
+			t.Errorf("%s reports %q", sections.Rules, issue)
+		}
+	}
+}
+
+func TestParseSeverity(t *testing.T) {
+	if got, err := ParseSeverity(" Info "); err != nil || got != SeverityInfo {
+		t.Errorf("ParseSeverity(Info) = %q, %v", got, err)
+	}
+	if _, err := ParseSeverity("critical"); err == nil {
+		t.Error("ParseSeverity(critical) succeeded")
+	}
+}
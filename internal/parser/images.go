@@ -0,0 +1,158 @@
+package parser
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Issue messages of the image checks, each followed by ": " and the image or
+// figure reference at fault.
+const (
+	imageMissingFileMessage  = "Image file not found"
+	imageMissingAltMessage   = "Image has no alt text"
+	imagePressReleaseMessage = "Press release relies on a figure"
+)
+
+// imageRules is the first rules version with the image checks, so
+// documents pinned to rules/v1 report the same findings as before.
+var imageRules = RulesVersion{Major: 2, Minor: 1}
+
+// Image is an image embedded with markdown or an HTML <img> tag.
+type Image struct {
+	Src  string // path or URL as written
+	Alt  string
+	Line int // 1-based
+}
+
+var (
+	// markdownImagePattern captures the alt text and source of ![alt](src "title").
+	markdownImagePattern = regexp.MustCompile(`!\[([^\]]*)\]\(\s*(?:<([^>]*)>|([^)\s]*))(?:\s+["'][^)]*)?\s*\)`)
+	// htmlImagePattern matches an HTML <img> tag.
+	htmlImagePattern = regexp.MustCompile(`(?i)<img\b[^>]*>`)
+	// htmlAttrPattern captures a src or alt attribute and its quoted or bare value.
+	htmlAttrPattern = regexp.MustCompile(`(?i)\b(src|alt)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+))`)
+	// figureRefPattern matches prose that points the reader at a figure,
+	// e.g. "see Figure 2" or "the diagram below".
+	figureRefPattern = regexp.MustCompile(`(?i)\b(?:see|in|shown in)\s+(?:figure|fig\.)\s*\d+|\b(?:diagram|chart|graph|image|screenshot|figure)\s+(?:below|above)\b`)
+)
+
+// findImages returns the images outside code blocks, in source order.
+func findImages(lines []string) []Image {
+	var images []Image
+	for i, kind := range classifyLines(lines) {
+		if kind == blockCode {
+			continue
+		}
+		for _, m := range markdownImagePattern.FindAllStringSubmatch(lines[i], -1) {
+			images = append(images, Image{Src: m[2] + m[3], Alt: strings.TrimSpace(m[1]), Line: i + 1})
+		}
+		for _, tag := range htmlImagePattern.FindAllString(lines[i], -1) {
+			img := Image{Line: i + 1}
+			for _, a := range htmlAttrPattern.FindAllStringSubmatch(tag, -1) {
+				value := a[2] + a[3] + a[4]
+				if strings.EqualFold(a[1], "src") {
+					img.Src = value
+				} else {
+					img.Alt = strings.TrimSpace(value)
+				}
+			}
+			images = append(images, img)
+		}
+	}
+	return images
+}
+
+// scoreImages checks that every image has alt text and, when the document's
+// directory is known, that local image files exist. It also flags press
+// releases that depend on a figure, since wire services and email distribute
+// them as plain text. Findings cost no points.
+func scoreImages(s *SpecSections) analysis {
+	a := analysis{category: "Structure"}
+	pr := s.Positions.PressRelease
+	for _, img := range s.Images {
+		name := img.Src
+		if name == "" {
+			name = "(no source)"
+		}
+		if img.Alt == "" {
+			a.issueAt(img.Line, fmt.Sprintf("%s: %q - describe what it shows for screen readers and plain-text readers", imageMissingAltMessage, name))
+		}
+		if s.Dir != "" && !localImageExists(s.Dir, img.Src) {
+			a.issueAt(img.Line, fmt.Sprintf("%s: %q does not exist relative to the document", imageMissingFileMessage, name))
+		}
+		if pr.Start > 0 && img.Line >= pr.Start && img.Line <= pr.End {
+			a.issueAt(img.Line, fmt.Sprintf("%s: %q - images are dropped from plain-text distribution, so state its point in words", imagePressReleaseMessage, name))
+		}
+	}
+	for _, ref := range figureRefs(s.Tree, pr) {
+		a.issueAt(ref.line, fmt.Sprintf("%s: %q - images are dropped from plain-text distribution, so state its point in words", imagePressReleaseMessage, ref.text))
+	}
+	return a
+}
+
+// localImageExists reports whether src, resolved against dir, is a file.
+// Remote and data URLs, and images without a source, are not checked.
+func localImageExists(dir, src string) bool {
+	path, local := localImagePath(dir, src)
+	if !local {
+		return true
+	}
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// localImagePath resolves src against dir. It reports false for remote and
+// data URLs, fragments, and images without a source, which are not files.
+func localImagePath(dir, src string) (string, bool) {
+	if src == "" || strings.HasPrefix(src, "#") || strings.HasPrefix(src, "//") || strings.HasPrefix(src, "data:") || strings.Contains(src, "://") {
+		return "", false
+	}
+	path := src
+	if u, err := url.Parse(src); err == nil && u.Path != "" {
+		path = u.Path // drop "?raw=true" and "#fragment", and decode "%20"
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+	}
+	return path, true
+}
+
+// figureRef is prose that refers to a figure, and its source line.
+type figureRef struct {
+	text string
+	line int
+}
+
+// figureRefs returns the figure references in the paragraphs within span.
+func figureRefs(tree *DocumentTree, span LineSpan) []figureRef {
+	if tree == nil || span.Start == 0 {
+		return nil
+	}
+	var refs []figureRef
+	check := func(paras []Paragraph) {
+		for _, p := range paras {
+			if p.Span.Start < span.Start || p.Span.Start > span.End {
+				continue
+			}
+			for i, line := range strings.Split(p.Text, "\n") {
+				for _, m := range figureRefPattern.FindAllString(line, -1) {
+					refs = append(refs, figureRef{text: m, line: p.Span.Start + i})
+				}
+			}
+		}
+	}
+	check(tree.Paragraphs)
+	var walk func([]*Section)
+	walk = func(sections []*Section) {
+		for _, sec := range sections {
+			check(sec.Paragraphs)
+			walk(sec.Subsections)
+		}
+	}
+	walk(tree.Sections)
+	return refs
+}
@@ -0,0 +1,115 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFindImages(t *testing.T) {
+	lines := strings.Split("![Dashboard](img/dash.png \"Hourly\")\n"+
+		"Text <img src='flow.svg' alt=\"Flow\"> and ![](<my chart.png>)\n\n"+
+		"```\n![not an image](x.png)\n```\n"+
+		"<IMG SRC=bare.png>", "\n")
+	want := []Image{
+		{Src: "img/dash.png", Alt: "Dashboard", Line: 1},
+		{Src: "my chart.png", Line: 2},
+		{Src: "flow.svg", Alt: "Flow", Line: 2},
+		{Src: "bare.png", Line: 7},
+	}
+	if got := findImages(lines); !slices.Equal(got, want) {
+		t.Errorf("findImages() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLocalImageExists(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "img"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "img", "my chart.png"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		src  string
+		want bool
+	}{
+		{"img/my chart.png", true},
+		{"img/my%20chart.png?raw=true", true},
+		{"img/missing.png", false},
+		{"img", false},
+		{"https://example.com/missing.png", true},
+		{"data:image/png;base64,AAAA", true},
+		{"", true},
+	}
+	for _, tt := range tests {
+		if got := localImageExists(dir, tt.src); got != tt.want {
+			t.Errorf("localImageExists(%q) = %v, want %v", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestScoreImages(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "arch.png"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	doc := "# Acme Launches Ledger Sync\n\n## Press Release\n\n" +
+		"SEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync, shown in the diagram below.\n\n" +
+		"![](arch.png)\n\n" +
+		"## FAQ\n\nQ: How does it work?\nA: See the architecture.\n\n" +
+		"![Architecture](arch.png)\n\n![Rollout](rollout.png)\n"
+	path := filepath.Join(dir, "prfaq.md")
+	if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	sections, err := ParsePRFAQ(path)
+	if err != nil {
+		t.Fatalf("ParsePRFAQ() error = %v", err)
+	}
+
+	a := scoreImages(sections)
+	want := []string{
+		imageMissingAltMessage + `: "arch.png" - describe what it shows for screen readers and plain-text readers`,
+		imagePressReleaseMessage + `: "arch.png" - images are dropped from plain-text distribution, so state its point in words`,
+		imageMissingFileMessage + `: "rollout.png" does not exist relative to the document`,
+		imagePressReleaseMessage + `: "diagram below" - images are dropped from plain-text distribution, so state its point in words`,
+	}
+	if strings.Join(a.issues, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("issues = %q, want %q", a.issues, want)
+	}
+	for i, line := range []int{7, 7, 16, 5} {
+		if a.lines[i] != line {
+			t.Errorf("issue %d on line %d, want %d", i, a.lines[i], line)
+		}
+	}
+
+	// Without a directory, as when parsing from a reader, files are not checked
+	sections.Dir = ""
+	for _, issue := range scoreImages(sections).issues {
+		if strings.HasPrefix(issue, imageMissingFileMessage) {
+			t.Errorf("unexpected %q without a directory", issue)
+		}
+	}
+}
+
+func TestScore_ImageRules(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today announced Ledger Sync.\n\n![](sync.png)\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	has := func() bool {
+		return slices.ContainsFunc(Score(sections).QualityBreakdown.Issues, func(issue string) bool {
+			return strings.HasPrefix(issue, imageMissingAltMessage)
+		})
+	}
+	if !has() {
+		t.Errorf("%s has no image findings", CurrentRules)
+	}
+	sections.Rules = supportedRules[1]
+	if has() {
+		t.Errorf("%s has image findings", sections.Rules)
+	}
+}
@@ -0,0 +1,196 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// maxIncludeDepth bounds how deeply includes nest, as a guard against
+// runaway documents that are not strictly cycles.
+const maxIncludeDepth = 16
+
+// includePattern matches a transclusion directive on a line of its own, as
+// in "{{include: faq.md}}". The path may be quoted.
+var includePattern = regexp.MustCompile(`^\s*\{\{\s*include:\s*["']?([^"'{}]+?)["']?\s*\}\}\s*$`)
+
+// HasIncludes reports whether data has a transclusion directive.
+func HasIncludes(data []byte) bool {
+	for line := range bytes.Lines(data) {
+		if includePattern.Match(bytes.TrimRight(line, "\r\n")) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseSource parses data read from path, replacing each
+// "{{include: other.md}}" line with the file it names, resolved against the
+// including file's directory. Includes nest; a cycle is an error. When any
+// are resolved, Parts records which file each line came from, so findings
+// point into included files. Image paths resolve against path's directory.
+func ParseSource(path string, data []byte) (*SpecSections, error) {
+	lines, err := readLines(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	sections, err := parseSourceLines(path, lines)
+	if err != nil {
+		return nil, err
+	}
+	sections.PRScore = Score(sections)
+	return sections, nil
+}
+
+// parseSourceLines is ParseSource for the lines of path, without scoring.
+func parseSourceLines(path string, lines []string) (*SpecSections, error) {
+	lines, parts, err := expandIncludes(path, lines, nil)
+	if err != nil {
+		return nil, err
+	}
+	sections, err := parseLines(lines, filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) > 1 {
+		sections.Parts = parts
+	}
+	if err := sections.applyReviewFile(path); err != nil {
+		return nil, err
+	}
+	if err := sections.applyDismissalsFile(path); err != nil {
+		return nil, err
+	}
+	return sections, nil
+}
+
+// Dependencies lists the files besides the document at path that its
+// findings depend on: the files it includes, its review and dismissals
+// sidecars, and its local images. Files that do not exist are listed too,
+// since creating one changes the findings as well.
+func (s *SpecSections) Dependencies(path string) []string {
+	var deps []string
+	for _, p := range s.Parts {
+		if p.Path != path {
+			deps = append(deps, p.Path)
+		}
+	}
+	deps = append(deps, ReviewFile(path), DismissalsFile(path))
+	if s.Dir != "" {
+		for _, img := range s.Images {
+			if file, local := localImagePath(s.Dir, img.Src); local {
+				deps = append(deps, file)
+			}
+		}
+	}
+	slices.Sort(deps)
+	return slices.Compact(deps)
+}
+
+// expandIncludes resolves the include directives in lines, read from path,
+// and returns the expanded lines with the parts they came from. stack holds
+// the absolute paths of the files including this one. Directives inside
+// fenced code blocks are left alone, so documentation can show them.
+func expandIncludes(path string, lines []string, stack []string) ([]string, []SourcePart, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrRead, err)
+	}
+	stack = append(stack, abs)
+
+	var out []string
+	var parts []SourcePart
+	part := SourcePart{Path: path, Start: 1}
+	flush := func() {
+		if part.Lines = len(out) - part.Start + 1; part.Lines > 0 {
+			parts = append(parts, part)
+		}
+	}
+	inFence := false
+	for i, line := range lines {
+		if fencePattern.MatchString(line) {
+			inFence = !inFence
+		}
+		m := includePattern.FindStringSubmatch(line)
+		if m == nil || inFence {
+			out = append(out, line)
+			continue
+		}
+
+		target := m[1]
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(filepath.Dir(path), target)
+		}
+		if err := checkInclude(path, i+1, target, stack); err != nil {
+			return nil, nil, err
+		}
+		included, err := readIncluded(target)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %s:%d: %w", ErrInclude, path, i+1, err)
+		}
+		included, includedParts, err := expandIncludes(target, included, stack)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		flush()
+		for _, p := range includedParts {
+			p.Start += len(out)
+			parts = append(parts, p)
+		}
+		out = append(out, included...)
+		part = SourcePart{Path: path, Start: len(out) + 1, Offset: i + 1}
+	}
+	flush()
+	return out, parts, nil
+}
+
+// checkInclude rejects an include of target, on line of path, that would
+// loop back to a file already being included or nest too deeply.
+func checkInclude(path string, line int, target string, stack []string) error {
+	abs, err := filepath.Abs(target)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrRead, err)
+	}
+	if i := slices.Index(stack, abs); i >= 0 {
+		chain := make([]string, 0, len(stack)-i+1)
+		for _, p := range stack[i:] {
+			chain = append(chain, filepath.Base(p))
+		}
+		return fmt.Errorf("%w: %s:%d: include cycle %s -> %s", ErrInclude, path, line, strings.Join(chain, " -> "), filepath.Base(abs))
+	}
+	if len(stack) > maxIncludeDepth {
+		return fmt.Errorf("%w: %s:%d: includes nest more than %d deep", ErrInclude, path, line, maxIncludeDepth)
+	}
+	return nil
+}
+
+// readIncluded reads the lines of an included file, with any front matter
+// blanked so it cannot override the including document's.
+func readIncluded(path string) ([]string, error) {
+	f, err := os.Open(path) //nolint:gosec // path is named by an include directive in the user's document
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	lines, err := readLines(f)
+	if err != nil {
+		return nil, err
+	}
+	blankFrontMatter(lines)
+	return lines, nil
+}
+
+// blankFrontMatter replaces the front matter at the top of lines, if any,
+// with blank lines, keeping line numbers.
+func blankFrontMatter(lines []string) {
+	if _, n, err := parseFrontMatter(lines); err == nil {
+		for i := range n {
+			lines[i] = ""
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package parser
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseSource_Includes(t *testing.T) {
+	dir := t.TempDir()
+	writeDocs(t, dir, map[string]string{
+		"faq/faq.md":     "## FAQ\n\n{{include: pricing.md}}\n\nQ: Who is it for?\nA: Finance teams.\n",
+		"faq/pricing.md": "---\ndoc_type: prfaq\n---\nQ: What does it cost?\nA: It is 3x cheaper.\n",
+	})
+	doc := "# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today launched Ledger Sync.\n\n{{ include: \"faq/faq.md\" }}\n\n```markdown\n{{include: missing.md}}\n```\n"
+	path := filepath.Join(dir, "prfaq.md")
+
+	sections, err := ParseSource(path, []byte(doc))
+	if err != nil {
+		t.Fatalf("ParseSource() error = %v", err)
+	}
+	for _, want := range []string{"Who is it for?", "What does it cost?"} {
+		if !strings.Contains(sections.FAQs, want) {
+			t.Errorf("FAQs lack included %q:\n%s", want, sections.FAQs)
+		}
+	}
+	if strings.Contains(sections.FAQs, "doc_type") {
+		t.Error("front matter of the included file kept")
+	}
+
+	faq := filepath.Join(dir, "faq", "faq.md")
+	pricing := filepath.Join(dir, "faq", "pricing.md")
+	tests := []struct {
+		line     int
+		wantFile string
+		wantLine int
+	}{
+		{5, path, 5},
+		{7, faq, 1},
+		{9, pricing, 1},
+		{13, pricing, 5},
+		{14, faq, 4},
+		{16, faq, 6},
+		{18, path, 9},
+	}
+	for _, tt := range tests {
+		if file, line := sections.Locate(tt.line); file != tt.wantFile || line != tt.wantLine {
+			t.Errorf("Locate(%d) = %s:%d, want %s:%d", tt.line, file, line, tt.wantFile, tt.wantLine)
+		}
+	}
+}
+
+func TestParseSource_NoIncludes(t *testing.T) {
+	sections, err := ParseSource("prfaq.md", []byte("# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today launched Ledger Sync.\n"))
+	if err != nil {
+		t.Fatalf("ParseSource() error = %v", err)
+	}
+	if sections.Parts != nil {
+		t.Errorf("Parts = %+v, want none without includes", sections.Parts)
+	}
+}
+
+func TestParseSource_InvalidInclude(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  string
+	}{
+		{"cycle", map[string]string{"a.md": "{{include: b.md}}\n", "b.md": "{{include: a.md}}\n"}, "a.md -> b.md -> a.md"},
+		{"self", map[string]string{"a.md": "{{include: a.md}}\n"}, "a.md -> a.md"},
+		{"missing", map[string]string{"a.md": "{{include: gone.md}}\n"}, "a.md:1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeDocs(t, dir, tt.files)
+			_, err := ParseSource(filepath.Join(dir, "prfaq.md"), []byte("# Doc\n\n{{include: a.md}}\n"))
+			if !errors.Is(err, ErrInclude) || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseSource() error = %v, want %v naming %q", err, ErrInclude, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasIncludes(t *testing.T) {
+	for doc, want := range map[string]bool{
+		"# Doc\n\n{{include: faq.md}}\n":         true,
+		"# Doc\r\n\r\n{{ include: faq.md }}\r\n": true,
+		"# Doc\n\nSee {{include: faq.md}}.\n":    false,
+		"# Doc\n":                                false,
+	} {
+		if got := HasIncludes([]byte(doc)); got != want {
+			t.Errorf("HasIncludes(%q) = %v, want %v", doc, got, want)
+		}
+	}
+}
+
+// writeDocs writes files, named relative to dir.
+func writeDocs(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Issue messages of the leakage check, followed by ": " and the details.
+const (
+	leakCostMessage     = "Internal cost details leaked"
+	leakCodenameMessage = "Internal codename leaked"
+	leakStrategyMessage = "Competitive strategy leaked"
+	leakStaffingMessage = "Staffing numbers leaked"
+)
+
+// leakageRules is the first rules version with the leakage check.
+var leakageRules = RulesVersion{Major: 5, Minor: 8}
+
+var (
+	// leakCostPattern matches what the launch costs the company, as opposed
+	// to what it costs the customer.
+	leakCostPattern = regexp.MustCompile(`(?i)\b(?:cost to (?:build|run|serve|operate)|(?:gross|operating|profit|contribution|our) margins?|unit economics|cogs|cost of goods|(?:build|engineering|infrastructure|hosting|development) (?:costs?|budget|spend)|(?:our|internal) (?:costs|budget)|burn rate|payback period|break[- ]even|p&l|opex|capex)\b`)
+	// leakStrategyPattern matches how the company means to beat its
+	// competitors, which customers and competitors should not read.
+	leakStrategyPattern = regexp.MustCompile(`(?i)\b(?:competitive (?:strategy|response|moat|threat|positioning)|(?:undercut|outflank|displace|neutrali[sz]e) (?:our |the )?(?:competitors?|competition|rivals?|incumbents?)|(?:steal|take|win) (?:market )?share from|moat|lock customers in|customer lock-in|switching costs?|land[- ]and[- ]expand|go-to-market strategy)\b`)
+	// leakStaffingPattern matches the size of the team or the effort it
+	// takes, such as "12 engineers" or "3 FTEs".
+	leakStaffingPattern = regexp.MustCompile(`(?i)\b(?:(?:\d+|one|two|three|four|five|six|seven|eight|nine|ten|twelve|a dozen) (?:(?:full-time|additional|more|new|dedicated) )?(?:engineers?|developers?|designers?|product managers?|pms?|ftes?|contractors?|people on the team)|headcount|(?:engineer|person|developer)-(?:weeks?|months?|years?)|staffing (?:plan|needs|levels?|model))\b`)
+)
+
+// CheckCodenames validates codename patterns, such as those from a config
+// file: each must be a regular expression.
+func CheckCodenames(patterns []string) error {
+	for _, p := range patterns {
+		if _, err := regexp.Compile(p); err != nil {
+			return fmt.Errorf("%w: %q: %w", ErrCodenames, p, err)
+		}
+	}
+	return nil
+}
+
+// codenamePatterns compiles the codename patterns, leaving out any that
+// CheckCodenames would reject.
+func codenamePatterns(patterns []string) []*regexp.Regexp {
+	var out []*regexp.Regexp
+	for _, p := range patterns {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		if re, err := regexp.Compile(p); err == nil {
+			out = append(out, re)
+		}
+	}
+	return out
+}
+
+// externalParagraphs returns the paragraphs customers read, each with the
+// name of its section: the press release, and the external FAQ. A lone
+// unlabeled FAQ is not external, as in scoreExternalFAQ.
+func externalParagraphs(s *SpecSections) ([]Paragraph, []string) {
+	if s.Tree == nil {
+		return nil, nil
+	}
+	faq := s.Positions.ExternalFAQs
+	var paragraphs []Paragraph
+	var where []string
+	for _, p := range treeParagraphs(s.Tree) {
+		switch line := p.Span.Start; {
+		case s.Positions.PressRelease.contains(line):
+			paragraphs, where = append(paragraphs, p), append(where, "press release")
+		case faq.Start > 0 && faq.contains(line):
+			paragraphs, where = append(paragraphs, p), append(where, "external FAQ")
+		}
+	}
+	return paragraphs, where
+}
+
+// scoreLeakage reports internal-only information in the sections customers
+// read: what the launch costs the company, codenames matching the
+// configured patterns, competitive strategy, and staffing numbers. Each
+// kind is reported once per sentence, as an error. Findings cost no points.
+// Internal and design documents, and documents for an internal audience,
+// are never published, so they are not checked.
+func scoreLeakage(s *SpecSections) analysis {
+	a := analysis{category: "Credibility"}
+	if s.DocType == DocTypeInternal || s.DocType == DocTypeDesign || s.Audience == AudienceInternal {
+		return a
+	}
+	codenames := codenamePatterns(s.Codenames)
+	paragraphs, where := externalParagraphs(s)
+	for i, p := range paragraphs {
+		for _, sentence := range p.Sentences {
+			line := sentence.Position.Line
+			if m := leakCostPattern.FindString(sentence.Text); m != "" {
+				a.issueAt(line, fmt.Sprintf("%s: %q in the %s - move what it costs us to the internal FAQ", leakCostMessage, m, where[i]))
+			}
+			for _, re := range codenames {
+				if m := re.FindString(sentence.Text); m != "" {
+					a.issueAt(line, fmt.Sprintf("%s: %q in the %s - use the name customers will see", leakCodenameMessage, m, where[i]))
+					break
+				}
+			}
+			if m := leakStrategyPattern.FindString(sentence.Text); m != "" {
+				a.issueAt(line, fmt.Sprintf("%s: %q in the %s - say what customers get, and keep the strategy in the internal FAQ", leakStrategyMessage, m, where[i]))
+			}
+			if m := leakStaffingPattern.FindString(sentence.Text); m != "" {
+				a.issueAt(line, fmt.Sprintf("%s: %q in the %s - move team size and effort to the internal FAQ", leakStaffingMessage, m, where[i]))
+			}
+		}
+	}
+	return a
+}
@@ -0,0 +1,97 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestScoreLeakage(t *testing.T) {
+	doc := `# Acme Launches Ledger Sync
+
+## Press Release
+
+Acme today launched Ledger Sync, built by Project Falcon. A team of 12 engineers built it in six months.
+
+It will undercut competitors on price.
+
+## FAQ
+
+Q: How much does it cost?
+A: $5 a month, and our gross margin is 80%.
+
+## Internal FAQ
+
+Q: What does it cost us?
+A: Four engineers and a hosting budget of $2,000 a month. Project Falcon stays internal.
+`
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sections.Codenames = []string{`Project [A-Z][a-z]+`}
+
+	a := scoreLeakage(sections)
+	want := []string{
+		leakCodenameMessage + `: "Project Falcon" in the press release - use the name customers will see`,
+		leakStaffingMessage + `: "12 engineers" in the press release - move team size and effort to the internal FAQ`,
+		leakStrategyMessage + `: "undercut competitors" in the press release - say what customers get, and keep the strategy in the internal FAQ`,
+		leakCostMessage + `: "gross margin" in the external FAQ - move what it costs us to the internal FAQ`,
+	}
+	if got := strings.Join(a.issues, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("issues =\n%s\nwant\n%s", got, strings.Join(want, "\n"))
+	}
+	if a.lines[0] != 5 || a.lines[2] != 7 || a.lines[3] != 12 {
+		t.Errorf("lines = %v, want the sentences' lines", a.lines)
+	}
+}
+
+func TestScoreLeakage_CustomerCosts(t *testing.T) {
+	doc := "# Launch\n\n## Press Release\n\nLedger Sync costs $5 a month and saves finance teams two days at every close.\n\n## FAQ\n\nQ: Can my team of 12 use it?\nA: Yes, with a team plan.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := scoreLeakage(sections); len(a.issues) != 0 {
+		t.Errorf("issues = %q, want none", a.issues)
+	}
+}
+
+func TestScoreLeakage_InternalDocuments(t *testing.T) {
+	const body = "\n\nQ: What will it cost?\nA: We need 12 engineers for six months, and the cost to build is $2M.\n"
+	tests := []struct {
+		name     string
+		faq      string
+		docType  DocType
+		audience Audience
+		want     int
+	}{
+		{"single unlabeled FAQ", "## FAQ", "", "", 0},
+		{"internal audience", "## Customer FAQ", "", AudienceInternal, 0},
+		{"internal document", "## Customer FAQ", DocTypeInternal, "", 0},
+		{"design document", "## Customer FAQ", DocTypeDesign, "", 0},
+		{"external FAQ", "## Customer FAQ", "", "", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := "# Ledger Sync\n\n## Press Release\n\nAcme today launched Ledger Sync.\n\n" + tt.faq + body
+			sections, err := Parse(strings.NewReader(doc))
+			if err != nil {
+				t.Fatal(err)
+			}
+			sections.DocType, sections.Audience = tt.docType, tt.audience
+			if a := scoreLeakage(sections); len(a.issues) != tt.want {
+				t.Errorf("issues = %q, want %d", a.issues, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckCodenames(t *testing.T) {
+	if err := CheckCodenames([]string{`Project [A-Z]\w+`, "Falcon"}); err != nil {
+		t.Errorf("CheckCodenames() = %v, want nil", err)
+	}
+	if err := CheckCodenames([]string{"Project (Falcon"}); !errors.Is(err, ErrCodenames) {
+		t.Errorf("CheckCodenames() = %v, want ErrCodenames", err)
+	}
+}
@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Issue messages of the legal phrase screening, followed by ": " and the
+// details.
+const (
+	legalGuaranteeMessage   = "Absolute guarantee"
+	legalSuperiorityMessage = "Unsubstantiated superiority claim"
+	legalTrademarkMessage   = "Registered mark without ®"
+	legalPhraseMessage      = "Legally risky phrase"
+)
+
+// legalRules is the first rules version with the legal phrase screening.
+var legalRules = RulesVersion{Major: 5, Minor: 4}
+
+// legalMessages are the messages LegalStrict reports as errors.
+var legalMessages = []string{legalGuaranteeMessage, legalSuperiorityMessage, legalTrademarkMessage, legalPhraseMessage}
+
+var (
+	// guaranteePattern matches promises no product can keep.
+	guaranteePattern = regexp.MustCompile(`(?i)\b(?:guarantee[sd]?|100% (?:secure|safe|accurate|reliable|uptime)|never (?:fails?|goes down|loses?)|always works|risk-free|zero risk|completely (?:secure|safe)|unbreakable|bulletproof|fail-?proof)\b`)
+	// superiorityPattern matches claims of being better than everyone else,
+	// such as "is the fastest"; "finds the best candidates" is no claim.
+	superiorityPattern = regexp.MustCompile(`(?i)(?:#1\b|\b(?:(?:is|are|as) the (?:best|only|fastest|leading|most (?:secure|advanced|accurate|trusted|powerful))|best-in-class|number one|industry-leading|market-leading|world's (?:first|best|fastest|leading)|first-ever|better than any|unmatched|unrivall?ed|superior to)\b)`)
+	// substantiationPattern matches the source a comparative claim cites.
+	substantiationPattern = regexp.MustCompile(`(?i)(?:\b(?:according to|as measured by|based on|in (?:a|an) (?:independent )?(?:study|benchmark|survey|test)|benchmark(?:ed|s)?|source:)|\[\d+\]|\^\d+)`)
+)
+
+// LegalOptions configures the legal phrase screening.
+type LegalOptions struct {
+	// Phrases are screened along with the built-in guarantees and
+	// superiority claims, e.g. "certified" or "HIPAA compliant".
+	Phrases []string
+	// RegisteredMarks must carry ® on their first use in the press release,
+	// e.g. "Ledger Sync".
+	RegisteredMarks []string
+	// Strict reports legal findings as errors instead of warnings.
+	Strict bool
+}
+
+// scoreLegal screens the press release for phrasing legal review would
+// flag: absolute guarantees, superiority claims without a figure or a
+// source in the same sentence, configured phrases, and registered marks
+// whose first use lacks ®. Findings cost no points.
+func scoreLegal(s *SpecSections) analysis {
+	a := analysis{category: "Credibility"}
+	phrases := phrasePattern(s.Legal.Phrases)
+	for _, p := range pressReleaseParagraphs(s) {
+		for _, sentence := range p.Sentences {
+			line := sentence.Position.Line
+			if m := guaranteePattern.FindString(sentence.Text); m != "" {
+				a.issueAt(line, fmt.Sprintf("%s: %q promises what no product can - say what it does, and how well", legalGuaranteeMessage, m))
+			}
+			if m := superiorityPattern.FindString(sentence.Text); m != "" && !substantiated(sentence.Text) {
+				a.issueAt(line, fmt.Sprintf("%s: %q has no figure or source - cite the benchmark or study behind it, or drop the comparison", legalSuperiorityMessage, m))
+			}
+			if phrases != nil {
+				if m := phrases.FindString(sentence.Text); m != "" {
+					a.issueAt(line, fmt.Sprintf("%s: %q is on the legal screening list - check it with legal review, or rephrase", legalPhraseMessage, m))
+				}
+			}
+		}
+	}
+	for _, mark := range s.Legal.RegisteredMarks {
+		if line, ok := markWithoutSymbol(s, mark); ok {
+			a.issueAt(line, fmt.Sprintf("%s: the first use of %q needs the registered trademark symbol - write %s®", legalTrademarkMessage, mark, mark))
+		}
+	}
+	return a
+}
+
+// substantiated reports whether a sentence backs its claim with a metric
+// or cites a source.
+func substantiated(sentence string) bool {
+	metrics, _ := detectMetricsInText(sentence)
+	return len(metrics) > 0 || substantiationPattern.MatchString(sentence)
+}
+
+// phrasePattern compiles screening phrases into one case-insensitive
+// pattern matching whole words, or returns nil when there are none.
+func phrasePattern(phrases []string) *regexp.Regexp {
+	var quoted []string
+	for _, p := range phrases {
+		if p = strings.TrimSpace(p); p != "" {
+			quoted = append(quoted, regexp.QuoteMeta(p))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b`)
+}
+
+// markWithoutSymbol returns the line of the first use of mark in the press
+// release when it is not followed by ® or (R). It reports false when the
+// mark is not used or its first use has the symbol.
+func markWithoutSymbol(s *SpecSections, mark string) (int, bool) {
+	mark = strings.TrimSpace(mark)
+	if mark == "" {
+		return 0, false
+	}
+	re := regexp.MustCompile(`\b` + regexp.QuoteMeta(mark) + `\b(®|\(R\))?`)
+	for _, p := range pressReleaseParagraphs(s) {
+		for _, sentence := range p.Sentences {
+			m := re.FindStringSubmatch(sentence.Text)
+			if m == nil {
+				continue
+			}
+			return sentence.Position.Line, m[1] == ""
+		}
+	}
+	return 0, false
+}
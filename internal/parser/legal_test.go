@@ -0,0 +1,69 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScoreLegal(t *testing.T) {
+	tests := []struct {
+		name  string
+		pr    string
+		legal LegalOptions
+		want  []string // issue message heads
+	}{
+		{"clean", "Ledger Sync reconciles bank feeds overnight.", LegalOptions{}, nil},
+		{"guarantee", "Ledger Sync is guaranteed to balance your books.", LegalOptions{}, []string{legalGuaranteeMessage}},
+		{"superiority", "Ledger Sync is the fastest way to close the books.", LegalOptions{}, []string{legalSuperiorityMessage}},
+		{"superiority with a figure", "Ledger Sync is the fastest way to close the books, 40% faster than manual matching.", LegalOptions{}, nil},
+		{"superiority with a source", "Ledger Sync is the fastest way to close the books, according to a Forrester study.", LegalOptions{}, nil},
+		{"no comparison", "Recruiters find the best candidates.", LegalOptions{}, nil},
+		{"screened phrase", "Ledger Sync is SOC 2 certified.", LegalOptions{Phrases: []string{"certified"}}, []string{legalPhraseMessage}},
+		{"mark without symbol", "Ledger Sync reconciles bank feeds. Ledger Sync® is free.", LegalOptions{RegisteredMarks: []string{"Ledger Sync"}}, []string{legalTrademarkMessage}},
+		{"mark with symbol", "Ledger Sync® reconciles bank feeds. Ledger Sync is free.", LegalOptions{RegisteredMarks: []string{"Ledger Sync"}}, nil},
+		{"mark unused", "Acme reconciles bank feeds.", LegalOptions{RegisteredMarks: []string{"Ledger Sync"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\n" + tt.pr + "\n"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			sections.Legal = tt.legal
+			a := scoreLegal(sections)
+			if len(a.issues) != len(tt.want) {
+				t.Fatalf("issues = %q, want %q", a.issues, tt.want)
+			}
+			for i, issue := range a.issues {
+				if !strings.HasPrefix(issue, tt.want[i]+": ") {
+					t.Errorf("issue = %q, want %q", issue, tt.want[i])
+				}
+				if a.lines[i] != 5 {
+					t.Errorf("line = %d, want 5", a.lines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFindings_LegalStrict(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\nLedger Sync is guaranteed to balance your books.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	severity := func() Severity {
+		for _, f := range sections.Findings() {
+			if f.RuleID == "legal-guarantee" {
+				return f.Severity
+			}
+		}
+		return ""
+	}
+	if got := severity(); got != SeverityWarning {
+		t.Errorf("severity = %q, want %q", got, SeverityWarning)
+	}
+	sections.Legal.Strict = true
+	if got := severity(); got != SeverityError {
+		t.Errorf("severity with Strict = %q, want %q", got, SeverityError)
+	}
+}
@@ -0,0 +1,250 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Issue messages of the ordering checks. The section and boilerplate
+// messages are followed by ": " and a reorder suggestion.
+const (
+	orderSectionsMessage    = "Sections out of PR-FAQ order"
+	orderQuoteLeadMessage   = "Press release opens with a quote - lead with the news and move the quote below the supporting details"
+	orderDetailsLateMessage = "Supporting details follow every quote - move them up, right after the lead"
+	orderBoilerplateMessage = "Boilerplate should close the press release"
+)
+
+// docPart ranks the parts of a PR-FAQ in canonical order.
+type docPart int
+
+const (
+	partNone docPart = iota
+	partPressRelease
+	partExternalFAQ
+	partInternalFAQ
+	partAppendix
+)
+
+func (p docPart) String() string {
+	switch p {
+	case partPressRelease:
+		return "Press Release"
+	case partExternalFAQ:
+		return "External FAQ"
+	case partInternalFAQ:
+		return "Internal FAQ"
+	case partAppendix:
+		return "Appendix"
+	}
+	return ""
+}
+
+// scoreOrdering checks that the document reads press release, external FAQ,
+// internal FAQ, then appendices, and that the press release follows the
+// inverted pyramid: lead, supporting details, quotes, then boilerplate.
+// Out-of-order structure is a finding and costs no points.
+func scoreOrdering(s *SpecSections) analysis {
+	a := analysis{category: "Structure"}
+	if s.Tree != nil {
+		for _, m := range sectionOrder(s.Tree.Sections, s.Positions.PressRelease.Start) {
+			a.issueAt(m.line, orderSectionsMessage+": "+m.suggestion)
+		}
+	}
+	for _, issue := range pyramidOrder(s.PressRelease) {
+		a.issue(issue)
+	}
+	return a
+}
+
+// orderedSection is a heading that maps to a canonical part.
+type orderedSection struct {
+	heading string
+	line    int
+	part    docPart
+}
+
+// misplaced is a section that appears after one meant to follow it.
+type misplaced struct {
+	line       int
+	suggestion string // e.g. move "FAQ" (External FAQ) before "Appendix" (Appendix)
+}
+
+// sectionOrder returns a reorder suggestion for every section that appears
+// after a section meant to follow it. prStart is the first line of the press
+// release, which identifies it when its heading is not "Press Release".
+func sectionOrder(sections []*Section, prStart int) []misplaced {
+	var seen []orderedSection
+	var walk func([]*Section)
+	walk = func(sections []*Section) {
+		for _, sec := range sections {
+			if part := classifySection(sec, prStart); part != partNone {
+				seen = append(seen, orderedSection{heading: sec.Heading, line: sec.Line, part: part})
+			}
+			walk(sec.Subsections)
+		}
+	}
+	walk(sections)
+
+	var out []misplaced
+	for i, sec := range seen {
+		for _, earlier := range seen[:i] {
+			if earlier.part > sec.part {
+				out = append(out, misplaced{line: sec.line, suggestion: fmt.Sprintf("move %q (%s) before %q (%s)", sec.heading, sec.part, earlier.heading, earlier.part)})
+				break
+			}
+		}
+	}
+	return out
+}
+
+// maxSectionWords is the longest heading classifySection treats as a
+// section name rather than prose set as a heading.
+const maxSectionWords = 10
+
+// classifySection maps a heading to its canonical part, or partNone for
+// sections such as Success Metrics that have no fixed place.
+func classifySection(sec *Section, prStart int) docPart {
+	heading := strings.ToLower(sec.Heading)
+	switch {
+	case len(strings.Fields(heading)) > maxSectionWords:
+		return partNone
+	case heading == "press release" || heading == "announcement":
+		return partPressRelease
+	case len(sec.Paragraphs) > 0 && sec.Paragraphs[0].Span.Start == prStart:
+		return partPressRelease
+	case isAppendix(heading):
+		return partAppendix
+	case isFAQSection(heading) && faqHeadingOf(heading) == faqInternal:
+		return partInternalFAQ
+	case isFAQSection(heading):
+		return partExternalFAQ
+	}
+	return partNone
+}
+
+// paraKind is the role of a press release paragraph in the inverted pyramid.
+type paraKind int
+
+const (
+	paraLead paraKind = iota
+	paraDetail
+	paraQuote
+	paraBoilerplate
+	paraContact
+)
+
+var (
+	// attributionPattern matches the verb that attributes a quote to a speaker.
+	attributionPattern = regexp.MustCompile(`(?i)\b(?:said|says|added|adds|explained|noted|according to)\b`)
+	// endMarkPattern matches the marks that end a press release, and
+	// horizontal rules.
+	endMarkPattern = regexp.MustCompile(`^(?:#\s*#\s*#|-\s*30\s*-|-{3,}|\*{3,}|_{3,})$`)
+)
+
+// pyramidOrder returns the inverted-pyramid issues of a press release.
+func pyramidOrder(content string) []string {
+	kinds, boilerplate := pressParagraphs(content)
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	var issues []string
+	if kinds[0] == paraQuote {
+		issues = append(issues, orderQuoteLeadMessage)
+	}
+
+	// Quotes and details are compared up to the boilerplate, which is checked on its own
+	body := kinds[1:]
+	if boilerplate > 0 {
+		body = kinds[1:boilerplate]
+	}
+	firstDetail, lastQuote := -1, -1
+	for i, k := range body {
+		switch {
+		case k == paraDetail && firstDetail < 0:
+			firstDetail = i
+		case k == paraQuote:
+			lastQuote = i
+		}
+	}
+	if firstDetail >= 0 && lastQuote >= 0 && firstDetail > lastQuote {
+		issues = append(issues, orderDetailsLateMessage)
+	}
+
+	after := 0
+	if boilerplate >= 0 {
+		for _, k := range kinds[boilerplate:] {
+			if k != paraBoilerplate && k != paraContact {
+				after++
+			}
+		}
+	}
+	if after > 0 {
+		issues = append(issues, fmt.Sprintf("%s: move the %d %s after it above the \"About\" section", orderBoilerplateMessage, after, plural(after, "paragraph", "paragraphs")))
+	}
+	return issues
+}
+
+// pressParagraphs classifies the paragraphs of a press release, skipping
+// headings, release designations, and end marks. It also returns the index of
+// the first boilerplate paragraph, or -1.
+func pressParagraphs(content string) ([]paraKind, int) {
+	var kinds []paraKind
+	boilerplate := -1
+	bodyNext := false // the last paragraph was an "About" heading on its own
+	for _, p := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		p = strings.TrimSpace(p)
+		first, rest, _ := strings.Cut(p, "\n")
+		kind := paraDetail
+		if p == "" {
+			continue
+		}
+		switch {
+		case boilerplateHeadingPattern.MatchString(first), boilerplateInlinePattern.MatchString(p), bodyNext:
+			kind = paraBoilerplate
+		case isFurniture(p):
+			continue
+		case boilerplate >= 0 && mediaContactPattern.MatchString(p):
+			kind = paraContact
+		case isQuoteParagraph(p):
+			kind = paraQuote
+		case len(kinds) == 0:
+			kind = paraLead
+		}
+		bodyNext = boilerplateHeadingPattern.MatchString(first) && strings.TrimSpace(rest) == ""
+		if kind == paraBoilerplate && boilerplate < 0 {
+			boilerplate = len(kinds)
+		}
+		kinds = append(kinds, kind)
+	}
+	return kinds, boilerplate
+}
+
+// isFurniture reports whether a paragraph is a heading, a release
+// designation such as FOR IMMEDIATE RELEASE, or an end mark such as "###".
+func isFurniture(p string) bool {
+	if endMarkPattern.MatchString(p) || strings.HasPrefix(p, "#") {
+		return true
+	}
+	plain := strings.TrimSpace(strings.Trim(p, "*_"))
+	return !strings.Contains(plain, "\n") && len(plain) < 120 &&
+		(immediateLoosePattern.MatchString(plain) || embargoPattern.MatchString(plain))
+}
+
+// isQuoteParagraph reports whether a paragraph is a quote: it opens with a
+// quotation mark, or quotes someone and attributes it.
+func isQuoteParagraph(p string) bool {
+	p = strings.TrimLeft(p, "> ")
+	if strings.HasPrefix(p, "\"") || strings.HasPrefix(p, "“") {
+		return true
+	}
+	return len(extractQuotes(p)) > 0 && attributionPattern.MatchString(p)
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
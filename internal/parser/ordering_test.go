@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSectionOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want []string
+	}{
+		{
+			name: "canonical order",
+			doc:  "# Title\n\n## Press Release\n\nNews.\n\n## FAQ\n\nQ.\n\n## Internal FAQ\n\nQ.\n\n## Appendix A\n\nData.\n",
+		},
+		{
+			name: "internal FAQ first",
+			doc:  "# Title\n\n## Press Release\n\nNews.\n\n## Internal FAQ\n\nQ.\n\n## Customer FAQ\n\nQ.\n",
+			want: []string{`move "Customer FAQ" (External FAQ) before "Internal FAQ" (Internal FAQ)`},
+		},
+		{
+			name: "press release last",
+			doc:  "# Title\n\n## Appendix\n\nData.\n\n## FAQ\n\nQ.\n\n## Press Release\n\nNews.\n",
+			want: []string{
+				`move "FAQ" (External FAQ) before "Appendix" (Appendix)`,
+				`move "Press Release" (Press Release) before "Appendix" (Appendix)`,
+			},
+		},
+		{
+			name: "nested FAQs",
+			doc:  "# Title\n\n## Press Release\n\nNews.\n\n## FAQs\n\n### Internal FAQ\n\nQ.\n\n### External FAQ\n\nQ.\n",
+			want: []string{`move "External FAQ" (External FAQ) before "Internal FAQ" (Internal FAQ)`},
+		},
+		{
+			name: "unplaced sections are ignored",
+			doc:  "# Title\n\n## Press Release\n\nNews.\n\n## Success Metrics\n\nM.\n\n## FAQ\n\nQ.\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := buildTree(strings.Split(tt.doc, "\n"))
+			var got []string
+			for _, m := range sectionOrder(tree.Sections, 0) {
+				got = append(got, m.suggestion)
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("sectionOrder() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPyramidOrder(t *testing.T) {
+	const (
+		lead        = "SEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync."
+		detail      = "Ledger Sync reconciles accounts every hour and flags mismatches."
+		quote       = `"Month-end close now takes a day instead of a week," said Jane Doe, CFO of Example Corp.`
+		boilerplate = "About Acme\nFounded in 2019, Acme is headquartered in Seattle. Learn more at www.acme.com."
+		contact     = "Media Contact\nJane Roe, press@acme.com"
+	)
+	tests := []struct {
+		name  string
+		paras []string
+		want  []string
+	}{
+		{"inverted pyramid", []string{"FOR IMMEDIATE RELEASE", lead, detail, quote, boilerplate, contact, "###"}, nil},
+		{"quote sandwich", []string{lead, quote, detail, quote, boilerplate}, nil},
+		{"quote lead", []string{quote, lead, detail}, []string{orderQuoteLeadMessage}},
+		{"details last", []string{lead, quote, quote, detail, boilerplate}, []string{orderDetailsLateMessage}},
+		{"news after boilerplate", []string{lead, detail, "## About Acme", "Acme makes ledgers.", quote, detail, contact},
+			[]string{orderBoilerplateMessage + `: move the 2 paragraphs after it above the "About" section`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pyramidOrder(strings.Join(tt.paras, "\n\n"))
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("pyramidOrder() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreOrdering_Findings(t *testing.T) {
+	doc := "# Acme Launches Ledger Sync\n\n## FAQ\n\nQ: What?\nA: Sync.\n\n## Press Release\n\n" +
+		`"We close faster," said Jane Doe.` + "\n\nSEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	found := map[string]Finding{}
+	for _, f := range sections.Findings() {
+		found[f.RuleID] = f
+	}
+	if f, ok := found["order-sections"]; !ok || f.Line != 8 || !strings.Contains(f.Message, `move "Press Release"`) {
+		t.Errorf("order-sections finding = %+v, want one on the Press Release heading suggesting the move", f)
+	}
+	if _, ok := found["order-quote-lead"]; !ok {
+		t.Errorf("findings %v lack order-quote-lead", found)
+	}
+}
@@ -4,20 +4,75 @@ package parser
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
-	"regexp"
 	"strings"
 	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/buildinfo"
 )
 
 // SpecSections represents the parsed sections of a PR-FAQ document.
 type SpecSections struct {
 	Title         string
 	PressRelease  string
-	FAQs          string
+	FAQs          string // every FAQ section, internal and external together
+	InternalFAQs  string // the FAQ for the team and its reviewers, e.g. "## Internal FAQ"
+	ExternalFAQs  string // the FAQ for customers, when the document separates the two
 	Metrics       string
 	OtherSections map[string]string
 	PRScore       *PRScore
+	Positions     SectionPositions
+	Tree          *DocumentTree     // full heading/paragraph structure of the source
+	Dateline      Dateline          // press release dateline, normalized
+	Audience      Audience          // readership Score tunes its heuristics for; "" is AudienceGeneral
+	DocType       DocType           // from the front matter doc_type; "" is DocTypeExternal
+	Tone          Tone              // from the front matter tone; "" is ToneFormal
+	HedgeSeverity Severity          // severity of hedging findings; "" keeps the catalog's SeverityWarning
+	PublicCompany bool              // forward-looking statements need a safe-harbor statement
+	Legal         LegalOptions      // screening phrases, registered marks, and strict mode of the legal check
+	BrandNames    []string          // canonical spellings of product and company names, e.g. from a config file
+	Codenames     []string          // patterns of internal codenames, e.g. `Project [A-Z]\w+` from a config file
+	Deterministic bool              // report the same date and validator on every run, for snapshot tests
+	Review        Review            // review workflow state and sign-offs, from the front matter or a sidecar file
+	Dismissals    []Dismissal       // findings dismissed in the dismissals sidecar file
+	Weights       Weights           // category weights overriding the document type's, e.g. from a config file
+	ScoringMode   ScoringMode       // how the overall score is computed; "" is ScoringCategories
+	SeverityCosts SeverityCosts     // points each severity costs under ScoringSeverity; nil keeps DefaultSeverityCosts
+	Rules         RulesVersion      // scoring model pinned with ResolveRules; the zero value is CurrentRules
+	Appendices    []Appendix        // sections headed "Appendix ..." or "Appendices", in document order
+	Tables        int               // markdown tables anywhere in the document
+	Figures       int               // images, or numbered figure captions when there are more of those
+	Images        []Image           // embedded images outside code blocks, in source order
+	Dir           string            // directory image paths resolve against; "" skips the file check
+	Parts         []SourcePart      // files the document was assembled from by ParseFiles; nil for one file
+	TopicMatcher  TopicMatcher      // matches FAQ questions to required ones by meaning; nil matches keywords
+	FlowChecker   FlowChecker       // checks the press release story; nil skips the check
+	Similar       []SimilarDocument // existing documents this one duplicates, set by the caller from a Corpus
+
+	headings sectionHeadings
+}
+
+// sectionHeadings records which key sections had an explicit heading, so an
+// empty section can be told apart from a missing one.
+type sectionHeadings struct {
+	pressRelease bool
+	faqs         bool
+}
+
+// SectionPositions records where the key sections appear in the source document.
+type SectionPositions struct {
+	Title        int      // 1-based line of the title heading, 0 if absent
+	PressRelease LineSpan // content lines of the press release
+	FAQs         LineSpan // content lines of the FAQ, including numbered questions
+	InternalFAQs LineSpan // content lines of the internal FAQ
+	ExternalFAQs LineSpan // content lines of the external FAQ
+}
+
+// LineSpan is an inclusive, 1-based range of source lines. A zero span means unknown.
+type LineSpan struct {
+	Start int
+	End   int
 }
 
 // PRScore contains the overall quality score and metrics for a press release.
@@ -27,6 +82,14 @@ type PRScore struct {
 	MetricDetails     []MetricInfo
 	OverallScore      int // 0-100
 	QualityBreakdown  PRQualityBreakdown
+	Designation       ReleaseDesignation
+	DocType           DocType // document type the overall score was weighted for
+	// Weights are the category weights of the overall score when
+	// SpecSections.Weights overrode the document type's; nil otherwise.
+	Weights Weights
+	// Severity is how the overall score was computed from the findings
+	// under ScoringSeverity; nil when it adds up the categories.
+	Severity *SeverityScore
 }
 
 // MetricInfo contains details about metrics found in a customer quote.
@@ -35,6 +98,7 @@ type MetricInfo struct {
 	Metrics     []string
 	MetricTypes []string // percentage, number, ratio, etc.
 	Score       int      // 0-10 for this quote
+	Voice       QuoteVoice
 }
 
 // PRQualityBreakdown provides detailed scoring across multiple quality dimensions.
@@ -56,9 +120,74 @@ type PRQualityBreakdown struct {
 	// Customer Evidence (15 points) - existing quote scoring
 	QuoteScore int // 0-15: Quality customer quotes with metrics
 
+	// Customer Focus (10 points) - customer perspective and benefit phrasing,
+	// scored from rules/v5
+	CustomerFocusScore int  // 0-10: Customer mentions and benefits over company and features
+	customerFocus      bool // set when the rules version scores Customer Focus
+
 	// Detailed feedback
-	Issues    []string
-	Strengths []string
+	Issues []string
+	// IssueLines maps the index of an issue about one source line, such as a
+	// misplaced heading, to that 1-based line.
+	IssueLines map[int]int
+	Strengths  []string
+	Trace      []ScoreEvent // every point award and deduction, in analyzer order
+
+	// Dismissed are the issues the dismissals sidecar marks as won't fix or
+	// acknowledged, kept out of Issues; DismissedLines maps them to lines
+	// as IssueLines does.
+	Dismissed      []string
+	DismissedLines map[int]int
+}
+
+// Overall score thresholds for the report status bands. Scores below
+// ThresholdNeedsWork have major issues.
+const (
+	ThresholdExcellent = 80
+	ThresholdGood      = 60
+	ThresholdNeedsWork = 40
+)
+
+// deterministicDate is the analysis date of reports for documents with
+// SpecSections.Deterministic set.
+var deterministicDate = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// CategoryScore is one scored dimension of the quality breakdown.
+type CategoryScore struct {
+	Name  string
+	Score int
+	Max   int
+}
+
+// Categories lists the breakdown dimensions in report order. Names match Rule.Category.
+func (b PRQualityBreakdown) Categories() []CategoryScore {
+	categories := []CategoryScore{
+		{Name: "Headline Quality", Score: b.HeadlineScore, Max: 10},
+		{Name: "Newsworthy Hook", Score: b.HookScore, Max: 15},
+		{Name: "Release Date", Score: b.ReleaseDateScore, Max: 5},
+		{Name: "5 Ws Coverage", Score: b.FiveWsScore, Max: 15},
+		{Name: "Credibility", Score: b.CredibilityScore, Max: 10},
+		{Name: "Structure", Score: b.StructureScore, Max: 10},
+		{Name: "Tone & Readability", Score: b.ToneScore, Max: 10},
+		{Name: "Fluff Avoidance", Score: b.FluffScore, Max: 10},
+		{Name: "Quote Quality", Score: b.QuoteScore, Max: 15},
+	}
+	if b.customerFocus {
+		categories = append(categories, CategoryScore{Name: "Customer Focus", Score: b.CustomerFocusScore, Max: 10})
+	}
+	return categories
+}
+
+// AllCategories lists every category of the current rules, with no score,
+// in report order. Breakdowns scored with earlier rules may lack some.
+func AllCategories() []CategoryScore {
+	return PRQualityBreakdown{customerFocus: true}.Categories()
+}
+
+// Categories lists the categories s is scored in under its rules version,
+// with no score, in report order.
+func (s *SpecSections) Categories() []CategoryScore {
+	return PRQualityBreakdown{customerFocus: !s.ScoringRules().less(customerFocusRules)}.Categories()
 }
 
 // GenerateMarkdownReport creates a comprehensive markdown report with scoring table.
@@ -70,21 +199,57 @@ func GenerateMarkdownReport(sections *SpecSections, prScore *PRScore) string {
 	if sections.Title != "" {
 		report.WriteString("**Document:** " + sections.Title + "\n")
 	}
-	report.WriteString("**Analysis Date:** " + time.Now().Format("January 2, 2006") + "\n")
+	date, validator := time.Now(), "pr-faq-validator "+buildinfo.Get().String()
+	if sections.Deterministic {
+		date, validator = deterministicDate, "pr-faq-validator"
+	}
+	report.WriteString("**Analysis Date:** " + date.Format("January 2, 2006") + "\n")
+	report.WriteString("**Validator:** " + validator + "\n")
+	report.WriteString("**Rules:** " + sections.ScoringRules().String() + "\n")
+	if note := prScore.Designation.Note(); note != "" {
+		report.WriteString("**Release:** " + note + "\n")
+	}
+	if sections.Audience != "" && sections.Audience != AudienceGeneral {
+		report.WriteString("**Audience:** " + string(sections.Audience) + "\n")
+	}
+	if sections.Tone != "" && sections.Tone != ToneFormal {
+		report.WriteString("**Tone:** " + string(sections.Tone) + "\n")
+	}
+	if prScore.DocType != "" && prScore.DocType != DocTypeExternal {
+		report.WriteString("**Document Type:** " + string(prScore.DocType))
+		if note := prScore.DocType.WeightNote(); note != "" && prScore.Weights == nil {
+			report.WriteString(" (weighted " + note + ")")
+		}
+		report.WriteString("\n")
+	}
+	if note := weightNote(prScore.Weights); note != "" {
+		report.WriteString("**Category Weights:** " + note + "\n")
+	}
+	if prScore.Severity != nil {
+		report.WriteString("**Scoring:** by finding severity, " + prScore.Severity.Note() + "\n")
+	}
+	if sections.Review.State != "" {
+		report.WriteString("**Review Status:** " + string(sections.Review.State) + "\n")
+	}
+	if contents := ContentSummary(sections.Tables, sections.Figures, len(sections.Appendices)); contents != "" {
+		report.WriteString("**Contents:** " + contents + "\n")
+	}
 	report.WriteString("**Overall Score:** " + fmt.Sprintf("%d/100", prScore.OverallScore) + "\n\n")
 
 	// Executive Summary
 	report.WriteString("## Executive Summary\n\n")
-	if prScore.OverallScore >= 80 {
+	if prScore.OverallScore >= ThresholdExcellent {
 		report.WriteString("🟢 **Excellent** - This press release meets high journalistic standards and is ready for media distribution.\n\n")
-	} else if prScore.OverallScore >= 60 {
+	} else if prScore.OverallScore >= ThresholdGood {
 		report.WriteString("🟡 **Good** - This press release has solid foundations but could benefit from targeted improvements.\n\n")
-	} else if prScore.OverallScore >= 40 {
+	} else if prScore.OverallScore >= ThresholdNeedsWork {
 		report.WriteString("🟠 **Needs Improvement** - This press release requires significant enhancements before media distribution.\n\n")
 	} else {
 		report.WriteString("🔴 **Major Issues** - This press release needs substantial revision to meet professional standards.\n\n")
 	}
 
+	writeSimilar(&report, sections.Similar)
+
 	// Results Table
 	breakdown := prScore.QualityBreakdown
 	report.WriteString("## Scoring Results\n\n")
@@ -134,9 +299,20 @@ func GenerateMarkdownReport(sections *SpecSections, prScore *PRScore) string {
 	report.WriteString(fmt.Sprintf("| └─ Quote Quality | %d | 15 | %s | %s |\n",
 		breakdown.QuoteScore, getScoreStatus(breakdown.QuoteScore, 15), getPriority(breakdown.QuoteScore, 15)))
 
+	// Customer Focus, from rules/v5
+	if breakdown.customerFocus {
+		report.WriteString(fmt.Sprintf("| **Customer Focus** | %d | 10 | %s | %s |\n",
+			breakdown.CustomerFocusScore, getScoreStatus(breakdown.CustomerFocusScore, 10), getPriority(breakdown.CustomerFocusScore, 10)))
+		report.WriteString(fmt.Sprintf("| └─ Customer Focus | %d | 10 | %s | %s |\n",
+			breakdown.CustomerFocusScore, getScoreStatus(breakdown.CustomerFocusScore, 10), getPriority(breakdown.CustomerFocusScore, 10)))
+	}
+
 	// Total
 	report.WriteString(fmt.Sprintf("| **TOTAL SCORE** | **%d** | **100** | %s | - |\n\n",
 		prScore.OverallScore, getOverallStatus(prScore.OverallScore)))
+	writeRadar(&report, breakdown)
+
+	writeReview(&report, sections.Review)
 
 	// Strengths
 	if len(breakdown.Strengths) > 0 {
@@ -149,7 +325,7 @@ func GenerateMarkdownReport(sections *SpecSections, prScore *PRScore) string {
 
 	// Priority Improvements
 	report.WriteString("## 🎯 Priority Improvements\n\n")
-	improvements := getPriorityImprovements(breakdown)
+	improvements := PriorityImprovements(breakdown)
 	if len(improvements) == 0 {
 		report.WriteString("No critical issues identified. Consider the suggestions below for further optimization.\n\n")
 	} else {
@@ -164,25 +340,54 @@ func GenerateMarkdownReport(sections *SpecSections, prScore *PRScore) string {
 		}
 	}
 
-	// All Issues
-	if len(breakdown.Issues) > 0 {
+	// All Issues; the quote placement issues are listed with the quote
+	// analysis, and anti-patterns and claims in sections of their own
+	var issues, placement []string
+	for _, f := range sections.findingsOf(prScore) {
+		issue := f.Message
+		switch {
+		case isQuotePlacementIssue(issue):
+			placement = append(placement, issue)
+		case !isAntiPatternIssue(issue) && !isClaimIssue(issue):
+			issues = append(issues, issue)
+		}
+	}
+	if len(issues) > 0 {
 		report.WriteString("## ⚠️ Detailed Issues to Address\n\n")
-		categoryIssues := categorizeIssues(breakdown.Issues)
-
-		for category, issues := range categoryIssues {
-			report.WriteString("### " + category + "\n\n")
-			for _, issue := range issues {
+		for _, group := range categorizeIssues(issues) {
+			report.WriteString("### " + group.Category + "\n\n")
+			for _, issue := range group.Issues {
 				report.WriteString("- " + issue + "\n")
 			}
 			report.WriteString("\n")
 		}
 	}
 
+	writeDismissed(&report, sections.DismissedFindings())
+
+	if !sections.ScoringRules().less(antiPatternRules) {
+		writeAntiPatterns(&report, sections.undismissedAntiPatterns())
+	}
+	if !sections.ScoringRules().less(claimRules) {
+		writeClaims(&report, sections.Claims())
+	}
+	writeRewrites(&report, sections.Rewrites())
+
 	// Quote Analysis
-	if len(prScore.MetricDetails) > 0 {
+	if len(prScore.MetricDetails) > 0 || len(placement) > 0 {
 		report.WriteString("## 📊 Customer Quote Analysis\n\n")
 		report.WriteString(fmt.Sprintf("**Total Quotes:** %d | **Quotes with Metrics:** %d\n\n",
 			prScore.TotalQuotes, prScore.QuotesWithMetrics))
+		if mix := voiceMixSummary(VoiceMix(prScore.MetricDetails)); mix != "" {
+			report.WriteString("**Voices:** " + mix + "\n\n")
+		}
+		if len(placement) > 0 {
+			report.WriteString("**Placement and Density:**\n")
+			for _, issue := range placement {
+				report.WriteString("- " + issue + "\n")
+			}
+			report.WriteString("\n")
+		}
 
 		for i, detail := range prScore.MetricDetails {
 			score := detail.Score
@@ -193,7 +398,11 @@ func GenerateMarkdownReport(sections *SpecSections, prScore *PRScore) string {
 				scoreEmoji = "🟡"
 			}
 
-			report.WriteString(fmt.Sprintf("### Quote %d %s (%d/10 points)\n\n", i+1, scoreEmoji, score))
+			voice := ""
+			if detail.Voice != "" {
+				voice = ", " + string(detail.Voice)
+			}
+			report.WriteString(fmt.Sprintf("### Quote %d %s (%d/10 points%s)\n\n", i+1, scoreEmoji, score, voice))
 			report.WriteString("> \"" + detail.Quote + "\"\n\n")
 
 			if len(detail.Metrics) > 0 {
@@ -247,12 +456,27 @@ func getPriority(score, maxScore int) string {
 	}
 }
 
+// StatusLabel names the report status band of an overall score: "Ready",
+// "Good", "Needs Work", or "Major Issues".
+func StatusLabel(score int) string {
+	switch {
+	case score >= ThresholdExcellent:
+		return "Ready"
+	case score >= ThresholdGood:
+		return "Good"
+	case score >= ThresholdNeedsWork:
+		return "Needs Work"
+	default:
+		return "Major Issues"
+	}
+}
+
 func getOverallStatus(score int) string {
-	if score >= 80 {
+	if score >= ThresholdExcellent {
 		return "🟢 Ready"
-	} else if score >= 60 {
+	} else if score >= ThresholdGood {
 		return "🟡 Good"
-	} else if score >= 40 {
+	} else if score >= ThresholdNeedsWork {
 		return "🟠 Needs Work"
 	} else {
 		return "🔴 Major Issues"
@@ -266,7 +490,9 @@ type Improvement struct {
 	Steps  []string
 }
 
-func getPriorityImprovements(breakdown PRQualityBreakdown) []Improvement {
+// PriorityImprovements returns remediation steps for every category scoring
+// below its critical threshold.
+func PriorityImprovements(breakdown PRQualityBreakdown) []Improvement {
 	var improvements []Improvement
 
 	// Critical issues (score < 40% of max)
@@ -338,14 +564,32 @@ func getPriorityImprovements(breakdown PRQualityBreakdown) []Improvement {
 	return improvements
 }
 
-func categorizeIssues(issues []string) map[string][]string {
+// issueCategories are the groups of the report's detailed issues, in the
+// order the report lists them.
+var issueCategories = []string{
+	"Headline & Title", "Opening Hook", "5 Ws Coverage", "Customer Evidence",
+	"Customer Focus", "Professional Tone", "Document Structure", "Writing Quality", "General",
+}
+
+// issueGroup is the issues of one of the report's issueCategories.
+type issueGroup struct {
+	Category string
+	Issues   []string
+}
+
+// categorizeIssues groups issues under the issueCategories their wording
+// matches, in issueCategories order, keeping the order of issues within each
+// group. Categories without issues are left out.
+func categorizeIssues(issues []string) []issueGroup {
 	categories := make(map[string][]string)
 
 	for _, issue := range issues {
 		category := "General"
 		issueLower := strings.ToLower(issue)
 
-		if strings.Contains(issueLower, "headline") || strings.Contains(issueLower, "title") {
+		if ruleForMessage(issue).Category == "Customer Focus" {
+			category = "Customer Focus"
+		} else if strings.Contains(issueLower, "headline") || strings.Contains(issueLower, "title") {
 			category = "Headline & Title"
 		} else if strings.Contains(issueLower, "hook") || strings.Contains(issueLower, "opening") || strings.Contains(issueLower, "first sentence") {
 			category = "Opening Hook"
@@ -364,7 +608,13 @@ func categorizeIssues(issues []string) map[string][]string {
 		categories[category] = append(categories[category], issue)
 	}
 
-	return categories
+	var groups []issueGroup
+	for _, category := range issueCategories {
+		if len(categories[category]) > 0 {
+			groups = append(groups, issueGroup{category, categories[category]})
+		}
+	}
+	return groups
 }
 
 // isPressReleaseContent analyzes content to determine if it looks like a press release.
@@ -372,14 +622,8 @@ func isPressReleaseContent(content string) bool {
 	content = strings.ToLower(content)
 
 	// Check for date patterns commonly found in press releases
-	datePatterns := []string{
-		`\b(?:jan|feb|mar|apr|may|jun|jul|aug|sep|oct|nov|dec)[a-z]*\s+\d{1,2},?\s+\d{4}`,
-		`\b\d{1,2}/\d{1,2}/\d{4}`,
-		`\b\d{4}-\d{1,2}-\d{1,2}`,
-	}
-
-	for _, pattern := range datePatterns {
-		if matched, _ := regexp.MatchString(pattern, content); matched {
+	for _, re := range pressReleaseDatePatterns {
+		if re.MatchString(content) {
 			// Also check for announcement language
 			announceWords := []string{"announce", "today", "excited", "pleased", "proud", "launch", "introduce", "unveil", "reveal"}
 			for _, word := range announceWords {
@@ -393,7 +637,7 @@ func isPressReleaseContent(content string) bool {
 	// Check for press release structure indicators
 	prIndicators := []string{
 		"business wire", "pr newswire", "press release",
-		"for immediate release", "contact:", "about ",
+		"for immediate release", "embargoed until", "contact:", "about ",
 		"announces", "today announced", "is excited to announce",
 		"is pleased to announce", "is proud to announce",
 	}
@@ -432,36 +676,15 @@ func isNumberedFAQQuestion(header string) bool {
 
 	// Check for patterns like "1. Question here", "2. Another question", etc.
 	// Also handle variations like "1) Question" or "Q1. Question"
-	patterns := []string{
-		`^\d+\.\s+.+`,      // "1. Question here"
-		`^\d+\)\s+.+`,      // "1) Question here"
-		`^Q\d+[\.\)]\s+.+`, // "Q1. Question" or "Q1) Question"
-		`^Question\s+\d+`,  // "Question 1"
-	}
-
-	for _, pattern := range patterns {
-		if matched, _ := regexp.MatchString(pattern, header); matched {
-			return true
-		}
-	}
-
-	return false
+	return matchesAny(numberedFAQPatterns, header)
 }
 
 // extractQuotes finds customer quotes in press release content.
 func extractQuotes(content string) []string {
 	var quotes []string
 
-	// Look for quoted text patterns - use Unicode code points for curly quotes
-	quotePatterns := []string{
-		`"(.+?)"`,           // Standard double quotes
-		"\u201C(.+?)\u201D", // Curly quotes (U+201C and U+201D)
-		`'(.+?)'`,           // Single quotes
-		"\u2018(.+?)\u2019", // Curly single quotes (U+2018 and U+2019)
-	}
-
-	for _, pattern := range quotePatterns {
-		re := regexp.MustCompile(`(?s)` + pattern) // (?s) enables multiline mode
+	// Look for quoted text patterns (straight, curly, and single quotes)
+	for _, re := range quotePatterns {
 		matches := re.FindAllStringSubmatch(content, -1)
 		for _, match := range matches {
 			if len(match) > 1 {
@@ -482,14 +705,7 @@ func detectMetricsInText(text string) ([]string, []string) {
 	var metricTypes []string
 
 	// Percentage patterns
-	percentagePatterns := []string{
-		`\d+(?:\.\d+)?%`,                       // 50%, 12.5%
-		`\d+(?:\.\d+)?\s*percent`,              // 50 percent
-		`\d+(?:\.\d+)?\s*percentage\s*points?`, // 12 percentage points
-	}
-
-	for _, pattern := range percentagePatterns {
-		re := regexp.MustCompile(`(?i)` + pattern)
+	for _, re := range percentagePatterns {
 		matches := re.FindAllString(text, -1)
 		for _, match := range matches {
 			metrics = append(metrics, match)
@@ -498,14 +714,7 @@ func detectMetricsInText(text string) ([]string, []string) {
 	}
 
 	// Ratio and multiplier patterns
-	ratioPatterns := []string{
-		`\d+x`,                        // 2x, 10x improvement
-		`\d+(?:\.\d+)?:\d+(?:\.\d+)?`, // 2:1, 3.5:1 ratios
-		`\d+(?:\.\d+)?\s*times`,       // 3 times faster
-	}
-
-	for _, pattern := range ratioPatterns {
-		re := regexp.MustCompile(`(?i)` + pattern)
+	for _, re := range ratioPatterns {
 		matches := re.FindAllString(text, -1)
 		for _, match := range matches {
 			metrics = append(metrics, match)
@@ -514,15 +723,7 @@ func detectMetricsInText(text string) ([]string, []string) {
 	}
 
 	// Absolute number patterns with business context
-	numberPatterns := []string{
-		`\$\d+(?:,\d{3})*(?:\.\d+)?(?:\s*(?:million|billion|thousand|k|m|b))?`,        // $1.5M, $500K
-		`\d+(?:,\d{3})*(?:\.\d+)?\s*(?:milliseconds?|seconds?|minutes?|hours?|days?)`, // 50ms, 2.5 seconds
-		`\d+(?:,\d{3})*(?:\.\d+)?\s*(?:customers?|users?|transactions?)`,              // 1000 customers
-		`\d+(?:,\d{3})*(?:\.\d+)?\s*(?:basis\s*points?)`,                              // 200 basis points
-	}
-
-	for _, pattern := range numberPatterns {
-		re := regexp.MustCompile(`(?i)` + pattern)
+	for _, re := range absoluteNumberPatterns {
 		matches := re.FindAllString(text, -1)
 		for _, match := range matches {
 			metrics = append(metrics, match)
@@ -531,14 +732,7 @@ func detectMetricsInText(text string) ([]string, []string) {
 	}
 
 	// NPS, score-based metrics
-	scorePatterns := []string{
-		`nps\s*(?:score\s*)?(?:by\s*)?\d+(?:\.\d+)?\s*points?`,                 // NPS by 12 points
-		`\d+(?:\.\d+)?\s*(?:point|points)\s*(?:improvement|increase|decrease)`, // 12 points improvement
-		`score\s*(?:of\s*)?\d+(?:\.\d+)?`,                                      // score of 9.2
-	}
-
-	for _, pattern := range scorePatterns {
-		re := regexp.MustCompile(`(?i)` + pattern)
+	for _, re := range scoreMetricPatterns {
 		matches := re.FindAllString(text, -1)
 		for _, match := range matches {
 			metrics = append(metrics, match)
@@ -629,6 +823,7 @@ func analyzePRQuotes(prContent string) *PRScore {
 		})
 	}
 
+	classifyQuotes(prContent, score.MetricDetails)
 	score.QuotesWithMetrics = quotesWithMetrics
 
 	// Calculate overall score (0-100)
@@ -669,99 +864,80 @@ func analyzePRQuotes(prContent string) *PRScore {
 
 // analyzeHeadlineQuality evaluates headline effectiveness.
 func analyzeHeadlineQuality(title string) (int, []string, []string) {
-	var issues []string
-	var strengths []string
-	score := 0
+	return scoreHeadline(title).result()
+}
+
+// scoreHeadline is analyzeHeadlineQuality with a trace of the points awarded.
+func scoreHeadline(title string) analysis {
+	a := analysis{category: "Headline Quality"}
 
 	if title == "" {
-		issues = append(issues, "Missing headline/title")
-		return 0, issues, strengths
+		a.issue("Missing headline/title")
+		return a
 	}
 
 	// Length analysis (ideal: 6-12 words, 50-80 characters)
 	words := len(strings.Fields(title))
 	chars := len(title)
+	length := fmt.Sprintf("%d chars, %d words", chars, words)
 
 	if chars >= 50 && chars <= 80 && words >= 6 && words <= 12 {
-		score += 3
-		strengths = append(strengths, "Headline length is optimal")
+		a.award(3, "headline length optimal", length)
+		a.strength("Headline length is optimal")
 	} else if chars > 100 || words > 15 {
-		issues = append(issues, "Headline too long (reduces scannability)")
+		a.issue("Headline too long (reduces scannability)")
 	} else if chars < 30 || words < 4 {
-		issues = append(issues, "Headline too short (lacks specificity)")
+		a.issue("Headline too short (lacks specificity)")
 	} else {
-		score += 1
+		a.award(1, "headline length acceptable", length)
 	}
 
 	// Active voice and strong verbs
 	strongVerbs := []string{"launches", "announces", "introduces", "unveils", "delivers", "creates", "develops", "achieves", "reduces", "increases", "improves", "transforms"}
 	titleLower := strings.ToLower(title)
 
-	hasStrongVerb := false
-	for _, verb := range strongVerbs {
-		if strings.Contains(titleLower, verb) {
-			hasStrongVerb = true
-			break
-		}
-	}
-
-	if hasStrongVerb {
-		score += 2
-		strengths = append(strengths, "Uses strong action verbs")
+	if verb := firstContained(titleLower, strongVerbs); verb != "" {
+		a.award(2, "strong action verb", verb)
+		a.strength("Uses strong action verbs")
 	} else {
-		issues = append(issues, "Consider using stronger action verbs")
+		a.issue("Consider using stronger action verbs")
 	}
 
 	// Specificity check (numbers, percentages, specific outcomes)
-	hasSpecifics := false
-	specificityPatterns := []string{`\d+%`, `\d+x`, `\d+(?:,\d{3})*`, `\$\d+`, `by \d+`, `up to \d+`}
-
-	for _, pattern := range specificityPatterns {
-		if matched, _ := regexp.MatchString(pattern, title); matched {
-			hasSpecifics = true
-			break
-		}
-	}
-
-	if hasSpecifics {
-		score += 3
-		strengths = append(strengths, "Includes specific metrics or outcomes")
+	if specific := firstMatch(headlineSpecificityPatterns, title); specific != "" {
+		a.award(3, "specific metric or outcome", specific)
+		a.strength("Includes specific metrics or outcomes")
 	} else {
-		issues = append(issues, "Consider adding specific metrics to the headline")
+		a.issue("Consider adding specific metrics to the headline")
 	}
 
 	// Avoid generic/weak language
 	weakLanguage := []string{"new", "innovative", "cutting-edge", "revolutionary", "world-class", "leading", "comprehensive", "robust"}
-	hasWeakLanguage := false
-
-	for _, weak := range weakLanguage {
-		if strings.Contains(titleLower, weak) {
-			hasWeakLanguage = true
-			break
-		}
-	}
 
-	if hasWeakLanguage {
-		issues = append(issues, "Avoid generic marketing language in headlines")
+	if firstContained(titleLower, weakLanguage) != "" {
+		a.issue("Avoid generic marketing language in headlines")
 	} else {
-		score += 2
-		strengths = append(strengths, "Avoids generic marketing language")
+		a.award(2, "no generic marketing language", "")
+		a.strength("Avoids generic marketing language")
 	}
 
-	return score, issues, strengths
+	return a
 }
 
 // analyzeNewswortyHook evaluates the opening for immediate relevance and impact.
 func analyzeNewswortyHook(content string) (int, []string, []string) {
-	var issues []string
-	var strengths []string
-	score := 0
+	return scoreHook(content).result()
+}
+
+// scoreHook is analyzeNewswortyHook with a trace of the points awarded.
+func scoreHook(content string) analysis {
+	a := analysis{category: "Newsworthy Hook"}
 
 	// Get first paragraph (hook)
 	paragraphs := strings.Split(content, "\n\n")
 	if len(paragraphs) == 0 {
-		issues = append(issues, "No content to analyze")
-		return 0, issues, strengths
+		a.issue("No content to analyze")
+		return a
 	}
 
 	hook := strings.TrimSpace(paragraphs[0])
@@ -770,64 +946,38 @@ func analyzeNewswortyHook(content string) (int, []string, []string) {
 	}
 
 	if hook == "" {
-		issues = append(issues, "Missing opening hook")
-		return 0, issues, strengths
+		a.issue("Missing opening hook")
+		return a
 	}
 
 	hookLower := strings.ToLower(hook)
 
 	// Check for timeliness indicators
 	timelinessWords := []string{"today", "this week", "announces", "launched", "released", "unveiled", "now available"}
-	hasTimeliness := false
 
-	for _, word := range timelinessWords {
-		if strings.Contains(hookLower, word) {
-			hasTimeliness = true
-			break
-		}
-	}
-
-	if hasTimeliness {
-		score += 3
-		strengths = append(strengths, "Opens with timely announcement")
+	if word := firstContained(hookLower, timelinessWords); word != "" {
+		a.award(3, "timely announcement", word)
+		a.strength("Opens with timely announcement")
 	} else {
-		issues = append(issues, "Hook lacks immediate timeliness")
+		a.issue("Hook lacks immediate timeliness")
 	}
 
 	// Check for specificity (metrics, outcomes, concrete details)
-	specificityIndicators := []string{`\d+%`, `\d+x`, `cuts .+ by`, `improves .+ by`, `reduces .+ by`, `increases .+ by`}
-	hasSpecificity := false
-
-	for _, pattern := range specificityIndicators {
-		if matched, _ := regexp.MatchString(`(?i)`+pattern, hook); matched {
-			hasSpecificity = true
-			break
-		}
-	}
-
-	if hasSpecificity {
-		score += 4
-		strengths = append(strengths, "Hook includes specific, measurable outcomes")
+	if specific := firstMatch(hookSpecificityPatterns, hook); specific != "" {
+		a.award(4, "specific, measurable outcome", specific)
+		a.strength("Hook includes specific, measurable outcomes")
 	} else {
-		issues = append(issues, "Hook lacks specific metrics or outcomes")
+		a.issue("Hook lacks specific metrics or outcomes")
 	}
 
 	// Check for industry relevance/pain point addressing
 	problemWords := []string{"solves", "addresses", "tackles", "eliminates", "reduces", "improves", "streamlines", "automates"}
-	addressesProblem := false
 
-	for _, word := range problemWords {
-		if strings.Contains(hookLower, word) {
-			addressesProblem = true
-			break
-		}
-	}
-
-	if addressesProblem {
-		score += 3
-		strengths = append(strengths, "Addresses clear problem or improvement")
+	if word := firstContained(hookLower, problemWords); word != "" {
+		a.award(3, "addresses a problem", word)
+		a.strength("Addresses clear problem or improvement")
 	} else {
-		issues = append(issues, "Hook doesn't clearly address a problem or need")
+		a.issue("Hook doesn't clearly address a problem or need")
 	}
 
 	// Check for company/product clarity (who is doing what)
@@ -836,40 +986,35 @@ func analyzeNewswortyHook(content string) (int, []string, []string) {
 		firstSentence := sentences[0]
 		// Should mention company and action
 		if strings.Contains(firstSentence, ",") && (strings.Contains(strings.ToLower(firstSentence), "announce") || strings.Contains(strings.ToLower(firstSentence), "launch")) {
-			score += 2
-			strengths = append(strengths, "Clear company identification and action")
+			a.award(2, "first sentence names who and what", "")
+			a.strength("Clear company identification and action")
 		} else {
-			issues = append(issues, "First sentence should clearly identify who is doing what")
+			a.issue("First sentence should clearly identify who is doing what")
 		}
 	}
 
 	// Avoid fluff language in hook
 	fluffWords := []string{"excited", "pleased", "proud", "thrilled", "delighted", "revolutionary", "groundbreaking", "cutting-edge"}
-	hasFluff := false
-
-	for _, fluff := range fluffWords {
-		if strings.Contains(hookLower, fluff) {
-			hasFluff = true
-			break
-		}
-	}
 
-	if hasFluff {
-		issues = append(issues, "Hook contains marketing fluff - focus on concrete value")
-		score -= 1
+	if fluff := firstContained(hookLower, fluffWords); fluff != "" {
+		a.issue("Hook contains marketing fluff - focus on concrete value")
+		a.award(-1, "marketing fluff in hook", fluff)
 	} else {
-		score += 3
-		strengths = append(strengths, "Hook avoids marketing fluff")
+		a.award(3, "no marketing fluff", "")
+		a.strength("Hook avoids marketing fluff")
 	}
 
-	return score, issues, strengths
+	return a
 }
 
 // analyzeFiveWs checks coverage of who, what, when, where, why.
 func analyzeFiveWs(content string) (int, []string, []string) {
-	var issues []string
-	var strengths []string
-	score := 0
+	return scoreFiveWs(content).result()
+}
+
+// scoreFiveWs is analyzeFiveWs with a trace of the points awarded.
+func scoreFiveWs(content string) analysis {
+	a := analysis{category: "5 Ws Coverage"}
 
 	// Get first 2-3 paragraphs for analysis
 	paragraphs := strings.Split(content, "\n\n")
@@ -880,132 +1025,104 @@ func analyzeFiveWs(content string) (int, []string, []string) {
 	leadContentLower := strings.ToLower(leadContent)
 
 	// WHO: Company/organization clearly identified
-	companyPatterns := []string{`\b[A-Z][a-z]+\s+(?:Inc|Corp|Company|LLC|Ltd)`, `[A-Z][a-zA-Z]+\s+announced`, `[A-Z][a-zA-Z]+\s+today`}
-	hasWho := false
-
-	for _, pattern := range companyPatterns {
-		if matched, _ := regexp.MatchString(pattern, leadContent); matched {
-			hasWho = true
-			break
-		}
-	}
-
-	if hasWho {
-		score += 3
-		strengths = append(strengths, "Clearly identifies WHO (company/organization)")
+	if who := firstMatch(companyPatterns, leadContent); who != "" {
+		a.award(3, "WHO identified", who)
+		a.strength("Clearly identifies WHO (company/organization)")
 	} else {
-		issues = append(issues, "WHO: Company/organization not clearly identified in lead")
+		a.issue("WHO: Company/organization not clearly identified in lead")
 	}
 
 	// WHAT: Product/service/action clearly described
 	actionWords := []string{"announces", "launches", "introduces", "unveils", "releases", "develops", "creates"}
-	hasWhat := false
-
-	for _, action := range actionWords {
-		if strings.Contains(leadContentLower, action) {
-			hasWhat = true
-			break
-		}
-	}
 
-	if hasWhat {
-		score += 3
-		strengths = append(strengths, "Clearly describes WHAT (action/product/service)")
+	if what := firstContained(leadContentLower, actionWords); what != "" {
+		a.award(3, "WHAT described", what)
+		a.strength("Clearly describes WHAT (action/product/service)")
 	} else {
-		issues = append(issues, "WHAT: Action or offering not clearly described")
+		a.issue("WHAT: Action or offering not clearly described")
 	}
 
 	// WHEN: Timing/date mentioned
-	timePatterns := []string{`\b(?:jan|feb|mar|apr|may|jun|jul|aug|sep|oct|nov|dec)[a-z]*\s+\d`, `today`, `this week`, `this month`, `\d{4}`, `yesterday`, `recently`}
-	hasWhen := false
-
-	for _, pattern := range timePatterns {
-		if matched, _ := regexp.MatchString(`(?i)`+pattern, leadContent); matched {
-			hasWhen = true
-			break
-		}
-	}
-
-	if hasWhen {
-		score += 3
-		strengths = append(strengths, "Includes WHEN (timing/date)")
+	if when := firstMatch(timePatterns, leadContent); when != "" {
+		a.award(3, "WHEN given", when)
+		a.strength("Includes WHEN (timing/date)")
 	} else {
-		issues = append(issues, "WHEN: Timing or date not specified")
+		a.issue("WHEN: Timing or date not specified")
 	}
 
-	// WHERE: Location/market mentioned
-	wherePatterns := []string{`[A-Z][a-z]+,\s+[A-Z]{2}`, `[A-Z][a-z]+\s+\([A-Z][a-z]+\s+Wire\)`, `headquarters`, `market`, `globally`, `worldwide`, `nation`}
-	hasWhere := false
-
-	for _, pattern := range wherePatterns {
-		if matched, _ := regexp.MatchString(pattern, leadContent); matched {
-			hasWhere = true
-			break
-		}
-	}
-
-	if hasWhere {
-		score += 2
-		strengths = append(strengths, "Mentions WHERE (location/market)")
+	// WHERE: Location/market mentioned, preferably in the dateline
+	if dateline := ParseDateline(content); dateline.Found() {
+		a.award(2, "WHERE given", dateline.Normalized)
+		a.strength("Mentions WHERE (location/market)")
+	} else if where := firstMatch(wherePatterns, leadContent); where != "" {
+		a.award(2, "WHERE given", where)
+		a.strength("Mentions WHERE (location/market)")
 	} else {
-		issues = append(issues, "WHERE: Location or market context could be clearer")
+		a.issue("WHERE: Location or market context could be clearer")
 	}
 
 	// WHY: Reason/problem/benefit explained
 	whyIndicators := []string{"because", "to help", "to address", "to solve", "to improve", "to reduce", "to increase", "enables", "allows", "provides"}
-	hasWhy := false
 
-	for _, indicator := range whyIndicators {
-		if strings.Contains(leadContentLower, indicator) {
-			hasWhy = true
-			break
-		}
-	}
-
-	if hasWhy {
-		score += 4
-		strengths = append(strengths, "Explains WHY (reason/benefit/problem solved)")
+	if why := firstContained(leadContentLower, whyIndicators); why != "" {
+		a.award(4, "WHY explained", why)
+		a.strength("Explains WHY (reason/benefit/problem solved)")
 	} else {
-		issues = append(issues, "WHY: Reason or benefit not clearly explained")
+		a.issue("WHY: Reason or benefit not clearly explained")
 	}
 
-	return score, issues, strengths
+	return a
 }
 
 // analyzeToneAndReadability evaluates professional tone and accessibility.
 func analyzeToneAndReadability(content string) (int, []string, []string) {
-	var issues []string
-	var strengths []string
-	score := 5 // Start with neutral score
+	return scoreTone(content, AudienceGeneral, ToneFormal).result()
+}
+
+// scoreTone is analyzeToneAndReadability with a trace of the points awarded,
+// using the jargon list and sentence-length limits of the audience, adjusted
+// for the target tone.
+func scoreTone(content string, audience Audience, target Tone) analysis {
+	profile := audience.profile()
+	tone := target.profile()
+	profile.idealWords[0] += tone.lengthOffset
+	profile.idealWords[1] += tone.lengthOffset
+	profile.longWords += tone.lengthOffset
+	a := analysis{category: "Tone & Readability"}
+	if tone.register == nil {
+		a.award(5, "neutral starting score", "") // Start with neutral score
+	} else {
+		a.award(4, "neutral starting score", "the register check awards the fifth point")
+	}
 
 	contentLower := strings.ToLower(content)
 
-	// Check sentence length (ideal: 15-20 words average)
-	sentences := regexp.MustCompile(`[.!?]+`).Split(content, -1)
+	// Check sentence length (ideal: 15-20 words average for a general audience)
+	sentences := sentenceTerminatorPattern.Split(content, -1)
 	totalWords := 0
 	longSentences := 0
 
 	for _, sentence := range sentences {
 		words := len(strings.Fields(strings.TrimSpace(sentence)))
 		totalWords += words
-		if words > 25 {
+		if words > profile.longWords {
 			longSentences++
 		}
 	}
 
 	if len(sentences) > 1 {
 		avgWordsPerSentence := totalWords / len(sentences)
-		if avgWordsPerSentence >= 15 && avgWordsPerSentence <= 20 {
-			score += 2
-			strengths = append(strengths, "Good sentence length for readability")
-		} else if avgWordsPerSentence > 25 {
-			issues = append(issues, "Sentences too long - break into shorter, clearer statements")
+		if avgWordsPerSentence >= profile.idealWords[0] && avgWordsPerSentence <= profile.idealWords[1] {
+			a.award(2, "sentence length readable", fmt.Sprintf("%d words per sentence", avgWordsPerSentence))
+			a.strength("Good sentence length for readability")
+		} else if avgWordsPerSentence > profile.longWords {
+			a.issue("Sentences too long - break into shorter, clearer statements")
 		}
 	}
 
 	if longSentences > len(sentences)/3 {
-		issues = append(issues, "Too many overly long sentences - impacts readability")
-		score -= 1
+		a.issue("Too many overly long sentences - impacts readability")
+		a.award(-1, fmt.Sprintf("too many sentences over %d words", profile.longWords), fmt.Sprintf("%d of %d", longSentences, len(sentences)))
 	}
 
 	// Check for passive voice overuse
@@ -1016,168 +1133,164 @@ func analyzeToneAndReadability(content string) (int, []string, []string) {
 		passiveCount += strings.Count(contentLower, passive)
 	}
 
-	if passiveCount > len(sentences)/4 {
-		issues = append(issues, "Overuse of passive voice - use active voice for clarity")
-		score -= 1
+	passive := fmt.Sprintf("%d passive constructions", passiveCount)
+	if passiveCount > len(sentences)/tone.passiveShare {
+		a.issue("Overuse of passive voice - use active voice for clarity")
+		a.award(-1, "passive voice overused", passive)
 	} else {
-		score += 1
-		strengths = append(strengths, "Good use of active voice")
+		a.award(1, "active voice", passive)
+		a.strength("Good use of active voice")
 	}
 
 	// Check for jargon density
-	techJargon := []string{"synergies", "paradigm", "leverage", "ecosystem", "scalable", "turnkey", "best-in-class", "enterprise-grade"}
 	jargonCount := 0
 
-	for _, jargon := range techJargon {
-		if strings.Contains(contentLower, jargon) {
+	for _, jargon := range profile.jargon {
+		if containsTerm(contentLower, jargon) {
 			jargonCount++
 		}
 	}
 
-	if jargonCount > 3 {
-		issues = append(issues, "Too much technical jargon - write for broader audience")
-		score -= 1
+	if jargonCount > profile.maxJargon {
+		a.issue("Too much technical jargon - write for broader audience")
+		a.award(-1, "too much jargon", fmt.Sprintf("%d jargon terms", jargonCount))
 	} else if jargonCount == 0 {
-		score += 1
-		strengths = append(strengths, "Avoids unnecessary jargon")
+		a.award(1, "no jargon", "")
+		a.strength("Avoids unnecessary jargon")
 	}
 
 	// Check for quotation variety and quality
 	quotes := extractQuotes(content)
 	executiveFluff := []string{"excited", "pleased", "proud", "thrilled", "honored", "delighted"}
-	fluffyQuotes := 0
-
-	for _, quote := range quotes {
-		quoteLower := strings.ToLower(quote)
-		for _, fluff := range executiveFluff {
-			if strings.Contains(quoteLower, fluff) {
-				fluffyQuotes++
-				break
-			}
-		}
-	}
+	fluffyQuotes := countQuotesContaining(quotes, executiveFluff)
 
 	if len(quotes) > 0 {
 		if fluffyQuotes < len(quotes)/2 {
-			score += 1
-			strengths = append(strengths, "Quotes provide substantive insight")
+			a.award(1, "substantive quotes", fmt.Sprintf("%d of %d quotes are generic", fluffyQuotes, len(quotes)))
+			a.strength("Quotes provide substantive insight")
 		} else {
-			issues = append(issues, "Too many generic 'excited' quotes - add substantive insights")
+			a.issue("Too many generic 'excited' quotes - add substantive insights")
 		}
 	}
 
-	return score, issues, strengths
+	if tone.register != nil {
+		tone.register(content, contentLower, &a)
+	}
+	return a
 }
 
 // analyzeMarketingFluff detects and penalizes excessive promotional language.
 func analyzeMarketingFluff(content string) (int, []string, []string) {
-	var issues []string
-	var strengths []string
-	score := 10 // Start with full points, deduct for fluff
+	return scoreFluff(content).result()
+}
+
+// hypeWords are the hyperbolic adjectives Fluff Avoidance deducts for.
+var hypeWords = []string{
+	"revolutionary", "groundbreaking", "cutting-edge", "world-class",
+	"industry-leading", "best-in-class", "state-of-the-art", "next-generation",
+	"breakthrough", "game-changing", "disruptive", "unprecedented",
+	"ultimate", "premier", "superior", "exceptional", "outstanding",
+}
+
+// scoreFluff is analyzeMarketingFluff with a trace of the points deducted.
+func scoreFluff(content string) analysis {
+	a := analysis{category: "Fluff Avoidance"}
+	a.award(10, "full marks before deductions", "") // Start with full points, deduct for fluff
 
 	contentLower := strings.ToLower(content)
 
 	// Hyperbolic adjectives
-	hypeWords := []string{
-		"revolutionary", "groundbreaking", "cutting-edge", "world-class",
-		"industry-leading", "best-in-class", "state-of-the-art", "next-generation",
-		"breakthrough", "game-changing", "disruptive", "unprecedented",
-		"ultimate", "premier", "superior", "exceptional", "outstanding",
-	}
 
-	hypeCount := 0
-	for _, hype := range hypeWords {
-		if strings.Contains(contentLower, hype) {
-			hypeCount++
+	var hype []string
+	for _, word := range hypeWords {
+		if strings.Contains(contentLower, word) {
+			hype = append(hype, word)
 		}
 	}
 
-	if hypeCount > 3 {
-		score -= 3
-		issues = append(issues, "Excessive hyperbolic language reduces credibility")
-	} else if hypeCount > 1 {
-		score -= 1
-		issues = append(issues, "Consider reducing promotional adjectives")
-	} else if hypeCount == 0 {
-		strengths = append(strengths, "Avoids hyperbolic marketing language")
+	if len(hype) > 3 {
+		a.award(-3, "excessive hyperbole", strings.Join(hype, ", "))
+		a.issue("Excessive hyperbolic language reduces credibility")
+	} else if len(hype) > 1 {
+		a.award(-1, "promotional adjectives", strings.Join(hype, ", "))
+		a.issue("Consider reducing promotional adjectives")
+	} else if len(hype) == 0 {
+		a.strength("Avoids hyperbolic marketing language")
 	}
 
 	// Emotional fluff in quotes
 	emotionalFluff := []string{"excited", "thrilled", "delighted", "pleased", "proud", "honored"}
 	quotes := extractQuotes(content)
-	fluffyQuotes := 0
-
-	for _, quote := range quotes {
-		quoteLower := strings.ToLower(quote)
-		for _, fluff := range emotionalFluff {
-			if strings.Contains(quoteLower, fluff) {
-				fluffyQuotes++
-				break
-			}
-		}
-	}
+	fluffyQuotes := countQuotesContaining(quotes, emotionalFluff)
 
 	if len(quotes) > 0 {
 		fluffRatio := float64(fluffyQuotes) / float64(len(quotes))
+		emotional := fmt.Sprintf("%d of %d quotes are emotional", fluffyQuotes, len(quotes))
 		if fluffRatio > 0.7 {
-			score -= 3
-			issues = append(issues, "Most quotes are generic emotional responses")
+			a.award(-3, "mostly emotional quotes", emotional)
+			a.issue("Most quotes are generic emotional responses")
 		} else if fluffRatio > 0.3 {
-			score -= 1
-			issues = append(issues, "Some quotes lack substantive content")
+			a.award(-1, "some emotional quotes", emotional)
+			a.issue("Some quotes lack substantive content")
 		} else {
-			strengths = append(strengths, "Quotes provide meaningful insights")
+			a.strength("Quotes provide meaningful insights")
 		}
 	}
 
 	// Vague benefits without proof
 	vagueTerms := []string{"comprehensive solution", "robust platform", "seamless integration", "enhanced productivity", "improved efficiency", "optimal performance"}
-	vagueCount := 0
 
-	for _, vague := range vagueTerms {
-		if strings.Contains(contentLower, vague) {
-			vagueCount++
+	var vague []string
+	for _, term := range vagueTerms {
+		if strings.Contains(contentLower, term) {
+			vague = append(vague, term)
 		}
 	}
 
-	if vagueCount > 2 {
-		score -= 2
-		issues = append(issues, "Vague benefit claims need specific proof points")
-	} else if vagueCount == 0 {
-		strengths = append(strengths, "Avoids vague, unsubstantiated claims")
+	if len(vague) > 2 {
+		a.award(-2, "vague benefit claims", strings.Join(vague, ", "))
+		a.issue("Vague benefit claims need specific proof points")
+	} else if len(vague) == 0 {
+		a.strength("Avoids vague, unsubstantiated claims")
 	}
 
 	// Check for proof backing claims
-	proofIndicators := []string{`\d+%`, `\d+x`, `study shows`, `research indicates`, `data reveals`, `according to`, `measured`, `demonstrated`}
-	hasProof := false
-
-	for _, pattern := range proofIndicators {
-		if matched, _ := regexp.MatchString(`(?i)`+pattern, content); matched {
-			hasProof = true
-			break
-		}
-	}
-
-	if hasProof {
-		strengths = append(strengths, "Backs claims with data or evidence")
+	if matchesAny(proofPatterns, content) {
+		a.strength("Backs claims with data or evidence")
 	} else {
-		score -= 1
-		issues = append(issues, "Claims would be stronger with supporting data")
+		a.award(-1, "no supporting data", "")
+		a.issue("Claims would be stronger with supporting data")
 	}
 
-	return score, issues, strengths
+	return a
+}
+
+// countQuotesContaining counts the quotes that contain any of words.
+func countQuotesContaining(quotes, words []string) int {
+	count := 0
+	for _, quote := range quotes {
+		if firstContained(strings.ToLower(quote), words) != "" {
+			count++
+		}
+	}
+	return count
 }
 
 // analyzeStructure evaluates inverted pyramid and logical flow.
 func analyzeStructure(content string) (int, []string, []string) {
-	var issues []string
-	var strengths []string
-	score := 0
+	return scoreStructure(content, true).result()
+}
+
+// scoreStructure is analyzeStructure with a trace of the points awarded.
+// Without boilerplate, the company boilerplate points are awarded unchecked.
+func scoreStructure(content string, boilerplate bool) analysis {
+	a := analysis{category: "Structure"}
 
 	paragraphs := strings.Split(content, "\n\n")
 	if len(paragraphs) < 3 {
-		issues = append(issues, "Press release too short for proper structure analysis")
-		return 2, issues, strengths
+		a.issue("Press release too short for proper structure analysis")
+		a.award(2, "fixed score for fewer than 3 paragraphs", fmt.Sprintf("%d paragraphs", len(paragraphs)))
+		return a
 	}
 
 	// First paragraph should contain key info (lead)
@@ -1189,12 +1302,12 @@ func analyzeStructure(content string) (int, []string, []string) {
 	// Lead should be substantial but not too long
 	leadWords := len(strings.Fields(firstPara))
 	if leadWords >= 25 && leadWords <= 50 {
-		score += 3
-		strengths = append(strengths, "Lead paragraph has appropriate length")
+		a.award(3, "lead paragraph length", fmt.Sprintf("%d words", leadWords))
+		a.strength("Lead paragraph has appropriate length")
 	} else if leadWords > 60 {
-		issues = append(issues, "Lead paragraph too long - should be concise")
+		a.issue("Lead paragraph too long - should be concise")
 	} else if leadWords < 20 {
-		issues = append(issues, "Lead paragraph too brief - lacks key details")
+		a.issue("Lead paragraph too brief - lacks key details")
 	}
 
 	// Check for supporting details in middle paragraphs
@@ -1212,70 +1325,51 @@ func analyzeStructure(content string) (int, []string, []string) {
 	if len(middleContent) > 0 {
 		// Should contain supporting details, context, or additional quotes
 		supportingElements := []string{"according to", "the company", "additionally", "furthermore", "the solution", "customers"}
-		hasSupporting := false
 
-		for _, element := range supportingElements {
-			if strings.Contains(strings.ToLower(middleContent), element) {
-				hasSupporting = true
-				break
-			}
-		}
-
-		if hasSupporting {
-			score += 3
-			strengths = append(strengths, "Includes supporting details and context")
+		if element := firstContained(strings.ToLower(middleContent), supportingElements); element != "" {
+			a.award(3, "supporting details", element)
+			a.strength("Includes supporting details and context")
 		} else {
-			issues = append(issues, "Middle content lacks supporting details")
+			a.issue("Middle content lacks supporting details")
 		}
 	}
 
 	// Last paragraph should contain boilerplate (about company)
-	if len(paragraphs) >= 3 {
+	if len(paragraphs) >= 3 && !boilerplate {
+		a.award(2, "company boilerplate not required", "")
+	} else if len(paragraphs) >= 3 {
 		lastPara := strings.ToLower(paragraphs[len(paragraphs)-1])
 		boilerplateIndicators := []string{"about ", "founded", "headquartered", "company", "organization", "learn more"}
-		hasBoilerplate := false
 
-		for _, indicator := range boilerplateIndicators {
-			if strings.Contains(lastPara, indicator) {
-				hasBoilerplate = true
-				break
-			}
-		}
-
-		if hasBoilerplate {
-			score += 2
-			strengths = append(strengths, "Includes proper company boilerplate")
+		if indicator := firstContained(lastPara, boilerplateIndicators); indicator != "" {
+			a.award(2, "company boilerplate", strings.TrimSpace(indicator))
+			a.strength("Includes proper company boilerplate")
 		} else {
-			issues = append(issues, "Missing company boilerplate information")
+			a.issue("Missing company boilerplate information")
 		}
 	}
 
 	// Check for logical flow and transitions
 	transitionWords := []string{"additionally", "furthermore", "moreover", "however", "meanwhile", "as a result"}
-	hasTransitions := false
-
-	for _, transition := range transitionWords {
-		if strings.Contains(strings.ToLower(content), transition) {
-			hasTransitions = true
-			break
-		}
-	}
 
-	if hasTransitions {
-		score += 2
-		strengths = append(strengths, "Uses transitions for logical flow")
+	if transition := firstContained(strings.ToLower(content), transitionWords); transition != "" {
+		a.award(2, "transitions", transition)
+		a.strength("Uses transitions for logical flow")
 	} else if len(paragraphs) > 4 {
-		issues = append(issues, "Consider adding transitions between sections")
+		a.issue("Consider adding transitions between sections")
 	}
 
-	return score, issues, strengths
+	return a
 }
 
 // analyzeReleaseDate checks for proper date formatting in the opening lines.
 func analyzeReleaseDate(content string) (int, []string, []string) {
-	var issues []string
-	var strengths []string
-	score := 0
+	return scoreReleaseDate(content).result()
+}
+
+// scoreReleaseDate is analyzeReleaseDate with a trace of the points awarded.
+func scoreReleaseDate(content string) analysis {
+	a := analysis{category: "Release Date"}
 
 	// Get the first few lines (first 200 characters) to look for release date
 	firstLines := content
@@ -1284,189 +1378,360 @@ func analyzeReleaseDate(content string) (int, []string, []string) {
 	}
 
 	// Common date patterns for press releases
-	datePatterns := []string{
-		// Month Day, Year format: "Aug 20, 2024", "August 20, 2024", "Jan 1, 2025"
-		`(?i)\b(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\s+\d{1,2},?\s+\d{4}\b`,
-		// Month Day Year format: "August 20 2024"
-		`(?i)\b(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\s+\d{1,2}\s+\d{4}\b`,
-		// Day Month Year format: "20 August 2024"
-		`(?i)\b\d{1,2}\s+(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\s+\d{4}\b`,
-		// MM/DD/YYYY format: "08/20/2024"
-		`\b\d{1,2}/\d{1,2}/\d{4}\b`,
-		// MM-DD-YYYY format: "08-20-2024"
-		`\b\d{1,2}-\d{1,2}-\d{4}\b`,
-		// YYYY-MM-DD format: "2024-08-20"
-		`\b\d{4}-\d{1,2}-\d{1,2}\b`,
-		// Full date with day: "Monday, August 20, 2024"
-		`(?i)\b(Monday|Tuesday|Wednesday|Thursday|Friday|Saturday|Sunday),?\s+(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\s+\d{1,2},?\s+\d{4}\b`,
-	}
-
-	hasDate := false
-	for _, pattern := range datePatterns {
-		if matched, _ := regexp.MatchString(pattern, firstLines); matched {
-			hasDate = true
-			break
-		}
+	if date := firstMatch(releaseDatePatterns, firstLines); date != "" {
+		a.award(5, "release date in opening lines", date)
+		a.strength("Includes release date in opening lines")
+	} else {
+		a.issue("Missing release date in opening lines")
+		a.issue("Add date and location (e.g., 'Aug 20, 2024. Seattle, WA.')")
 	}
 
-	if hasDate {
-		score = 5
-		strengths = append(strengths, "Includes release date in opening lines")
-
-		// Check if it follows the standard press release format (Date. Location. Company...)
-		locationPattern := `(?i)\b[A-Z][a-z]+,?\s+[A-Z]{2,}\b` // City, State/Country pattern
-		if matched, _ := regexp.MatchString(locationPattern, firstLines); matched {
-			strengths = append(strengths, "Follows standard press release dateline format")
+	// Release designation problems are deductions, down to zero
+	designation := scoreReleaseDesignation(content)
+	for _, e := range designation.trace {
+		if a.score+e.Delta >= 0 {
+			a.award(e.Delta, e.Rule, e.Detail)
 		}
-	} else {
-		score = 0
-		issues = append(issues, "Missing release date in opening lines")
-		issues = append(issues, "Add date and location (e.g., 'Aug 20, 2024. Seattle, WA.')")
 	}
+	a.issues = append(a.issues, designation.issues...)
+	a.strengths = append(a.strengths, designation.strengths...)
+
+	dateline := scoreDateline(content)
+	a.issues = append(a.issues, dateline.issues...)
+	a.strengths = append(a.strengths, dateline.strengths...)
 
-	return score, issues, strengths
+	return a
+}
+
+// scoring selects the heuristics comprehensivePRAnalysis applies.
+type scoring struct {
+	audience Audience
+	docType  DocType
+	tone     Tone
+	rules    RulesVersion
+	weights  Weights // overrides of the document type's weights
 }
 
 // comprehensivePRAnalysis combines all quality metrics.
-func comprehensivePRAnalysis(prContent string, title string, quoteScore int) *PRScore {
+func comprehensivePRAnalysis(prContent string, title string, quoteScore int, opts scoring) *PRScore {
 	if prContent == "" {
 		return &PRScore{OverallScore: 0}
 	}
 
-	// Analyze each component
-	headlineScore, headlineIssues, headlineStrengths := analyzeHeadlineQuality(title)
-	hookScore, hookIssues, hookStrengths := analyzeNewswortyHook(prContent)
-	releaseDateScore, releaseDateIssues, releaseDateStrengths := analyzeReleaseDate(prContent)
-	fiveWsScore, fiveWsIssues, fiveWsStrengths := analyzeFiveWs(prContent)
-	structureScore, structIssues, structStrengths := analyzeStructure(prContent)
-	toneScore, toneIssues, toneStrengths := analyzeToneAndReadability(prContent)
-	fluffScore, fluffIssues, fluffStrengths := analyzeMarketingFluff(prContent)
-
-	// Combine all issues and strengths
-	allIssues := append(headlineIssues, hookIssues...)
-	allIssues = append(allIssues, releaseDateIssues...)
-	allIssues = append(allIssues, fiveWsIssues...)
-	allIssues = append(allIssues, structIssues...)
-	allIssues = append(allIssues, toneIssues...)
-	allIssues = append(allIssues, fluffIssues...)
-
-	allStrengths := append(headlineStrengths, hookStrengths...)
-	allStrengths = append(allStrengths, releaseDateStrengths...)
-	allStrengths = append(allStrengths, fiveWsStrengths...)
-	allStrengths = append(allStrengths, structStrengths...)
-	allStrengths = append(allStrengths, toneStrengths...)
-	allStrengths = append(allStrengths, fluffStrengths...)
-
-	// Calculate overall score (100 points total)
-	// New scoring: Structure & Hook (30), Content Quality (35), Professional Quality (20), Customer Evidence (15)
-	totalScore := headlineScore + hookScore + releaseDateScore + fiveWsScore + structureScore + toneScore + fluffScore + quoteScore
-
-	breakdown := PRQualityBreakdown{
-		HeadlineScore:    headlineScore,
-		HookScore:        hookScore,
-		ReleaseDateScore: releaseDateScore,
-		FiveWsScore:      fiveWsScore,
-		CredibilityScore: toneScore, // Use tone score for credibility
-		StructureScore:   structureScore,
-		ToneScore:        toneScore,
-		FluffScore:       fluffScore,
-		QuoteScore:       quoteScore,
-		Issues:           allIssues,
-		Strengths:        allStrengths,
+	// Press-distribution checks only apply to documents meant for the press
+	media := opts.docType.profile().media
+	boilerplateAnalyzer := func() analysis { return scoreBoilerplate(prContent) }
+	if !media {
+		boilerplateAnalyzer = func() analysis { return analysis{category: "Structure"} }
+	}
+	customerFocus := !opts.rules.less(customerFocusRules)
+
+	// Independent analyzers run concurrently; results come back in this order
+	var quoteAnalysis *PRScore
+	results := runAnalyzers(
+		opts.categoryAnalyzer("Headline Quality", prContent, title),
+		opts.categoryAnalyzer("Newsworthy Hook", prContent, title),
+		opts.categoryAnalyzer("Release Date", prContent, title),
+		opts.categoryAnalyzer("5 Ws Coverage", prContent, title),
+		opts.categoryAnalyzer("Structure", prContent, title),
+		opts.categoryAnalyzer("Tone & Readability", prContent, title),
+		opts.categoryAnalyzer("Fluff Avoidance", prContent, title),
+		boilerplateAnalyzer,
+		func() analysis {
+			quoteAnalysis = analyzePRQuotes(prContent)
+			return analysis{}
+		},
+		opts.categoryAnalyzer("Customer Focus", prContent, title),
+	)
+	headline, hook, releaseDate, fiveWs := results[0], results[1], results[2], results[3]
+	structure, tone, fluff, customer := results[4], results[5], results[6], results[9]
+
+	// Combine all issues, strengths, and point awards in analyzer order
+	var allIssues, allStrengths []string
+	var trace []ScoreEvent
+	for _, r := range results {
+		allIssues = append(allIssues, r.issues...)
+		allStrengths = append(allStrengths, r.strengths...)
+		trace = append(trace, r.trace...)
+	}
+	if quoteScore != 0 {
+		trace = append(trace, ScoreEvent{
+			Category: "Quote Quality",
+			Rule:     "customer quotes with metrics",
+			Delta:    quoteScore,
+			Detail:   fmt.Sprintf("%d of %d quotes include metrics", quoteAnalysis.QuotesWithMetrics, quoteAnalysis.TotalQuotes),
+		})
 	}
 
-	// Get quote analysis from existing function
-	quoteAnalysis := analyzePRQuotes(prContent)
+	// A CEO praising their own launch is not customer evidence
+	if !opts.rules.less(voiceRules) && quoteScore > maxCompanyQuoteScore && companyOnly(quoteAnalysis.MetricDetails) {
+		trace = append(trace, ScoreEvent{
+			Category: "Quote Quality",
+			Rule:     "no customer, partner, or analyst voice",
+			Delta:    maxCompanyQuoteScore - quoteScore,
+			Detail:   fmt.Sprintf("all %d quotes come from the company", quoteAnalysis.TotalQuotes),
+		})
+		quoteScore = maxCompanyQuoteScore
+	}
+	if !opts.rules.less(voiceRules) && companyOnly(quoteAnalysis.MetricDetails) {
+		allIssues = append(allIssues, quoteCompanyMessage)
+	}
 
 	// Add quote count feedback
-	var quoteCountIssues []string
 	if quoteAnalysis.TotalQuotes > 4 {
-		quoteCountIssues = append(quoteCountIssues, "Consider reducing quotes - press releases work best with 3-4 focused customer testimonials")
+		allIssues = append(allIssues, "Consider reducing quotes - press releases work best with 3-4 focused customer testimonials")
 	}
 
-	// Combine quote count feedback with other issues
-	allIssues = append(allIssues, quoteCountIssues...)
+	breakdown := PRQualityBreakdown{
+		HeadlineScore:    headline.score,
+		HookScore:        hook.score,
+		ReleaseDateScore: releaseDate.score,
+		FiveWsScore:      fiveWs.score,
+		CredibilityScore: tone.score, // Use tone score for credibility
+		StructureScore:   structure.score,
+		ToneScore:        tone.score,
+		FluffScore:       fluff.score,
+		QuoteScore:       quoteScore,
+		Issues:           allIssues,
+		Strengths:        allStrengths,
+		Trace:            trace,
 
-	// Update the breakdown with the complete issue list
-	breakdown.Issues = allIssues
+		CustomerFocusScore: customer.score,
+		customerFocus:      customerFocus,
+	}
 
-	return &PRScore{
+	// Calculate overall score (100 points total)
+	// New scoring: Structure & Hook (30), Content Quality (35), Professional Quality (20), Customer Evidence (15),
+	// weighted by document type
+	prScore := &PRScore{
 		TotalQuotes:       quoteAnalysis.TotalQuotes,
 		QuotesWithMetrics: quoteAnalysis.QuotesWithMetrics,
 		MetricDetails:     quoteAnalysis.MetricDetails,
-		OverallScore:      totalScore,
+		OverallScore:      opts.docType.profile().weightedTotal(breakdown.Categories()),
 		QualityBreakdown:  breakdown,
+		DocType:           opts.docType,
+	}
+	if len(opts.weights) > 0 {
+		prScore.Weights = opts.docType.withWeights(opts.weights)
+		prScore.OverallScore = docTypeProfile{weights: prScore.Weights}.weightedTotal(breakdown.Categories())
+	}
+	if media {
+		prScore.Designation = DetectReleaseDesignation(prContent)
+	}
+	return prScore
+}
+
+// categoryAnalyzer returns the analyzer that scores one breakdown category
+// under these heuristics. Credibility is scored by the Tone & Readability
+// analyzer; Quote Quality has none, since Score scales it from the quotes.
+func (opts scoring) categoryAnalyzer(name, prContent, title string) analyzer {
+	media := opts.docType.profile().media
+	switch name {
+	case "Headline Quality":
+		return func() analysis { return scoreHeadline(title) }
+	case "Newsworthy Hook":
+		return func() analysis { return scoreHook(prContent) }
+	case "Release Date":
+		if !media {
+			return func() analysis { return notRequired("Release Date", 5, opts.docType) }
+		}
+		return func() analysis { return scoreReleaseDate(prContent) }
+	case "5 Ws Coverage":
+		return func() analysis { return scoreFiveWs(prContent) }
+	case "Structure":
+		return func() analysis { return scoreStructure(prContent, media) }
+	case "Tone & Readability", "Credibility":
+		// Tables and code blocks are not prose, so they stay out of the readability statistics
+		toneContent := prContent
+		if opts.rules.Major >= 2 {
+			toneContent = proseText(prContent)
+		}
+		return func() analysis {
+			a := scoreTone(toneContent, opts.audience, opts.tone)
+			if !opts.rules.less(hedgingRules) {
+				scoreHedgingTone(toneContent, &a)
+			}
+			return a
+		}
+	case "Fluff Avoidance":
+		return func() analysis { return scoreFluff(prContent) }
+	case "Customer Focus":
+		if opts.rules.less(customerFocusRules) {
+			return func() analysis { return analysis{category: "Customer Focus"} }
+		}
+		return func() analysis { return scoreCustomerFocus(prContent) }
+	}
+	return func() analysis { return analysis{category: name} }
+}
+
+// ScoreCategory scores a single breakdown category of the parsed sections,
+// with the same heuristics and result as the category in Score's
+// breakdown. It lets callers show categories as they finish instead of
+// waiting for the whole score.
+func ScoreCategory(sections *SpecSections, name string) CategoryScore {
+	category := CategoryScore{Name: name}
+	for _, c := range AllCategories() {
+		if c.Name == name {
+			category.Max = c.Max
+		}
+	}
+	if sections.PressRelease == "" {
+		return category
+	}
+
+	opts := scoring{audience: sections.Audience, docType: sections.DocType, tone: sections.Tone, rules: sections.ScoringRules()}
+	if name != "Quote Quality" {
+		category.Score = opts.categoryAnalyzer(name, sections.PressRelease, sections.Title)().score
+		return category
+	}
+	quoteAnalysis := analyzePRQuotes(sections.PressRelease)
+	category.Score = (quoteAnalysis.OverallScore * 15) / 100
+	if !opts.rules.less(voiceRules) && category.Score > maxCompanyQuoteScore && companyOnly(quoteAnalysis.MetricDetails) {
+		category.Score = maxCompanyQuoteScore
 	}
+	return category
 }
 
 // ParsePRFAQ reads a markdown file and extracts key sections.
 func ParsePRFAQ(path string) (*SpecSections, error) {
-	file, err := os.Open(path) //nolint:gosec // path is user-provided CLI argument
+	sections, err := parseFile(path)
+	if err != nil {
+		return nil, err
+	}
+	sections.PRScore = Score(sections)
+	return sections, nil
+}
+
+// parseFile reads a markdown file line by line, never holding it whole,
+// and extracts its sections and outline without scoring them.
+func parseFile(path string) (*SpecSections, error) {
+	f, err := os.Open(path) //nolint:gosec // path is user-provided CLI argument
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRead, err)
+	}
+	defer func() { _ = f.Close() }()
+	lines, err := readLines(f)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		if closeErr := file.Close(); closeErr != nil {
-			// Log error but don't override return error
-			fmt.Printf("Warning: failed to close file: %v\n", closeErr)
+	return parseSourceLines(path, lines)
+}
+
+// commonHeaders are section names recognized even without a markdown heading marker.
+var commonHeaders = []string{
+	"Press Release", "Announcement", "FAQ", "FAQs", "Frequently Asked Questions",
+	"Q&A", "Questions and Answers", "Success Metrics", "Key Metrics",
+	"Metrics", "Internal FAQ", "Questions", "Answers",
+}
+
+// isCommonHeader reports whether a trimmed line is a plain-text section header.
+func isCommonHeader(line string) bool {
+	for _, header := range commonHeaders {
+		if strings.EqualFold(line, header) {
+			return true
 		}
-	}()
+	}
+	return false
+}
 
+// readLines reads r fully, splitting it into lines without terminators.
+func readLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRead, err)
+	}
+	return lines, nil
+}
+
+// Parse extracts key sections from markdown read from r and scores the press release.
+// Image files are not checked, since r has no directory to resolve them against.
+func Parse(r io.Reader) (*SpecSections, error) {
+	return parse(r, "")
+}
+
+// parse is Parse with image paths resolved against dir.
+func parse(r io.Reader, dir string) (*SpecSections, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+	sections, err := parseLines(lines, dir)
+	if err != nil {
+		return nil, err
+	}
+	sections.PRScore = Score(sections)
+	return sections, nil
+}
+
+// parseLines extracts the sections and outline of a document from its
+// lines, blanking the front matter in place, and leaves PRScore nil.
+func parseLines(lines []string, dir string) (*SpecSections, error) {
 	sections := &SpecSections{
 		OtherSections: make(map[string]string),
+		Dir:           dir,
 	}
 
 	type sectionInfo struct {
 		name    string
 		content string
+		span    LineSpan
 	}
 
 	var currentSection string
 	var sectionBuffer strings.Builder
+	var sectionSpan LineSpan
 	var titleSet bool
 	var allSections []sectionInfo
 
-	// Define common section headers once
-	commonHeaders := []string{
-		"Press Release", "Announcement", "FAQ", "FAQs", "Frequently Asked Questions",
-		"Q&A", "Questions and Answers", "Success Metrics", "Key Metrics",
-		"Metrics", "Internal FAQ", "Questions", "Answers",
+	// Front matter selects the document type and tone; its lines are blanked so line numbers hold
+	fm, fmLines, err := parseFrontMatter(lines)
+	if err != nil {
+		return nil, err
+	}
+	if fm.DocType != "" {
+		if sections.DocType, err = ParseDocType(fm.DocType); err != nil {
+			return nil, fmt.Errorf("%w: doc_type: %w", ErrFrontMatter, err)
+		}
+	}
+	if fm.Tone != "" {
+		if sections.Tone, err = ParseTone(fm.Tone); err != nil {
+			return nil, fmt.Errorf("%w: tone: %w", ErrFrontMatter, err)
+		}
+	}
+	if err := sections.Review.apply(fm.reviewFields); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFrontMatter, err)
+	}
+	for i := range fmLines {
+		lines[i] = ""
 	}
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
+	for i, line := range lines {
+		lineNum := i + 1
 
 		// Extract the title (first H1)
 		if !titleSet && strings.HasPrefix(line, "# ") {
 			titleText := strings.TrimPrefix(line, "# ")
 			sections.Title = titleText
+			sections.Positions.Title = lineNum
 			titleSet = true
 
 			// Check if this H1 is also a section header (like "# Press Release")
-			for _, header := range commonHeaders {
-				if strings.EqualFold(titleText, header) {
-					// This H1 is both a title and a section header
-					currentSection = titleText
-					continue
-				}
+			if isCommonHeader(titleText) {
+				currentSection = titleText
 			}
 			continue
 		}
 
 		// Detect section heading (both markdown ## and plain text)
 		isMarkdownHeader := strings.HasPrefix(line, "## ")
-		isPlainTextHeader := false
 
 		// Check for plain text headers (common section names that stand alone)
 		trimmedLine := strings.TrimSpace(line)
-
-		for _, header := range commonHeaders {
-			if strings.EqualFold(trimmedLine, header) {
-				isPlainTextHeader = true
-				break
-			}
-		}
+		isPlainTextHeader := isCommonHeader(trimmedLine)
 
 		if isMarkdownHeader || isPlainTextHeader {
 			// Save the previous section's content
@@ -1475,6 +1740,7 @@ func ParsePRFAQ(path string) (*SpecSections, error) {
 				allSections = append(allSections, sectionInfo{
 					name:    currentSection,
 					content: content,
+					span:    sectionSpan,
 				})
 				sectionBuffer.Reset()
 			}
@@ -1484,34 +1750,68 @@ func ParsePRFAQ(path string) (*SpecSections, error) {
 			} else {
 				currentSection = trimmedLine
 			}
+			sectionSpan = LineSpan{}
 			continue
 		}
 
 		// Accumulate section content
 		if currentSection != "" {
 			sectionBuffer.WriteString(line + "\n")
+			if trimmedLine != "" {
+				if sectionSpan.Start == 0 {
+					sectionSpan.Start = lineNum
+				}
+				sectionSpan.End = lineNum
+			}
 		}
 	}
-
 	// Capture last section
 	if currentSection != "" {
 		content := strings.TrimSpace(sectionBuffer.String())
 		allSections = append(allSections, sectionInfo{
 			name:    currentSection,
 			content: content,
+			span:    sectionSpan,
 		})
 	}
 
 	// Process sections with fuzzy logic and handle FAQ numbering
 	var faqContent strings.Builder
 	var inFAQSection bool
+	// The internal and external FAQs; an unlabeled FAQ is external
+	var kindContent [2]strings.Builder
+	kindSpans := [2]*LineSpan{&sections.Positions.ExternalFAQs, &sections.Positions.InternalFAQs}
+	var faqKind faqHeading
+	externalLabeled := false
 
 	for _, section := range allSections {
+		// Appendices support the document and are never the press release or FAQ
+		if isAppendix(section.name) {
+			if inFAQSection {
+				sections.FAQs = strings.TrimSpace(faqContent.String())
+				inFAQSection = false
+			}
+			sections.Appendices = append(sections.Appendices, Appendix{Name: section.name, Content: section.content, Span: section.span})
+			continue
+		}
+
 		// Check for FAQ sections first (more specific)
 		if isFAQSection(section.name) {
+			sections.headings.faqs = true
 			sections.FAQs = section.content
+			sections.Positions.FAQs = section.span
 			faqContent.WriteString(section.content + "\n\n")
 			inFAQSection = true
+
+			faqKind = faqHeadingOf(section.name)
+			externalLabeled = externalLabeled || faqKind == faqExternal
+			kind := faqKind.kind()
+			kindContent[kind].WriteString(section.content + "\n\n")
+			if kindSpans[kind].Start == 0 {
+				*kindSpans[kind] = section.span
+			} else if section.span.End > 0 {
+				kindSpans[kind].End = section.span.End
+			}
 			continue
 		}
 
@@ -1519,6 +1819,11 @@ func ParsePRFAQ(path string) (*SpecSections, error) {
 		if inFAQSection && isNumberedFAQQuestion(section.name) {
 			faqContent.WriteString("## " + section.name + "\n\n")
 			faqContent.WriteString(section.content + "\n\n")
+			kindContent[faqKind.kind()].WriteString("## " + section.name + "\n\n" + section.content + "\n\n")
+			if section.span.End > 0 {
+				sections.Positions.FAQs.End = section.span.End
+				kindSpans[faqKind.kind()].End = section.span.End
+			}
 			continue
 		} else if inFAQSection {
 			// We've left the FAQ section, finalize it
@@ -1529,7 +1834,9 @@ func ParsePRFAQ(path string) (*SpecSections, error) {
 		// Check for explicit press release header
 		lowerSectionName := strings.ToLower(section.name)
 		if lowerSectionName == "press release" || lowerSectionName == "announcement" {
+			sections.headings.pressRelease = true
 			sections.PressRelease = section.content
+			sections.Positions.PressRelease = section.span
 			continue
 		}
 
@@ -1543,6 +1850,7 @@ func ParsePRFAQ(path string) (*SpecSections, error) {
 		// Use fuzzy logic to detect press release content
 		if sections.PressRelease == "" && isPressReleaseContent(section.content) {
 			sections.PressRelease = section.content
+			sections.Positions.PressRelease = section.span
 			continue
 		}
 
@@ -1555,14 +1863,83 @@ func ParsePRFAQ(path string) (*SpecSections, error) {
 		sections.FAQs = strings.TrimSpace(faqContent.String())
 	}
 
-	// Analyze PR with comprehensive quality metrics
-	if sections.PressRelease != "" {
-		quoteAnalysis := analyzePRQuotes(sections.PressRelease)
-		quoteScore := (quoteAnalysis.OverallScore * 15) / 100 // Scale to 15 points max
-		sections.PRScore = comprehensivePRAnalysis(sections.PressRelease, sections.Title, quoteScore)
+	// A lone unlabeled FAQ is neither kind: it answers everyone
+	sections.InternalFAQs = strings.TrimSpace(kindContent[1].String())
+	if sections.InternalFAQs != "" || externalLabeled {
+		sections.ExternalFAQs = strings.TrimSpace(kindContent[0].String())
 	} else {
-		sections.PRScore = &PRScore{OverallScore: 0}
+		sections.Positions.ExternalFAQs = LineSpan{}
 	}
 
+	sections.Tree = buildTree(lines)
+	sections.Tables = countTables(lines)
+	sections.Figures = countFigures(lines)
+	sections.Images = findImages(lines)
+	sections.Dateline = ParseDateline(sections.PressRelease)
 	return sections, nil
 }
+
+// gatedChecks are the finding checks added in later rules versions; each
+// runs from minRules on.
+var gatedChecks = []struct {
+	minRules RulesVersion
+	check    func(*SpecSections) analysis
+}{
+	{imageRules, scoreImages},
+	{quotePlacementRules, scoreQuotePlacement},
+	{antiPatternRules, scoreAntiPatterns},
+	{hedgingRules, scoreHedging},
+	{forwardLookingRules, scoreForwardLooking},
+	{claimRules, scoreClaims},
+	{featureDumpRules, scoreFeatureDumps},
+	{disclosureRules, scoreDisclosures},
+	{problemRules, scoreProblem},
+	{legalRules, scoreLegal},
+	{brandRules, scoreBrands},
+	{scopeRules, scoreScope},
+	{faqKindRules, scoreExternalFAQ},
+	{leakageRules, scoreLeakage},
+}
+
+// Score runs every press release analyzer over the parsed sections. A document
+// without a press release scores zero.
+func Score(sections *SpecSections) *PRScore {
+	if sections.PressRelease == "" {
+		return &PRScore{OverallScore: 0}
+	}
+	quoteAnalysis := analyzePRQuotes(sections.PressRelease)
+	quoteScore := (quoteAnalysis.OverallScore * 15) / 100 // Scale to 15 points max
+	score := comprehensivePRAnalysis(sections.PressRelease, sections.Title, quoteScore, scoring{audience: sections.Audience, docType: sections.DocType, tone: sections.Tone, rules: sections.ScoringRules(), weights: sections.Weights})
+
+	// The checks below only report findings; hedges also cost Tone &
+	// Readability points, which scoreHedgingTone deducted
+	extras := []analysis{
+		scoreRequiredSections(sections),
+		scoreFAQChecklists(sections),
+		scoreOrdering(sections),
+		scoreHeadings(sections.Tree),
+	}
+	for _, g := range gatedChecks {
+		if !sections.ScoringRules().less(g.minRules) {
+			extras = append(extras, g.check(sections))
+		}
+	}
+	if sections.FlowChecker != nil {
+		extras = append(extras, scoreFlow(sections))
+	}
+	for _, extra := range extras {
+		for i, line := range extra.lines {
+			if score.QualityBreakdown.IssueLines == nil {
+				score.QualityBreakdown.IssueLines = map[int]int{}
+			}
+			score.QualityBreakdown.IssueLines[len(score.QualityBreakdown.Issues)+i] = line
+		}
+		score.QualityBreakdown.Issues = append(score.QualityBreakdown.Issues, extra.issues...)
+		score.QualityBreakdown.Strengths = append(score.QualityBreakdown.Strengths, extra.strengths...)
+	}
+	score.QualityBreakdown.dismiss(sections)
+	if sections.ScoringMode == ScoringSeverity {
+		sections.scoreBySeverity(score)
+	}
+	return score
+}
@@ -1,8 +1,12 @@
 package parser
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -682,7 +686,7 @@ Available starting next month at website.com.`,
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			score := comprehensivePRAnalysis(tt.prContent, "Test Title", 5)
+			score := comprehensivePRAnalysis(tt.prContent, "Test Title", 5, scoring{})
 
 			if score.OverallScore < tt.wantScoreMin || score.OverallScore > tt.wantScoreMax {
 				t.Errorf("comprehensivePRAnalysis() OverallScore = %d, want between %d and %d",
@@ -774,11 +778,16 @@ func TestCategorizeIssues(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			result := categorizeIssues(tt.issues)
-			for category, expectedIssues := range tt.expected {
-				if len(result[category]) != len(expectedIssues) {
-					t.Errorf("category %q: got %d issues, want %d", category, len(result[category]), len(expectedIssues))
+			got := make(map[string][]string, len(result))
+			for i, group := range result {
+				got[group.Category] = group.Issues
+				if i > 0 && slices.Index(issueCategories, group.Category) < slices.Index(issueCategories, result[i-1].Category) {
+					t.Errorf("category %q listed after %q", group.Category, result[i-1].Category)
 				}
 			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("categorizeIssues() = %v, want %v", got, tt.expected)
+			}
 		})
 	}
 }
@@ -927,7 +936,57 @@ The product helps customers save money and improve efficiency. It works by optim
 Available starting next month at website.com.`
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		comprehensivePRAnalysis(content, "Company Launches New Product", 8)
+		comprehensivePRAnalysis(content, "Company Launches New Product", 8, scoring{})
+	}
+}
+
+// BenchmarkParse parses and scores every sample document, approximating batch runs.
+func BenchmarkParse(b *testing.B) {
+	paths, err := filepath.Glob(filepath.Join("..", "..", "testdata", "example_prfaq_*"))
+	if err != nil || len(paths) == 0 {
+		b.Fatalf("no sample documents found: %v", err)
+	}
+	var docs []string
+	for _, path := range paths {
+		data, err := os.ReadFile(path) //nolint:gosec // test code with controlled paths
+		if err != nil {
+			b.Fatalf("failed to read %s: %v", path, err)
+		}
+		docs = append(docs, string(data))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, doc := range docs {
+			if _, err := Parse(strings.NewReader(doc)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkParseOutline_Appendices reads an appendix-heavy document from
+// disk without scoring it, as the TUI does to show the overview first.
+func BenchmarkParseOutline_Appendices(b *testing.B) {
+	var doc strings.Builder
+	doc.WriteString("# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today launched Ledger Sync, which cuts month-end close time by 40%.\n\n## FAQ\n\nQ: Is it free?\nA: No, it costs $5 a month.\n")
+	for i := range 200 {
+		fmt.Fprintf(&doc, "\n## Appendix %d: Data\n\n", i+1)
+		for range 20 {
+			doc.WriteString("Ledger Sync reconciled 1,204 transactions in 3.2 seconds. \"It matched,\" the auditor said. Close took 3 days.\n\n")
+		}
+	}
+	path := filepath.Join(b.TempDir(), "large.md")
+	if err := os.WriteFile(path, []byte(doc.String()), 0o600); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseOutline(path); err != nil {
+			b.Fatal(err)
+		}
 	}
 }
 
@@ -1067,3 +1126,60 @@ Available starting next month at website.com.`,
 		})
 	}
 }
+
+func TestMatchesAny(t *testing.T) {
+	patterns := mustCompileAll(`(?i)`, `\d+%`, `cuts .+ by`)
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"up 40%", true},
+		{"CUTS costs BY half", true},
+		{"no metrics here", false},
+	}
+	for _, tt := range tests {
+		if got := matchesAny(patterns, tt.in); got != tt.want {
+			t.Errorf("matchesAny(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+	if matchesAny(nil, "anything") {
+		t.Error("matchesAny(nil) should be false")
+	}
+}
+
+// BenchmarkAnalyzers times each regex-heavy analyzer on a sample document,
+// to find the hotspots of large runs; profile one with
+// go test -bench 'Analyzers/tone' -cpuprofile cpu.pprof.
+func BenchmarkAnalyzers(b *testing.B) {
+	s, err := ParsePRFAQ(filepath.Join("..", "..", "testdata", "example_prfaq_1.md"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	s.Codenames = []string{`Project [A-Z]\w+`}
+	pr := s.PressRelease
+	analyzers := []struct {
+		name string
+		run  func() analysis
+	}{
+		{"hook", func() analysis { return scoreHook(pr) }},
+		{"five-ws", func() analysis { return scoreFiveWs(pr) }},
+		{"tone", func() analysis { return scoreTone(pr, s.Audience, s.Tone) }},
+		{"fluff", func() analysis { return scoreFluff(pr) }},
+		{"boilerplate", func() analysis { return scoreBoilerplate(pr) }},
+		{"customer-focus", func() analysis { return scoreCustomerFocus(pr) }},
+		{"hedging", func() analysis { return scoreHedging(s) }},
+		{"anti-patterns", func() analysis { return scoreAntiPatterns(s) }},
+		{"forward-looking", func() analysis { return scoreForwardLooking(s) }},
+		{"claims", func() analysis { return scoreClaims(s) }},
+		{"legal", func() analysis { return scoreLegal(s) }},
+		{"leakage", func() analysis { return scoreLeakage(s) }},
+	}
+	for _, a := range analyzers {
+		b.Run(a.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				a.run()
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Every regular expression used by the analyzers is compiled once here, at
+// package initialization, instead of on each call. Pattern slices keep their
+// original order because detectMetricsInText reports matches in that order.
+
+// mustCompileAll compiles each pattern with the given flag prefix, e.g. "(?i)".
+func mustCompileAll(prefix string, patterns ...string) []*regexp.Regexp {
+	compiled := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		compiled[i] = regexp.MustCompile(prefix + pattern)
+	}
+	return compiled
+}
+
+// matchesAny reports whether any pattern matches s.
+func matchesAny(patterns []*regexp.Regexp, s string) bool {
+	for _, re := range patterns {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Section detection
+var (
+	// pressReleaseDatePatterns find dates in lowercased content.
+	pressReleaseDatePatterns = mustCompileAll("",
+		`\b(?:jan|feb|mar|apr|may|jun|jul|aug|sep|oct|nov|dec)[a-z]*\s+\d{1,2},?\s+\d{4}`,
+		`\b\d{1,2}/\d{1,2}/\d{4}`,
+		`\b\d{4}-\d{1,2}-\d{1,2}`,
+	)
+
+	numberedFAQPatterns = mustCompileAll("",
+		`^\d+\.\s+.+`,      // "1. Question here"
+		`^\d+\)\s+.+`,      // "1) Question here"
+		`^Q\d+[\.\)]\s+.+`, // "Q1. Question" or "Q1) Question"
+		`^Question\s+\d+`,  // "Question 1"
+	)
+)
+
+// Quotes and metrics
+var (
+	// quotePatterns capture quoted text; (?s) lets quotes span lines.
+	quotePatterns = mustCompileAll(`(?s)`,
+		`"(.+?)"`,           // Standard double quotes
+		"\u201C(.+?)\u201D", // Curly quotes (U+201C and U+201D)
+		`'(.+?)'`,           // Single quotes
+		"\u2018(.+?)\u2019", // Curly single quotes (U+2018 and U+2019)
+	)
+
+	percentagePatterns = mustCompileAll(`(?i)`,
+		`\d+(?:\.\d+)?%`,                       // 50%, 12.5%
+		`\d+(?:\.\d+)?\s*percent`,              // 50 percent
+		`\d+(?:\.\d+)?\s*percentage\s*points?`, // 12 percentage points
+	)
+
+	ratioPatterns = mustCompileAll(`(?i)`,
+		`\d+x`,                        // 2x, 10x improvement
+		`\d+(?:\.\d+)?:\d+(?:\.\d+)?`, // 2:1, 3.5:1 ratios
+		`\d+(?:\.\d+)?\s*times`,       // 3 times faster
+	)
+
+	absoluteNumberPatterns = mustCompileAll(`(?i)`,
+		`\$\d+(?:,\d{3})*(?:\.\d+)?(?:\s*(?:million|billion|thousand|k|m|b))?`,        // $1.5M, $500K
+		`\d+(?:,\d{3})*(?:\.\d+)?\s*(?:milliseconds?|seconds?|minutes?|hours?|days?)`, // 50ms, 2.5 seconds
+		`\d+(?:,\d{3})*(?:\.\d+)?\s*(?:customers?|users?|transactions?)`,              // 1000 customers
+		`\d+(?:,\d{3})*(?:\.\d+)?\s*(?:basis\s*points?)`,                              // 200 basis points
+	)
+
+	scoreMetricPatterns = mustCompileAll(`(?i)`,
+		`nps\s*(?:score\s*)?(?:by\s*)?\d+(?:\.\d+)?\s*points?`,                 // NPS by 12 points
+		`\d+(?:\.\d+)?\s*(?:point|points)\s*(?:improvement|increase|decrease)`, // 12 points improvement
+		`score\s*(?:of\s*)?\d+(?:\.\d+)?`,                                      // score of 9.2
+	)
+)
+
+// Headline and hook
+var (
+	headlineSpecificityPatterns = mustCompileAll("",
+		`\d+%`, `\d+x`, `\d+(?:,\d{3})*`, `\$\d+`, `by \d+`, `up to \d+`,
+	)
+
+	hookSpecificityPatterns = mustCompileAll(`(?i)`,
+		`\d+%`, `\d+x`, `cuts .+ by`, `improves .+ by`, `reduces .+ by`, `increases .+ by`,
+	)
+)
+
+// 5 Ws
+var (
+	companyPatterns = mustCompileAll("",
+		`\b[A-Z][a-z]+\s+(?:Inc|Corp|Company|LLC|Ltd)`, `[A-Z][a-zA-Z]+\s+announced`, `[A-Z][a-zA-Z]+\s+today`,
+	)
+
+	timePatterns = mustCompileAll(`(?i)`,
+		`\b(?:jan|feb|mar|apr|may|jun|jul|aug|sep|oct|nov|dec)[a-z]*\s+\d`, `today`, `this week`, `this month`, `\d{4}`, `yesterday`, `recently`,
+	)
+
+	wherePatterns = mustCompileAll("",
+		`[A-Z][a-z]+,\s+[A-Z]{2}`, `[A-Z][a-z]+\s+\([A-Z][a-z]+\s+Wire\)`, `headquarters`, `market`, `globally`, `worldwide`, `nation`,
+	)
+)
+
+// Tone and fluff
+var (
+	sentenceTerminatorPattern = regexp.MustCompile(`[.!?]+`)
+
+	proofPatterns = mustCompileAll(`(?i)`,
+		`\d+%`, `\d+x`, `study shows`, `research indicates`, `data reveals`, `according to`, `measured`, `demonstrated`,
+	)
+)
+
+// Release date
+var (
+	releaseDatePatterns = mustCompileAll("",
+		// Month Day, Year format: "Aug 20, 2024", "August 20, 2024", "Jan 1, 2025"
+		`(?i)\b(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\s+\d{1,2},?\s+\d{4}\b`,
+		// Month Day Year format: "August 20 2024"
+		`(?i)\b(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\s+\d{1,2}\s+\d{4}\b`,
+		// Day Month Year format: "20 August 2024"
+		`(?i)\b\d{1,2}\s+(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\s+\d{4}\b`,
+		// MM/DD/YYYY format: "08/20/2024"
+		`\b\d{1,2}/\d{1,2}/\d{4}\b`,
+		// MM-DD-YYYY format: "08-20-2024"
+		`\b\d{1,2}-\d{1,2}-\d{4}\b`,
+		// YYYY-MM-DD format: "2024-08-20"
+		`\b\d{4}-\d{1,2}-\d{1,2}\b`,
+		// Full date with day: "Monday, August 20, 2024"
+		`(?i)\b(Monday|Tuesday|Wednesday|Thursday|Friday|Saturday|Sunday),?\s+(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\s+\d{1,2},?\s+\d{4}\b`,
+	)
+)
+
+// Boilerplate
+var (
+	// boilerplateHeadingPattern matches an "About <Company>" line, as a
+	// heading, bold text, or plain line.
+	boilerplateHeadingPattern = regexp.MustCompile(`^(?:#{1,6}\s+)?(?:\*\*|__)?About\s+[A-Z][^\n]{0,59}?(?:\*\*|__)?:?\s*$`)
+
+	// boilerplateInlinePattern matches a paragraph that starts "About <Company>: ...".
+	boilerplateInlinePattern = regexp.MustCompile(`^(?:\*\*|__)?About\s+[A-Z][^\n:]{0,59}:(?:\*\*|__)?\s+\S`)
+
+	boilerplateFoundingPattern = regexp.MustCompile(`(?i)\b(?:founded|established|since\s+\d{4})\b`)
+	boilerplateHQPattern       = regexp.MustCompile(`(?i)\b(?:headquartered|headquarters|based in|hq)\b`)
+	boilerplateMissionPattern  = regexp.MustCompile(`(?i)\b(?:mission|dedicated to|committed to|helps|enables|empowers|specializes in|provider of)\b`)
+	boilerplateURLPattern      = regexp.MustCompile(`(?i)(?:https?://|www\.)\S+|\b[a-z0-9-]+\.(?:com|org|net|io|ai|co)\b`)
+	mediaContactPattern        = regexp.MustCompile(`(?i)\b(?:media|press)\s+(?:contact|inquiries)\b|[\w.+-]+@[\w-]+\.[\w.]+`)
+)
+
+// firstMatch returns the text of the first pattern that matches s, or "".
+func firstMatch(patterns []*regexp.Regexp, s string) string {
+	for _, re := range patterns {
+		if m := re.FindString(s); m != "" {
+			return m
+		}
+	}
+	return ""
+}
+
+// firstContained returns the first of words that s contains, or "".
+func firstContained(s string, words []string) string {
+	for _, word := range words {
+		if strings.Contains(s, word) {
+			return word
+		}
+	}
+	return ""
+}
+
+// firstTerm is firstContained matching whole words, so "api" does not match
+// "rapid". A term ending in "*" is a stem: "integrat*" matches "integration".
+func firstTerm(s string, terms []string) string {
+	for _, term := range terms {
+		if containsTerm(s, term) {
+			return strings.TrimSuffix(term, "*")
+		}
+	}
+	return ""
+}
+
+func containsTerm(s, term string) bool {
+	stem := strings.HasSuffix(term, "*")
+	term = strings.TrimSuffix(term, "*")
+	for i := 0; i < len(s); {
+		j := strings.Index(s[i:], term)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(term)
+		if (start == 0 || !isWordByte(s[start-1])) && (stem || end == len(s) || !isWordByte(s[end])) {
+			return true
+		}
+		i = start + 1
+	}
+	return false
+}
+
+func isWordByte(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
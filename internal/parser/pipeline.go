@@ -0,0 +1,63 @@
+package parser
+
+import "sync"
+
+// analyzer is one independent scoring pass. Analyzers only read their input,
+// so they are safe to run concurrently.
+type analyzer func() analysis
+
+// analysis is the result of a single analyzer.
+type analysis struct {
+	category  string // breakdown category the points count toward
+	score     int
+	issues    []string
+	lines     map[int]int // issue index to the 1-based source line it is about
+	strengths []string
+	trace     []ScoreEvent
+}
+
+// award adds points to the score and records the rule that awarded them.
+// Negative points are deductions.
+func (a *analysis) award(points int, rule, detail string) {
+	a.score += points
+	a.trace = append(a.trace, ScoreEvent{Category: a.category, Rule: rule, Delta: points, Detail: detail})
+}
+
+func (a *analysis) issue(msg string) {
+	a.issues = append(a.issues, msg)
+}
+
+// issueAt records an issue about a single source line, which its finding
+// reports instead of the rule's anchor.
+func (a *analysis) issueAt(line int, msg string) {
+	if a.lines == nil {
+		a.lines = map[int]int{}
+	}
+	a.lines[len(a.issues)] = line
+	a.issue(msg)
+}
+
+func (a *analysis) strength(msg string) {
+	a.strengths = append(a.strengths, msg)
+}
+
+// result returns the score, issues, and strengths in analyzer return order.
+func (a analysis) result() (int, []string, []string) {
+	return a.score, a.issues, a.strengths
+}
+
+// runAnalyzers runs every analyzer in its own goroutine and returns the
+// results in argument order, so merged output does not depend on scheduling.
+func runAnalyzers(analyzers ...analyzer) []analysis {
+	results := make([]analysis, len(analyzers))
+	var wg sync.WaitGroup
+	for i, run := range analyzers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = run()
+		}()
+	}
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,50 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRunAnalyzers_PreservesOrder(t *testing.T) {
+	// The first analyzer finishes last; results must still come back in argument order.
+	results := runAnalyzers(
+		func() analysis {
+			time.Sleep(10 * time.Millisecond)
+			return analysis{score: 1, issues: []string{"first issue"}}
+		},
+		func() analysis { return analysis{score: 2, strengths: []string{"second strength"}} },
+		func() analysis {
+			return analysis{score: 3, issues: []string{"third issue"}, strengths: []string{"third strength"}}
+		},
+	)
+
+	want := []analysis{
+		{score: 1, issues: []string{"first issue"}},
+		{score: 2, strengths: []string{"second strength"}},
+		{score: 3, issues: []string{"third issue"}, strengths: []string{"third strength"}},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("runAnalyzers() = %+v, want %+v", results, want)
+	}
+}
+
+func TestComprehensivePRAnalysis_Deterministic(t *testing.T) {
+	content := `SEATTLE, WA - November 20, 2025 - Acme today announced Ledger Sync, which cuts reconciliation time by 60%.
+
+Finance teams spend days matching records by hand. Ledger Sync matches them automatically.
+
+"We closed our books 3 days faster," said Jane Doe, Controller at Example Corp.
+
+"Audit prep dropped from 2 weeks to 4 days," said John Roe, CFO.
+
+Ledger Sync is available today worldwide.`
+
+	first := comprehensivePRAnalysis(content, "Acme Ledger Sync Cuts Reconciliation Time by 60%", 8, scoring{})
+	for i := 0; i < 20; i++ {
+		got := comprehensivePRAnalysis(content, "Acme Ledger Sync Cuts Reconciliation Time by 60%", 8, scoring{})
+		if !reflect.DeepEqual(got, first) {
+			t.Fatalf("run %d differs from first run:\n got %+v\nwant %+v", i, got, first)
+		}
+	}
+}
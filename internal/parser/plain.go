@@ -0,0 +1,31 @@
+package parser
+
+import "strings"
+
+// plainMarkers maps the report's emoji to text. Status markers become
+// PASS, WARN, or FAIL; decorative heading emoji are dropped.
+var plainMarkers = strings.NewReplacer(
+	"🟢", "PASS",
+	"🟡", "WARN",
+	"🟠", "WARN",
+	"🔴", "FAIL",
+	"## ✅ ", "## ",
+	"## 🎯 ", "## ",
+	"## ⚠️ ", "## ",
+	"## 📊 ", "## ",
+	"## ✏️ ", "## ",
+	"## 🔁 ", "## ",
+	"## 🚩 ", "## ",
+	"## 🔗 ", "## ",
+	"## 🕸️ ", "## ",
+	"## ✍️ ", "## ",
+	"## 🔕 ", "## ",
+	"⚠️ ", "WARN: ",
+)
+
+// PlainReport replaces the emoji in a GenerateMarkdownReport report with
+// text labels, for documentation systems and PDF pipelines that cannot
+// render emoji.
+func PlainReport(report string) string {
+	return plainMarkers.Replace(report)
+}
@@ -0,0 +1,42 @@
+package parser
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPlainReport(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("..", "..", "testdata", "example_prfaq_*"))
+	if err != nil || len(paths) == 0 {
+		t.Fatalf("no example documents: %v", err)
+	}
+	for _, path := range paths {
+		sections, err := ParsePRFAQ(path)
+		if err != nil {
+			t.Fatalf("ParsePRFAQ(%s) error = %v", path, err)
+		}
+		report := PlainReport(GenerateMarkdownReport(sections, sections.PRScore))
+		for _, r := range report {
+			if r >= 0x2600 && !strings.ContainsRune(sections.Title, r) {
+				t.Errorf("%s: plain report contains %q", filepath.Base(path), r)
+				break
+			}
+		}
+	}
+
+	tests := []struct {
+		in, want string
+	}{
+		{"| **TOTAL SCORE** | **85** | **100** | 🟢 Ready | - |", "| **TOTAL SCORE** | **85** | **100** | PASS Ready | - |"},
+		{"### Quote 1 🔴 (2/10 points)", "### Quote 1 FAIL (2/10 points)"},
+		{"🟠 **Needs Improvement** - ...", "WARN **Needs Improvement** - ..."},
+		{"## ⚠️ Detailed Issues to Address", "## Detailed Issues to Address"},
+		{"**⚠️ No quantitative metrics detected**", "**WARN: No quantitative metrics detected**"},
+	}
+	for _, tt := range tests {
+		if got := PlainReport(tt.in); got != tt.want {
+			t.Errorf("PlainReport(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
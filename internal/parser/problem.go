@@ -0,0 +1,81 @@
+package parser
+
+import "fmt"
+
+// problemUnquantifiedMessage is the issue message of a problem asserted
+// without a figure, followed by ": " and the details.
+const problemUnquantifiedMessage = "Problem not quantified"
+
+// problemRules is the first rules version with the quantified problem check.
+var problemRules = RulesVersion{Major: 5, Minor: 3}
+
+// problemParagraphs is how many press release paragraphs, from the lead,
+// should state the problem.
+const problemParagraphs = 2
+
+// ProblemStatement is a sentence of the lead or second paragraph that
+// describes the customer's problem, and the metrics it gives.
+type ProblemStatement struct {
+	Text    string
+	Line    int    // 1-based line the sentence starts on
+	Phrase  string // the problem phrase, e.g. "manually"
+	Metrics []string
+}
+
+// Quantified reports whether the statement backs the problem with a figure.
+func (p ProblemStatement) Quantified() bool {
+	return len(p.Metrics) > 0
+}
+
+// ProblemStatements returns the sentences of the lead and second paragraph
+// of the press release that describe the customer's problem, in order.
+func (s *SpecSections) ProblemStatements() []ProblemStatement {
+	paragraphs := pressReleaseParagraphs(s)
+	if len(paragraphs) > problemParagraphs {
+		paragraphs = paragraphs[:problemParagraphs]
+	}
+	var out []ProblemStatement
+	for _, p := range paragraphs {
+		for _, sentence := range p.Sentences {
+			phrase := problemPattern.FindString(sentence.Text)
+			if phrase == "" {
+				continue
+			}
+			metrics, _ := detectMetricsInText(sentence.Text)
+			out = append(out, ProblemStatement{Text: sentence.Text, Line: sentence.Position.Line, Phrase: phrase, Metrics: metrics})
+		}
+	}
+	return out
+}
+
+// scoreProblem flags a problem the lead and second paragraph assert without
+// quantifying it, as in "Reconciling bank feeds is tedious and manual",
+// and a problem first stated later in the press release. A press release
+// that never states the problem is left to the anti-pattern check. Findings
+// cost no points.
+func scoreProblem(s *SpecSections) analysis {
+	a := analysis{category: "Credibility"}
+	statements := s.ProblemStatements()
+	for _, st := range statements {
+		if st.Quantified() {
+			return a
+		}
+	}
+	if len(statements) > 0 {
+		a.issueAt(statements[0].Line, fmt.Sprintf("%s: %q is asserted without a figure - back it with a metric such as the time wasted, the cost incurred, or the market size", problemUnquantifiedMessage, statements[0].Phrase))
+		return a
+	}
+	paragraphs := pressReleaseParagraphs(s)
+	if len(paragraphs) <= problemParagraphs {
+		return a
+	}
+	for _, p := range paragraphs[problemParagraphs:] {
+		for _, sentence := range p.Sentences {
+			if problemPattern.MatchString(sentence.Text) {
+				a.issueAt(sentence.Position.Line, fmt.Sprintf("%s: the problem first comes up after the second paragraph - state it in the lead or second paragraph, with a metric such as the time wasted or the cost incurred", problemUnquantifiedMessage))
+				return a
+			}
+		}
+	}
+	return a
+}
@@ -0,0 +1,56 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScoreProblem(t *testing.T) {
+	lead := "Acme today launched Ledger Sync for finance teams.\n\n"
+	tests := []struct {
+		name     string
+		pr       string
+		want     string // the issue's details, empty for none
+		wantLine int
+	}{
+		{"quantified in the lead", "Finance teams spend 12 hours a week matching bank feeds by hand.\n\n", "", 0},
+		{"quantified in the second paragraph", lead + "Reconciling bank feeds is tedious, costing teams $40,000 a year.\n\n", "", 0},
+		{"asserted", lead + "Reconciling bank feeds is tedious and error-prone.\n\n",
+			`"tedious" is asserted without a figure`, 7},
+		{"stated late", lead + "Ledger Sync matches transactions overnight.\n\nReconciling by hand is tedious.\n\n",
+			"the problem first comes up after the second paragraph", 9},
+		{"never stated", lead + "Ledger Sync matches transactions overnight.\n\n", "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\n" + tt.pr + "## FAQ\n\nQ: Why?\nA: Speed.\n"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			a := scoreProblem(sections)
+			if tt.want == "" {
+				if len(a.issues) > 0 {
+					t.Errorf("issues = %q, want none", a.issues)
+				}
+				return
+			}
+			if len(a.issues) != 1 || !strings.HasPrefix(a.issues[0], problemUnquantifiedMessage+": "+tt.want) {
+				t.Fatalf("issues = %q, want %q", a.issues, tt.want)
+			}
+			if a.lines[0] != tt.wantLine {
+				t.Errorf("line = %d, want %d", a.lines[0], tt.wantLine)
+			}
+		})
+	}
+}
+
+func TestSpecSections_ProblemStatements(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\nFinance teams waste 12 hours a week on manual matching. Acme fixes that.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got := sections.ProblemStatements()
+	if len(got) != 1 || got[0].Line != 5 || got[0].Phrase != "waste" || !got[0].Quantified() || got[0].Metrics[0] != "12 hours" {
+		t.Errorf("ProblemStatements() = %+v, want one quantified by 12 hours at line 5", got)
+	}
+}
@@ -0,0 +1,135 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Appendix is a supporting section after the PR-FAQ proper, such as data
+// tables or a glossary. Appendices are not scored.
+type Appendix struct {
+	Name    string
+	Content string
+	Span    LineSpan
+}
+
+var (
+	// tableDelimiterPattern matches the row under a markdown table header,
+	// e.g. "| --- | :---: |".
+	tableDelimiterPattern = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(?:\|\s*:?-+:?\s*)+\|?\s*$`)
+	// tableRowPattern matches a pipe-delimited table row.
+	tableRowPattern = regexp.MustCompile(`^\s*\|.*\|\s*$`)
+	// fencePattern matches the line that opens or closes a fenced code block.
+	fencePattern = regexp.MustCompile("^\\s*(?:```|~~~)")
+	// imagePattern matches a markdown or HTML image.
+	imagePattern = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)|(?i)<img\b`)
+	// figureCaptionPattern matches a numbered caption such as "Figure 2:".
+	figureCaptionPattern = regexp.MustCompile(`(?i)^\s*(?:\*\*|_|\*)?(?:figure|fig\.)\s+(\d+)`)
+)
+
+// ContentSummary describes the non-prose parts of a document, e.g.
+// "2 tables, 1 figure, 1 appendix", or "" when it has none.
+func ContentSummary(tables, figures, appendices int) string {
+	var parts []string
+	for _, c := range []struct {
+		n         int
+		one, many string
+	}{
+		{tables, "table", "tables"},
+		{figures, "figure", "figures"},
+		{appendices, "appendix", "appendices"},
+	} {
+		if c.n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", c.n, plural(c.n, c.one, c.many)))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// isAppendix reports whether a section name is an appendix, such as
+// "Appendix A: Pricing Model" or "Appendices".
+func isAppendix(name string) bool {
+	name = strings.ToLower(name)
+	return strings.Contains(name, "appendix") || strings.Contains(name, "appendices")
+}
+
+// blockKind marks source lines that are not prose.
+type blockKind int
+
+const (
+	blockProse blockKind = iota
+	blockTable
+	blockCode
+)
+
+// classifyLines marks every line as prose, part of a table, or part of a
+// fenced code block, including the fences. A table is a header row followed
+// by a delimiter row, or any run of lines that start and end with a pipe.
+func classifyLines(lines []string) []blockKind {
+	kinds := make([]blockKind, len(lines))
+	inCode := false
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		switch {
+		case fencePattern.MatchString(line):
+			kinds[i] = blockCode
+			inCode = !inCode
+		case inCode:
+			kinds[i] = blockCode
+		case tableRowPattern.MatchString(line):
+			kinds[i] = blockTable
+		case strings.Contains(line, "|") && i+1 < len(lines) && tableDelimiterPattern.MatchString(lines[i+1]):
+			// A header row without outer pipes, e.g. "Plan | Price"
+			kinds[i] = blockTable
+			for i+1 < len(lines) && strings.Contains(lines[i+1], "|") {
+				i++
+				kinds[i] = blockTable
+			}
+		}
+	}
+	return kinds
+}
+
+// proseText returns content with tables and code blocks blanked out, so
+// sentence statistics only see prose. Blank lines keep paragraphs apart.
+func proseText(content string) string {
+	lines := strings.Split(content, "\n")
+	for i, kind := range classifyLines(lines) {
+		if kind != blockProse {
+			lines[i] = ""
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// countTables counts the tables in the document's lines.
+func countTables(lines []string) int {
+	tables := 0
+	prev := blockProse
+	for _, kind := range classifyLines(lines) {
+		if kind == blockTable && prev != blockTable {
+			tables++
+		}
+		prev = kind
+	}
+	return tables
+}
+
+// countFigures counts images outside code blocks, or the distinct numbered
+// figure captions when there are more of those, as when figures are linked
+// rather than embedded.
+func countFigures(lines []string) int {
+	images := 0
+	captions := map[string]bool{}
+	for i, kind := range classifyLines(lines) {
+		if kind == blockCode {
+			continue
+		}
+		images += len(imagePattern.FindAllString(lines[i], -1))
+		if m := figureCaptionPattern.FindStringSubmatch(lines[i]); m != nil {
+			captions[m[1]] = true
+		}
+	}
+	return max(images, len(captions))
+}
@@ -0,0 +1,85 @@
+package parser
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+const tableDoc = "# Acme Launches Ledger Sync to Cut Month-End Close by 80%\n\n" +
+	"## Press Release\n\n" +
+	"SEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync. It reconciles accounts every hour.\n\n" +
+	"| Plan | Price | Seats | Support | Regions | Retention | Exports |\n" +
+	"| --- | --- | --- | --- | --- | --- | --- |\n" +
+	"| Starter | free | 3 | community forum only | us-east us-west | thirty days | csv json |\n" +
+	"| Team | 20 dollars per seat | 50 | email with next business day response | all regions | one year | csv json parquet |\n" +
+	"| Enterprise | contact sales | unlimited | dedicated manager and phone | all regions plus private | seven years | every format |\n\n" +
+	"```\nledger sync --all --since yesterday --format json --output reconciled\n```\n\n" +
+	"Teams close their books faster. Errors drop.\n\n" +
+	"![Reconciliation dashboard](dashboard.png)\n\n" +
+	"Figure 2: Hourly sync\n\n" +
+	"## FAQ\n\nQ: Is it free?\nA: Starter is.\n\n" +
+	"## Appendix A: Pricing Model\n\nRevenue assumptions.\n\n" +
+	"## Appendices\n\nMore data.\n"
+
+func TestClassifyLines(t *testing.T) {
+	lines := strings.Split("Prose.\n| a | b |\n|---|---|\n\nPlan | Price\n--- | ---\nFree | 0\n\n```go\n| not a table |\n```\nMore prose.", "\n")
+	want := []blockKind{blockProse, blockTable, blockTable, blockProse, blockTable, blockTable, blockTable, blockProse, blockCode, blockCode, blockCode, blockProse}
+	if got := classifyLines(lines); !slices.Equal(got, want) {
+		t.Errorf("classifyLines() = %v, want %v", got, want)
+	}
+	if got := countTables(lines); got != 2 {
+		t.Errorf("countTables() = %d, want 2", got)
+	}
+}
+
+func TestParse_TablesFiguresAppendices(t *testing.T) {
+	sections, err := Parse(strings.NewReader(tableDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if sections.Tables != 1 || sections.Figures != 1 {
+		t.Errorf("Tables, Figures = %d, %d, want 1, 1", sections.Tables, sections.Figures)
+	}
+	var names []string
+	for _, a := range sections.Appendices {
+		names = append(names, a.Name)
+	}
+	if want := []string{"Appendix A: Pricing Model", "Appendices"}; !slices.Equal(names, want) {
+		t.Errorf("Appendices = %q, want %q", names, want)
+	}
+	if _, ok := sections.OtherSections["Appendices"]; ok || strings.Contains(sections.FAQs, "Revenue") {
+		t.Error("appendices should not be other sections or part of the FAQ")
+	}
+}
+
+func TestCountFigures_Captions(t *testing.T) {
+	lines := strings.Split("Figure 1: Architecture\n\n**Figure 2.** Rollout\n\nSee Figure 1 again.\n\n```\n![not an image](x.png)\n```", "\n")
+	if got := countFigures(lines); got != 2 {
+		t.Errorf("countFigures() = %d, want 2", got)
+	}
+}
+
+// Tables used to be scored as one long sentence; rules/v1 still does.
+func TestScore_TablesAreNotProse(t *testing.T) {
+	const longSentences = "Sentences too long - break into shorter, clearer statements"
+	doc := "# Acme Launches Ledger Sync\n\n## Press Release\n\n" +
+		"SEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync.\n\n" +
+		"| Plan | Price | Seats | Support | Regions | Retention |\n" +
+		"| --- | --- | --- | --- | --- | --- |\n" +
+		"| Starter | free for small teams | 3 | community forum only | us-east and us-west | thirty days |\n" +
+		"| Team | 20 dollars per seat | 50 | email with next business day response | all regions | one year |\n" +
+		"| Enterprise | contact sales | unlimited | dedicated manager and phone | all regions plus private | seven years |\n\n" +
+		"Teams close faster.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if slices.Contains(Score(sections).QualityBreakdown.Issues, longSentences) {
+		t.Errorf("%s counted the table as prose", CurrentRules)
+	}
+	sections.Rules = supportedRules[1]
+	if !slices.Contains(Score(sections).QualityBreakdown.Issues, longSentences) {
+		t.Errorf("%s should still count the table as prose", supportedRules[1])
+	}
+}
@@ -0,0 +1,231 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Issue messages of the quote placement checks. The late and crowded
+// messages are followed by ": " and the details.
+const (
+	quoteLateMessage    = "First quote comes too late"
+	quoteCrowdedMessage = "Paragraph has more than one quote"
+	quoteHeavyMessage   = "Quotes crowd out the facts"
+	quoteCompanyMessage = "Every quote comes from the company - add a customer, partner, or analyst voice"
+)
+
+// quotePlacementRules is the first rules version with the quote placement
+// checks.
+var quotePlacementRules = RulesVersion{Major: 2, Minor: 2}
+
+const (
+	// maxFirstQuoteParagraph is the last press release paragraph the first
+	// quote may appear in.
+	maxFirstQuoteParagraph = 3
+	// maxQuoteShare is the largest percentage of press release words that
+	// may be inside quotes.
+	maxQuoteShare = 40
+	// minQuoteLength matches extractQuotes: shorter quoted text, such as a
+	// quoted term, is not a quote.
+	minQuoteLength = 20
+)
+
+// scoreQuotePlacement checks where the press release quotes are and how
+// much of it they take up: the first quote should appear by paragraph 3,
+// each paragraph should hold one speaker's quote, and quotes should stay
+// under maxQuoteShare of the words. Findings cost no points.
+func scoreQuotePlacement(s *SpecSections) analysis {
+	a := analysis{category: "Quote Quality"}
+	paragraph, firstQuote, firstLine := 0, 0, 0
+	words, quoted := 0, 0
+	for _, p := range pressReleaseParagraphs(s) {
+		paragraph++
+		words += len(strings.Fields(p.Text))
+
+		quotes := 0
+		for _, q := range p.Quotes {
+			if len(q.Text) > minQuoteLength {
+				quotes++
+				quoted += len(strings.Fields(q.Text))
+			}
+		}
+		if quotes == 0 {
+			continue
+		}
+		if firstQuote == 0 {
+			firstQuote, firstLine = paragraph, p.Quotes[0].Position.Line
+		}
+		// A quote broken by its attribution ("...," she said. "...") is still one quote
+		if speakers := len(attributionPattern.FindAllString(p.Text, -1)); quotes > 1 && speakers > 1 {
+			a.issueAt(p.Span.Start, fmt.Sprintf("%s: give each of the %d speakers a paragraph of their own", quoteCrowdedMessage, speakers))
+		}
+	}
+
+	if firstQuote > maxFirstQuoteParagraph {
+		a.issueAt(firstLine, fmt.Sprintf("%s: it is in paragraph %d; move a quote up to paragraph 2 or 3", quoteLateMessage, firstQuote))
+	}
+	if words > 0 && quoted*100/words > maxQuoteShare {
+		a.issue(fmt.Sprintf("%s: quotes are %d%% of the press release; keep them under %d%%", quoteHeavyMessage, quoted*100/words, maxQuoteShare))
+	}
+	return a
+}
+
+// isQuotePlacementIssue reports whether an issue comes from the quote
+// placement checks, which the report lists with the quote analysis.
+func isQuotePlacementIssue(issue string) bool {
+	head, _, _ := strings.Cut(issue, ": ")
+	return head == quoteLateMessage || head == quoteCrowdedMessage || head == quoteHeavyMessage
+}
+
+// QuoteVoice is who a quote speaks for, judged from its attribution. It is
+// empty for a quote with no attribution.
+type QuoteVoice string
+
+// Quote voices. Only VoiceExecutive speaks for the announcing company.
+const (
+	VoiceCustomer  QuoteVoice = "customer"
+	VoicePartner   QuoteVoice = "partner"
+	VoiceExecutive QuoteVoice = "executive"
+	VoiceAnalyst   QuoteVoice = "analyst"
+)
+
+// QuoteVoices lists the voices in report order.
+var QuoteVoices = []QuoteVoice{VoiceCustomer, VoicePartner, VoiceAnalyst, VoiceExecutive}
+
+// voiceRules is the first rules version that withholds Customer Evidence
+// points from press releases quoting only the company.
+var voiceRules = RulesVersion{Major: 3}
+
+// maxCompanyQuoteScore is the most Quote Quality points a press release
+// earns when every quote comes from the company.
+const maxCompanyQuoteScore = 10
+
+var (
+	// aboutNamePattern captures the company of an "About <Company>" line.
+	aboutNamePattern = regexp.MustCompile(`(?m)^[#*_\s]*About\s+([A-Z][^\n*_:]{0,59}?)[*_:\s]*$`)
+	// announcerPattern captures the company in "Acme today announced".
+	announcerPattern = regexp.MustCompile(`\b([A-Z][\w&.-]*(?:\s+[A-Z][\w&.-]*){0,3}),?\s+(?:today\s+|formally\s+)?(?:announced|launched|introduced|unveiled|released|(?:is|are)\s+(?:pleased|proud|excited|thrilled)\s+to\s+announce)\b`)
+	// corporateSuffixPattern matches a trailing "Inc." or similar.
+	corporateSuffixPattern = regexp.MustCompile(`,?\s+(?:Inc|Corp|Corporation|LLC|Ltd|GmbH|PLC|Co)\.?$`)
+	// analystPattern matches the attribution of an industry analyst.
+	analystPattern = regexp.MustCompile(`(?i)\b(?:analysts?|gartner|forrester|idc|research director)\b`)
+	// partnerPattern matches the attribution of a partner.
+	partnerPattern = regexp.MustCompile(`(?i)\b(?:partners?|resellers?|integrators?)\b`)
+	// jobTitlePattern matches a job title in an attribution.
+	jobTitlePattern = regexp.MustCompile(`(?i)\b(?:C[A-Z]O|[SE]?VP|chief|president|founder|co-founder|head of|director|manager|engineer|officer|spokesperson)\b`)
+	// otherOrgPattern matches a speaker's organization, as in "at Initech".
+	otherOrgPattern = regexp.MustCompile(`\b(?:at|from|with)\s+[A-Z]`)
+)
+
+// companyNames returns patterns matching the announcing company's names
+// found in a press release, without corporate suffixes: from the "About"
+// boilerplate heading and from "<Company> today announced".
+func companyNames(content string) []*regexp.Regexp {
+	plain := strings.NewReplacer("**", "", "__", "").Replace(content)
+	var names []string
+	for _, m := range aboutNamePattern.FindAllStringSubmatch(plain, -1) {
+		names = append(names, m[1])
+	}
+	for _, m := range announcerPattern.FindAllStringSubmatch(plain, -1) {
+		names = append(names, m[1])
+	}
+	var out []*regexp.Regexp
+	for _, name := range names {
+		name = strings.TrimSpace(corporateSuffixPattern.ReplaceAllString(strings.TrimSpace(name), ""))
+		if name != "" && name != "Today" {
+			out = append(out, regexp.MustCompile(`\b`+regexp.QuoteMeta(name)+`\b`))
+		}
+	}
+	return out
+}
+
+// stripQuotes returns a paragraph with every quotation removed, leaving its
+// attribution, e.g. ", said Dana Lee, controller at Initech."
+func stripQuotes(p string) string {
+	for _, re := range quotePatterns {
+		p = re.ReplaceAllString(p, " ")
+	}
+	return strings.NewReplacer("**", "", "__", "", "*", "").Replace(p)
+}
+
+// classifyVoice judges who an attribution speaks for. An analyst firm or
+// title makes an analyst, and a partner or reseller a partner, even when
+// the company is named ("an Acme partner"). Otherwise the company's name, or
+// a job title with no other organization, makes an executive; anyone else is
+// a customer.
+func classifyVoice(attribution string, companies []*regexp.Regexp) QuoteVoice {
+	if analystPattern.MatchString(attribution) {
+		return VoiceAnalyst
+	}
+	if partnerPattern.MatchString(attribution) {
+		return VoicePartner
+	}
+	for _, company := range companies {
+		if company.MatchString(attribution) {
+			return VoiceExecutive
+		}
+	}
+	if jobTitlePattern.MatchString(attribution) && !otherOrgPattern.MatchString(attribution) {
+		return VoiceExecutive
+	}
+	return VoiceCustomer
+}
+
+// classifyQuotes sets the voice of every quote from the attribution in its
+// paragraph. A paragraph of quotes with no attribution, such as the second
+// paragraph of a long quote, keeps the voice of the paragraph before it;
+// other unattributed quotes have no voice.
+func classifyQuotes(content string, details []MetricInfo) {
+	companies := companyNames(content)
+	type quoted struct {
+		text  string
+		voice QuoteVoice
+	}
+	var paragraphs []quoted
+	var voice QuoteVoice
+	for _, p := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		switch text := stripQuotes(p); {
+		case text == p:
+			voice = ""
+		case attributionPattern.MatchString(text):
+			voice = classifyVoice(text, companies)
+		}
+		paragraphs = append(paragraphs, quoted{p, voice})
+	}
+	for i := range details {
+		for _, p := range paragraphs {
+			if strings.Contains(p.text, details[i].Quote) {
+				details[i].Voice = p.voice
+				break
+			}
+		}
+	}
+}
+
+// VoiceMix counts the quotes of each voice.
+func VoiceMix(details []MetricInfo) map[QuoteVoice]int {
+	mix := map[QuoteVoice]int{}
+	for _, d := range details {
+		mix[d.Voice]++
+	}
+	return mix
+}
+
+// voiceMixSummary describes a voice mix, e.g. "2 customer, 1 executive".
+func voiceMixSummary(mix map[QuoteVoice]int) string {
+	var parts []string
+	for _, v := range QuoteVoices {
+		if mix[v] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", mix[v], v))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// companyOnly reports whether the company is quoted and no one else is.
+// Unattributed quotes are not counted.
+func companyOnly(details []MetricInfo) bool {
+	mix := VoiceMix(details)
+	return mix[VoiceExecutive] > 0 && mix[VoiceCustomer]+mix[VoicePartner]+mix[VoiceAnalyst] == 0
+}
@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestScoreQuotePlacement(t *testing.T) {
+	lead := "SEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync, which reconciles bank feeds overnight for finance teams.\n\n"
+	detail := "Ledger Sync matches every entry in the general ledger against the bank feed and flags the ones that differ.\n\n"
+	quote := "\"Our close went from ten days to three,\" said Dana Lee, controller at Initech.\n\n"
+	tests := []struct {
+		name  string
+		pr    string
+		want  []string
+		lines []int
+	}{
+		{"well placed", lead + detail + quote + detail, nil, nil},
+		{"late", lead + detail + detail + detail + quote,
+			[]string{quoteLateMessage + ": it is in paragraph 5; move a quote up to paragraph 2 or 3"}, []int{13}},
+		{"two speakers", lead + detail + "\"Our close went from ten days to three,\" said Dana Lee. \"We finally trust the numbers on day one,\" added Sam Ortiz.\n\n" + detail,
+			[]string{quoteCrowdedMessage + ": give each of the 2 speakers a paragraph of their own"}, []int{9}},
+		{"split quote", lead + detail + "\"Our close went from ten days to three,\" said Dana Lee. \"We finally trust the numbers on day one.\"\n\n" + detail, nil, nil},
+		{"heavy", lead + "\"Our close went from ten days to three and we finally trust the numbers on day one of every single month,\" said Dana Lee.\n\n",
+			[]string{quoteHeavyMessage + ": quotes are 47% of the press release; keep them under 40%"}, []int{0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\n" + tt.pr + "## FAQ\n\nQ: Why?\nA: Speed.\n"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			a := scoreQuotePlacement(sections)
+			if !slices.Equal(a.issues, tt.want) {
+				t.Fatalf("issues = %q, want %q", a.issues, tt.want)
+			}
+			for i, line := range tt.lines {
+				if a.lines[i] != line {
+					t.Errorf("issue %d on line %d, want %d", i, a.lines[i], line)
+				}
+			}
+		})
+	}
+}
+
+func TestScore_QuotePlacementRules(t *testing.T) {
+	sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\n" +
+		"Acme today announced Ledger Sync.\n\n\"Our close went from ten days to three and we finally trust the numbers,\" said Dana Lee.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	has := func() bool {
+		return slices.ContainsFunc(Score(sections).QualityBreakdown.Issues, isQuotePlacementIssue)
+	}
+	if !has() {
+		t.Errorf("%s has no quote placement findings", CurrentRules)
+	}
+	if report := GenerateMarkdownReport(sections, Score(sections)); !strings.Contains(report, "**Placement and Density:**\n- "+quoteHeavyMessage) {
+		t.Errorf("report does not list the placement findings with the quote analysis:\n%s", report)
+	}
+	sections.Rules = RulesVersion{Major: 2, Minor: 1}
+	if has() {
+		t.Errorf("%s has quote placement findings", sections.Rules)
+	}
+}
+
+func TestClassifyVoice(t *testing.T) {
+	companies := companyNames("Acme Corp. today announced Ledger Sync.\n\n**About Acme Corp.**\nAcme makes ledgers.")
+	tests := []struct {
+		attribution string
+		want        QuoteVoice
+	}{
+		{", said Dana Lee, controller at Initech.", VoiceCustomer},
+		{", said Dana Lee, a nurse in Ohio.", VoiceCustomer},
+		{", said Sam Ortiz, CEO of Acme.", VoiceExecutive},
+		{", said Acme founder Sam Ortiz.", VoiceExecutive},
+		{", said Sam Ortiz, Product Manager.", VoiceExecutive},
+		{", said Kim Park, VP of Alliances at Globex, an Acme partner.", VoicePartner},
+		{", said Kim Park, managing partner at Globex Consulting.", VoicePartner},
+		{", said Lee Chan, principal analyst at Forrester.", VoiceAnalyst},
+	}
+	for _, tt := range tests {
+		if got := classifyVoice(tt.attribution, companies); got != tt.want {
+			t.Errorf("classifyVoice(%q) = %q, want %q", tt.attribution, got, tt.want)
+		}
+	}
+}
+
+func TestScore_CompanyOnlyQuotes(t *testing.T) {
+	pr := "Acme today announced Ledger Sync, which cut the monthly close from 10 days to 3 days for 40 customers.\n\n" +
+		"\"Ledger Sync cut our customers' close by 70%, saved 12 hours a week, and grew revenue 3x,\" said Sam Ortiz, CEO of Acme.\n\n" +
+		"\"We reduced errors by 45% and processed 2,000 transactions per minute in 200 milliseconds,\" said Kim Park, CTO of Acme.\n"
+	score := func(pr string, rules RulesVersion) *PRScore {
+		sections, err := Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\n" + pr))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		sections.Rules = rules
+		return Score(sections)
+	}
+
+	got := score(pr, CurrentRules)
+	if mix := VoiceMix(got.MetricDetails); mix[VoiceExecutive] != 2 {
+		t.Fatalf("VoiceMix() = %v, want 2 executive quotes", mix)
+	}
+	if got.QualityBreakdown.QuoteScore != maxCompanyQuoteScore || !slices.Contains(got.QualityBreakdown.Issues, quoteCompanyMessage) {
+		t.Errorf("QuoteScore = %d, issues %q; want it capped at %d with a finding", got.QualityBreakdown.QuoteScore, got.QualityBreakdown.Issues, maxCompanyQuoteScore)
+	}
+	if v2 := score(pr, supportedRules[2]); v2.QualityBreakdown.QuoteScore <= maxCompanyQuoteScore {
+		t.Errorf("rules/v2 QuoteScore = %d, want the uncapped score", v2.QualityBreakdown.QuoteScore)
+	}
+
+	customer := strings.Replace(pr, "Kim Park, CTO of Acme", "Kim Park, controller at Initech", 1)
+	if got := score(customer, CurrentRules); got.QualityBreakdown.QuoteScore <= maxCompanyQuoteScore {
+		t.Errorf("QuoteScore with a customer quote = %d, want the full score", got.QualityBreakdown.QuoteScore)
+	}
+}
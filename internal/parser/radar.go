@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RadarMermaid returns a Mermaid radar chart of categories, each score as a
+// percentage of its maximum so categories with different weights compare.
+// The chart shows a document's shape at a glance: strong structure, say,
+// with weak evidence.
+func RadarMermaid(categories []CategoryScore) string {
+	var b strings.Builder
+	b.WriteString("```mermaid\nradar-beta\n  axis ")
+	for i, c := range categories {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "c%d[%q]", i+1, c.Name)
+	}
+	b.WriteString("\n  curve score[\"Score %\"]{")
+	for i, c := range categories {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%d", Percent(c.Score, c.Max))
+	}
+	b.WriteString("}\n  max 100\n  min 0\n```\n")
+	return b.String()
+}
+
+// Percent returns score as a whole-number percentage of max, or 0 when max is 0.
+func Percent(score, max int) int {
+	if max == 0 {
+		return 0
+	}
+	return score * 100 / max
+}
+
+// writeRadar writes the report's score shape section.
+func writeRadar(report *strings.Builder, b PRQualityBreakdown) {
+	report.WriteString("## 🕸️ Score Shape\n\n")
+	report.WriteString("Each category as a percentage of its maximum.\n\n")
+	report.WriteString(RadarMermaid(b.Categories()))
+	report.WriteString("\n")
+}
@@ -0,0 +1,131 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ReviewState is where a document stands in its review workflow.
+type ReviewState string
+
+// Review states, in workflow order.
+const (
+	ReviewDraft    ReviewState = "draft"
+	ReviewInReview ReviewState = "in-review"
+	ReviewApproved ReviewState = "approved"
+)
+
+// ReviewStates lists the review states in workflow order.
+var ReviewStates = []ReviewState{ReviewDraft, ReviewInReview, ReviewApproved}
+
+// ParseReviewState parses a review state such as "in-review". Spaces and
+// underscores may stand in for the hyphen.
+func ParseReviewState(s string) (ReviewState, error) {
+	norm := strings.NewReplacer(" ", "-", "_", "-").Replace(strings.ToLower(strings.TrimSpace(s)))
+	for _, state := range ReviewStates {
+		if string(state) == norm {
+			return state, nil
+		}
+	}
+	names := make([]string, len(ReviewStates))
+	for i, state := range ReviewStates {
+		names[i] = string(state)
+	}
+	return "", fmt.Errorf("unknown review status %q (want %s)", s, strings.Join(names, ", "))
+}
+
+// Signoff is a reviewer's approval of a document.
+type Signoff struct {
+	Reviewer string `yaml:"reviewer"`
+	Role     string `yaml:"role"`
+	Date     string `yaml:"date"`
+}
+
+// Review is a document's workflow state and the reviewers who signed off
+// on it, from the front matter or a review sidecar file.
+type Review struct {
+	State    ReviewState // "" when the document does not say
+	Signoffs []Signoff
+}
+
+// reviewFields are the front matter and sidecar keys of a Review.
+type reviewFields struct {
+	Status   string    `yaml:"status"`
+	Signoffs []Signoff `yaml:"signoffs"`
+}
+
+// apply sets the state, when f gives one, and adds f's sign-offs to r.
+func (r *Review) apply(f reviewFields) error {
+	if f.Status != "" {
+		state, err := ParseReviewState(f.Status)
+		if err != nil {
+			return err
+		}
+		r.State = state
+	}
+	for _, s := range f.Signoffs {
+		if strings.TrimSpace(s.Reviewer) == "" {
+			return errors.New("sign-off without a reviewer")
+		}
+		r.Signoffs = append(r.Signoffs, s)
+	}
+	return nil
+}
+
+// ReviewFile returns the path of the review sidecar of the document at
+// path: docs/launch.md has docs/launch.review.yaml.
+func ReviewFile(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ".review.yaml"
+}
+
+// applyReviewFile applies the review sidecar of the document at path, if
+// there is one. Its status overrides the front matter's; its sign-offs are
+// added to the front matter's.
+func (s *SpecSections) applyReviewFile(path string) error {
+	sidecar := ReviewFile(path)
+	data, err := os.ReadFile(sidecar) //nolint:gosec // derived from the user-provided document path
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrRead, err)
+	}
+	var f reviewFields
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrReview, sidecar, err)
+	}
+	if err := s.Review.apply(f); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrReview, sidecar, err)
+	}
+	return nil
+}
+
+// Approved reports whether the document is marked approved.
+func (r Review) Approved() bool {
+	return r.State == ReviewApproved
+}
+
+// writeReview writes the report's sign-off section.
+func writeReview(report *strings.Builder, r Review) {
+	if len(r.Signoffs) == 0 {
+		return
+	}
+	report.WriteString("## ✍️ Sign-offs\n\n")
+	report.WriteString("| Reviewer | Role | Date |\n")
+	report.WriteString("|----------|------|------|\n")
+	for _, s := range r.Signoffs {
+		fmt.Fprintf(report, "| %s | %s | %s |\n", cellText(s.Reviewer), cellText(s.Role), cellText(s.Date))
+	}
+	report.WriteString("\n")
+}
+
+// cellText escapes text for a markdown table cell.
+func cellText(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
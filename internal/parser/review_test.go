@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseReviewState(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ReviewState
+		wantErr bool
+	}{
+		{"draft", ReviewDraft, false},
+		{"In Review", ReviewInReview, false},
+		{"in_review", ReviewInReview, false},
+		{" APPROVED ", ReviewApproved, false},
+		{"shipped", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseReviewState(tt.in)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseReviewState(%q) = %q, %v, want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+const reviewDoc = `---
+status: in-review
+signoffs:
+  - reviewer: Dana Lee
+    role: VP Product
+    date: 2026-03-02
+---
+# Acme Launches Ledger Sync
+
+## Press Release
+
+Acme today launched Ledger Sync.
+`
+
+func TestParseSource_Review(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "launch.md")
+
+	sections, err := ParseSource(path, []byte(reviewDoc))
+	if err != nil {
+		t.Fatalf("ParseSource() error = %v", err)
+	}
+	want := Review{State: ReviewInReview, Signoffs: []Signoff{{Reviewer: "Dana Lee", Role: "VP Product", Date: "2026-03-02"}}}
+	if !reflect.DeepEqual(sections.Review, want) {
+		t.Errorf("Review = %+v, want %+v", sections.Review, want)
+	}
+
+	// The sidecar's status wins; its sign-offs are added
+	sidecar := "status: approved\nsignoffs:\n  - reviewer: Sam | Ops\n"
+	if err := os.WriteFile(filepath.Join(dir, "launch.review.yaml"), []byte(sidecar), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	sections, err = ParseSource(path, []byte(reviewDoc))
+	if err != nil {
+		t.Fatalf("ParseSource() error = %v", err)
+	}
+	if !sections.Review.Approved() || len(sections.Review.Signoffs) != 2 {
+		t.Errorf("Review = %+v, want approved with 2 sign-offs", sections.Review)
+	}
+
+	report := GenerateMarkdownReport(sections, sections.PRScore)
+	for _, want := range []string{"**Review Status:** approved\n", "| Dana Lee | VP Product | 2026-03-02 |", `| Sam \| Ops |  |  |`} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report lacks %q", want)
+		}
+	}
+}
+
+func TestParseSource_InvalidReview(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "launch.md")
+	if _, err := ParseSource(path, []byte("---\nstatus: shipped\n---\n# Doc\n")); !errors.Is(err, ErrFrontMatter) {
+		t.Errorf("unknown front matter status error = %v, want %v", err, ErrFrontMatter)
+	}
+
+	for name, sidecar := range map[string]string{
+		"unknown status": "status: shipped\n",
+		"no reviewer":    "signoffs:\n  - role: VP Product\n",
+		"not yaml":       "status: [approved\n",
+	} {
+		if err := os.WriteFile(ReviewFile(path), []byte(sidecar), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ParseSource(path, []byte("# Doc\n")); !errors.Is(err, ErrReview) {
+			t.Errorf("%s: error = %v, want %v", name, err, ErrReview)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxRewrites caps the sentence rewrites a report suggests.
+const maxRewrites = 10
+
+// SentenceRewrite is a suggested rewrite of a press release sentence that is
+// too long, passive, or fluffy.
+type SentenceRewrite struct {
+	Line   int    // 1-based line the sentence starts on
+	Reason string // why it was flagged, e.g. "passive voice"
+	Before string
+	After  string
+}
+
+// Reasons a sentence is rewritten.
+const (
+	reasonFluff   = "marketing language"
+	reasonPassive = "passive voice"
+	reasonLong    = "too long"
+)
+
+var (
+	// hypePattern matches a run of hype words with their commas, as in "a
+	// revolutionary, world-class tool", and a leading article or "most".
+	hypePattern = regexp.MustCompile(`(?i)\b(an?\s+)?(?:(?:most\s+)?(?:` + quoteAll(hypeWords) + `)\b,?\s*(?:and\s+)?)+`)
+	// excitedPattern matches an executive's excitement before the verb it
+	// delays, as in "is thrilled to announce".
+	excitedPattern = regexp.MustCompile(`(?i)(?:\s+(is|are|am)|['’](?:re|m))\s+(?:so\s+|very\s+|really\s+)?(?:excited|thrilled|delighted|pleased|proud|honored)\s+to\s+(\w+)`)
+	// passivePattern matches a sentence that is one passive clause with its
+	// agent, as in "The dashboard was redesigned by our UX team."
+	passivePattern = regexp.MustCompile(`^([^,;]+?) (was|were|has been|have been|had been) (\w+ed) by ([^,;]+?)([.!?])$`)
+	// clauseBreakPattern matches where a long sentence can be split in two.
+	clauseBreakPattern = regexp.MustCompile(`; |, (?:and|but|so|which) `)
+)
+
+// quoteAll joins words into a regexp alternation.
+func quoteAll(words []string) string {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = regexp.QuoteMeta(w)
+	}
+	return strings.Join(quoted, "|")
+}
+
+// Rewrites returns rewrites for the press release sentences that are too
+// long for the audience and tone, passive, fluffy, or misspell a brand name,
+// in source order and at most maxRewrites. Only sentences the rules can
+// rewrite mechanically are included; the rest are left to the findings.
+func (s *SpecSections) Rewrites() []SentenceRewrite {
+	if s.Tree == nil || s.Positions.PressRelease.Start == 0 {
+		return nil
+	}
+	longWords := s.Audience.profile().longWords + s.Tone.profile().lengthOffset
+	brands := brandNames(s.BrandNames)
+
+	var out []SentenceRewrite
+	for _, p := range treeParagraphs(s.Tree) {
+		if !s.Positions.PressRelease.contains(p.Span.Start) || !isProse(p.Text) {
+			continue
+		}
+		for _, sentence := range p.Sentences {
+			fixed := fixBrands(sentence.Text, brands)
+			r, ok := rewriteSentence(fixed, longWords)
+			if fixed != sentence.Text {
+				r.Before = sentence.Text
+				r.Reason = strings.TrimSuffix(reasonBrand+", "+r.Reason, ", ")
+				ok = true
+			}
+			if ok {
+				r.Line = sentence.Position.Line
+				out = append(out, r)
+				if len(out) == maxRewrites {
+					return out
+				}
+			}
+		}
+	}
+	return out
+}
+
+// writeRewrites writes the report section with a before/after block for
+// each rewritten sentence.
+func writeRewrites(report *strings.Builder, rewrites []SentenceRewrite) {
+	if len(rewrites) == 0 {
+		return
+	}
+	report.WriteString("## ✏️ Suggested Rewrites\n\n")
+	report.WriteString("Flagged sentences with a mechanical rewrite. Check each one reads right before copying it.\n\n")
+	for _, r := range rewrites {
+		report.WriteString(fmt.Sprintf("**Line %d** (%s)\n\n```diff\n- %s\n+ %s\n```\n\n", r.Line, r.Reason, r.Before, r.After))
+	}
+}
+
+// treeParagraphs returns every paragraph of t in document order.
+func treeParagraphs(t *DocumentTree) []Paragraph {
+	out := append([]Paragraph{}, t.Paragraphs...)
+	var walk func([]*Section)
+	walk = func(sections []*Section) {
+		for _, sec := range sections {
+			out = append(out, sec.Paragraphs...)
+			walk(sec.Subsections)
+		}
+	}
+	walk(t.Sections)
+	return out
+}
+
+// isProse reports whether a paragraph has no table or code lines.
+func isProse(text string) bool {
+	for _, kind := range classifyLines(strings.Split(text, "\n")) {
+		if kind != blockProse {
+			return false
+		}
+	}
+	return true
+}
+
+// rewriteSentence applies every rewrite that changes sentence: fluff first,
+// then passive voice, then splitting a sentence longer than longWords.
+func rewriteSentence(sentence string, longWords int) (SentenceRewrite, bool) {
+	r := SentenceRewrite{Before: sentence, After: sentence}
+	var reasons []string
+	for _, step := range []struct {
+		reason  string
+		rewrite func(string) string
+	}{
+		{reasonFluff, dropFluff},
+		{reasonPassive, activeVoice},
+		{reasonLong, func(s string) string { return splitLong(s, longWords) }},
+	} {
+		if after := step.rewrite(r.After); after != r.After {
+			r.After = after
+			reasons = append(reasons, step.reason)
+		}
+	}
+	r.Reason = strings.Join(reasons, ", ")
+	return r, len(reasons) > 0
+}
+
+// dropFluff removes hype words and executive excitement: "We are thrilled
+// to announce a revolutionary app" becomes "We announce an app".
+func dropFluff(s string) string {
+	out := dropHype(s)
+	return excitedPattern.ReplaceAllStringFunc(out, func(m string) string {
+		sub := excitedPattern.FindStringSubmatch(m)
+		verb := sub[2]
+		if strings.EqualFold(sub[1], "is") {
+			verb = thirdPerson(verb)
+		}
+		return " " + verb
+	})
+}
+
+// dropHype removes hype words, fixing the article before them: "an
+// unprecedented, world-class tool" becomes "a tool".
+func dropHype(s string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range hypePattern.FindAllStringSubmatchIndex(s, -1) {
+		b.WriteString(s[last:loc[0]])
+		last = loc[1]
+		if loc[2] < 0 {
+			continue
+		}
+		article := "a "
+		if next := s[loc[1]:]; next != "" && strings.ContainsRune("aeiouAEIOU", rune(next[0])) {
+			article = "an "
+		}
+		if s[loc[2]] == 'A' {
+			article = strings.ToUpper(article[:1]) + article[1:]
+		}
+		b.WriteString(article)
+	}
+	b.WriteString(s[last:])
+	out := b.String()
+	if r, _ := utf8.DecodeRuneInString(s); unicode.IsUpper(r) && out != "" {
+		out = upperFirst(out)
+	}
+	return out
+}
+
+// thirdPerson conjugates a verb for a singular subject: "announce" becomes
+// "announces", "launch" becomes "launches".
+func thirdPerson(verb string) string {
+	for _, suffix := range []string{"s", "x", "ch", "sh"} {
+		if strings.HasSuffix(verb, suffix) {
+			return verb + "es"
+		}
+	}
+	return verb + "s"
+}
+
+// activeVoice turns a passive sentence with an agent around: "The dashboard
+// was redesigned by our UX team." becomes "Our UX team redesigned the
+// dashboard."
+func activeVoice(s string) string {
+	m := passivePattern.FindStringSubmatch(s)
+	if m == nil {
+		return s
+	}
+	subject, aux, verb, agent, end := m[1], m[2], m[3], m[4], m[5]
+	switch aux {
+	case "has been", "have been":
+		aux = "has"
+		if pluralNoun(agent) {
+			aux = "have"
+		}
+		verb = aux + " " + verb
+	case "had been":
+		verb = "had " + verb
+	}
+	return upperFirst(agent) + " " + verb + " " + lowerDeterminer(subject) + end
+}
+
+// pluralNoun guesses whether a noun phrase is plural from its last word.
+func pluralNoun(phrase string) bool {
+	words := strings.Fields(phrase)
+	last := strings.ToLower(words[len(words)-1])
+	return strings.HasSuffix(last, "s") && !strings.HasSuffix(last, "ss") && !strings.HasSuffix(last, "us")
+}
+
+// lowerDeterminer lowercases a phrase that opens with a determiner, such as
+// "The dashboard", and leaves proper nouns alone.
+func lowerDeterminer(phrase string) string {
+	first, _, _ := strings.Cut(phrase, " ")
+	switch first {
+	case "The", "This", "That", "These", "Those", "A", "An", "Our", "Its", "Their", "Every", "Each":
+		return strings.ToLower(first) + phrase[len(first):]
+	}
+	return phrase
+}
+
+// splitLong splits a sentence of more than longWords words in two at the
+// clause break nearest its middle, when both halves have at least four words.
+func splitLong(s string, longWords int) string {
+	words := len(strings.Fields(s))
+	if words <= longWords {
+		return s
+	}
+	best, bestDistance := []int(nil), len(s)
+	for _, loc := range clauseBreakPattern.FindAllStringIndex(s, -1) {
+		if len(strings.Fields(s[:loc[0]])) < 4 || len(strings.Fields(s[loc[1]:])) < 4 || !clauseBreak(s, loc) {
+			continue
+		}
+		if d := abs(loc[0] - len(s)/2); d < bestDistance {
+			best, bestDistance = loc, d
+		}
+	}
+	if best == nil {
+		return s
+	}
+	first, rest := s[:best[0]], s[best[1]:]
+	switch brk := s[best[0]:best[1]]; brk {
+	case ", but ", ", so ":
+		rest = strings.TrimPrefix(brk, ", ") + rest
+	case ", which ":
+		rest = "It " + rest
+	}
+	return first + ". " + upperFirst(rest)
+}
+
+// clauseStarters are words that open an independent clause after "and",
+// "but", or "so": pronouns and determiners that begin a subject.
+var clauseStarters = map[string]bool{
+	"i": true, "we": true, "you": true, "he": true, "she": true, "it": true, "they": true, "there": true,
+	"our": true, "your": true, "his": true, "her": true, "its": true, "their": true, "my": true,
+	"this": true, "that": true, "these": true, "those": true, "the": true,
+	"many": true, "most": true, "some": true, "every": true, "each": true,
+}
+
+// clauseBreak reports whether the break at loc separates two clauses rather
+// than ending a list, as ", and" does in "bank, card, and payroll". After
+// "and", "but", or "so" the next word must start a subject, and the text
+// since the previous comma must be longer than a list item.
+func clauseBreak(s string, loc []int) bool {
+	brk := s[loc[0]:loc[1]]
+	if brk == "; " || brk == ", which " {
+		return true
+	}
+	next, _, _ := strings.Cut(s[loc[1]:], " ")
+	if !clauseStarters[strings.ToLower(next)] {
+		return false
+	}
+	before := s[:loc[0]]
+	if i := strings.LastIndex(before, ", "); i >= 0 {
+		before = before[i+2:]
+	}
+	return len(strings.Fields(before)) > 4
+}
+
+// upperFirst capitalizes the first letter of s.
+func upperFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRewriteSentence(t *testing.T) {
+	tests := []struct {
+		name       string
+		sentence   string
+		wantAfter  string
+		wantReason string
+	}{
+		{"hype", "Acme launched a revolutionary, world-class ledger.", "Acme launched a ledger.", reasonFluff},
+		{"article", "It is an unprecedented and exceptional upgrade.", "It is an upgrade.", reasonFluff},
+		{"excitement", "Acme is thrilled to launch Ledger Sync.", "Acme launches Ledger Sync.", reasonFluff},
+		{"contraction", "We're so excited to announce Ledger Sync.", "We announce Ledger Sync.", reasonFluff},
+		{"passive", "The dashboard was redesigned by our UX team.", "Our UX team redesigned the dashboard.", reasonPassive},
+		{"perfect passive", "Ledger Sync has been adopted by 40 finance teams.", "40 finance teams have adopted Ledger Sync.", reasonPassive},
+		{"long", "Ledger Sync matches every entry in the general ledger against the bank feed overnight, and our finance teams start each morning with a reconciled close.",
+			"Ledger Sync matches every entry in the general ledger against the bank feed overnight. Our finance teams start each morning with a reconciled close.", reasonLong},
+		{"fluff and long", "Acme launched an industry-leading ledger that matches every entry against the bank feed overnight, so our finance teams start each morning with a reconciled close and no spreadsheets.",
+			"Acme launched a ledger that matches every entry against the bank feed overnight. So our finance teams start each morning with a reconciled close and no spreadsheets.", reasonFluff + ", " + reasonLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := rewriteSentence(tt.sentence, 20)
+			if !ok || got.After != tt.wantAfter || got.Reason != tt.wantReason {
+				t.Errorf("rewriteSentence() = %q (%s), %v; want %q (%s)", got.After, got.Reason, ok, tt.wantAfter, tt.wantReason)
+			}
+		})
+	}
+
+	// Passive voice without an agent, long sentences without a clause break,
+	// and lists are left to the findings
+	for _, s := range []string{
+		"Ledger Sync will be available in March.",
+		"Ledger Sync reconciles bank, card, and payroll transactions against the general ledger every night so finance teams close in three days.",
+		"Ledger Sync matches every single entry in the general ledger against the bank feed overnight for every finance team in the company.",
+	} {
+		if got, ok := rewriteSentence(s, 20); ok {
+			t.Errorf("rewriteSentence(%q) = %q, want no rewrite", s, got.After)
+		}
+	}
+}
+
+func TestSpecSections_Rewrites(t *testing.T) {
+	doc := "# Acme Launches Ledger Sync\n\n## Press Release\n\n" +
+		"Acme today launched Ledger Sync.\n" +
+		"The close was shortened by finance teams.\n\n" +
+		"| Plan | Note |\n|---|---|\n| Pro | The dashboard was redesigned by our UX team. |\n\n" +
+		"## FAQ\n\nQ: Why?\nA: The FAQ was written by our PM team.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got := sections.Rewrites()
+	if len(got) != 1 {
+		t.Fatalf("Rewrites() = %+v, want only the press release prose sentence", got)
+	}
+	if got[0].Line != 6 || got[0].After != "Finance teams shortened the close." {
+		t.Errorf("Rewrites()[0] = %+v", got[0])
+	}
+}
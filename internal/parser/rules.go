@@ -0,0 +1,126 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrRulesVersion is returned when a pinned rules version is not available
+// in this release.
+var ErrRulesVersion = errors.New("unsupported rules version")
+
+// RulesVersion is the semantic version of the scoring model: the rules,
+// their IDs, and the points they award.
+//
+//   - Major changes when the same document can score differently, or a rule
+//     ID is renamed or removed.
+//   - Minor changes when rules are added that report findings without
+//     changing scores.
+//   - Patch changes for message wording and fixes that change neither.
+type RulesVersion struct {
+	Major, Minor, Patch int
+}
+
+// CurrentRules is the scoring model of this release.
+//
+// rules/v2 leaves tables and code blocks out of the sentence-length and
+// passive-voice statistics, which rules/v1 counted as prose. rules/v2.1 adds
+// the image checks, and rules/v2.2 the quote placement checks. rules/v3
+// caps Quote Quality when every quote comes from the company, rules/v3.1
+// adds the anti-pattern catalog, and rules/v3.2 reports a lead without the
+// key metric as a buried lede. rules/v4 deducts Tone & Readability points
+// for hedging, rules/v4.1 adds the forward-looking statement checks, and
+// rules/v4.2 the claims traceability check. rules/v5 adds the Customer
+// Focus category, rules/v5.1 the feature dump check, rules/v5.2 the
+// pricing and availability disclosure checks, rules/v5.3 the quantified
+// problem check, rules/v5.4 the legal phrase screening, rules/v5.5 the
+// brand name check, rules/v5.6 the out-of-scope FAQ check, rules/v5.7 the
+// separate internal and external FAQ checks, and rules/v5.8 the leakage
+// check.
+var CurrentRules = RulesVersion{Major: 5, Minor: 8, Patch: 0}
+
+// supportedRules holds the latest version of each major version this release
+// can score with. A release that bumps the major version keeps the previous
+// one here, so pipelines pinned to it keep their scores until they opt in.
+var supportedRules = map[int]RulesVersion{
+	1: {Major: 1, Minor: 2, Patch: 0},
+	2: {Major: 2, Minor: 2, Patch: 0},
+	3: {Major: 3, Minor: 2, Patch: 0},
+	4: {Major: 4, Minor: 2, Patch: 0},
+	5: CurrentRules,
+}
+
+// ID is the stable identifier of the major version, e.g. "rules/v1".
+func (v RulesVersion) ID() string {
+	return fmt.Sprintf("rules/v%d", v.Major)
+}
+
+// String is the full version, e.g. "rules/v1.0.0".
+func (v RulesVersion) String() string {
+	return fmt.Sprintf("rules/v%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// ParseRulesVersion parses a pin such as "rules/v1", "v1.2", or "1.2.0".
+// Omitted minor and patch numbers are zero.
+func ParseRulesVersion(s string) (RulesVersion, error) {
+	text := strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(s), "rules/"), "v")
+	parts := strings.Split(text, ".")
+	if text == "" || len(parts) > 3 {
+		return RulesVersion{}, fmt.Errorf("%w: %q is not a version like rules/v1 or 1.2.0", ErrRulesVersion, s)
+	}
+	var nums [3]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return RulesVersion{}, fmt.Errorf("%w: %q is not a version like rules/v1 or 1.2.0", ErrRulesVersion, s)
+		}
+		nums[i] = n
+	}
+	return RulesVersion{Major: nums[0], Minor: nums[1], Patch: nums[2]}, nil
+}
+
+// ResolveRules checks a pin against the versions this release provides and
+// returns the version documents will be scored with. The empty pin is
+// CurrentRules. A pin is satisfied by the latest supported version of its
+// major version if that is at or above the pinned minor and patch, so
+// "rules/v1.2" fails on a release that only has rules/v1.1.0 instead of
+// silently scoring without the newer rules.
+func ResolveRules(pin string) (RulesVersion, error) {
+	if strings.TrimSpace(pin) == "" {
+		return CurrentRules, nil
+	}
+	v, err := ParseRulesVersion(pin)
+	if err != nil {
+		return RulesVersion{}, err
+	}
+	latest, ok := supportedRules[v.Major]
+	if !ok {
+		return RulesVersion{}, fmt.Errorf("%w: %s is not provided by this release, which scores with %s", ErrRulesVersion, v.ID(), CurrentRules)
+	}
+	if latest.less(v) {
+		return RulesVersion{}, fmt.Errorf("%w: %s is newer than %s; upgrade pr-faq-validator", ErrRulesVersion, v, latest)
+	}
+	return latest, nil
+}
+
+// ScoringRules returns the rules version s is scored with: s.Rules, or
+// CurrentRules when it is unset.
+func (s *SpecSections) ScoringRules() RulesVersion {
+	if s.Rules == (RulesVersion{}) {
+		return CurrentRules
+	}
+	return s.Rules
+}
+
+// less reports whether v precedes w.
+func (v RulesVersion) less(w RulesVersion) bool {
+	if v.Major != w.Major {
+		return v.Major < w.Major
+	}
+	if v.Minor != w.Minor {
+		return v.Minor < w.Minor
+	}
+	return v.Patch < w.Patch
+}
@@ -0,0 +1,120 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRulesVersion(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    RulesVersion
+		wantErr bool
+	}{
+		{"rules/v1", RulesVersion{1, 0, 0}, false},
+		{"v1.2", RulesVersion{1, 2, 0}, false},
+		{"1.2.3", RulesVersion{1, 2, 3}, false},
+		{"rules/v", RulesVersion{}, true},
+		{"v1.x", RulesVersion{}, true},
+		{"1.2.3.4", RulesVersion{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRulesVersion(tt.in)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseRulesVersion(%q) = %v, %v, want %v (error %v)", tt.in, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveRules(t *testing.T) {
+	next := CurrentRules
+	next.Minor++
+	tests := []struct {
+		pin     string
+		major   int
+		wantErr bool
+	}{
+		{"", CurrentRules.Major, false},
+		{CurrentRules.ID(), CurrentRules.Major, false},
+		{CurrentRules.String(), CurrentRules.Major, false},
+		{next.String(), 0, true},
+		{"rules/v1", 1, false},
+		{"rules/v1.1", 1, false},
+		{"rules/v1.3", 0, true},
+		{"rules/v99", 0, true},
+		{"rules/v0", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pin, func(t *testing.T) {
+			got, err := ResolveRules(tt.pin)
+			if tt.wantErr {
+				if !errors.Is(err, ErrRulesVersion) {
+					t.Errorf("ResolveRules(%q) error = %v, want ErrRulesVersion", tt.pin, err)
+				}
+				return
+			}
+			if err != nil || got.Major != tt.major || got != supportedRules[tt.major] {
+				t.Errorf("ResolveRules(%q) = %v, %v, want the latest rules/v%d", tt.pin, got, err, tt.major)
+			}
+		})
+	}
+}
+
+// TestSupportedRules_Scores pins the example documents' scores under every
+// rules version this release provides. When a change moves one of them under
+// the current version, bump CurrentRules.Major, keep the previous version in
+// supportedRules, and add its expected scores here.
+func TestSupportedRules_Scores(t *testing.T) {
+	want := map[RulesVersion]map[string]int{
+		supportedRules[1]: {"example_prfaq_1.md": 77, "example_prfaq_2.txt": 36, "example_prfaq_3.md": 38, "example_prfaq_4.md": 51},
+		supportedRules[2]: {"example_prfaq_1.md": 77, "example_prfaq_2.txt": 36, "example_prfaq_3.md": 38, "example_prfaq_4.md": 51},
+		supportedRules[3]: {"example_prfaq_1.md": 77, "example_prfaq_2.txt": 36, "example_prfaq_3.md": 38, "example_prfaq_4.md": 51},
+		supportedRules[4]: {"example_prfaq_1.md": 77, "example_prfaq_2.txt": 36, "example_prfaq_3.md": 38, "example_prfaq_4.md": 51},
+		supportedRules[5]: {"example_prfaq_1.md": 85, "example_prfaq_2.txt": 46, "example_prfaq_3.md": 48, "example_prfaq_4.md": 52},
+	}
+	if len(want) != len(supportedRules) {
+		t.Fatalf("supportedRules = %v; add the expected scores for every version", supportedRules)
+	}
+	for rules, scores := range want {
+		for name, score := range scores {
+			t.Run(rules.String()+"/"+name, func(t *testing.T) {
+				sections, err := ParsePRFAQ("../../testdata/" + name)
+				if err != nil {
+					t.Fatalf("ParsePRFAQ() error = %v", err)
+				}
+				sections.Rules = rules
+				if got := Score(sections).OverallScore; got != score {
+					t.Errorf("score = %d under %s, want %d; scoring changes need a new major rules version", got, rules, score)
+				}
+			})
+		}
+	}
+}
+
+func TestScoreCategory(t *testing.T) {
+	for _, rules := range supportedRules {
+		for _, name := range []string{"example_prfaq_1.md", "example_prfaq_2.txt", "example_prfaq_3.md", "example_prfaq_4.md"} {
+			t.Run(rules.String()+"/"+name, func(t *testing.T) {
+				sections, err := ParsePRFAQ("../../testdata/" + name)
+				if err != nil {
+					t.Fatalf("ParsePRFAQ() error = %v", err)
+				}
+				sections.Rules = rules
+				for _, docType := range DocTypes {
+					sections.DocType = docType
+					for _, want := range Score(sections).QualityBreakdown.Categories() {
+						if got := ScoreCategory(sections, want.Name); got != want {
+							t.Errorf("ScoreCategory(%q) as %s = %+v, want %+v from Score", want.Name, docType, got, want)
+						}
+					}
+				}
+			})
+		}
+	}
+
+	if got := ScoreCategory(&SpecSections{}, "Quote Quality"); got != (CategoryScore{Name: "Quote Quality", Max: 15}) {
+		t.Errorf("ScoreCategory() without a press release = %+v, want no points", got)
+	}
+}
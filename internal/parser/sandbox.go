@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Sandbox recomputes a score with scoring rules switched off and category
+// weights changed, so a team can try a weighting policy on real documents
+// before putting it in the config. Switching a rule off takes back the
+// points it awarded or deducted, within the category's range.
+type Sandbox struct {
+	score   *PRScore
+	off     []bool // by index into the score's trace
+	weights Weights
+}
+
+// NewSandbox starts a sandbox for score with every rule on and the weights
+// the score was computed with.
+func NewSandbox(score *PRScore) *Sandbox {
+	sb := &Sandbox{score: score, off: make([]bool, len(score.QualityBreakdown.Trace))}
+	sb.Reset()
+	return sb
+}
+
+// Reset switches every rule back on and restores the score's weights.
+func (sb *Sandbox) Reset() {
+	clear(sb.off)
+	sb.weights = sb.score.DocType.withWeights(sb.score.Weights)
+}
+
+// Rules returns the point awards and deductions that can be switched off,
+// in analyzer order.
+func (sb *Sandbox) Rules() []ScoreEvent {
+	return sb.score.QualityBreakdown.Trace
+}
+
+// Toggle switches rule i, an index into Rules, off or back on.
+func (sb *Sandbox) Toggle(i int) {
+	if i >= 0 && i < len(sb.off) {
+		sb.off[i] = !sb.off[i]
+	}
+}
+
+// On reports whether rule i counts toward the score.
+func (sb *Sandbox) On(i int) bool {
+	return !sb.off[i]
+}
+
+// Weight returns the weight of a category.
+func (sb *Sandbox) Weight(category string) float64 {
+	return docTypeProfile{weights: sb.weights}.weight(category)
+}
+
+// SetWeight sets the weight of a category, within 0-MaxWeight.
+func (sb *Sandbox) SetWeight(category string, w float64) {
+	sb.weights[category] = min(max(w, 0), MaxWeight)
+}
+
+// Categories returns the category scores with the points of the rules
+// switched off taken back. Credibility, which mirrors Tone & Readability
+// and does not count, is left out.
+func (sb *Sandbox) Categories() []CategoryScore {
+	var categories []CategoryScore
+	for _, c := range sb.score.QualityBreakdown.Categories() {
+		if !isWeighted(c.Name) {
+			continue
+		}
+		for i, e := range sb.score.QualityBreakdown.Trace {
+			if sb.off[i] && e.Category == c.Name {
+				c.Score -= e.Delta
+			}
+		}
+		c.Score = min(max(c.Score, 0), c.Max)
+		categories = append(categories, c)
+	}
+	return categories
+}
+
+// Overall returns the overall score with the sandbox's rules and weights.
+func (sb *Sandbox) Overall() int {
+	return docTypeProfile{weights: sb.weights}.weightedTotal(sb.Categories())
+}
+
+// Config returns the weights setting of the config file that scores
+// documents of the score's type with the sandbox's weights, or "" when they
+// are the type's own.
+func (sb *Sandbox) Config() string {
+	defaults := docTypeProfile{weights: sb.score.DocType.profile().weights}
+	var b strings.Builder
+	for _, c := range sb.Categories() {
+		if w := sb.Weight(c.Name); w != defaults.weight(c.Name) {
+			fmt.Fprintf(&b, "  %s: %g\n", c.Name, w)
+		}
+	}
+	if b.Len() == 0 {
+		return ""
+	}
+	return "weights:\n" + b.String()
+}
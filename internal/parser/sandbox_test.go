@@ -0,0 +1,97 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSandbox(t *testing.T) {
+	breakdown := PRQualityBreakdown{
+		HeadlineScore: 8, HookScore: 10, ReleaseDateScore: 5, FiveWsScore: 12,
+		CredibilityScore: 6, StructureScore: 7, ToneScore: 6, FluffScore: 4, QuoteScore: 9,
+		Trace: []ScoreEvent{
+			{Category: "Headline Quality", Rule: "headline length optimal", Delta: 3},
+			{Category: "Fluff Avoidance", Rule: "hype words", Delta: -6},
+			{Category: "Fluff Avoidance", Rule: "exclamation marks", Delta: -2},
+		},
+	}
+	score := &PRScore{OverallScore: 61, QualityBreakdown: breakdown}
+	sb := NewSandbox(score)
+	if got := sb.Overall(); got != 61 {
+		t.Fatalf("Overall() = %d, want the score's 61", got)
+	}
+
+	sb.Toggle(2)
+	if got := sb.Overall(); got != 63 {
+		t.Errorf("Overall() without the exclamation marks deduction = %d, want 63", got)
+	}
+	sb.Toggle(1)
+	if got := sb.Overall(); got != 67 {
+		t.Errorf("Overall() without the fluff deductions = %d, want 67 (fluff capped at 10)", got)
+	}
+
+	sb.SetWeight("Newsworthy Hook", 0)
+	sb.SetWeight("Quote Quality", 9)
+	if w := sb.Weight("Quote Quality"); w != MaxWeight {
+		t.Errorf("Weight() = %g, want it capped at %d", w, MaxWeight)
+	}
+	if want := "weights:\n  Newsworthy Hook: 0\n  Quote Quality: 3\n"; sb.Config() != want {
+		t.Errorf("Config() = %q, want %q", sb.Config(), want)
+	}
+
+	sb.Reset()
+	if sb.Overall() != 61 || sb.Config() != "" || !sb.On(2) {
+		t.Errorf("after Reset, Overall() = %d, Config() = %q", sb.Overall(), sb.Config())
+	}
+}
+
+func TestSandbox_DocTypeWeights(t *testing.T) {
+	score := &PRScore{DocType: DocTypeInternal, QualityBreakdown: PRQualityBreakdown{HookScore: 15}}
+	sb := NewSandbox(score)
+	if w := sb.Weight("Newsworthy Hook"); w != 0.5 {
+		t.Errorf("Weight() = %g, want the internal document weight 0.5", w)
+	}
+	sb.SetWeight("Newsworthy Hook", 1)
+	if want := "weights:\n  Newsworthy Hook: 1\n"; sb.Config() != want {
+		t.Errorf("Config() = %q, want %q", sb.Config(), want)
+	}
+}
+
+func TestCheckWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights Weights
+		wantErr bool
+	}{
+		{"valid", Weights{"5 Ws Coverage": 1.5, "Quote Quality": 0}, false},
+		{"unknown category", Weights{"Grammar": 1}, true},
+		{"credibility does not count", Weights{"Credibility": 2}, true},
+		{"negative", Weights{"Structure": -1}, true},
+		{"too large", Weights{"Structure": MaxWeight + 1}, true},
+	}
+	for _, tt := range tests {
+		if err := CheckWeights(tt.weights); (err != nil) != tt.wantErr {
+			t.Errorf("CheckWeights() with %s = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestScore_Weights(t *testing.T) {
+	sections, err := Parse(strings.NewReader(claimsDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	base := sections.PRScore.OverallScore
+	sections.Weights = Weights{"Quote Quality": 0, "Headline Quality": 2}
+	score := Score(sections)
+	sb := NewSandbox(sections.PRScore)
+	sb.SetWeight("Quote Quality", 0)
+	sb.SetWeight("Headline Quality", 2)
+	if score.OverallScore != sb.Overall() {
+		t.Errorf("weighted score = %d, want the sandbox's %d (unweighted %d)", score.OverallScore, sb.Overall(), base)
+	}
+	report := GenerateMarkdownReport(sections, score)
+	if !strings.Contains(report, "**Category Weights:** Headline Quality ×2, Quote Quality ×0\n") {
+		t.Errorf("report lacks the category weights:\n%s", report)
+	}
+}
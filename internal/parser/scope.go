@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// Issue messages of the scope exclusion check. The thin answer message is
+// followed by ": " and the question.
+const (
+	scopeMissingMessage = `FAQ should say what is out of scope ("What are we not doing?")`
+	scopeThinMessage    = "Out-of-scope answer is not substantive"
+)
+
+// scopeRules is the rules version that adds the scope exclusion check.
+var scopeRules = RulesVersion{Major: 5, Minor: 6}
+
+// scopeQuestion is the scope exclusion question as a reader would ask it,
+// which a TopicMatcher compares the FAQ questions with.
+const scopeQuestion = "What are we not doing, and what is out of scope?"
+
+// scopeKeywords mark a question as asking what is excluded. They are
+// matched by firstTerm against the lowercased question.
+var scopeKeywords = []string{
+	"not doing", "not building", "not going to", "won't", "will not", "out of scope", "out-of-scope",
+	"not in scope", "non-goal*", "nongoal*", "not includ*", "exclud*", "not cover*", "not support*", "left out",
+}
+
+// minScopeWords is the fewest words a substantive out-of-scope answer has.
+// "Nothing." or "TBD" is a weasel answer: it says no trade-off was made.
+const minScopeWords = 8
+
+// scopePlaceholders are answers, or the start of answers, that defer or
+// dismiss the question instead of answering it.
+var scopePlaceholders = []string{"todo", "tbd", "n/a", "none", "nothing", "see above", "to be decided", "to be determined"}
+
+// scoreScope checks that the FAQ asks what the team is not doing, and that
+// the answer names what is excluded. A TopicMatcher finds the question by
+// meaning, so "Which features did we cut from v1?" counts; without one, or
+// when it fails, the question is found by keyword. Findings cost no points.
+// An empty FAQ is left to Validate.
+func scoreScope(s *SpecSections) analysis {
+	a := analysis{category: "Structure"}
+	if s.FAQs == "" {
+		return a
+	}
+
+	pairs := s.FAQPairs()
+	thin := ""
+	for i, asks := range scopeQuestions(pairs, s.TopicMatcher) {
+		switch {
+		case !asks:
+		case substantiveScopeAnswer(pairs[i].Answer):
+			a.strength("FAQ says what is out of scope")
+			return a
+		case thin == "":
+			thin = pairs[i].Question
+		}
+	}
+	if thin != "" {
+		a.issue(fmt.Sprintf("%s: %q - name what is excluded and why", scopeThinMessage, thin))
+		return a
+	}
+	a.issue(scopeMissingMessage)
+	return a
+}
+
+// scopeQuestions reports which FAQ questions ask what is out of scope: by
+// matcher when there is one, asking for each FAQ question whether the scope
+// question asks the same, otherwise, or when the matcher fails, by keyword.
+func scopeQuestions(pairs []FAQPair, matcher TopicMatcher) []bool {
+	questions := make([]string, len(pairs))
+	for i, p := range pairs {
+		questions[i] = p.Question
+	}
+	if matcher != nil && len(questions) > 0 {
+		asks, err := matcher.MatchTopics([]string{scopeQuestion}, questions)
+		if err == nil && len(asks) == len(questions) {
+			return asks
+		}
+		slog.Warn("semantic scope question matching failed, matching keywords instead", "error", err)
+	}
+
+	asks := make([]bool, len(questions))
+	for i, q := range questions {
+		asks[i] = firstTerm(strings.ReplaceAll(strings.ToLower(q), "’", "'"), scopeKeywords) != ""
+	}
+	return asks
+}
+
+// substantiveScopeAnswer reports whether an answer names what is excluded
+// rather than deferring or dismissing the question.
+func substantiveScopeAnswer(answer string) bool {
+	text := strings.ToLower(strings.TrimSpace(answer))
+	for _, p := range scopePlaceholders {
+		if strings.Trim(text, ".!*_ ") == p || strings.HasPrefix(text, p+":") {
+			return false
+		}
+	}
+	return len(strings.Fields(text)) >= minScopeWords
+}
@@ -0,0 +1,48 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestScoreScope(t *testing.T) {
+	tests := []struct {
+		name string
+		faqs string
+		want string // the issue, or "" for none
+	}{
+		{"missing", "Q: Is it free?\nA: Yes, under the MIT license.", scopeMissingMessage},
+		{"substantive", "Q: What are we not doing?\nA: We are not building a Windows client or supporting on-premises installs this year.", ""},
+		{"placeholder", "Q: What's out of scope?\nA: TBD.", scopeThinMessage + `: "What's out of scope?" - name what is excluded and why`},
+		{"dismissed", "Q: What won’t v1 include?\nA: Nothing.", scopeThinMessage + `: "What won’t v1 include?" - name what is excluded and why`},
+		{"no FAQ", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := scoreScope(&SpecSections{FAQs: tt.faqs})
+			got := strings.Join(a.issues, "\n")
+			if got != tt.want {
+				t.Errorf("issues = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreScope_Matcher(t *testing.T) {
+	// No scope keyword, but the matcher recognizes the second question
+	faqs := "Q: Is it free?\nA: Yes.\n\nQ: Which features did we cut from v1?\nA: Payroll and multi-currency ledgers wait until the second release."
+	m := &fakeMatcher{answered: []bool{false, true}}
+	if a := scoreScope(&SpecSections{FAQs: faqs, TopicMatcher: m}); len(a.issues) != 0 {
+		t.Errorf("matcher issues = %q, want none", a.issues)
+	}
+	if len(m.required) != 2 || m.required[1] != "Which features did we cut from v1?" {
+		t.Errorf("matcher compared %q, want the FAQ questions", m.required)
+	}
+
+	// A failing matcher falls back to keywords
+	m = &fakeMatcher{err: errors.New("no network")}
+	if a := scoreScope(&SpecSections{FAQs: faqs, TopicMatcher: m}); len(a.issues) != 1 || a.issues[0] != scopeMissingMessage {
+		t.Errorf("fallback issues = %q, want the question missing", a.issues)
+	}
+}
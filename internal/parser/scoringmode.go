@@ -0,0 +1,119 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScoringMode is how the overall score is computed from the analysis.
+type ScoringMode string
+
+// Scoring modes.
+const (
+	// ScoringCategories adds up the points of the scoring categories,
+	// weighted by document type: the 100-point rubric.
+	ScoringCategories ScoringMode = "categories"
+	// ScoringSeverity starts at 100 and takes off points for every finding,
+	// more for errors than for warnings.
+	ScoringSeverity ScoringMode = "severity"
+)
+
+// ScoringModes lists the accepted scoring modes.
+var ScoringModes = []ScoringMode{ScoringCategories, ScoringSeverity}
+
+// ParseScoringMode parses a scoring mode name. The empty string is
+// ScoringCategories.
+func ParseScoringMode(s string) (ScoringMode, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return ScoringCategories, nil
+	}
+	for _, mode := range ScoringModes {
+		if string(mode) == s {
+			return mode, nil
+		}
+	}
+	names := make([]string, len(ScoringModes))
+	for i, mode := range ScoringModes {
+		names[i] = string(mode)
+	}
+	return "", fmt.Errorf("%w: unknown scoring mode %q (want %s)", ErrScoring, s, strings.Join(names, ", "))
+}
+
+// SeverityCosts are the points a finding of each severity costs under
+// ScoringSeverity.
+type SeverityCosts map[Severity]int
+
+// DefaultSeverityCosts are the costs of severities a SeverityCosts leaves
+// out. A typical draft has a dozen or so warnings, so a warning costs little
+// enough that such a draft still scores in the middle of the range.
+var DefaultSeverityCosts = SeverityCosts{SeverityError: 10, SeverityWarning: 3, SeverityInfo: 1}
+
+// ParseSeverityCosts parses the points each severity costs, by severity
+// name, such as those from a config file. Each is between 0 and 100.
+func ParseSeverityCosts(costs map[string]int) (SeverityCosts, error) {
+	if len(costs) == 0 {
+		return nil, nil
+	}
+	parsed := SeverityCosts{}
+	for name, cost := range costs {
+		sev, err := ParseSeverity(name)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrScoring, err)
+		}
+		if cost < 0 || cost > 100 {
+			return nil, fmt.Errorf("%w: %s cost %d is outside 0-100", ErrScoring, sev, cost)
+		}
+		parsed[sev] = cost
+	}
+	return parsed, nil
+}
+
+// cost is the points a finding of severity sev costs.
+func (c SeverityCosts) cost(sev Severity) int {
+	if cost, ok := c[sev]; ok {
+		return cost
+	}
+	return DefaultSeverityCosts[sev]
+}
+
+// SeverityScore is how a severity-weighted overall score was reached: the
+// findings of each severity and what each cost.
+type SeverityScore struct {
+	Counts map[Severity]int
+	Costs  SeverityCosts // every severity's cost, defaults included
+}
+
+// Note describes the deductions, e.g. "100 - 1 error × 10 - 12 warnings × 3".
+func (s SeverityScore) Note() string {
+	note := "100"
+	for _, sev := range Severities {
+		n := s.Counts[sev]
+		if n == 0 {
+			continue
+		}
+		name := string(sev)
+		if n > 1 && sev != SeverityInfo {
+			name += "s"
+		}
+		note += fmt.Sprintf(" - %d %s × %d", n, name, s.Costs[sev])
+	}
+	return note
+}
+
+// scoreBySeverity replaces the overall score of score with 100 less the cost
+// of each of its findings, down to 0. Dismissed findings cost nothing.
+func (s *SpecSections) scoreBySeverity(score *PRScore) {
+	total := 100
+	counts := map[Severity]int{}
+	costs := SeverityCosts{}
+	for _, sev := range Severities {
+		costs[sev] = s.SeverityCosts.cost(sev)
+	}
+	for _, f := range s.findingsOf(score) {
+		counts[f.Severity]++
+		total -= costs[f.Severity]
+	}
+	score.OverallScore = max(total, 0)
+	score.Severity = &SeverityScore{Counts: counts, Costs: costs}
+}
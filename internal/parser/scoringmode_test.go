@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseScoringMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ScoringMode
+		wantErr bool
+	}{
+		{"", ScoringCategories, false},
+		{"categories", ScoringCategories, false},
+		{" Severity ", ScoringSeverity, false},
+		{"strict", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseScoringMode(tt.in)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseScoringMode(%q) = %q, %v, want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrScoring) {
+			t.Errorf("ParseScoringMode(%q) error = %v, want %v", tt.in, err, ErrScoring)
+		}
+	}
+}
+
+func TestParseSeverityCosts(t *testing.T) {
+	costs, err := ParseSeverityCosts(map[string]int{"Warning": 2})
+	if err != nil {
+		t.Fatalf("ParseSeverityCosts() error = %v", err)
+	}
+	for sev, want := range map[Severity]int{SeverityError: 10, SeverityWarning: 2, SeverityInfo: 1} {
+		if got := costs.cost(sev); got != want {
+			t.Errorf("cost(%s) = %d, want %d", sev, got, want)
+		}
+	}
+
+	for _, bad := range []map[string]int{{"fatal": 20}, {"info": -1}, {"error": 101}} {
+		if _, err := ParseSeverityCosts(bad); !errors.Is(err, ErrScoring) {
+			t.Errorf("ParseSeverityCosts(%v) error = %v, want %v", bad, err, ErrScoring)
+		}
+	}
+}
+
+func TestScore_SeverityMode(t *testing.T) {
+	sections, err := Parse(strings.NewReader(dismissalDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	categories := Score(sections)
+	if categories.Severity != nil {
+		t.Errorf("Severity = %+v under category scoring, want nil", categories.Severity)
+	}
+
+	sections.ScoringMode = ScoringSeverity
+	sections.SeverityCosts = SeverityCosts{SeverityInfo: 0}
+	sections.PRScore = Score(sections)
+	counts := map[Severity]int{}
+	want := 100
+	for _, f := range sections.Findings() {
+		counts[f.Severity]++
+		want -= map[Severity]int{SeverityError: 10, SeverityWarning: 3}[f.Severity]
+	}
+	if got := sections.PRScore.OverallScore; got != max(want, 0) {
+		t.Errorf("OverallScore = %d, want %d", got, max(want, 0))
+	}
+	if sections.PRScore.QualityBreakdown.HeadlineScore != categories.QualityBreakdown.HeadlineScore {
+		t.Error("severity scoring changed the category scores")
+	}
+
+	// A dismissed finding costs nothing
+	before := sections.PRScore.OverallScore
+	sections.Dismissals = []Dismissal{{Rule: "headline-too-short", Status: DismissWontFix}}
+	if got := Score(sections).OverallScore; got != min(before+3, 100) {
+		t.Errorf("OverallScore with a warning dismissed = %d, want %d", got, min(before+3, 100))
+	}
+
+	report := GenerateMarkdownReport(sections, sections.PRScore)
+	if want := "**Scoring:** by finding severity, 100"; !strings.Contains(report, want) {
+		t.Errorf("report lacks %q", want)
+	}
+	if want := "Scored by finding severity: "; !strings.Contains(Explain(sections.PRScore), want) {
+		t.Errorf("Explain() lacks %q", want)
+	}
+}
+
+func TestSeverityScore_Note(t *testing.T) {
+	s := SeverityScore{
+		Counts: map[Severity]int{SeverityError: 1, SeverityWarning: 12, SeverityInfo: 5},
+		Costs:  DefaultSeverityCosts,
+	}
+	if got, want := s.Note(), "100 - 1 error × 10 - 12 warnings × 3 - 5 info × 1"; got != want {
+		t.Errorf("Note() = %q, want %q", got, want)
+	}
+}
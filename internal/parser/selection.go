@@ -0,0 +1,74 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReviewSection is a document section that can be selected for analysis.
+type ReviewSection struct {
+	Key  string // name accepted by -sections
+	Name string // display name, also sent to the AI reviewer
+}
+
+// ReviewSections lists the selectable sections in analysis order.
+var ReviewSections = []ReviewSection{
+	{Key: "pr", Name: "Press Release"},
+	{Key: "faq", Name: "FAQs"},
+	{Key: "metrics", Name: "Success Metrics"},
+}
+
+// DefaultSections is the selection used when none is given. The metrics
+// section is opt-in because it adds an AI request.
+const DefaultSections = "pr,faq"
+
+// SectionSet is a selection of ReviewSections keys. A nil set selects all.
+type SectionSet map[string]bool
+
+// ParseSectionSet parses a comma-separated list of section keys such as "faq,metrics".
+func ParseSectionSet(list string) (SectionSet, error) {
+	set := SectionSet{}
+	for _, key := range strings.Split(list, ",") {
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !isReviewSection(key) {
+			return nil, fmt.Errorf("unknown section %q (want %s)", key, sectionKeys())
+		}
+		set[key] = true
+	}
+	return set, nil
+}
+
+// Has reports whether the section with the given key is selected.
+func (set SectionSet) Has(key string) bool {
+	return set == nil || set[key]
+}
+
+// Content returns the text of the section with the given key.
+func (s *SpecSections) Content(key string) string {
+	switch key {
+	case "pr":
+		return s.PressRelease
+	case "faq":
+		return s.FAQs
+	case "metrics":
+		return s.Metrics
+	}
+	return ""
+}
+
+func isReviewSection(key string) bool {
+	for _, sec := range ReviewSections {
+		if sec.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func sectionKeys() string {
+	keys := make([]string, len(ReviewSections))
+	for i, sec := range ReviewSections {
+		keys[i] = sec.Key
+	}
+	return strings.Join(keys, ", ")
+}
@@ -0,0 +1,54 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SimilarDocument is an existing document that a scored one substantially
+// duplicates, such as the same initiative written up again by another team.
+type SimilarDocument struct {
+	Name       string // path of the existing document
+	Title      string
+	Similarity int // percent of shared phrasing
+}
+
+// Corpus finds the existing documents a document duplicates. Documents named
+// name, the scored document itself, are never reported.
+type Corpus interface {
+	Similar(name string, s *SpecSections) []SimilarDocument
+}
+
+// Text returns the scored text of the document: the title and every section
+// except the appendices.
+func (s *SpecSections) Text() string {
+	parts := []string{s.Title, s.PressRelease, s.FAQs, s.Metrics}
+	names := make([]string, 0, len(s.OtherSections))
+	for name := range s.OtherSections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		parts = append(parts, s.OtherSections[name])
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// writeSimilar writes the report section that links the documents this one
+// duplicates.
+func writeSimilar(report *strings.Builder, similar []SimilarDocument) {
+	if len(similar) == 0 {
+		return
+	}
+	report.WriteString("## 🔁 Similar Documents\n\n")
+	report.WriteString("This document shares much of its wording with existing documents. If it describes the same initiative, work with their owners instead of starting a parallel effort.\n\n")
+	for _, d := range similar {
+		title := d.Title
+		if title == "" {
+			title = d.Name
+		}
+		report.WriteString(fmt.Sprintf("- [%s](%s): %d%% similar\n", title, strings.ReplaceAll(d.Name, " ", "%20"), d.Similarity))
+	}
+	report.WriteString("\n")
+}
@@ -0,0 +1,168 @@
+package parser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SourcePart is one file of a document assembled from several, such as a
+// press release and an FAQ kept apart by a team's template.
+type SourcePart struct {
+	Path  string
+	Start int // 1-based line of the part's first line in the assembled document
+	Lines int
+	// Offset is the number of lines of the file before the part. It is
+	// nonzero for the rest of a file after an include directive.
+	Offset int
+}
+
+// manifest lists the files of a document, in order. Paths are relative to
+// the manifest.
+type manifest struct {
+	Files []string `yaml:"files"`
+}
+
+// manifestExtensions are the extensions of manifest files.
+var manifestExtensions = []string{".yaml", ".yml"}
+
+// IsManifest reports whether path names a manifest rather than a document.
+func IsManifest(path string) bool {
+	return slices.Contains(manifestExtensions, strings.ToLower(filepath.Ext(path)))
+}
+
+// ReadFiles assembles one document from files, in order, separated by a
+// blank line. A manifest among them is replaced by the files it lists, and
+// include directives are resolved as ParseSource does. The front matter of
+// every file after the first is blanked, so only the first sets the document
+// type and tone, and line numbers within each file hold.
+func ReadFiles(paths ...string) ([]byte, []SourcePart, error) {
+	lines, parts, err := readFiles(paths)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []byte(strings.Join(lines, "\n") + "\n"), parts, nil
+}
+
+// readFiles is ReadFiles returning the lines of the document.
+func readFiles(paths []string) ([]string, []SourcePart, error) {
+	files, err := expandManifests(paths)
+	if err != nil {
+		return nil, nil, err
+	}
+	var lines []string
+	var parts []SourcePart
+	for i, path := range files {
+		f, err := os.Open(path) //nolint:gosec // path is user-provided CLI argument or listed in a manifest
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", ErrRead, err)
+		}
+		partLines, err := readLines(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %s", err, path)
+		}
+		if i > 0 {
+			if _, n, err := parseFrontMatter(partLines); err == nil {
+				for j := range n {
+					partLines[j] = ""
+				}
+			}
+			lines = append(lines, "")
+		}
+		parts = append(parts, SourcePart{Path: path, Start: len(lines) + 1, Lines: len(partLines)})
+		lines = append(lines, partLines...)
+	}
+	return lines, parts, nil
+}
+
+// expandManifests replaces each manifest in paths with the files it lists.
+func expandManifests(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		if !IsManifest(path) {
+			files = append(files, path)
+			continue
+		}
+		data, err := os.ReadFile(path) //nolint:gosec // path is user-provided CLI argument
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrRead, err)
+		}
+		var m manifest
+		if err := yaml.Unmarshal(data, &m); err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrManifest, path, err)
+		}
+		if len(m.Files) == 0 {
+			return nil, fmt.Errorf("%w: %s lists no files", ErrManifest, path)
+		}
+		for _, f := range m.Files {
+			if IsManifest(f) {
+				return nil, fmt.Errorf("%w: %s lists another manifest, %s", ErrManifest, path, f)
+			}
+			if !filepath.IsAbs(f) {
+				f = filepath.Join(filepath.Dir(path), f)
+			}
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w: no files given", ErrRead)
+	}
+	return files, nil
+}
+
+// ParseFiles is ParsePRFAQ for a document kept in several files, or listed
+// in a manifest. A single document file is parsed as ParsePRFAQ does. Image
+// paths resolve against the first file's directory.
+func ParseFiles(paths ...string) (*SpecSections, error) {
+	sections, err := ParseOutline(paths...)
+	if err != nil {
+		return nil, err
+	}
+	sections.PRScore = Score(sections)
+	return sections, nil
+}
+
+// ParseOutline is ParseFiles without the scoring: it extracts the sections
+// and outline of a document and leaves PRScore nil for Score to fill in, so
+// a caller can show the structure of a large document at once and score it
+// in the background. Files are read line by line, never whole, and the
+// paragraphs of appendices are not split into sentences.
+func ParseOutline(paths ...string) (*SpecSections, error) {
+	if len(paths) == 1 && !IsManifest(paths[0]) {
+		return parseFile(paths[0])
+	}
+	lines, parts, err := readFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+	sections, err := parseLines(lines, filepath.Dir(parts[0].Path))
+	if err != nil {
+		return nil, err
+	}
+	sections.Parts = parts
+	if err := sections.applyReviewFile(paths[0]); err != nil {
+		return nil, err
+	}
+	if err := sections.applyDismissalsFile(paths[0]); err != nil {
+		return nil, err
+	}
+	return sections, nil
+}
+
+// Locate maps a line of the document to the file it came from and the line
+// within that file. For a document read from a single file without
+// includes, it returns "" and line unchanged; so it does for the blank lines
+// between files.
+func (s *SpecSections) Locate(line int) (string, int) {
+	for _, p := range s.Parts {
+		if line >= p.Start && line < p.Start+p.Lines {
+			return p.Path, p.Offset + line - p.Start + 1
+		}
+	}
+	return "", line
+}
@@ -0,0 +1,169 @@
+package parser
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	sourcesPR = `# Acme Launches Ledger Sync
+
+## Press Release
+
+Acme today launched Ledger Sync, which cuts month-end close time by 40%.
+
+Beta customers processed 3x more transactions.
+`
+	sourcesFAQ = `---
+doc_type: prfaq
+---
+## FAQ
+
+Q: How did you measure the 40%?
+A: Across 30 beta customers, the close fell from 5 days to 3.
+`
+)
+
+// writeSources writes the press release and FAQ files, and a manifest
+// listing them, to a temporary directory.
+func writeSources(t *testing.T) (pr, faq, manifest string) {
+	t.Helper()
+	dir := t.TempDir()
+	pr = filepath.Join(dir, "pr.md")
+	faq = filepath.Join(dir, "faq.md")
+	manifest = filepath.Join(dir, "prfaq.yaml")
+	for path, content := range map[string]string{pr: sourcesPR, faq: sourcesFAQ, manifest: "files:\n  - pr.md\n  - faq.md\n"} {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return pr, faq, manifest
+}
+
+func TestParseFiles(t *testing.T) {
+	pr, faq, manifest := writeSources(t)
+	for name, paths := range map[string][]string{"files": {pr, faq}, "manifest": {manifest}} {
+		t.Run(name, func(t *testing.T) {
+			sections, err := ParseFiles(paths...)
+			if err != nil {
+				t.Fatalf("ParseFiles() error = %v", err)
+			}
+			if sections.FAQs == "" {
+				t.Error("FAQ from the second file not parsed")
+			}
+			want := []SourcePart{{Path: pr, Start: 1, Lines: 7}, {Path: faq, Start: 9, Lines: 7}}
+			if len(sections.Parts) != len(want) {
+				t.Fatalf("Parts = %+v, want %+v", sections.Parts, want)
+			}
+			for i := range want {
+				if sections.Parts[i] != want[i] {
+					t.Errorf("Parts[%d] = %+v, want %+v", i, sections.Parts[i], want[i])
+				}
+			}
+
+			var found bool
+			for _, f := range sections.Findings() {
+				if f.RuleID == "claim-unsubstantiated" {
+					found = true
+					if f.File != pr || f.Line != 7 {
+						t.Errorf("finding at %s:%d, want %s:7", f.File, f.Line, pr)
+					}
+				}
+			}
+			if !found {
+				t.Error("no claim-unsubstantiated finding for 3x")
+			}
+		})
+	}
+}
+
+func TestParseOutline(t *testing.T) {
+	pr, faq, manifest := writeSources(t)
+	for name, paths := range map[string][]string{"file": {pr}, "files": {pr, faq}, "manifest": {manifest}} {
+		t.Run(name, func(t *testing.T) {
+			outline, err := ParseOutline(paths...)
+			if err != nil {
+				t.Fatalf("ParseOutline() error = %v", err)
+			}
+			if outline.PRScore != nil {
+				t.Error("ParseOutline() scored the document")
+			}
+			scored, err := ParseFiles(paths...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if outline.PressRelease != scored.PressRelease || outline.FAQs != scored.FAQs || len(outline.Parts) != len(scored.Parts) {
+				t.Errorf("outline sections differ from ParseFiles")
+			}
+			if outline.PRScore = Score(outline); outline.PRScore.OverallScore != scored.PRScore.OverallScore {
+				t.Errorf("Score(outline) = %d, want %d", outline.PRScore.OverallScore, scored.PRScore.OverallScore)
+			}
+		})
+	}
+
+	if _, err := ParseOutline(filepath.Join(t.TempDir(), "missing.md")); !errors.Is(err, ErrRead) {
+		t.Errorf("ParseOutline(missing) error = %v, want ErrRead", err)
+	}
+}
+
+func TestReadFiles_FrontMatter(t *testing.T) {
+	pr, faq, _ := writeSources(t)
+	data, _, err := ReadFiles(pr, faq)
+	if err != nil {
+		t.Fatalf("ReadFiles() error = %v", err)
+	}
+	if strings.Contains(string(data), "doc_type") {
+		t.Errorf("front matter of the second file kept:\n%s", data)
+	}
+	if !strings.Contains(string(data), "\n\n\n\n## FAQ") {
+		t.Errorf("front matter not blanked line for line:\n%s", data)
+	}
+}
+
+func TestSpecSections_Locate(t *testing.T) {
+	s := &SpecSections{Parts: []SourcePart{{Path: "pr.md", Start: 1, Lines: 7}, {Path: "faq.md", Start: 9, Lines: 7}}}
+	tests := []struct {
+		line     int
+		wantFile string
+		wantLine int
+	}{
+		{1, "pr.md", 1},
+		{7, "pr.md", 7},
+		{8, "", 8},
+		{9, "faq.md", 1},
+		{15, "faq.md", 7},
+	}
+	for _, tt := range tests {
+		if file, line := s.Locate(tt.line); file != tt.wantFile || line != tt.wantLine {
+			t.Errorf("Locate(%d) = %q, %d, want %q, %d", tt.line, file, line, tt.wantFile, tt.wantLine)
+		}
+	}
+	if file, line := (&SpecSections{}).Locate(3); file != "" || line != 3 {
+		t.Errorf("Locate(3) without parts = %q, %d, want \"\", 3", file, line)
+	}
+}
+
+func TestParseFiles_InvalidManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+	}{
+		{"no files", "files: []\n"},
+		{"nested manifest", "files:\n  - other.yaml\n"},
+		{"not yaml", "files: [pr.md\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "prfaq.yml")
+			if err := os.WriteFile(path, []byte(tt.manifest), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := ParseFiles(path); !errors.Is(err, ErrManifest) {
+				t.Errorf("ParseFiles() error = %v, want %v", err, ErrManifest)
+			}
+		})
+	}
+}
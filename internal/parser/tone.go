@@ -0,0 +1,125 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Tone is the register a PR-FAQ is meant to be written in. It selects what
+// the Tone & Readability category rewards: an internal PR-FAQ may be written
+// conversationally, a design review technically.
+type Tone string
+
+// Supported tones. ToneFormal is the professional press release standard and
+// keeps the original scoring.
+const (
+	ToneFormal         Tone = "formal"
+	ToneConversational Tone = "conversational"
+	ToneTechnical      Tone = "technical"
+)
+
+// Tones lists the accepted -tone and front matter tone values.
+var Tones = []Tone{ToneFormal, ToneConversational, ToneTechnical}
+
+// ParseTone parses a tone name. The empty string is ToneFormal.
+func ParseTone(s string) (Tone, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return ToneFormal, nil
+	}
+	for _, t := range Tones {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	names := make([]string, len(Tones))
+	for i, t := range Tones {
+		names[i] = string(t)
+	}
+	return "", fmt.Errorf("unknown tone %q (want %s)", s, strings.Join(names, ", "))
+}
+
+// Register checks. Each tone other than formal rewards the markers of its
+// register and flags phrasing that belongs to another one.
+const (
+	msgToneImpersonal = "Speak to the reader directly - use you, we, and contractions"
+	msgToneStiff      = "Stiff, formal phrasing - say it the way you would out loud"
+	msgToneVague      = "Technical tone needs specifics - give numbers, units, and limits"
+	msgToneMarketing  = "Marketing language undercuts a technical tone - describe what it does"
+)
+
+// contractionPattern matches contractions such as "we're" and "don't".
+var contractionPattern = regexp.MustCompile(`(?i)\b[a-z]+['’](?:re|ve|ll|d|t|m)\b`)
+
+// measurementPattern matches numbers with a unit, such as "200 ms", "5 GB",
+// or "10,000 requests per second".
+var measurementPattern = regexp.MustCompile(`(?i)\b\d[\d,.]*\s*(?:%|ms|milliseconds?|seconds?|minutes?|hours?|[kmgt]b|[kmgt]ib|gbps|mbps|rps|qps|requests|req/s|nodes|cores|regions|x)(?:\b|/)`)
+
+// toneProfile holds the heuristics that vary by tone.
+type toneProfile struct {
+	lengthOffset int // added to the audience's sentence-length limits
+	passiveShare int // passive voice is overused in more than 1 of this many sentences
+	// register scores the markers of the tone; nil for formal, which keeps
+	// the original checks and their points.
+	register func(content, contentLower string, a *analysis)
+}
+
+var toneProfiles = map[Tone]toneProfile{
+	ToneFormal: {passiveShare: 4},
+	// Conversational writing uses shorter sentences and the active voice
+	ToneConversational: {lengthOffset: -3, passiveShare: 6, register: scoreConversational},
+	// Technical writing runs longer and legitimately uses the passive ("data is encrypted")
+	ToneTechnical: {lengthOffset: 5, passiveShare: 3, register: scoreTechnical},
+}
+
+// profile returns the heuristics for t, falling back to ToneFormal for the
+// zero value and unknown tones.
+func (t Tone) profile() toneProfile {
+	if p, ok := toneProfiles[t]; ok {
+		return p
+	}
+	return toneProfiles[ToneFormal]
+}
+
+// stiffPhrases are formal constructions a conversational document avoids.
+var stiffPhrases = []string{"hereby", "pursuant to", "utilize*", "in order to", "furthermore", "aforementioned", "henceforth", "whereby", "heretofore", "shall"}
+
+// scoreConversational rewards addressing the reader and flags stiff phrasing.
+func scoreConversational(content, contentLower string, a *analysis) {
+	stiff := false
+	for _, p := range stiffPhrases {
+		stiff = stiff || containsTerm(contentLower, p)
+	}
+	personal := containsTerm(contentLower, "you") || containsTerm(contentLower, "your") || containsTerm(contentLower, "we")
+	switch {
+	case stiff:
+		a.issue(msgToneStiff)
+	case !personal && !contractionPattern.MatchString(content):
+		a.issue(msgToneImpersonal)
+	default:
+		a.award(1, "conversational register", "addresses the reader")
+		a.strength("Reads conversationally")
+	}
+}
+
+// vagueClaims are marketing words a technical document replaces with specifics.
+var vagueClaims = []string{"seamless*", "effortless*", "magical*", "blazing*", "lightning-fast", "best-in-class", "world-class", "cutting-edge"}
+
+// scoreTechnical rewards measurable specifics and flags marketing language.
+func scoreTechnical(content, contentLower string, a *analysis) {
+	vague := false
+	for _, w := range vagueClaims {
+		vague = vague || containsTerm(contentLower, w)
+	}
+	measurements := measurementPattern.FindAllString(content, -1)
+	switch {
+	case vague:
+		a.issue(msgToneMarketing)
+	case len(measurements) == 0:
+		a.issue(msgToneVague)
+	default:
+		a.award(1, "technical specifics", fmt.Sprintf("%d measurements", len(measurements)))
+		a.strength("Backs claims with measurable specifics")
+	}
+}
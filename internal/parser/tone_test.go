@@ -0,0 +1,95 @@
+package parser
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseTone(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Tone
+		wantErr bool
+	}{
+		{"", ToneFormal, false},
+		{"conversational", ToneConversational, false},
+		{" Technical ", ToneTechnical, false},
+		{"casual", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseTone(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseTone(%q) = %q, %v; want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestScoreTone_Target(t *testing.T) {
+	const (
+		conversational = "We built Ledger Sync because you shouldn't lose a week to month-end close. " +
+			"It matches your entries while you sleep."
+		stiff = "The company hereby announces Ledger Sync. " +
+			"Finance teams utilize it in order to reconcile ledgers."
+		technical = "Ledger Sync reconciles 10,000 entries in 200 ms on 4 cores. " +
+			"Data is encrypted at rest and replicated across 3 regions."
+		marketing = "Ledger Sync delivers seamless, blazing reconciliation. " +
+			"It processes 10,000 entries per run."
+	)
+	tests := []struct {
+		name      string
+		content   string
+		tone      Tone
+		wantIssue string // "" expects the register point
+	}{
+		{"conversational", conversational, ToneConversational, ""},
+		{"stiff", stiff, ToneConversational, msgToneStiff},
+		{"impersonal", technical, ToneConversational, msgToneImpersonal},
+		{"technical", technical, ToneTechnical, ""},
+		{"vague", conversational, ToneTechnical, msgToneVague},
+		{"marketing", marketing, ToneTechnical, msgToneMarketing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := scoreTone(tt.content, AudienceGeneral, tt.tone)
+			rewarded := slices.ContainsFunc(a.trace, func(e ScoreEvent) bool {
+				return e.Delta == 1 && (e.Rule == "conversational register" || e.Rule == "technical specifics")
+			})
+			if tt.wantIssue == "" && !rewarded {
+				t.Errorf("no register point (issues %q)", a.issues)
+			}
+			if tt.wantIssue != "" && (rewarded || !slices.Contains(a.issues, tt.wantIssue)) {
+				t.Errorf("issues = %q, want %q and no register point", a.issues, tt.wantIssue)
+			}
+		})
+	}
+
+	// Formal keeps the original scoring
+	if got, want := scoreTone(stiff, AudienceGeneral, ToneFormal).score, scoreTone(stiff, AudienceGeneral, "").score; got != want {
+		t.Errorf("formal score = %d, want %d as without a tone", got, want)
+	}
+}
+
+func TestParse_Tone(t *testing.T) {
+	doc := "---\ntone: conversational\n---\n# Ledger Sync\n\n## Press Release\n\n" +
+		"The company hereby announces Ledger Sync, which cuts month-end close from 5 days to 2.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if sections.Tone != ToneConversational {
+		t.Fatalf("Tone = %q, want %q", sections.Tone, ToneConversational)
+	}
+	if !slices.Contains(sections.PRScore.QualityBreakdown.Issues, msgToneStiff) {
+		t.Errorf("issues = %q, want %q", sections.PRScore.QualityBreakdown.Issues, msgToneStiff)
+	}
+	if !strings.Contains(GenerateMarkdownReport(sections, sections.PRScore), "**Tone:** conversational") {
+		t.Error("report does not show the tone")
+	}
+
+	_, err = Parse(strings.NewReader("---\ntone: casual\n---\n# Title\n"))
+	if !errors.Is(err, ErrFrontMatter) {
+		t.Errorf("Parse() with unknown tone error = %v, want ErrFrontMatter", err)
+	}
+}
@@ -0,0 +1,273 @@
+package parser
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DocumentTree is the structural outline of a PR-FAQ: headings nested by
+// level, with paragraphs, sentences, and quotes positioned in the source.
+type DocumentTree struct {
+	Title     string
+	TitleLine int // 1-based line of the first H1, 0 if absent
+	// Paragraphs holds content that appears before the first section heading.
+	Paragraphs []Paragraph
+	Sections   []*Section
+}
+
+// Section is a heading and everything under it up to the next heading of the
+// same or a higher level. Plain-text headers such as "Press Release" on a
+// line by themselves are treated as level-2 headings.
+type Section struct {
+	Heading     string
+	Level       int      // 1-6; plain-text headers are 2
+	Line        int      // 1-based line of the heading
+	Span        LineSpan // heading through the last line of the last subsection
+	Paragraphs  []Paragraph
+	Subsections []*Section
+}
+
+// Paragraph is a run of consecutive non-blank lines. Paragraphs of
+// appendices have no Sentences or Quotes.
+type Paragraph struct {
+	Text      string // source lines joined with "\n"
+	Span      LineSpan
+	Sentences []Sentence
+	Quotes    []Quote
+}
+
+// Sentence is a sentence within a paragraph, with line breaks collapsed to spaces.
+type Sentence struct {
+	Text     string
+	Position Position
+}
+
+// Quote is text between double quotation marks (straight or curly), excluding the marks.
+type Quote struct {
+	Text     string
+	Position Position // position of the opening mark
+}
+
+// Position is a 1-based line and column; columns count runes, not bytes.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// Find returns the first section, at any depth, whose heading matches name
+// case-insensitively, or nil.
+func (t *DocumentTree) Find(name string) *Section {
+	return findSection(t.Sections, name)
+}
+
+func findSection(sections []*Section, name string) *Section {
+	for _, s := range sections {
+		if strings.EqualFold(s.Heading, name) {
+			return s
+		}
+		if found := findSection(s.Subsections, name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// buildTree derives the document outline from source lines.
+func buildTree(lines []string) *DocumentTree {
+	tree := &DocumentTree{}
+	var stack []*Section // open sections, outermost first
+	var para []string
+	paraStart := 0
+
+	flush := func() {
+		if len(para) == 0 {
+			return
+		}
+		p := newParagraph(para, paraStart, !inAppendix(stack))
+		if len(stack) == 0 {
+			tree.Paragraphs = append(tree.Paragraphs, p)
+		} else {
+			top := stack[len(stack)-1]
+			top.Paragraphs = append(top.Paragraphs, p)
+		}
+		para = nil
+	}
+
+	for i, line := range lines {
+		lineNum := i + 1
+		heading, level := headingOf(line)
+
+		if level == 1 && tree.TitleLine == 0 {
+			flush()
+			tree.Title, tree.TitleLine = heading, lineNum
+			if !isCommonHeader(heading) {
+				continue
+			}
+			// "# Press Release" is both the title and a section
+		}
+
+		if level > 0 {
+			flush()
+			for len(stack) > 0 && stack[len(stack)-1].Level >= level {
+				stack = stack[:len(stack)-1]
+			}
+			section := &Section{Heading: heading, Level: level, Line: lineNum, Span: LineSpan{Start: lineNum, End: lineNum}}
+			if len(stack) == 0 {
+				tree.Sections = append(tree.Sections, section)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.Subsections = append(parent.Subsections, section)
+			}
+			stack = append(stack, section)
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+		if len(para) == 0 {
+			paraStart = lineNum
+		}
+		para = append(para, line)
+		for _, open := range stack {
+			open.Span.End = lineNum
+		}
+	}
+	flush()
+
+	return tree
+}
+
+// headingOf returns the heading text and level of a line, or level 0 if the
+// line is not a heading.
+func headingOf(line string) (string, int) {
+	trimmed := strings.TrimSpace(line)
+	level := 0
+	for level < len(trimmed) && level < 7 && trimmed[level] == '#' {
+		level++
+	}
+	if level >= 1 && level <= 6 && len(trimmed) > level && trimmed[level] == ' ' {
+		return strings.TrimSpace(trimmed[level:]), level
+	}
+	if isCommonHeader(trimmed) {
+		return trimmed, 2
+	}
+	return "", 0
+}
+
+// inAppendix reports whether any of the open sections is an appendix.
+func inAppendix(stack []*Section) bool {
+	for _, s := range stack {
+		if isAppendix(s.Heading) {
+			return true
+		}
+	}
+	return false
+}
+
+// newParagraph positions the lines of a paragraph. Only when split is set
+// are they broken into sentences and quotes, which appendices, never
+// scored, can do without: for large appendices it is most of the work.
+func newParagraph(lines []string, start int, split bool) Paragraph {
+	p := Paragraph{
+		Text: strings.Join(lines, "\n"),
+		Span: LineSpan{Start: start, End: start + len(lines) - 1},
+	}
+	if split {
+		p.Sentences = splitSentences(lines, start)
+		p.Quotes = findQuotes(lines, start)
+	}
+	return p
+}
+
+// splitSentences breaks paragraph lines into sentences ending in . ! or ?
+// followed by whitespace or the end of the paragraph.
+func splitSentences(lines []string, start int) []Sentence {
+	var sentences []Sentence
+	var buf strings.Builder
+	var pos Position
+	started := false
+
+	emit := func() {
+		if started {
+			sentences = append(sentences, Sentence{Text: strings.TrimSpace(buf.String()), Position: pos})
+		}
+		buf.Reset()
+		started = false
+	}
+
+	for i, line := range lines {
+		if started {
+			buf.WriteByte(' ')
+		}
+		runes := []rune(line)
+		for col, r := range runes {
+			if !started {
+				if unicode.IsSpace(r) {
+					continue
+				}
+				started = true
+				pos = Position{Line: start + i, Column: col + 1}
+			}
+			buf.WriteRune(r)
+			if endsSentence(runes, col) {
+				emit()
+			}
+		}
+	}
+	emit()
+	return sentences
+}
+
+// endsSentence reports whether runes[i] closes a sentence: a terminator, or a
+// closing quote or bracket after one, followed by whitespace or end of line.
+func endsSentence(runes []rune, i int) bool {
+	if i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+		return false
+	}
+	for j := i; j >= 0; j-- {
+		switch runes[j] {
+		case '.', '!', '?':
+			return true
+		case '"', '”', '\'', '’', ')':
+			continue
+		}
+		return false
+	}
+	return false
+}
+
+// findQuotes returns the double-quoted spans of a paragraph. Single quotes are
+// skipped because they are indistinguishable from apostrophes.
+func findQuotes(lines []string, start int) []Quote {
+	var quotes []Quote
+	var buf strings.Builder
+	var open *Position
+	var closer rune
+
+	for i, line := range lines {
+		if open != nil {
+			buf.WriteByte(' ')
+		}
+		col := 0
+		for _, r := range line {
+			col++
+			switch {
+			case open == nil && (r == '"' || r == '“'):
+				open = &Position{Line: start + i, Column: col}
+				closer = '"'
+				if r == '“' {
+					closer = '”'
+				}
+			case open != nil && r == closer:
+				quotes = append(quotes, Quote{Text: strings.TrimSpace(buf.String()), Position: *open})
+				open = nil
+				buf.Reset()
+			case open != nil:
+				buf.WriteRune(r)
+			}
+		}
+	}
+	return quotes
+}
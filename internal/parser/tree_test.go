@@ -0,0 +1,157 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+const treeDoc = `# Acme Launches Ledger Sync
+
+Intro line before any section.
+
+## Press Release
+
+**SEATTLE** - Acme today announced Ledger Sync. It cuts close time by 40%!
+
+"We closed our books in 3 days," said Jane Doe.
+Her team agreed.
+
+### Availability
+
+Available now.
+
+FAQ
+
+Q: Who is it for?
+A: Finance teams.
+`
+
+func TestParse_Tree(t *testing.T) {
+	sections, err := Parse(strings.NewReader(treeDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	tree := sections.Tree
+	if tree == nil {
+		t.Fatal("Parse() did not build a tree")
+	}
+
+	if tree.Title != "Acme Launches Ledger Sync" || tree.TitleLine != 1 {
+		t.Errorf("title = %q at %d", tree.Title, tree.TitleLine)
+	}
+	if len(tree.Paragraphs) != 1 || tree.Paragraphs[0].Span.Start != 3 {
+		t.Errorf("preamble = %+v", tree.Paragraphs)
+	}
+
+	if len(tree.Sections) != 2 {
+		t.Fatalf("got %d top-level sections, want 2", len(tree.Sections))
+	}
+	pr, faq := tree.Sections[0], tree.Sections[1]
+	if pr.Heading != "Press Release" || pr.Level != 2 || pr.Line != 5 {
+		t.Errorf("press release section = %+v", pr)
+	}
+	if want := (LineSpan{Start: 5, End: 14}); pr.Span != want {
+		t.Errorf("press release span = %+v, want %+v", pr.Span, want)
+	}
+	if len(pr.Subsections) != 1 || pr.Subsections[0].Heading != "Availability" || pr.Subsections[0].Level != 3 {
+		t.Errorf("subsections = %+v", pr.Subsections)
+	}
+	if faq.Heading != "FAQ" || faq.Level != 2 || faq.Line != 16 {
+		t.Errorf("plain-text FAQ section = %+v", faq)
+	}
+
+	if got := tree.Find("availability"); got != pr.Subsections[0] {
+		t.Errorf("Find(availability) = %+v", got)
+	}
+	if tree.Find("missing") != nil {
+		t.Error("Find(missing) should be nil")
+	}
+}
+
+func TestParse_TreeParagraphs(t *testing.T) {
+	sections, err := Parse(strings.NewReader(treeDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	pr := sections.Tree.Find("Press Release")
+	if len(pr.Paragraphs) != 2 {
+		t.Fatalf("got %d paragraphs, want 2", len(pr.Paragraphs))
+	}
+
+	first := pr.Paragraphs[0]
+	if len(first.Sentences) != 2 {
+		t.Fatalf("sentences = %+v, want 2", first.Sentences)
+	}
+	if s := first.Sentences[1]; s.Text != "It cuts close time by 40%!" || s.Position != (Position{Line: 7, Column: 49}) {
+		t.Errorf("second sentence = %+v", s)
+	}
+
+	second := pr.Paragraphs[1]
+	if second.Span != (LineSpan{Start: 9, End: 10}) {
+		t.Errorf("paragraph span = %+v", second.Span)
+	}
+	if len(second.Quotes) != 1 || second.Quotes[0].Text != "We closed our books in 3 days," {
+		t.Fatalf("quotes = %+v", second.Quotes)
+	}
+	if second.Quotes[0].Position != (Position{Line: 9, Column: 1}) {
+		t.Errorf("quote position = %+v", second.Quotes[0].Position)
+	}
+	// The quoted clause does not end a sentence; the line break joins the next one
+	if len(second.Sentences) != 2 || second.Sentences[1].Text != "Her team agreed." {
+		t.Errorf("sentences = %+v", second.Sentences)
+	}
+}
+
+func TestParse_TreeAppendix(t *testing.T) {
+	doc := "# Launch\n\n## Press Release\n\nToday we launch. It is \"fast\".\n\n## Appendix A: Data\n\nRow one. Row two.\n\n### Method\n\nWe \"measured\" it.\n"
+	sections, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if p := sections.Tree.Find("Press Release").Paragraphs[0]; len(p.Sentences) != 2 || len(p.Quotes) != 1 {
+		t.Errorf("press release paragraph = %+v, want sentences and quotes", p)
+	}
+	for _, name := range []string{"Appendix A: Data", "Method"} {
+		p := sections.Tree.Find(name).Paragraphs[0]
+		if p.Text == "" || p.Span.Start == 0 || p.Sentences != nil || p.Quotes != nil {
+			t.Errorf("%s paragraph = %+v, want text and span only", name, p)
+		}
+	}
+}
+
+func TestSplitSentences(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  []string
+	}{
+		{"single", []string{"One sentence."}, []string{"One sentence."}},
+		{"no terminator", []string{"Trailing text"}, []string{"Trailing text"}},
+		{"decimal", []string{"It grew 3.5x. Then stopped."}, []string{"It grew 3.5x.", "Then stopped."}},
+		{"quoted end", []string{`He said "yes." She left.`}, []string{`He said "yes."`, "She left."}},
+		{"wrapped", []string{"A sentence that", "wraps lines."}, []string{"A sentence that wraps lines."}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitSentences(tt.lines, 1)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %+v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].Text != tt.want[i] {
+					t.Errorf("sentence %d = %q, want %q", i, got[i].Text, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFindQuotes_Curly(t *testing.T) {
+	quotes := findQuotes([]string{"He said “it works” and “it", "scales”."}, 4)
+	if len(quotes) != 2 {
+		t.Fatalf("quotes = %+v", quotes)
+	}
+	if quotes[1].Text != "it scales" || quotes[1].Position != (Position{Line: 4, Column: 24}) {
+		t.Errorf("second quote = %+v", quotes[1])
+	}
+}
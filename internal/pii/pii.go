@@ -0,0 +1,145 @@
+// Package pii finds personal data and secrets, such as email addresses, phone
+// numbers, API keys, and internal hostnames, in content about to leave the
+// machine.
+package pii
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Kind is a category of sensitive content.
+type Kind string
+
+// Kinds of sensitive content, in the order overlapping matches are kept.
+const (
+	KindSecret    Kind = "API key or secret"
+	KindEmail     Kind = "email address"
+	KindPhone     Kind = "phone number"
+	KindPrivateIP Kind = "private IP address"
+	KindHost      Kind = "internal hostname"
+)
+
+var (
+	// secretPatterns match the key formats of common APIs, private keys, and
+	// assignments such as "api_key: ...".
+	secretPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`\bsk-(?:ant-|proj-)?[A-Za-z0-9_-]{20,}`),
+		regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`),
+		regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`),
+		regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`),
+		regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`),
+		regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+		regexp.MustCompile(`(?i)\b(?:api[_-]?key|secret|token|passw(?:or)?d)\b\s*[:=]\s*["']?[^\s"']{8,}`),
+	}
+	emailPattern = regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`)
+	// phonePattern requires separators, so plain numbers such as revenue are not flagged.
+	phonePattern     = regexp.MustCompile(`(?:\+\d{1,3}[\s.-]?)?(?:\(\d{3}\)\s?|\b\d{3}[\s.-])\d{3}[\s.-]\d{4}\b`)
+	privateIPPattern = regexp.MustCompile(`\b(?:10\.\d{1,3}|172\.(?:1[6-9]|2\d|3[01])\.\d{1,3}|192\.168)\.\d{1,3}\.\d{1,3}\b`)
+	// hostPattern matches hostnames under top-level domains that are only used internally.
+	hostPattern = regexp.MustCompile(`(?i)\b(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+(?:internal|corp|local|localdomain|lan|intranet|private)\b`)
+)
+
+// Match is one piece of sensitive content.
+type Match struct {
+	Kind Kind
+	Text string
+}
+
+// String describes the match for a warning, e.g. `email address
+// "jane@acme.internal"`. Secrets are masked so they do not end up in logs.
+func (m Match) String() string {
+	text := m.Text
+	if m.Kind == KindSecret {
+		text = mask(text)
+	}
+	return fmt.Sprintf("%s %q", m.Kind, text)
+}
+
+// mask keeps the first four characters of a secret.
+func mask(s string) string {
+	r := []rune(s)
+	if len(r) <= 4 {
+		return "****"
+	}
+	return string(r[:4]) + "****"
+}
+
+// MaskSecrets returns text with every API key and secret masked as Match
+// does, for content that is written to disk.
+func MaskSecrets(text string) string {
+	for _, p := range secretPatterns {
+		text = p.ReplaceAllStringFunc(text, mask)
+	}
+	return text
+}
+
+// Scanner finds sensitive content.
+type Scanner struct {
+	hosts []*regexp.Regexp
+	allow map[string]bool
+}
+
+// New returns a Scanner that also flags hostnames under internalDomains,
+// such as "corp.example.com", and never flags the values in allow, such as
+// a public media contact.
+func New(internalDomains, allow []string) *Scanner {
+	s := &Scanner{hosts: []*regexp.Regexp{hostPattern}, allow: map[string]bool{}}
+	for _, d := range internalDomains {
+		if d = strings.Trim(strings.TrimSpace(d), "."); d != "" {
+			s.hosts = append(s.hosts, regexp.MustCompile(`(?i)\b(?:[a-z0-9-]+\.)*`+regexp.QuoteMeta(d)+`\b`))
+		}
+	}
+	for _, a := range allow {
+		if a = strings.TrimSpace(a); a != "" {
+			s.allow[strings.ToLower(a)] = true
+		}
+	}
+	return s
+}
+
+// span is a match with its byte offsets.
+type span struct {
+	Match
+	start, end int
+}
+
+// Scan returns the sensitive content in text in source order. Where matches
+// overlap, as an email address at an internal host does, only the first kind
+// in the Kind list is reported.
+func (s *Scanner) Scan(text string) []Match {
+	var kept []span
+	add := func(kind Kind, patterns ...*regexp.Regexp) {
+		for _, p := range patterns {
+			for _, loc := range p.FindAllStringIndex(text, -1) {
+				m := span{Match: Match{Kind: kind, Text: text[loc[0]:loc[1]]}, start: loc[0], end: loc[1]}
+				if !s.allow[strings.ToLower(m.Text)] && !overlaps(kept, m) {
+					kept = append(kept, m)
+				}
+			}
+		}
+	}
+	add(KindSecret, secretPatterns...)
+	add(KindEmail, emailPattern)
+	add(KindPhone, phonePattern)
+	add(KindPrivateIP, privateIPPattern)
+	add(KindHost, s.hosts...)
+
+	sort.Slice(kept, func(i, j int) bool { return kept[i].start < kept[j].start })
+	var matches []Match
+	for _, m := range kept {
+		matches = append(matches, m.Match)
+	}
+	return matches
+}
+
+func overlaps(kept []span, m span) bool {
+	for _, k := range kept {
+		if m.start < k.end && k.start < m.end {
+			return true
+		}
+	}
+	return false
+}
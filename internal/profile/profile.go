@@ -0,0 +1,62 @@
+// Package profile writes CPU and heap profiles of a run, for diagnosing
+// hotspots with go tool pprof.
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+)
+
+// Start begins a CPU profile written to cpuPath, if set, and returns a
+// function that ends it and writes a heap profile to memPath, if set. Call
+// the function once, before the program exits.
+func Start(cpuPath, memPath string) (stop func() error, err error) {
+	var cpu *os.File
+	if cpuPath != "" {
+		cpu, err = os.Create(cpuPath) //nolint:gosec // path is user-provided CLI argument
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CPU profile: %w", err)
+		}
+		if err := pprof.StartCPUProfile(cpu); err != nil {
+			_ = cpu.Close()
+			return nil, fmt.Errorf("failed to start CPU profile: %w", err)
+		}
+	}
+
+	return func() error {
+		var errs []error
+		if cpu != nil {
+			pprof.StopCPUProfile()
+			if err := cpu.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("failed to write CPU profile: %w", err))
+			}
+		}
+		if memPath != "" {
+			if err := writeHeap(memPath); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}, nil
+}
+
+// writeHeap writes a heap profile to path, after a collection so it shows
+// live memory as of the end of the run.
+func writeHeap(path string) error {
+	f, err := os.Create(path) //nolint:gosec // path is user-provided CLI argument
+	if err != nil {
+		return fmt.Errorf("failed to create memory profile: %w", err)
+	}
+	runtime.GC()
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write memory profile: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write memory profile: %w", err)
+	}
+	return nil
+}
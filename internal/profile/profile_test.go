@@ -0,0 +1,34 @@
+package profile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStart(t *testing.T) {
+	dir := t.TempDir()
+	cpu, mem := filepath.Join(dir, "cpu.pprof"), filepath.Join(dir, "mem.pprof")
+	stop, err := Start(cpu, mem)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := stop(); err != nil {
+		t.Fatalf("stop() error = %v", err)
+	}
+	for _, path := range []string{cpu, mem} {
+		if info, err := os.Stat(path); err != nil || info.Size() == 0 {
+			t.Errorf("%s not written: %v", filepath.Base(path), err)
+		}
+	}
+
+	// Neither profile is written unless asked for
+	stop, err = Start("", "")
+	if err != nil || stop() != nil {
+		t.Errorf("Start(\"\", \"\") failed: %v", err)
+	}
+
+	if _, err := Start(filepath.Join(dir, "missing", "cpu.pprof"), ""); err == nil {
+		t.Error("Start() into a missing directory succeeded, want an error")
+	}
+}
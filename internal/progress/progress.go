@@ -0,0 +1,116 @@
+// Package progress reports how far a batch run has got: a redrawn progress
+// bar on a terminal, or one line per document in CI logs.
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// barWidth is the number of cells in the progress bar.
+const barWidth = 24
+
+// Reporter prints progress for a run of a known number of documents.
+type Reporter struct {
+	w     io.Writer
+	total int
+	tty   bool // redraw one line instead of printing a line per document
+	start time.Time
+	now   func() time.Time
+
+	done     int
+	scored   int           // documents scored rather than restored
+	scoring  time.Duration // time spent on scored documents
+	lastLine int           // length of the last redrawn line, to clear it
+}
+
+// New returns a Reporter for total documents writing to w. It redraws a
+// single line when w is a terminal.
+func New(w io.Writer, total int) *Reporter {
+	return &Reporter{w: w, total: total, tty: isTerminal(w), start: time.Now(), now: time.Now}
+}
+
+// isTerminal reports whether w is a character device such as a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// Done records a finished document that took elapsed. Restored documents,
+// such as those taken from a checkpoint, count toward progress but not
+// toward the average used for the ETA.
+func (r *Reporter) Done(path string, elapsed time.Duration, restored bool) {
+	r.done++
+	if !restored {
+		r.scored++
+		r.scoring += elapsed
+	}
+
+	detail := fmt.Sprintf("%s (%s", path, elapsed.Round(time.Millisecond))
+	if restored {
+		detail = path + " (restored"
+	}
+	if avg := r.average(); avg > 0 {
+		detail += fmt.Sprintf(", avg %s, ETA %s", avg.Round(time.Millisecond), r.eta().Round(time.Second))
+	}
+	detail += ")"
+
+	if !r.tty {
+		fmt.Fprintf(r.w, "[%d/%d] %s\n", r.done, r.total, detail)
+		return
+	}
+	line := fmt.Sprintf("%s %3d%% %d/%d %s", bar(r.done, r.total), 100*r.done/max(1, r.total), r.done, r.total, detail)
+	fmt.Fprintf(r.w, "\r%s%s", line, strings.Repeat(" ", max(0, r.lastLine-len(line))))
+	r.lastLine = len(line)
+}
+
+// Notice prints msg on a line of its own, such as a rate limit wait. On a
+// terminal it replaces the progress bar until the next document redraws it.
+func (r *Reporter) Notice(msg string) {
+	if r.tty {
+		fmt.Fprintf(r.w, "\r%s\r", strings.Repeat(" ", r.lastLine))
+		r.lastLine = 0
+	}
+	fmt.Fprintln(r.w, msg)
+}
+
+// Finish ends the progress display with the total time.
+func (r *Reporter) Finish() {
+	if r.tty {
+		fmt.Fprintln(r.w)
+	}
+	fmt.Fprintf(r.w, "Scored %d documents in %s\n", r.done, r.now().Sub(r.start).Round(time.Millisecond))
+}
+
+// average is the mean time per scored document, zero before the first.
+func (r *Reporter) average() time.Duration {
+	if r.scored == 0 {
+		return 0
+	}
+	return r.scoring / time.Duration(r.scored)
+}
+
+// eta estimates the time left from the average of the documents scored so far.
+func (r *Reporter) eta() time.Duration {
+	return r.average() * time.Duration(r.total-r.done)
+}
+
+// bar draws "[=========>      ]" filled to done of total.
+func bar(done, total int) string {
+	filled := barWidth
+	if total > 0 {
+		filled = barWidth * done / total
+	}
+	head := ""
+	if filled < barWidth {
+		head = ">"
+	}
+	return "[" + strings.Repeat("=", filled) + head + strings.Repeat(" ", max(0, barWidth-filled-len(head))) + "]"
+}
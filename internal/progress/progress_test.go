@@ -0,0 +1,84 @@
+package progress
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReporter(t *testing.T) {
+	var buf bytes.Buffer
+	r := New(&buf, 4)
+	start := r.start
+	r.now = func() time.Time { return start.Add(3 * time.Second) }
+
+	r.Done("a.md", 0, true)
+	r.Done("b.md", 2*time.Second, false)
+	r.Done("c.md", time.Second, false)
+	r.Finish()
+
+	want := []string{
+		"[1/4] a.md (restored)",
+		"[2/4] b.md (2s, avg 2s, ETA 4s)",
+		"[3/4] c.md (1s, avg 1.5s, ETA 2s)",
+		"Scored 3 documents in 3s",
+	}
+	if got := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n"); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("output =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestReporter_Terminal(t *testing.T) {
+	var buf bytes.Buffer
+	r := New(&buf, 2)
+	r.tty = true
+
+	r.Done("a-long-name.md", time.Second, false)
+	r.Done("b.md", time.Second, false)
+	r.Finish()
+
+	out := buf.String()
+	if strings.Count(out, "\r") != 2 || strings.Count(out, "\n") != 2 {
+		t.Errorf("terminal output should redraw one line, got %q", out)
+	}
+	if !strings.Contains(out, "100% 2/2 b.md") {
+		t.Errorf("terminal output = %q, want the final line at 100%%", out)
+	}
+}
+
+func TestReporter_Notice(t *testing.T) {
+	var buf bytes.Buffer
+	r := New(&buf, 2)
+	r.Done("a.md", time.Second, false)
+	r.Notice("Rate limit reached")
+	if got := buf.String(); !strings.HasSuffix(got, ")\nRate limit reached\n") {
+		t.Errorf("output = %q, want the notice on its own line", got)
+	}
+
+	buf.Reset()
+	r.tty = true
+	r.Done("b.md", time.Second, false)
+	bar := r.lastLine
+	r.Notice("Rate limit reached")
+	if got := buf.String(); !strings.HasSuffix(got, "\r"+strings.Repeat(" ", bar)+"\rRate limit reached\n") {
+		t.Errorf("terminal output = %q, want the bar cleared before the notice", got)
+	}
+}
+
+func TestBar(t *testing.T) {
+	tests := []struct {
+		done, total int
+		want        string
+	}{
+		{0, 4, "[>" + strings.Repeat(" ", barWidth-1) + "]"},
+		{2, 4, "[" + strings.Repeat("=", barWidth/2) + ">" + strings.Repeat(" ", barWidth/2-1) + "]"},
+		{4, 4, "[" + strings.Repeat("=", barWidth) + "]"},
+		{0, 0, "[" + strings.Repeat("=", barWidth) + "]"},
+	}
+	for _, tt := range tests {
+		if got := bar(tt.done, tt.total); got != tt.want {
+			t.Errorf("bar(%d, %d) = %q, want %q", tt.done, tt.total, got, tt.want)
+		}
+	}
+}
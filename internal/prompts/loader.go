@@ -4,14 +4,19 @@ package prompts
 import (
 	"bytes"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"sync"
 	"text/template"
 
+	embedded "github.com/bordenet/pr-faq-validator/prompts"
 	"gopkg.in/yaml.v3"
 )
 
+// EmbeddedDir is what Loader.Dir reports for the prompts built into the binary.
+const EmbeddedDir = "(embedded)"
+
 // PromptTemplate represents a loaded prompt with metadata.
 type PromptTemplate struct {
 	Name               string                   `yaml:"name"`
@@ -68,41 +73,55 @@ func (pt *PromptTemplate) GetParameter(key string, defaultValue interface{}) int
 // Loader loads and caches prompt templates from YAML files.
 type Loader struct {
 	promptsDir string
+	files      fs.FS
 	cache      map[string]*PromptTemplate
 	mu         sync.RWMutex
 }
 
 // NewLoader creates a new prompt loader.
-// If promptsDir is empty, it looks for prompts directory relative to project root.
+// If promptsDir is empty, it uses the prompts directory of the project root
+// when run from a checkout, so edited prompts take effect without a rebuild,
+// and the prompts embedded in the binary otherwise.
 func NewLoader(promptsDir string) *Loader {
+	l := &Loader{
+		promptsDir: promptsDir,
+		cache:      make(map[string]*PromptTemplate),
+	}
 	if promptsDir == "" {
-		// Find project root by looking for go.mod
-		cwd, err := os.Getwd()
-		if err == nil {
-			// Try to find go.mod
-			dir := cwd
-			for {
-				if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
-					promptsDir = filepath.Join(dir, "prompts")
-					break
-				}
-				parent := filepath.Dir(dir)
-				if parent == dir {
-					// Reached root without finding go.mod
-					promptsDir = "prompts"
-					break
-				}
-				dir = parent
+		l.promptsDir = projectPrompts()
+	}
+	if l.promptsDir == "" {
+		l.promptsDir, l.files = EmbeddedDir, embedded.FS
+	}
+	return l
+}
+
+// projectPrompts finds the prompts directory next to the nearest go.mod
+// above the working directory, or returns "" when there is none.
+func projectPrompts() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			promptsDir := filepath.Join(dir, "prompts")
+			if info, err := os.Stat(promptsDir); err == nil && info.IsDir() {
+				return promptsDir
 			}
-		} else {
-			promptsDir = "prompts"
+			return ""
 		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
 	}
+}
 
-	return &Loader{
-		promptsDir: promptsDir,
-		cache:      make(map[string]*PromptTemplate),
-	}
+// Dir returns the directory prompts are loaded from.
+func (l *Loader) Dir() string {
+	return l.promptsDir
 }
 
 // Load loads a prompt template from a YAML file.
@@ -116,12 +135,9 @@ func (l *Loader) Load(promptPath string) (*PromptTemplate, error) {
 	}
 	l.mu.RUnlock()
 
-	// Load from filesystem
-	fullPath := filepath.Join(l.promptsDir, promptPath)
-	// #nosec G304 - promptPath is validated to be within prompts directory
-	data, err := os.ReadFile(fullPath)
+	data, err := l.read(promptPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read prompt file %s: %w", fullPath, err)
+		return nil, err
 	}
 
 	// Parse YAML
@@ -138,6 +154,25 @@ func (l *Loader) Load(promptPath string) (*PromptTemplate, error) {
 	return &tmpl, nil
 }
 
+// read returns the contents of a prompt file.
+func (l *Loader) read(promptPath string) ([]byte, error) {
+	if l.files != nil {
+		data, err := fs.ReadFile(l.files, filepath.ToSlash(promptPath))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read embedded prompt file %s: %w", promptPath, err)
+		}
+		return data, nil
+	}
+
+	fullPath := filepath.Join(l.promptsDir, promptPath)
+	// #nosec G304 - promptPath is validated to be within prompts directory
+	data, err := os.ReadFile(fullPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prompt file %s: %w", fullPath, err)
+	}
+	return data, nil
+}
+
 // ClearCache clears the prompt template cache.
 func (l *Loader) ClearCache() {
 	l.mu.Lock()
@@ -145,5 +180,5 @@ func (l *Loader) ClearCache() {
 	l.cache = make(map[string]*PromptTemplate)
 }
 
-// DefaultLoader returns a loader that uses embedded prompts.
+// DefaultLoader loads the project's prompts directory, or the embedded prompts.
 var DefaultLoader = NewLoader("")
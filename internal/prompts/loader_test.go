@@ -3,6 +3,7 @@ package prompts
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -28,6 +29,26 @@ func TestNewLoader(t *testing.T) {
 	})
 }
 
+func TestNewLoader_Embedded(t *testing.T) {
+	// Outside a checkout the prompts built into the binary are used
+	t.Chdir(t.TempDir())
+
+	loader := NewLoader("")
+	if loader.Dir() != EmbeddedDir {
+		t.Errorf("Dir() = %q, want %q", loader.Dir(), EmbeddedDir)
+	}
+	tmpl, err := loader.Load("analysis/section_review.yaml")
+	if err != nil {
+		t.Fatalf("failed to load embedded prompt: %v", err)
+	}
+	if tmpl.Name != "section-review" {
+		t.Errorf("expected name 'section-review', got '%s'", tmpl.Name)
+	}
+	if _, err := loader.Load("analysis/missing.yaml"); err == nil {
+		t.Error("expected an error for a missing embedded prompt")
+	}
+}
+
 func TestLoadPrompt(t *testing.T) {
 	// Use actual prompts directory
 	loader := NewLoader("../../prompts")
@@ -42,8 +63,8 @@ func TestLoadPrompt(t *testing.T) {
 			t.Errorf("expected name 'section-review', got '%s'", tmpl.Name)
 		}
 
-		if tmpl.Version != "1.0.0" {
-			t.Errorf("expected version '1.0.0', got '%s'", tmpl.Version)
+		if tmpl.Version != "1.1.0" {
+			t.Errorf("expected version '1.1.0', got '%s'", tmpl.Version)
 		}
 
 		if tmpl.SystemPrompt == "" {
@@ -302,3 +323,24 @@ func findSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestLoadSectionRewritePrompt(t *testing.T) {
+	loader := NewLoader("../../prompts")
+
+	tmpl, err := loader.Load("analysis/section_rewrite.yaml")
+	if err != nil {
+		t.Fatalf("failed to load prompt: %v", err)
+	}
+
+	user, err := tmpl.RenderUserPrompt(map[string]interface{}{
+		"section_name": "Press Release",
+		"content":      "Body text",
+		"issues":       []string{"Missing release date in opening lines"},
+	})
+	if err != nil {
+		t.Fatalf("failed to render user prompt: %v", err)
+	}
+	if !strings.Contains(user, "- Missing release date in opening lines") || !strings.Contains(user, "Body text") {
+		t.Errorf("unexpected user prompt:\n%s", user)
+	}
+}
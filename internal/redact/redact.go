@@ -0,0 +1,220 @@
+// Package redact replaces company names, people, and dollar figures with
+// placeholders such as [COMPANY_1], so drafts can be reviewed by an external
+// model without disclosing unannounced details, and maps the placeholders
+// back in the model's reply.
+package redact
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Entity kinds, used in placeholders.
+const (
+	KindCompany = "COMPANY"
+	KindPerson  = "PERSON"
+	KindAmount  = "AMOUNT"
+	KindTerm    = "TERM"
+)
+
+// name is a capitalized word or initialism, such as "Acme", "AT&T", or "J.".
+const name = `[A-Z][\w&.'-]*`
+
+var (
+	// companySuffixPattern captures a name followed by a corporate suffix, e.g. "Acme Robotics, Inc.".
+	companySuffixPattern = regexp.MustCompile(`\b(` + name + `(?:\s+` + name + `){0,3}),?\s+(?:Inc|Corp|Corporation|LLC|Ltd|GmbH|PLC|Co)\b\.?`)
+	// aboutPattern captures the company of an "About <Company>" boilerplate heading.
+	aboutPattern = regexp.MustCompile(`(?m)^[#*_\s]*About\s+(` + name + `(?:\s+` + name + `){0,3})[*_\s]*$`)
+	// announcerPattern captures the company that makes the announcement, e.g. "Acme today announced".
+	announcerPattern = regexp.MustCompile(`\b(` + name + `(?:\s+` + name + `){0,3}),?\s+(?:today\s+)?(?:announced|launched|introduced|unveiled|released)\b`)
+	// speakerPattern captures the person a quote is attributed to, e.g. "said Jane Doe".
+	speakerPattern = regexp.MustCompile(`\b(?:said|says|added|adds|explained|noted|according to)\s+([A-Z][a-z]+(?:\s+[A-Z]\.)?\s+[A-Z][a-zA-Z'-]+)`)
+	// titledPattern captures a person named with a job title, e.g. "Jane Doe, CEO".
+	titledPattern = regexp.MustCompile(`\b([A-Z][a-z]+(?:\s+[A-Z]\.)?\s+[A-Z][a-zA-Z'-]+),\s+(?:the\s+)?(?:C[A-Z]O\b|[SE]?VP\b|[Cc]hief|[Vv]ice [Pp]resident|[Pp]resident|[Hh]ead|[Dd]irector|[Mm]anager|[Ff]ounder|[Cc]o-founder|[Ee]ngineer|[Ll]ead)`)
+	// amountPattern matches dollar figures such as "$4.99", "$2M", "$1.5 billion", or "300 dollars".
+	amountPattern = regexp.MustCompile(`(?i)(?:US)?\$\s?\d[\d,]*(?:\.\d+)?(?:\s?(?:k|m|bn?|thousand|million|billion|trillion)\b)?|\b\d[\d,]*(?:\.\d+)?\s?(?:thousand|million|billion)?\s?(?:dollars|USD)\b|\bUSD\s?\d[\d,]*(?:\.\d+)?`)
+	// placeholderPattern matches the placeholders a Redactor writes.
+	placeholderPattern = regexp.MustCompile(`\[(?:` + KindCompany + `|` + KindPerson + `|` + KindAmount + `|` + KindTerm + `)_\d+\]`)
+)
+
+// Redactor replaces entities with numbered placeholders and restores them.
+// The same entity always gets the same placeholder, so a conversation
+// redacted message by message stays consistent. A Redactor is safe for
+// concurrent use.
+type Redactor struct {
+	mu       sync.Mutex
+	terms    []string          // always redacted, e.g. unannounced product names
+	byText   map[string]string // entity to placeholder
+	byHolder map[string]string // placeholder to entity
+	counts   map[string]int    // placeholders issued per kind
+}
+
+// New returns a Redactor that also redacts terms, such as codenames and
+// product names that no pattern would recognize.
+func New(terms []string) *Redactor {
+	r := &Redactor{byText: map[string]string{}, byHolder: map[string]string{}, counts: map[string]int{}}
+	for _, t := range terms {
+		if t = strings.TrimSpace(t); t != "" {
+			r.terms = append(r.terms, t)
+		}
+	}
+	return r
+}
+
+// Redact returns text with every detected entity, every entity seen in
+// earlier calls, and every configured term replaced by its placeholder.
+func (r *Redactor) Redact(text string) string {
+	return r.RedactAll([]string{text})[0]
+}
+
+// RedactAll redacts several texts, such as the turns of a conversation, with
+// the entities detected in any of them, so a name that first appears in a
+// later text is redacted in the earlier ones too.
+func (r *Redactor) RedactAll(texts []string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, t := range r.terms {
+		r.add(KindTerm, t)
+	}
+	for _, text := range texts {
+		for _, e := range Detect(text) {
+			r.add(e.Kind, e.Text)
+		}
+	}
+
+	out := make([]string, len(texts))
+	for i, text := range texts {
+		out[i] = r.replace(text)
+	}
+	return out
+}
+
+// replace substitutes the placeholder of every known entity that stands as
+// a whole word, trying the longest first, so "Acme Robotics" wins over "Acme"
+// and "Doe" is left alone in "Doesn't".
+func (r *Redactor) replace(text string) string {
+	if len(r.byText) == 0 {
+		return text
+	}
+	entities := make([]string, 0, len(r.byText))
+	for e := range r.byText {
+		entities = append(entities, e)
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		if len(entities[i]) != len(entities[j]) {
+			return len(entities[i]) > len(entities[j])
+		}
+		return entities[i] < entities[j]
+	})
+	for i, e := range entities {
+		entities[i] = regexp.QuoteMeta(e)
+	}
+	pattern := regexp.MustCompile(strings.Join(entities, "|"))
+
+	var b strings.Builder
+	last := 0
+	for _, loc := range pattern.FindAllStringIndex(text, -1) {
+		if !wholeWord(text, loc[0], loc[1]) {
+			continue
+		}
+		b.WriteString(text[last:loc[0]])
+		b.WriteString(r.byText[text[loc[0]:loc[1]]])
+		last = loc[1]
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// wholeWord reports whether text[start:end] does not continue a word on
+// either side.
+func wholeWord(text string, start, end int) bool {
+	before, _ := utf8.DecodeLastRuneInString(text[:start])
+	first, _ := utf8.DecodeRuneInString(text[start:end])
+	last, _ := utf8.DecodeLastRuneInString(text[start:end])
+	after, _ := utf8.DecodeRuneInString(text[end:])
+	return !(isWordRune(before) && isWordRune(first)) && !(isWordRune(last) && isWordRune(after))
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Restore replaces the placeholders in text with the entities they stand for.
+// Placeholders this Redactor did not issue are left as they are.
+func (r *Redactor) Restore(text string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return placeholderPattern.ReplaceAllStringFunc(text, func(holder string) string {
+		if e, ok := r.byHolder[holder]; ok {
+			return e
+		}
+		return holder
+	})
+}
+
+// add issues a placeholder for an entity that does not have one yet.
+func (r *Redactor) add(kind, text string) {
+	if _, ok := r.byText[text]; ok {
+		return
+	}
+	r.counts[kind]++
+	holder := fmt.Sprintf("[%s_%d]", kind, r.counts[kind])
+	r.byText[text] = holder
+	r.byHolder[holder] = text
+}
+
+// notCompanies are capitalized words that open a sentence before
+// "announced" or "launched" without naming anyone.
+var notCompanies = map[string]bool{
+	"The": true, "We": true, "It": true, "This": true, "Our": true, "They": true,
+	"He": true, "She": true, "Today": true, "Company": true, "Team": true,
+}
+
+// Entity is a span of text Detect considers sensitive.
+type Entity struct {
+	Kind string
+	Text string
+}
+
+// Detect finds company names, people, and dollar figures in text, in that
+// order. A person's surname is reported on its own too, since later
+// mentions often use it alone.
+func Detect(text string) []Entity {
+	var out []Entity
+	seen := map[string]bool{}
+	found := func(kind, s string) {
+		s = strings.TrimSpace(s)
+		if kind == KindCompany {
+			s = strings.TrimPrefix(s, "The ")
+			if notCompanies[s] {
+				return
+			}
+		}
+		if s != "" && !seen[s] {
+			seen[s] = true
+			out = append(out, Entity{Kind: kind, Text: s})
+		}
+	}
+	for _, p := range []*regexp.Regexp{companySuffixPattern, aboutPattern, announcerPattern} {
+		for _, m := range p.FindAllStringSubmatch(text, -1) {
+			found(KindCompany, m[1])
+		}
+	}
+	for _, p := range []*regexp.Regexp{speakerPattern, titledPattern} {
+		for _, m := range p.FindAllStringSubmatch(text, -1) {
+			found(KindPerson, m[1])
+			fields := strings.Fields(m[1])
+			found(KindPerson, fields[len(fields)-1])
+		}
+	}
+	for _, m := range amountPattern.FindAllString(text, -1) {
+		found(KindAmount, m)
+	}
+	return out
+}
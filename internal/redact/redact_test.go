@@ -0,0 +1,77 @@
+package redact
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []Entity
+	}{
+		{
+			name: "announcer and amount",
+			text: "SEATTLE, WA - Acme Robotics today announced Ledger Sync, starting at $4.99 per month.",
+			want: []Entity{{KindCompany, "Acme Robotics"}, {KindAmount, "$4.99"}},
+		},
+		{
+			name: "corporate suffix and boilerplate",
+			text: "Built with Globex Corp.\n\n**About Initech**\n\nInitech makes software.",
+			want: []Entity{{KindCompany, "Globex"}, {KindCompany, "Initech"}},
+		},
+		{
+			name: "speaker and titled person",
+			text: `"It saves hours," said Jane Doe. "We love it," added Raj P. Patel, VP of Finance at Contoso.`,
+			want: []Entity{{KindPerson, "Jane Doe"}, {KindPerson, "Doe"}, {KindPerson, "Raj P. Patel"}, {KindPerson, "Patel"}},
+		},
+		{
+			name: "amounts",
+			text: "Revenue of $2M, a $1.5 billion market, and 300 dollars saved, or USD 40.",
+			want: []Entity{{KindAmount, "$2M"}, {KindAmount, "$1.5 billion"}, {KindAmount, "300 dollars"}, {KindAmount, "USD 40"}},
+		},
+		{
+			name: "sentence openers are not companies",
+			text: "The company announced it. Today we launched. The Acme Group unveiled more.",
+			want: []Entity{{KindCompany, "Acme Group"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Detect(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Detect() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactor_RoundTrip(t *testing.T) {
+	r := New([]string{"Project Falcon", " "})
+	text := `Acme today announced Project Falcon for $10. "Doesn't it help?" said Jane Doe. Doe added more.`
+	got := r.Redact(text)
+	want := `[COMPANY_1] today announced [TERM_1] for [AMOUNT_1]. "Doesn't it help?" said [PERSON_1]. [PERSON_2] added more.`
+	if got != want {
+		t.Fatalf("Redact() = %q, want %q", got, want)
+	}
+	if back := r.Restore(got); back != text {
+		t.Errorf("Restore() = %q, want %q", back, text)
+	}
+
+	// Entities seen earlier keep their placeholders in later text
+	if got := r.Redact("Acme pricing stays at $10."); got != "[COMPANY_1] pricing stays at [AMOUNT_1]." {
+		t.Errorf("second Redact() = %q", got)
+	}
+	if got := r.Restore("Ask [PERSON_9] about [COMPANY_1]."); got != "Ask [PERSON_9] about Acme." {
+		t.Errorf("Restore() with an unknown placeholder = %q", got)
+	}
+}
+
+func TestRedactor_RedactAll(t *testing.T) {
+	r := New(nil)
+	got := r.RedactAll([]string{"Review the launch for Initech.", "Initech today announced Ledger Sync."})
+	want := []string{"Review the launch for [COMPANY_1].", "[COMPANY_1] today announced Ledger Sync."}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RedactAll() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,73 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// badgeLabel is the left-hand text of every badge.
+const badgeLabel = "PR-FAQ"
+
+// badgeColor is a badge color as an SVG hex value and a shields.io color name.
+type badgeColor struct {
+	hex   string
+	named string
+}
+
+// colorFor picks the badge color for the report status band of score.
+func colorFor(score int) badgeColor {
+	switch {
+	case score >= parser.ThresholdExcellent:
+		return badgeColor{hex: "#4c1", named: "brightgreen"}
+	case score >= parser.ThresholdGood:
+		return badgeColor{hex: "#dfb317", named: "yellow"}
+	case score >= parser.ThresholdNeedsWork:
+		return badgeColor{hex: "#fe7d37", named: "orange"}
+	default:
+		return badgeColor{hex: "#e05d44", named: "red"}
+	}
+}
+
+func badgeMessage(score int) string {
+	return fmt.Sprintf("%d/100", score)
+}
+
+// textWidth approximates the rendered width of s in 11px Verdana.
+func textWidth(s string) int {
+	return len(s)*7 + 10
+}
+
+// Badge writes a flat-style SVG score badge, e.g. "PR-FAQ | 77/100", colored
+// by the report status bands.
+func Badge(w io.Writer, score int) error {
+	message := badgeMessage(score)
+	labelWidth, messageWidth := textWidth(badgeLabel), textWidth(message)
+	width := labelWidth + messageWidth
+
+	_, err := fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="20" role="img" aria-label="%[4]s: %[5]s">
+<title>%[4]s: %[5]s</title>
+<linearGradient id="s" x2="0" y2="100%%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>
+<clipPath id="r"><rect width="%[1]d" height="20" rx="3" fill="#fff"/></clipPath>
+<g clip-path="url(#r)"><rect width="%[2]d" height="20" fill="#555"/><rect x="%[2]d" width="%[3]d" height="20" fill="%[6]s"/><rect width="%[1]d" height="20" fill="url(#s)"/></g>
+<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">
+<text x="%[7]d" y="15" fill="#010101" fill-opacity=".3">%[4]s</text><text x="%[7]d" y="14">%[4]s</text>
+<text x="%[8]d" y="15" fill="#010101" fill-opacity=".3">%[5]s</text><text x="%[8]d" y="14">%[5]s</text>
+</g>
+</svg>
+`, width, labelWidth, messageWidth, badgeLabel, message, colorFor(score).hex, labelWidth/2, labelWidth+messageWidth/2)
+	return err
+}
+
+// BadgeEndpoint writes the score as shields.io endpoint JSON, for badges
+// rendered by shields.io from a URL that serves this file.
+func BadgeEndpoint(w io.Writer, score int) error {
+	return json.NewEncoder(w).Encode(struct {
+		SchemaVersion int    `json:"schemaVersion"`
+		Label         string `json:"label"`
+		Message       string `json:"message"`
+		Color         string `json:"color"`
+	}{1, badgeLabel, badgeMessage(score), colorFor(score).named})
+}
@@ -0,0 +1,61 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestBadge(t *testing.T) {
+	tests := []struct {
+		score int
+		color string
+	}{
+		{score: 95, color: "#4c1"},
+		{score: 80, color: "#4c1"},
+		{score: 79, color: "#dfb317"},
+		{score: 60, color: "#dfb317"},
+		{score: 45, color: "#fe7d37"},
+		{score: 12, color: "#e05d44"},
+	}
+
+	for _, tt := range tests {
+		t.Run(badgeMessage(tt.score), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Badge(&buf, tt.score); err != nil {
+				t.Fatalf("Badge() error = %v", err)
+			}
+			svg := buf.String()
+
+			if err := xml.Unmarshal(buf.Bytes(), new(struct{})); err != nil {
+				t.Errorf("Badge() is not well-formed XML: %v\n%s", err, svg)
+			}
+			if !strings.Contains(svg, `fill="`+tt.color+`"`) {
+				t.Errorf("Badge() missing color %s\n%s", tt.color, svg)
+			}
+			if !strings.Contains(svg, ">"+badgeMessage(tt.score)+"<") {
+				t.Errorf("Badge() missing score text\n%s", svg)
+			}
+		})
+	}
+}
+
+func TestBadgeEndpoint(t *testing.T) {
+	var buf bytes.Buffer
+	if err := BadgeEndpoint(&buf, 72); err != nil {
+		t.Fatalf("BadgeEndpoint() error = %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	want := map[string]any{"schemaVersion": 1.0, "label": "PR-FAQ", "message": "72/100", "color": "yellow"}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %v, want %v", k, got[k], v)
+		}
+	}
+}
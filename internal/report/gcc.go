@@ -0,0 +1,38 @@
+// Package report renders analysis results in machine- and human-readable output formats.
+package report
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// GCC writes one line per finding in the compiler diagnostic format
+// "file:line:col: severity: message [rule-id]" understood by editor and CI problem matchers.
+// A finding with a File is reported against it instead of path.
+func GCC(w io.Writer, path string, findings []parser.Finding) error {
+	for _, f := range findings {
+		file := path
+		if f.File != "" {
+			file = f.File
+		}
+		if _, err := fmt.Fprintf(w, "%s:%d:%d: %s: %s [%s]\n",
+			file, f.Line, f.Column, gccSeverity(f.Severity), f.Message, f.RuleID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gccSeverity maps finding severities to gcc's error/warning/note labels.
+func gccSeverity(sev parser.Severity) string {
+	switch sev {
+	case parser.SeverityError:
+		return "error"
+	case parser.SeverityWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
@@ -0,0 +1,54 @@
+package report
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+func TestGCC(t *testing.T) {
+	findings := []parser.Finding{
+		{RuleID: "headline-too-short", Severity: parser.SeverityWarning, Message: "Headline too short (lacks specificity)", Line: 1, Column: 1},
+		{RuleID: "release-date-missing", Severity: parser.SeverityError, Message: "Missing release date in opening lines", Line: 5, Column: 1},
+		{RuleID: "five-ws-where", Severity: parser.SeverityInfo, Message: "WHERE: Location or market context could be clearer", Line: 5, Column: 1},
+	}
+
+	var buf bytes.Buffer
+	if err := GCC(&buf, "docs/prfaq.md", findings); err != nil {
+		t.Fatalf("GCC() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	want := []string{
+		"docs/prfaq.md:1:1: warning: Headline too short (lacks specificity) [headline-too-short]",
+		"docs/prfaq.md:5:1: error: Missing release date in opening lines [release-date-missing]",
+		"docs/prfaq.md:5:1: note: WHERE: Location or market context could be clearer [five-ws-where]",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d:\n%s", len(lines), len(want), buf.String())
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+
+	// VS Code's built-in $gcc problem matcher pattern
+	matcher := regexp.MustCompile(`^(.*?):(\d+):(\d*):?\s+(?:fatal\s+)?(warning|error):\s+(.*)$`)
+	if !matcher.MatchString(lines[0]) || !matcher.MatchString(lines[1]) {
+		t.Error("output does not match the $gcc problem matcher")
+	}
+}
+
+func TestGCC_NoFindings(t *testing.T) {
+	var buf bytes.Buffer
+	if err := GCC(&buf, "doc.md", nil); err != nil {
+		t.Fatalf("GCC() error = %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output, got %q", buf.String())
+	}
+}
@@ -0,0 +1,50 @@
+package report
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+)
+
+// glossaryHeadingPattern matches the heading of a glossary section.
+var glossaryHeadingPattern = regexp.MustCompile(`(?i)^##\s+glossary\s*$`)
+
+// Glossary renders definitions as a "## Glossary" markdown section.
+func Glossary(defs []llm.Definition) string {
+	var b strings.Builder
+	b.WriteString("## Glossary\n\n")
+	for _, d := range defs {
+		b.WriteString("- **" + d.Term + "**: " + d.Text + "\n")
+	}
+	return b.String()
+}
+
+// WithGlossary returns doc with its glossary section replaced by glossary,
+// or with glossary appended when doc has none.
+func WithGlossary(doc, glossary string) string {
+	lines := strings.Split(doc, "\n")
+	start := -1
+	for i, line := range lines {
+		if glossaryHeadingPattern.MatchString(strings.TrimSpace(line)) {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return strings.TrimRight(doc, "\n") + "\n\n" + glossary
+	}
+
+	end := len(lines)
+	for i := start + 1; i < len(lines); i++ {
+		if strings.HasPrefix(lines[i], "# ") || strings.HasPrefix(lines[i], "## ") {
+			end = i
+			break
+		}
+	}
+	before := strings.TrimRight(strings.Join(lines[:start], "\n"), "\n") + "\n\n"
+	if end == len(lines) {
+		return before + glossary
+	}
+	return before + glossary + "\n" + strings.Join(lines[end:], "\n")
+}
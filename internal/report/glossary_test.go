@@ -0,0 +1,39 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+)
+
+func TestGlossary(t *testing.T) {
+	got := Glossary([]llm.Definition{{Term: "Ledger Sync", Text: "Northwind's nightly reconciliation service."}, {Term: "SOC 2", Text: "A security audit."}})
+	want := "## Glossary\n\n- **Ledger Sync**: Northwind's nightly reconciliation service.\n- **SOC 2**: A security audit.\n"
+	if got != want {
+		t.Errorf("Glossary() = %q, want %q", got, want)
+	}
+}
+
+func TestWithGlossary(t *testing.T) {
+	glossary := "## Glossary\n\n- **API**: An interface.\n"
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{"appended", "# T\n\n## FAQ\n\nQ: Why?\n", "# T\n\n## FAQ\n\nQ: Why?\n\n## Glossary\n\n- **API**: An interface.\n"},
+		{"replaced at the end", "# T\n\n## glossary\n\n- **Old**: Gone.\n", "# T\n\n## Glossary\n\n- **API**: An interface.\n"},
+		{
+			"replaced before a section",
+			"# T\n\n## Glossary\n- **Old**: Gone.\n\n## Appendix\n\nData\n",
+			"# T\n\n## Glossary\n\n- **API**: An interface.\n\n## Appendix\n\nData\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WithGlossary(tt.doc, glossary); got != tt.want {
+				t.Errorf("WithGlossary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
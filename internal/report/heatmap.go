@@ -0,0 +1,46 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// heatStyles are the border and background colors of each heat level, from
+// none to high.
+var heatStyles = []struct{ border, background string }{
+	{"#d0d7de", "#f6f8fa"},
+	{"#1a7f37", "#dafbe1"},
+	{"#bf8700", "#fff8c5"},
+	{"#cf222e", "#ffebe9"},
+}
+
+// Heatmap writes the press release paragraphs as HTML, each shaded by its
+// heat level and followed by its findings, for HTML reports. Styles are
+// inline so the fragment needs no stylesheet.
+func Heatmap(w io.Writer, paragraphs []parser.ParagraphHeat) error {
+	var b strings.Builder
+	b.WriteString(`<div class="heatmap">` + "\n")
+	for _, p := range paragraphs {
+		level := p.Level()
+		style := heatStyles[level]
+		fmt.Fprintf(&b, `<div class="heat-%s" style="border-left: 4px solid %s; background: %s; padding: 0.4rem 0.8rem; margin-bottom: 0.6rem;">`+"\n",
+			parser.HeatLevels[level], style.border, style.background)
+		fmt.Fprintf(&b, "<small>%s</small>\n", html.EscapeString(p.Label()))
+		fmt.Fprintf(&b, "<p>%s</p>\n", html.EscapeString(p.Text))
+		if len(p.Findings) > 0 {
+			b.WriteString("<ul>\n")
+			for _, f := range p.Findings {
+				fmt.Fprintf(&b, "<li>%s</li>\n", html.EscapeString(f.Message))
+			}
+			b.WriteString("</ul>\n")
+		}
+		b.WriteString("</div>\n")
+	}
+	b.WriteString("</div>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
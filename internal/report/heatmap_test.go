@@ -0,0 +1,38 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+func TestHeatmap(t *testing.T) {
+	paragraphs := []parser.ParagraphHeat{
+		{Span: parser.LineSpan{Start: 5, End: 5}, Text: "Acme launched <Ledger Sync>."},
+		{Span: parser.LineSpan{Start: 7, End: 8}, Text: "It may help.", Heat: 6, Findings: []parser.Finding{
+			{Severity: parser.SeverityError, Message: "Vague & hedged"},
+			{Severity: parser.SeverityError, Message: "No metrics"},
+		}},
+	}
+	var buf bytes.Buffer
+	if err := Heatmap(&buf, paragraphs); err != nil {
+		t.Fatalf("Heatmap() error = %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		`<div class="heat-none" style="border-left: 4px solid #d0d7de;`,
+		"<p>Acme launched &lt;Ledger Sync&gt;.</p>",
+		`<div class="heat-high" style="border-left: 4px solid #cf222e;`,
+		"<small>Lines 7-8 · high · 2 findings</small>",
+		"<li>Vague &amp; hedged</li>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Heatmap() lacks %q:\n%s", want, out)
+		}
+	}
+	if got := strings.Count(out, "<ul>"); got != 1 {
+		t.Errorf("%d finding lists, want 1 for the paragraph with findings", got)
+	}
+}
@@ -0,0 +1,115 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// JUnitDocument is one scored document in a JUnit report.
+type JUnitDocument struct {
+	Path     string
+	Findings []parser.Finding
+}
+
+type junitSuites struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Name     string       `xml:"name,attr"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Suites   []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnit writes a JUnit XML report with one test suite per document and one
+// test case per catalog rule. A rule's test case fails when the rule
+// produced a finding in that document, so CI systems show each document's
+// results in their test report UI.
+func JUnit(w io.Writer, docs []JUnitDocument) error {
+	report := junitSuites{Name: "pr-faq-validator"}
+	for _, doc := range docs {
+		suite := junitSuiteFor(doc)
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Suites = append(report.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return fmt.Errorf("failed to encode JUnit report: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitSuiteFor(doc JUnitDocument) junitSuite {
+	byRule := make(map[string][]parser.Finding)
+	var uncataloged []parser.Finding
+	for _, f := range doc.Findings {
+		if _, ok := parser.LookupRule(f.RuleID); ok {
+			byRule[f.RuleID] = append(byRule[f.RuleID], f)
+		} else {
+			uncataloged = append(uncataloged, f)
+		}
+	}
+
+	suite := junitSuite{Name: doc.Path}
+	for _, rule := range parser.Rules {
+		suite.Cases = append(suite.Cases, junitCaseFor(doc.Path, rule.ID, rule.Category, byRule[rule.ID]))
+	}
+	// Findings outside the catalog each get their own failing case
+	for _, f := range uncataloged {
+		suite.Cases = append(suite.Cases, junitCaseFor(doc.Path, f.RuleID, f.Category, []parser.Finding{f}))
+	}
+
+	suite.Tests = len(suite.Cases)
+	for _, c := range suite.Cases {
+		if c.Failure != nil {
+			suite.Failures++
+		}
+	}
+	return suite
+}
+
+func junitCaseFor(path, ruleID, category string, findings []parser.Finding) junitCase {
+	c := junitCase{Name: ruleID, ClassName: category}
+	if len(findings) == 0 {
+		return c
+	}
+
+	lines := make([]string, len(findings))
+	for i, f := range findings {
+		lines[i] = fmt.Sprintf("%s:%d:%d: %s", path, f.Line, f.Column, f.Message)
+	}
+	c.Failure = &junitFailure{
+		Message: findings[0].Message,
+		Type:    string(findings[0].Severity),
+		Text:    strings.Join(lines, "\n"),
+	}
+	return c
+}
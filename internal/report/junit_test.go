@@ -0,0 +1,51 @@
+package report
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+func TestJUnit(t *testing.T) {
+	docs := []JUnitDocument{
+		{Path: "docs/a.md", Findings: []parser.Finding{
+			{RuleID: "headline-too-short", Category: "Headline Quality", Severity: parser.SeverityWarning, Message: "Headline too short (lacks specificity)", Line: 1, Column: 1},
+			{RuleID: "general", Category: "General", Severity: parser.SeverityWarning, Message: "Something else", Line: 3, Column: 1},
+		}},
+		{Path: "docs/b.md"},
+	}
+
+	var buf bytes.Buffer
+	if err := JUnit(&buf, docs); err != nil {
+		t.Fatalf("JUnit() error = %v", err)
+	}
+
+	var got junitSuites
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid XML: %v\n%s", err, buf.String())
+	}
+
+	rules := len(parser.Rules)
+	if len(got.Suites) != 2 || got.Tests != 2*rules+1 || got.Failures != 2 {
+		t.Fatalf("suites = %d, tests = %d, failures = %d; want 2, %d, 2", len(got.Suites), got.Tests, got.Failures, 2*rules+1)
+	}
+
+	a := got.Suites[0]
+	if a.Name != "docs/a.md" || a.Tests != rules+1 || a.Failures != 2 {
+		t.Errorf("suite a = %s with %d tests, %d failures", a.Name, a.Tests, a.Failures)
+	}
+	for _, c := range a.Cases {
+		if c.Name == "headline-too-short" {
+			if c.Failure == nil || c.Failure.Type != "warning" || !strings.Contains(c.Failure.Text, "docs/a.md:1:1:") {
+				t.Errorf("headline-too-short case = %+v", c)
+			}
+		}
+	}
+
+	if b := got.Suites[1]; b.Failures != 0 || b.Tests != rules {
+		t.Errorf("suite b = %d tests, %d failures; want %d, 0", b.Tests, b.Failures, rules)
+	}
+}
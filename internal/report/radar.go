@@ -0,0 +1,83 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// Radar chart geometry, in SVG user units. The margins leave room for the
+// category labels.
+const (
+	radarWidth  = 600
+	radarHeight = 340
+	radarRadius = 120
+)
+
+// radarPoint returns the SVG coordinates of axis i of n at fraction r of the
+// radius. The first axis points straight up; the rest follow clockwise.
+func radarPoint(i, n int, r float64) (float64, float64) {
+	angle := 2*math.Pi*float64(i)/float64(n) - math.Pi/2
+	return radarWidth/2 + r*radarRadius*math.Cos(angle), radarHeight/2 + r*radarRadius*math.Sin(angle)
+}
+
+// radarPolygon returns the points attribute of a polygon through fractions.
+func radarPolygon(fractions []float64) string {
+	points := make([]string, len(fractions))
+	for i, f := range fractions {
+		x, y := radarPoint(i, len(fractions), f)
+		points[i] = fmt.Sprintf("%.1f,%.1f", x, y)
+	}
+	return strings.Join(points, " ")
+}
+
+// Radar writes an SVG radar (spider) chart of categories, each score as a
+// fraction of its maximum, for HTML reports. Rings mark 25% steps.
+func Radar(w io.Writer, categories []parser.CategoryScore) error {
+	n := len(categories)
+	if n < 3 {
+		return fmt.Errorf("radar chart needs at least 3 categories, got %d", n)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %[1]d %[2]d" role="img" aria-label="Category scores">`+"\n", radarWidth, radarHeight)
+	b.WriteString(`<g fill="none" stroke="#d0d7de">` + "\n")
+	for ring := 1; ring <= 4; ring++ {
+		fractions := make([]float64, n)
+		for i := range fractions {
+			fractions[i] = float64(ring) / 4
+		}
+		fmt.Fprintf(&b, "<polygon points=\"%s\"/>\n", radarPolygon(fractions))
+	}
+	for i := range n {
+		x, y := radarPoint(i, n, 1)
+		fmt.Fprintf(&b, "<line x1=\"%d\" y1=\"%d\" x2=\"%.1f\" y2=\"%.1f\"/>\n", radarWidth/2, radarHeight/2, x, y)
+	}
+	b.WriteString("</g>\n")
+
+	fractions := make([]float64, n)
+	for i, c := range categories {
+		fractions[i] = float64(parser.Percent(c.Score, c.Max)) / 100
+	}
+	fmt.Fprintf(&b, "<polygon points=\"%s\" fill=\"#0969da\" fill-opacity=\".25\" stroke=\"#0969da\" stroke-width=\"2\"/>\n", radarPolygon(fractions))
+
+	b.WriteString(`<g font-family="-apple-system,Segoe UI,sans-serif" font-size="12" fill="#1f2328">` + "\n")
+	for i, c := range categories {
+		x, y := radarPoint(i, n, 1.12)
+		anchor := "middle"
+		switch {
+		case x < radarWidth/2-1:
+			anchor = "end"
+		case x > radarWidth/2+1:
+			anchor = "start"
+		}
+		fmt.Fprintf(&b, "<text x=\"%.1f\" y=\"%.1f\" text-anchor=\"%s\" dominant-baseline=\"middle\">%s %d%%</text>\n",
+			x, y, anchor, html.EscapeString(c.Name), parser.Percent(c.Score, c.Max))
+	}
+	b.WriteString("</g>\n</svg>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
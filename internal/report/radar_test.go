@@ -0,0 +1,41 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+func TestRadar(t *testing.T) {
+	categories := []parser.CategoryScore{
+		{Name: "Headline Quality", Score: 10, Max: 10},
+		{Name: "Tone & Readability", Score: 5, Max: 10},
+		{Name: "Quote Quality", Score: 0, Max: 15},
+		{Name: "Release Date", Score: 5, Max: 5},
+	}
+	var buf bytes.Buffer
+	if err := Radar(&buf, categories); err != nil {
+		t.Fatalf("Radar() error = %v", err)
+	}
+	svg := buf.String()
+	for _, want := range []string{
+		`<svg xmlns="http://www.w3.org/2000/svg"`,
+		"Tone &amp; Readability 50%",
+		// Headline at full score on the top axis, Tone at half on the right,
+		// Quote at zero in the center, Release Date full on the left
+		`points="300.0,50.0 360.0,170.0 300.0,170.0 180.0,170.0"`,
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("Radar() lacks %q:\n%s", want, svg)
+		}
+	}
+	if got := strings.Count(svg, "<polygon"); got != 5 {
+		t.Errorf("%d polygons, want 4 rings and the scores", got)
+	}
+
+	if err := Radar(&buf, categories[:2]); err == nil {
+		t.Error("Radar() with 2 categories should fail")
+	}
+}
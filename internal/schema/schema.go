@@ -0,0 +1,158 @@
+// Package schema checks the skeleton of a PR-FAQ against a team's document
+// schema: the sections it must have, their order, and how long each may be.
+// It is independent of scoring.
+package schema
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrInvalid is returned when a schema file cannot be read or parsed, or
+// describes an impossible section.
+var ErrInvalid = errors.New("invalid schema")
+
+// DefaultFile is the schema file looked up in the working directory when no
+// path is given.
+const DefaultFile = ".prfaq-schema.yaml"
+
+// Schema describes the skeleton a team's PR-FAQs follow. It is read from
+// YAML or JSON, e.g.
+//
+//	ordered: true
+//	sections:
+//	  - name: Press Release
+//	    required: true
+//	    max_words: 600
+//	  - name: FAQ
+//	    aliases: [Customer FAQ, External FAQ]
+//	    required: true
+type Schema struct {
+	// Ordered requires the sections the document has to appear in the
+	// order listed.
+	Ordered  bool      `yaml:"ordered"`
+	Sections []Section `yaml:"sections"`
+}
+
+// Section is one section of a Schema. A heading matches it when it equals
+// the name or one of the aliases, ignoring case.
+type Section struct {
+	Name     string   `yaml:"name"`
+	Aliases  []string `yaml:"aliases"`
+	Required bool     `yaml:"required"`
+	// MinWords and MaxWords bound the words under the heading, including
+	// its subsections. Zero is no bound.
+	MinWords int `yaml:"min_words"`
+	MaxWords int `yaml:"max_words"`
+}
+
+// Violation is a way a document departs from its schema. Line is the
+// 1-based line of the section heading, or 0 for a missing section.
+type Violation struct {
+	Line    int
+	Section string
+	Message string
+}
+
+// Load reads a schema from a YAML or JSON file.
+func Load(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	return Parse(data)
+}
+
+// Parse reads a schema from YAML or JSON and checks that every section has
+// a name and word bounds that can be met. Unknown keys, such as a
+// misspelled "min_word", are rejected.
+func Parse(data []byte) (*Schema, error) {
+	var s Schema
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&s); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	if len(s.Sections) == 0 {
+		return nil, fmt.Errorf("%w: no sections", ErrInvalid)
+	}
+	for i, sec := range s.Sections {
+		switch {
+		case strings.TrimSpace(sec.Name) == "":
+			return nil, fmt.Errorf("%w: section %d has no name", ErrInvalid, i+1)
+		case sec.MinWords < 0 || sec.MaxWords < 0:
+			return nil, fmt.Errorf("%w: %s: word counts must not be negative", ErrInvalid, sec.Name)
+		case sec.MaxWords > 0 && sec.MinWords > sec.MaxWords:
+			return nil, fmt.Errorf("%w: %s: min_words %d is above max_words %d", ErrInvalid, sec.Name, sec.MinWords, sec.MaxWords)
+		}
+	}
+	return &s, nil
+}
+
+// Check reports how the document outline departs from the schema: missing
+// required sections, sections out of order, and sections with too few or
+// too many words. Headings the schema does not list are allowed.
+func (s *Schema) Check(tree *parser.DocumentTree) []Violation {
+	var out []Violation
+	var prev *parser.Section
+	prevName := ""
+	for _, want := range s.Sections {
+		found := want.find(tree)
+		if found == nil {
+			if want.Required {
+				out = append(out, Violation{Section: want.Name, Message: "required section is missing"})
+			}
+			continue
+		}
+
+		if s.Ordered && prev != nil && found.Line < prev.Line {
+			out = append(out, Violation{Line: found.Line, Section: want.Name, Message: fmt.Sprintf("must come after %s (line %d)", prevName, prev.Line)})
+		}
+		if prev == nil || found.Line > prev.Line {
+			prev, prevName = found, want.Name
+		}
+
+		words := countWords(found)
+		switch {
+		case words == 0 && want.Required:
+			out = append(out, Violation{Line: found.Line, Section: want.Name, Message: "required section is empty"})
+		case want.MinWords > 0 && words < want.MinWords:
+			out = append(out, Violation{Line: found.Line, Section: want.Name, Message: fmt.Sprintf("%d words, fewer than the minimum of %d", words, want.MinWords)})
+		case want.MaxWords > 0 && words > want.MaxWords:
+			out = append(out, Violation{Line: found.Line, Section: want.Name, Message: fmt.Sprintf("%d words, more than the maximum of %d", words, want.MaxWords)})
+		}
+	}
+	return out
+}
+
+// find returns the first heading in the tree that matches the section.
+func (sec Section) find(tree *parser.DocumentTree) *parser.Section {
+	if tree == nil {
+		return nil
+	}
+	for _, name := range append([]string{sec.Name}, sec.Aliases...) {
+		if found := tree.Find(strings.TrimSpace(name)); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// countWords counts the words of a section's paragraphs and subsections.
+func countWords(sec *parser.Section) int {
+	n := 0
+	for _, p := range sec.Paragraphs {
+		n += len(strings.Fields(p.Text))
+	}
+	for _, sub := range sec.Subsections {
+		n += len(strings.Fields(sub.Heading)) + countWords(sub)
+	}
+	return n
+}
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+const testSchema = `
+ordered: true
+sections:
+  - name: Press Release
+    required: true
+    min_words: 5
+    max_words: 40
+  - name: FAQ
+    aliases: [Customer FAQ]
+    required: true
+  - name: Internal FAQ
+  - name: Success Metrics
+    required: true
+`
+
+func TestParse(t *testing.T) {
+	s, err := Parse([]byte(testSchema))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !s.Ordered || len(s.Sections) != 4 || s.Sections[1].Aliases[0] != "Customer FAQ" || s.Sections[0].MaxWords != 40 {
+		t.Errorf("Parse() = %+v", s)
+	}
+
+	// JSON is YAML
+	if _, err := Parse([]byte(`{"sections": [{"name": "Press Release", "required": true}]}`)); err != nil {
+		t.Errorf("Parse(JSON) error = %v", err)
+	}
+
+	for name, data := range map[string]string{
+		"empty":       "",
+		"no name":     "sections:\n  - required: true\n",
+		"unknown key": "sections:\n  - name: FAQ\n    min_word: 3\n",
+		"bounds":      "sections:\n  - name: FAQ\n    min_words: 30\n    max_words: 10\n",
+		"malformed":   "sections: [",
+	} {
+		if _, err := Parse([]byte(data)); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Parse(%s) error = %v, want ErrInvalid", name, err)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	doc := `# Launch
+
+## Customer FAQ
+
+Q: Is it free?
+A: Yes.
+
+## Press Release
+
+Today we launch.
+
+## Internal FAQ
+`
+	sections, err := parser.Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := Parse([]byte(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := s.Check(sections.Tree)
+	want := []Violation{
+		{Line: 8, Section: "Press Release", Message: "3 words, fewer than the minimum of 5"},
+		{Line: 3, Section: "FAQ", Message: "must come after Press Release (line 8)"},
+		{Section: "Success Metrics", Message: "required section is missing"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Check() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Check()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	s.Ordered = false
+	if got := s.Check(sections.Tree); len(got) != 2 {
+		t.Errorf("unordered Check() = %+v, want the order violation left out", got)
+	}
+}
@@ -0,0 +1,114 @@
+// Package semantic matches FAQ questions to the questions a reader expects by
+// meaning, comparing embeddings, so "What's the pricing?" counts as asking
+// "How much does it cost?" though the two share no keyword.
+package semantic
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+)
+
+// DefaultThreshold is the cosine similarity at and above which two questions
+// are taken to ask the same thing. Paraphrases score about 0.6 to 0.8 with
+// llm.EmbeddingModel; related but different questions rarely pass 0.5.
+const DefaultThreshold = 0.55
+
+// EmbedFunc returns an embedding vector for each text, in order.
+type EmbedFunc func(texts []string) ([][]float32, error)
+
+// Matcher matches questions by the cosine similarity of their embeddings.
+// Embeddings are cached, so the required questions are embedded once per
+// process. A Matcher is safe for concurrent use.
+type Matcher struct {
+	embed     EmbedFunc
+	threshold float64
+
+	mu    sync.Mutex
+	cache map[string][]float32
+}
+
+// New returns a Matcher that embeds with llm.Embed at DefaultThreshold.
+func New() *Matcher {
+	return NewWith(llm.Embed, DefaultThreshold)
+}
+
+// NewWith returns a Matcher that embeds with embed and matches at threshold.
+func NewWith(embed EmbedFunc, threshold float64) *Matcher {
+	return &Matcher{embed: embed, threshold: threshold, cache: map[string][]float32{}}
+}
+
+// MatchTopics reports, for each required question, whether any of questions
+// asks it.
+func (m *Matcher) MatchTopics(questions, required []string) ([]bool, error) {
+	vectors, err := m.vectors(append(append([]string{}, questions...), required...))
+	if err != nil {
+		return nil, err
+	}
+	asked, want := vectors[:len(questions)], vectors[len(questions):]
+
+	matched := make([]bool, len(required))
+	for i, r := range want {
+		for _, q := range asked {
+			if cosine(q, r) >= m.threshold {
+				matched[i] = true
+				break
+			}
+		}
+	}
+	return matched, nil
+}
+
+// vectors returns the embeddings of texts, embedding the uncached ones in a
+// single request.
+func (m *Matcher) vectors(texts []string) ([][]float32, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var missing []string
+	queued := map[string]bool{}
+	for _, t := range texts {
+		if _, ok := m.cache[t]; !ok && !queued[t] {
+			queued[t] = true
+			missing = append(missing, t)
+		}
+	}
+	if len(missing) > 0 {
+		embedded, err := m.embed(missing)
+		if err != nil {
+			return nil, fmt.Errorf("embedding questions: %w", err)
+		}
+		if len(embedded) != len(missing) {
+			return nil, fmt.Errorf("embedding questions: got %d embeddings for %d questions", len(embedded), len(missing))
+		}
+		for i, t := range missing {
+			m.cache[t] = embedded[i]
+		}
+	}
+
+	out := make([][]float32, len(texts))
+	for i, t := range texts {
+		out[i] = m.cache[t]
+	}
+	return out, nil
+}
+
+// cosine returns the cosine similarity of a and b, or 0 when either is zero
+// or their lengths differ.
+func cosine(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
@@ -0,0 +1,81 @@
+package semantic
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+// fakeEmbed embeds each known text as a fixed vector and counts the texts it
+// was asked for.
+type fakeEmbed struct {
+	vectors map[string][]float32
+	asked   int
+}
+
+func (f *fakeEmbed) embed(texts []string) ([][]float32, error) {
+	f.asked += len(texts)
+	out := make([][]float32, len(texts))
+	for i, t := range texts {
+		v, ok := f.vectors[t]
+		if !ok {
+			return nil, errors.New("unknown text " + t)
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+func TestMatcher_MatchTopics(t *testing.T) {
+	f := &fakeEmbed{vectors: map[string][]float32{
+		"What's the pricing?":               {0.9, 0.1, 0},
+		"Does it work offline?":             {0, 0.2, 0.9},
+		"How much does it cost?":            {1, 0, 0},
+		"What happens to my personal data?": {0, 1, 0},
+	}}
+	m := NewWith(f.embed, DefaultThreshold)
+
+	questions := []string{"What's the pricing?", "Does it work offline?"}
+	required := []string{"How much does it cost?", "What happens to my personal data?"}
+	got, err := m.MatchTopics(questions, required)
+	if err != nil {
+		t.Fatalf("MatchTopics() error = %v", err)
+	}
+	if want := []bool{true, false}; !slices.Equal(got, want) {
+		t.Errorf("MatchTopics() = %v, want %v", got, want)
+	}
+	if f.asked != 4 {
+		t.Errorf("embedded %d texts, want 4", f.asked)
+	}
+
+	// Cached texts are not embedded again
+	if _, err := m.MatchTopics(questions[:1], required); err != nil {
+		t.Fatalf("second MatchTopics() error = %v", err)
+	}
+	if f.asked != 4 {
+		t.Errorf("embedded %d texts after a cached call, want 4", f.asked)
+	}
+
+	if _, err := m.MatchTopics([]string{"Who is it for?"}, required); err == nil {
+		t.Error("MatchTopics() should fail when embedding fails")
+	}
+}
+
+func TestCosine(t *testing.T) {
+	tests := []struct {
+		a, b []float32
+		want float64
+	}{
+		{[]float32{1, 0}, []float32{2, 0}, 1},
+		{[]float32{1, 0}, []float32{0, 3}, 0},
+		{[]float32{1, 1}, []float32{-1, -1}, -1},
+		{[]float32{0, 0}, []float32{1, 0}, 0},
+		{[]float32{1}, []float32{1, 0}, 0},
+	}
+	for _, tt := range tests {
+		if got := cosine(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("cosine(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Package server implements the HTTP endpoints of the serve subcommand.
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/report"
+)
+
+// NewHandler returns the serve subcommand's routes:
+//
+//	GET /badge/{score}       SVG score badge; a ".svg" suffix is optional
+//	GET /badge/{score}.json  shields.io endpoint JSON for the same badge
+//
+// With profiling, it also serves the runtime profiles for go tool pprof:
+//
+//	GET /debug/pprof/        index of the profiles, e.g. /debug/pprof/heap
+//	GET /debug/pprof/profile CPU profile; ?seconds=30 by default
+//	GET /debug/pprof/trace   execution trace
+func NewHandler(profiling bool) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /badge/{score}", handleBadge)
+	if profiling {
+		mux.HandleFunc("GET /debug/pprof/", pprof.Index)
+		mux.HandleFunc("GET /debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("GET /debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("GET /debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("POST /debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("GET /debug/pprof/trace", pprof.Trace)
+	}
+	return mux
+}
+
+func handleBadge(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("score")
+	render := report.Badge
+	contentType := "image/svg+xml"
+	if trimmed, ok := strings.CutSuffix(name, ".json"); ok {
+		name = trimmed
+		render = report.BadgeEndpoint
+		contentType = "application/json"
+	} else {
+		name = strings.TrimSuffix(name, ".svg")
+	}
+
+	score, err := strconv.Atoi(name)
+	if err != nil || score < 0 || score > 100 {
+		http.Error(w, "score must be an integer from 0 to 100", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	if err := render(w, score); err != nil {
+		slog.Warn("failed to write badge", "score", score, "error", err)
+	}
+}
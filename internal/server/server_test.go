@@ -0,0 +1,56 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBadgeRoute(t *testing.T) {
+	tests := []struct {
+		path        string
+		status      int
+		contentType string
+		body        string
+	}{
+		{path: "/badge/85", status: http.StatusOK, contentType: "image/svg+xml", body: ">85/100<"},
+		{path: "/badge/42.svg", status: http.StatusOK, contentType: "image/svg+xml", body: "#fe7d37"},
+		{path: "/badge/63.json", status: http.StatusOK, contentType: "application/json", body: `"color":"yellow"`},
+		{path: "/badge/101", status: http.StatusBadRequest},
+		{path: "/badge/abc", status: http.StatusBadRequest},
+		{path: "/badge/", status: http.StatusNotFound},
+	}
+
+	handler := NewHandler(false)
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.status)
+			}
+			if tt.contentType != "" && rec.Header().Get("Content-Type") != tt.contentType {
+				t.Errorf("Content-Type = %q, want %q", rec.Header().Get("Content-Type"), tt.contentType)
+			}
+			if !strings.Contains(rec.Body.String(), tt.body) {
+				t.Errorf("body missing %q\n%s", tt.body, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestProfilingRoutes(t *testing.T) {
+	for _, profiling := range []bool{false, true} {
+		rec := httptest.NewRecorder()
+		NewHandler(profiling).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/heap?debug=1", nil))
+		want := http.StatusNotFound
+		if profiling {
+			want = http.StatusOK
+		}
+		if rec.Code != want {
+			t.Errorf("profiling %v: status = %d, want %d", profiling, rec.Code, want)
+		}
+	}
+}
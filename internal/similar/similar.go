@@ -0,0 +1,144 @@
+// Package similar detects when a PR-FAQ substantially duplicates an existing
+// one, such as the same initiative rewritten by another team, by comparing
+// the overlapping word sequences (shingles) the two documents share.
+package similar
+
+import (
+	"fmt"
+	"hash/fnv"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/batch"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// shingleWords is the length of the word sequences compared.
+const shingleWords = 3
+
+// minShingles is the size below which a document is too short to compare.
+const minShingles = 20
+
+// DefaultThreshold is the Jaccard similarity of shingle sets at and above
+// which two documents are reported. Unrelated PR-FAQs share a few stock
+// phrases, such as "how much does it cost", and score under 0.05; a rewrite
+// of the same launch keeps its product name, features, and FAQ questions and
+// scores 0.3 or more even when a third of it is new.
+const DefaultThreshold = 0.2
+
+// wordPattern matches the words of a document, ignoring markdown and punctuation.
+var wordPattern = regexp.MustCompile(`[\p{L}\p{N}]+(?:['’][\p{L}]+)?`)
+
+// Index is a corpus of existing documents. It implements parser.Corpus.
+type Index struct {
+	threshold float64
+	docs      []entry
+}
+
+type entry struct {
+	name     string // cleaned absolute path, to recognize the scored document itself
+	display  string // path as found
+	title    string
+	shingles map[uint64]struct{}
+}
+
+// New returns an empty Index that reports documents at threshold or above.
+func New(threshold float64) *Index {
+	return &Index{threshold: threshold}
+}
+
+// FromDir indexes the PR-FAQs under dir at DefaultThreshold.
+func FromDir(dir string) (*Index, error) {
+	paths, err := batch.Find(dir)
+	if err != nil {
+		return nil, err
+	}
+	ix := New(DefaultThreshold)
+	for _, p := range paths {
+		s, err := parser.ParseOutline(p)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		ix.Add(p, s.Title, s.Text())
+	}
+	return ix, nil
+}
+
+// Add indexes a document. Documents too short to compare are skipped.
+func (ix *Index) Add(name, title, text string) {
+	sh := shingles(text)
+	if len(sh) < minShingles {
+		return
+	}
+	ix.docs = append(ix.docs, entry{name: canonical(name), display: name, title: title, shingles: sh})
+}
+
+// Len returns the number of indexed documents.
+func (ix *Index) Len() int {
+	return len(ix.docs)
+}
+
+// Similar returns the indexed documents that s duplicates, most similar
+// first. The document named name is skipped, so a directory can serve as
+// the corpus for its own documents.
+func (ix *Index) Similar(name string, s *parser.SpecSections) []parser.SimilarDocument {
+	sh := shingles(s.Text())
+	if len(sh) < minShingles {
+		return nil
+	}
+	self := canonical(name)
+	var out []parser.SimilarDocument
+	for _, d := range ix.docs {
+		if name != "" && d.name == self {
+			continue
+		}
+		if j := jaccard(sh, d.shingles); j >= ix.threshold {
+			out = append(out, parser.SimilarDocument{Name: d.display, Title: d.title, Similarity: int(j*100 + 0.5)})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Similarity > out[j].Similarity })
+	return out
+}
+
+// canonical returns the cleaned absolute form of a path, or the path
+// cleaned when it cannot be made absolute.
+func canonical(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+// shingles returns the hashes of every run of shingleWords consecutive
+// lowercase words in text.
+func shingles(text string) map[uint64]struct{} {
+	words := wordPattern.FindAllString(strings.ToLower(text), -1)
+	out := make(map[uint64]struct{}, len(words))
+	for i := 0; i+shingleWords <= len(words); i++ {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(strings.Join(words[i:i+shingleWords], " ")))
+		out[h.Sum64()] = struct{}{}
+	}
+	return out
+}
+
+// jaccard returns the size of the intersection of a and b over the size of
+// their union.
+func jaccard(a, b map[uint64]struct{}) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	shared := 0
+	for h := range a {
+		if _, ok := b[h]; ok {
+			shared++
+		}
+	}
+	union := len(a) + len(b) - shared
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
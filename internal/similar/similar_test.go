@@ -0,0 +1,102 @@
+package similar
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+const corpusDir = "../benchmark/corpus"
+
+// rewrite is the Ledger Sync launch from the corpus written up again by
+// another team, with a new headline, reordered paragraphs, and edited quotes.
+const rewrite = `# Northwind Introduces Nightly Ledger Reconciliation
+
+## Press Release
+
+SEATTLE, WA — June 2, 2025 — Northwind Software today announced Ledger Sync, a service that reconciles bank, card, and payroll transactions against the general ledger every night.
+
+Ledger Sync connects to more than 9,000 banks and the five most widely used payroll providers. Each night it matches transactions to ledger entries, flags the 2% that need a person's judgment, and explains why each one did not match.
+
+Controllers at mid-size companies spend the first two weeks of every month matching thousands of transactions by hand.
+
+"We closed March in three days for the first time," said Priya Raman, Controller at Helix Outdoor.
+
+Ledger Sync is available today in the United States and Canada, starting at $400 per month for up to 5,000 transactions.
+
+## FAQ
+
+### Q: Who is Ledger Sync for?
+A: Finance teams at companies with 50 to 2,000 employees that close their books monthly.
+
+### Q: How much does it cost?
+A: Plans start at $400 per month for up to 5,000 transactions.
+`
+
+func parse(t *testing.T, text string) *parser.SpecSections {
+	t.Helper()
+	s, err := parser.Parse(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return s
+}
+
+func TestIndex_Similar(t *testing.T) {
+	ix, err := FromDir(corpusDir)
+	if err != nil {
+		t.Fatalf("FromDir() error = %v", err)
+	}
+	if ix.Len() != 8 {
+		t.Fatalf("Len() = %d, want 8", ix.Len())
+	}
+
+	got := ix.Similar("drafts/reconciliation.md", parse(t, rewrite))
+	if len(got) != 1 {
+		t.Fatalf("Similar() = %+v, want the Ledger Sync launch only", got)
+	}
+	if filepath.Base(got[0].Name) != "01-ledger-sync.md" || !strings.HasPrefix(got[0].Title, "Northwind Launches Ledger Sync") {
+		t.Errorf("Similar() = %+v, want 01-ledger-sync.md", got[0])
+	}
+	if got[0].Similarity < 20 || got[0].Similarity > 90 {
+		t.Errorf("Similarity = %d, want a partial match", got[0].Similarity)
+	}
+
+	// A corpus document matches none of the others, and is not compared with itself
+	self := filepath.Join(corpusDir, "01-ledger-sync.md")
+	s, err := parser.ParsePRFAQ(self)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ix.Similar(self, s); len(got) != 0 {
+		t.Errorf("Similar() of a corpus document = %+v, want none", got)
+	}
+	if got := ix.Similar("", s); len(got) != 1 || got[0].Similarity != 100 {
+		t.Errorf("Similar() of an unnamed copy = %+v, want a 100%% match", got)
+	}
+}
+
+func TestIndex_ShortDocuments(t *testing.T) {
+	ix := New(DefaultThreshold)
+	ix.Add("short.md", "Short", "Acme launches a widget.")
+	if ix.Len() != 0 {
+		t.Errorf("Len() = %d, want short documents skipped", ix.Len())
+	}
+	ix.Add("ledger.md", "Ledger", rewrite)
+	if got := ix.Similar("new.md", parse(t, "# Widget\n\n## Press Release\n\nAcme launches a widget.\n")); got != nil {
+		t.Errorf("Similar() of a short document = %+v, want nil", got)
+	}
+}
+
+func TestJaccard(t *testing.T) {
+	a := shingles("one two three four five")
+	b := shingles("one two three four six")
+	if got := jaccard(a, b); got != 0.5 {
+		t.Errorf("jaccard() = %v, want 0.5 (2 shared of 4)", got)
+	}
+	if got := jaccard(a, map[uint64]struct{}{}); got != 0 {
+		t.Errorf("jaccard() with an empty set = %v, want 0", got)
+	}
+}
@@ -0,0 +1,105 @@
+// Package summary records the outcome of a validator run as machine-readable
+// JSON, so automation can branch on more than the exit code.
+package summary
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/buildinfo"
+)
+
+// Summary is the run-summary.json document.
+type Summary struct {
+	Status     string     `json:"status"` // see the exit code table in the README
+	ExitCode   int        `json:"exit_code"`
+	Version    string     `json:"version"` // of the validator that produced it
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"started_at"`
+	DurationMS int64      `json:"duration_ms"`
+	MinScore   int        `json:"min_score"` // 0 when no threshold is set
+	Totals     Totals     `json:"totals"`
+	Documents  []Document `json:"documents"`
+
+	// RequireApproval holds only documents with review status approved to MinScore.
+	RequireApproval bool `json:"require_approval,omitempty"`
+}
+
+// Totals aggregates every scored document.
+type Totals struct {
+	Documents int            `json:"documents"`
+	Passed    int            `json:"passed"`
+	Failed    int            `json:"failed"` // scored below MinScore
+	Findings  map[string]int `json:"findings"`
+}
+
+// Document is the outcome for one scored document.
+type Document struct {
+	File     string         `json:"file"`
+	Score    int            `json:"score"`
+	Status   string         `json:"status,omitempty"` // review status: draft, in-review, or approved
+	Passed   bool           `json:"passed"`
+	Findings map[string]int `json:"findings"` // counts by severity
+}
+
+// approved is the review status RequireApproval holds to the threshold.
+const approved = "approved"
+
+// New starts a summary for a run that began at start.
+func New(start time.Time) *Summary {
+	return &Summary{
+		StartedAt: start,
+		Version:   buildinfo.Get().String(),
+		Totals:    Totals{Findings: map[string]int{}},
+		Documents: []Document{},
+	}
+}
+
+// Add records a scored document and the severities of its findings. Set
+// MinScore before adding documents.
+func (s *Summary) Add(file string, score int, severities []string) {
+	s.AddReviewed(file, score, "", severities)
+}
+
+// AddReviewed is Add for a document with a review status. With
+// RequireApproval set, only an approved document must reach MinScore.
+func (s *Summary) AddReviewed(file string, score int, status string, severities []string) {
+	passed := score >= s.MinScore || (s.RequireApproval && status != approved)
+	doc := Document{File: file, Score: score, Status: status, Passed: passed, Findings: map[string]int{}}
+	for _, sev := range severities {
+		doc.Findings[sev]++
+		s.Totals.Findings[sev]++
+	}
+
+	s.Documents = append(s.Documents, doc)
+	s.Totals.Documents++
+	if doc.Passed {
+		s.Totals.Passed++
+	} else {
+		s.Totals.Failed++
+	}
+}
+
+// Finish records how the run ended.
+func (s *Summary) Finish(status string, exitCode int, err error, end time.Time) {
+	s.Status = status
+	s.ExitCode = exitCode
+	if err != nil {
+		s.Error = err.Error()
+	}
+	s.DurationMS = end.Sub(s.StartedAt).Milliseconds()
+}
+
+// Write saves the summary as indented JSON.
+func (s *Summary) Write(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode run summary: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil { //nolint:gosec // summaries are meant to be read by other tools
+		return fmt.Errorf("failed to write run summary: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package summary
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSummary(t *testing.T) {
+	start := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	s := New(start)
+	s.MinScore = 60
+	s.Add("a.md", 72, []string{"warning", "warning", "info"})
+	s.Add("b.md", 41, []string{"error"})
+	s.Finish("below-threshold", 1, nil, start.Add(1500*time.Millisecond))
+
+	if s.Totals.Documents != 2 || s.Totals.Passed != 1 || s.Totals.Failed != 1 {
+		t.Errorf("Totals = %+v", s.Totals)
+	}
+	if s.Totals.Findings["warning"] != 2 || s.Totals.Findings["error"] != 1 {
+		t.Errorf("Totals.Findings = %v", s.Totals.Findings)
+	}
+	if s.Documents[1].Passed || !s.Documents[0].Passed {
+		t.Errorf("Documents = %+v", s.Documents)
+	}
+	if s.DurationMS != 1500 {
+		t.Errorf("DurationMS = %d, want 1500", s.DurationMS)
+	}
+
+	path := filepath.Join(t.TempDir(), "run-summary.json")
+	if err := s.Write(path); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // test code
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if decoded["status"] != "below-threshold" || decoded["exit_code"] != 1.0 {
+		t.Errorf("decoded = %v", decoded)
+	}
+}
+
+func TestSummary_Error(t *testing.T) {
+	s := New(time.Now())
+	s.Finish("parse-error", 2, errors.New("no press release"), time.Now())
+
+	if s.Error != "no press release" || len(s.Documents) != 0 {
+		t.Errorf("summary = %+v", s)
+	}
+}
+
+func TestSummary_RequireApproval(t *testing.T) {
+	s := New(time.Now())
+	s.MinScore = 60
+	s.RequireApproval = true
+	s.AddReviewed("draft.md", 30, "draft", nil)
+	s.AddReviewed("unmarked.md", 30, "", nil)
+	s.AddReviewed("approved.md", 59, "approved", nil)
+	s.AddReviewed("ready.md", 60, "approved", nil)
+
+	var passed []bool
+	for _, d := range s.Documents {
+		passed = append(passed, d.Passed)
+	}
+	if want := []bool{true, true, false, true}; !slices.Equal(passed, want) {
+		t.Errorf("Passed = %v, want %v", passed, want)
+	}
+	if s.Totals.Failed != 1 || s.Documents[2].Status != "approved" {
+		t.Errorf("summary = %+v", s)
+	}
+}
@@ -0,0 +1,90 @@
+// Package telemetry reports anonymous score distributions and rule hit
+// counts to an organization's endpoint, for runs that opt in, so a tooling
+// team can see which rules fire most and tune default weights.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/bordenet/pr-faq-validator/internal/buildinfo"
+)
+
+// ErrEndpoint is returned for an endpoint that is not an http or https URL.
+var ErrEndpoint = errors.New("invalid telemetry endpoint")
+
+// Report is the payload sent for a run. It holds counts only: no file
+// names, document text, or anything else that identifies a document or user.
+type Report struct {
+	Version   string         `json:"version"` // of the validator that produced it
+	Documents int            `json:"documents"`
+	Scores    map[string]int `json:"scores"` // documents per ten-point band, "0-9" to "90-100"
+	Rules     map[string]int `json:"rules"`  // findings per rule ID
+}
+
+// New starts an empty report.
+func New() *Report {
+	return &Report{
+		Version: buildinfo.Get().Version,
+		Scores:  map[string]int{},
+		Rules:   map[string]int{},
+	}
+}
+
+// Add counts a scored document and the rule IDs of its findings.
+func (r *Report) Add(score int, rules []string) {
+	r.Documents++
+	r.Scores[Band(score)]++
+	for _, rule := range rules {
+		r.Rules[rule]++
+	}
+}
+
+// Band is the ten-point band a score is counted in. 100 falls in "90-100".
+func Band(score int) string {
+	low := min(max(score, 0), 99) / 10 * 10
+	high := low + 9
+	if high == 99 {
+		high = 100
+	}
+	return fmt.Sprintf("%d-%d", low, high)
+}
+
+// CheckEndpoint reports whether endpoint is an absolute http or https URL.
+func CheckEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: %q is not an http or https URL", ErrEndpoint, endpoint)
+	}
+	return nil
+}
+
+// Send posts the report to endpoint as JSON.
+func Send(ctx context.Context, client *http.Client, endpoint string, r *Report) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to encode telemetry: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "pr-faq-validator")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("telemetry request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("POST %s: %s", endpoint, resp.Status)
+	}
+	return nil
+}
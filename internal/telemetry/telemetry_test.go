@@ -0,0 +1,93 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBand(t *testing.T) {
+	tests := []struct {
+		score int
+		want  string
+	}{
+		{0, "0-9"},
+		{9, "0-9"},
+		{42, "40-49"},
+		{89, "80-89"},
+		{90, "90-100"},
+		{100, "90-100"},
+		{-3, "0-9"},
+	}
+	for _, tt := range tests {
+		if got := Band(tt.score); got != tt.want {
+			t.Errorf("Band(%d) = %q, want %q", tt.score, got, tt.want)
+		}
+	}
+}
+
+func TestCheckEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		wantErr  bool
+	}{
+		{"https://telemetry.example.com/prfaq", false},
+		{"http://localhost:8080", false},
+		{"ftp://example.com", true},
+		{"telemetry.example.com", true},
+		{"https://", true},
+	}
+	for _, tt := range tests {
+		err := CheckEndpoint(tt.endpoint)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CheckEndpoint(%q) error = %v, wantErr %v", tt.endpoint, err, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrEndpoint) {
+			t.Errorf("CheckEndpoint(%q) error = %v, want ErrEndpoint", tt.endpoint, err)
+		}
+	}
+}
+
+func TestSend(t *testing.T) {
+	var got Report
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("request = %s %s, want a JSON POST", r.Method, r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	r := New()
+	r.Add(72, []string{"tone-hedging", "tone-hedging", "headline-weak-verb"})
+	r.Add(95, nil)
+	if err := Send(context.Background(), srv.Client(), srv.URL, r); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if got.Documents != 2 {
+		t.Errorf("documents = %d, want 2", got.Documents)
+	}
+	if want := map[string]int{"70-79": 1, "90-100": 1}; !maps.Equal(got.Scores, want) {
+		t.Errorf("scores = %v, want %v", got.Scores, want)
+	}
+	if want := map[string]int{"tone-hedging": 2, "headline-weak-verb": 1}; !maps.Equal(got.Rules, want) {
+		t.Errorf("rules = %v, want %v", got.Rules, want)
+	}
+}
+
+func TestSend_Status(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	if err := Send(context.Background(), srv.Client(), srv.URL, New()); err == nil {
+		t.Error("Send() error = nil, want the status")
+	}
+}
@@ -0,0 +1,140 @@
+package tickets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// jiraTracker talks to the Jira REST API v2, which accepts plain-text descriptions.
+type jiraTracker struct {
+	baseURL   string
+	email     string
+	token     string
+	project   string
+	issueType string
+	client    *http.Client
+}
+
+type jiraSearchResponse struct {
+	Issues []struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Summary string `json:"summary"`
+		} `json:"fields"`
+	} `json:"issues"`
+}
+
+// Upsert implements Tracker.
+func (j *jiraTracker) Upsert(ctx context.Context, t Ticket) (Result, error) {
+	key, err := j.findOpen(ctx, t.Summary)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if key != "" {
+		fields := map[string]interface{}{"description": t.Description}
+		if len(t.Labels) > 0 {
+			fields["labels"] = t.Labels
+		}
+		body := map[string]interface{}{"fields": fields}
+		if err := j.do(ctx, http.MethodPut, "/rest/api/2/issue/"+url.PathEscape(key), body, nil); err != nil {
+			return Result{}, err
+		}
+		return Result{Key: key, Summary: t.Summary}, nil
+	}
+
+	fields := map[string]interface{}{
+		"project":     map[string]string{"key": j.project},
+		"summary":     t.Summary,
+		"description": t.Description,
+		"issuetype":   map[string]string{"name": j.issueType},
+	}
+	if len(t.Labels) > 0 {
+		fields["labels"] = t.Labels
+	}
+
+	var created struct {
+		Key string `json:"key"`
+	}
+	if err := j.do(ctx, http.MethodPost, "/rest/api/2/issue", map[string]interface{}{"fields": fields}, &created); err != nil {
+		return Result{}, err
+	}
+	return Result{Key: created.Key, Summary: t.Summary, Created: true}, nil
+}
+
+// findOpen returns the key of an unresolved issue with exactly this summary, or "".
+func (j *jiraTracker) findOpen(ctx context.Context, summary string) (string, error) {
+	jql := fmt.Sprintf(`project = "%s" AND summary ~ "%s" AND statusCategory != Done`,
+		jqlEscape(j.project), jqlEscape(summary))
+	query := url.Values{}
+	query.Set("jql", jql)
+	query.Set("fields", "summary")
+	query.Set("maxResults", "50")
+
+	var resp jiraSearchResponse
+	if err := j.do(ctx, http.MethodGet, "/rest/api/2/search/jql?"+query.Encode(), nil, &resp); err != nil {
+		return "", err
+	}
+
+	// summary ~ is a fuzzy text match, so confirm the exact title
+	for _, issue := range resp.Issues {
+		if issue.Fields.Summary == summary {
+			return issue.Key, nil
+		}
+	}
+	return "", nil
+}
+
+func (j *jiraTracker) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode jira request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, j.baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to build jira request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if j.email != "" {
+		req.SetBasicAuth(j.email, j.token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+j.token)
+	}
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("jira request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("jira %s %s: %s: %s", method, strings.SplitN(path, "?", 2)[0], resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode jira response: %w", err)
+		}
+	}
+	return nil
+}
+
+// jqlEscape escapes a value for use inside a double-quoted JQL string.
+func jqlEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
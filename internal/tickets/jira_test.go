@@ -0,0 +1,95 @@
+package tickets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestJira(t *testing.T, handler http.HandlerFunc) *jiraTracker {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &jiraTracker{
+		baseURL:   server.URL,
+		email:     "bot@example.com",
+		token:     "token",
+		project:   "DOCS",
+		issueType: "Task",
+		client:    server.Client(),
+	}
+}
+
+func TestJiraUpsert_Creates(t *testing.T) {
+	var createdFields map[string]interface{}
+	tracker := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		if user, _, ok := r.BasicAuth(); !ok || user != "bot@example.com" {
+			t.Errorf("missing basic auth")
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/2/search/jql":
+			_, _ = w.Write([]byte(`{"issues": [{"key": "DOCS-9", "fields": {"summary": "something else"}}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/2/issue":
+			var body struct {
+				Fields map[string]interface{} `json:"fields"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			createdFields = body.Fields
+			_, _ = w.Write([]byte(`{"key": "DOCS-10"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	result, err := tracker.Upsert(context.Background(), Ticket{Summary: "Fix headline", Description: "steps", Labels: []string{"prfaq"}})
+	if err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+	if !result.Created || result.Key != "DOCS-10" {
+		t.Errorf("result = %+v, want created DOCS-10", result)
+	}
+	if createdFields["summary"] != "Fix headline" {
+		t.Errorf("summary field = %v", createdFields["summary"])
+	}
+}
+
+func TestJiraUpsert_UpdatesExisting(t *testing.T) {
+	updated := false
+	tracker := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"issues": [{"key": "DOCS-3", "fields": {"summary": "Fix headline"}}]}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/rest/api/2/issue/DOCS-3":
+			updated = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	result, err := tracker.Upsert(context.Background(), Ticket{Summary: "Fix headline", Description: "steps"})
+	if err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+	if result.Created || result.Key != "DOCS-3" || !updated {
+		t.Errorf("result = %+v, updated = %v", result, updated)
+	}
+}
+
+func TestJiraUpsert_HTTPError(t *testing.T) {
+	tracker := newTestJira(t, func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "nope", http.StatusUnauthorized)
+	})
+
+	if _, err := tracker.Upsert(context.Background(), Ticket{Summary: "x"}); err == nil {
+		t.Error("expected error for 401 response")
+	}
+}
+
+func TestJQLEscape(t *testing.T) {
+	if got := jqlEscape(`PR-FAQ "Doc": a\b`); got != `PR-FAQ \"Doc\": a\\b` {
+		t.Errorf("jqlEscape() = %q", got)
+	}
+}
@@ -0,0 +1,136 @@
+package tickets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const linearEndpoint = "https://api.linear.app/graphql"
+
+const linearFindQuery = `query($teamId: ID!, $title: String!) {
+  issues(first: 1, filter: {
+    team: { id: { eq: $teamId } }
+    title: { eq: $title }
+    state: { type: { nin: ["completed", "canceled"] } }
+  }) { nodes { id identifier } }
+}`
+
+const linearCreateMutation = `mutation($input: IssueCreateInput!) {
+  issueCreate(input: $input) { success issue { identifier } }
+}`
+
+const linearUpdateMutation = `mutation($id: String!, $input: IssueUpdateInput!) {
+  issueUpdate(id: $id, input: $input) { success issue { identifier } }
+}`
+
+// linearTracker talks to the Linear GraphQL API.
+type linearTracker struct {
+	endpoint string
+	apiKey   string
+	teamID   string
+	client   *http.Client
+}
+
+type linearIssueRef struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+}
+
+// Upsert implements Tracker.
+func (l *linearTracker) Upsert(ctx context.Context, t Ticket) (Result, error) {
+	var found struct {
+		Issues struct {
+			Nodes []linearIssueRef `json:"nodes"`
+		} `json:"issues"`
+	}
+	vars := map[string]interface{}{"teamId": l.teamID, "title": t.Summary}
+	if err := l.query(ctx, linearFindQuery, vars, &found); err != nil {
+		return Result{}, err
+	}
+
+	input := map[string]interface{}{"description": t.Description}
+	if len(t.Labels) > 0 {
+		input["labelIds"] = t.Labels
+	}
+
+	if len(found.Issues.Nodes) > 0 {
+		existing := found.Issues.Nodes[0]
+		var updated struct {
+			IssueUpdate struct {
+				Success bool `json:"success"`
+			} `json:"issueUpdate"`
+		}
+		vars := map[string]interface{}{"id": existing.ID, "input": input}
+		if err := l.query(ctx, linearUpdateMutation, vars, &updated); err != nil {
+			return Result{}, err
+		}
+		if !updated.IssueUpdate.Success {
+			return Result{}, fmt.Errorf("linear rejected update of %s", existing.Identifier)
+		}
+		return Result{Key: existing.Identifier, Summary: t.Summary}, nil
+	}
+
+	input["teamId"] = l.teamID
+	input["title"] = t.Summary
+	var created struct {
+		IssueCreate struct {
+			Success bool           `json:"success"`
+			Issue   linearIssueRef `json:"issue"`
+		} `json:"issueCreate"`
+	}
+	if err := l.query(ctx, linearCreateMutation, map[string]interface{}{"input": input}, &created); err != nil {
+		return Result{}, err
+	}
+	if !created.IssueCreate.Success {
+		return Result{}, fmt.Errorf("linear rejected creation of %q", t.Summary)
+	}
+	return Result{Key: created.IssueCreate.Issue.Identifier, Summary: t.Summary, Created: true}, nil
+}
+
+func (l *linearTracker) query(ctx context.Context, query string, vars map[string]interface{}, out interface{}) error {
+	payload, err := json.Marshal(map[string]interface{}{"query": query, "variables": vars})
+	if err != nil {
+		return fmt.Errorf("failed to encode linear request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, l.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build linear request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", l.apiKey)
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("linear request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("linear: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	var envelope struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("failed to decode linear response: %w", err)
+	}
+	if len(envelope.Errors) > 0 {
+		return fmt.Errorf("linear: %s", envelope.Errors[0].Message)
+	}
+
+	if err := json.Unmarshal(envelope.Data, out); err != nil {
+		return fmt.Errorf("failed to decode linear data: %w", err)
+	}
+	return nil
+}
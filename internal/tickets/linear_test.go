@@ -0,0 +1,87 @@
+package tickets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestLinear(t *testing.T, handler func(query string, vars map[string]interface{}) string) *linearTracker {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "lin_key" {
+			t.Errorf("Authorization = %q", r.Header.Get("Authorization"))
+		}
+		var req struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_, _ = w.Write([]byte(handler(req.Query, req.Variables)))
+	}))
+	t.Cleanup(server.Close)
+	return &linearTracker{endpoint: server.URL, apiKey: "lin_key", teamID: "team-1", client: server.Client()}
+}
+
+func TestLinearUpsert_Creates(t *testing.T) {
+	tracker := newTestLinear(t, func(query string, vars map[string]interface{}) string {
+		switch {
+		case strings.Contains(query, "issues("):
+			return `{"data": {"issues": {"nodes": []}}}`
+		case strings.Contains(query, "issueCreate"):
+			input := vars["input"].(map[string]interface{})
+			if input["teamId"] != "team-1" || input["title"] != "Fix headline" {
+				t.Errorf("create input = %v", input)
+			}
+			return `{"data": {"issueCreate": {"success": true, "issue": {"identifier": "DOC-7"}}}}`
+		}
+		t.Errorf("unexpected query %s", query)
+		return `{}`
+	})
+
+	result, err := tracker.Upsert(context.Background(), Ticket{Summary: "Fix headline", Description: "steps"})
+	if err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+	if !result.Created || result.Key != "DOC-7" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestLinearUpsert_UpdatesExisting(t *testing.T) {
+	tracker := newTestLinear(t, func(query string, vars map[string]interface{}) string {
+		switch {
+		case strings.Contains(query, "issues("):
+			return `{"data": {"issues": {"nodes": [{"id": "uuid-1", "identifier": "DOC-2"}]}}}`
+		case strings.Contains(query, "issueUpdate"):
+			if vars["id"] != "uuid-1" {
+				t.Errorf("update id = %v", vars["id"])
+			}
+			return `{"data": {"issueUpdate": {"success": true}}}`
+		}
+		t.Errorf("unexpected query %s", query)
+		return `{}`
+	})
+
+	result, err := tracker.Upsert(context.Background(), Ticket{Summary: "Fix headline"})
+	if err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+	if result.Created || result.Key != "DOC-2" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestLinearUpsert_GraphQLError(t *testing.T) {
+	tracker := newTestLinear(t, func(string, map[string]interface{}) string {
+		return `{"errors": [{"message": "team not found"}]}`
+	})
+
+	_, err := tracker.Upsert(context.Background(), Ticket{Summary: "x"})
+	if err == nil || !strings.Contains(err.Error(), "team not found") {
+		t.Errorf("error = %v, want graphql error", err)
+	}
+}
@@ -0,0 +1,127 @@
+// Package tickets files remediation tickets for critical PR-FAQ findings in
+// an issue tracker (Jira or Linear), so action items from a report don't get lost.
+package tickets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/config"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// Ticket is a tracker-agnostic description of a remediation ticket.
+type Ticket struct {
+	Summary     string
+	Description string
+	Labels      []string
+}
+
+// Result reports what happened to a single ticket during a sync.
+type Result struct {
+	Key     string
+	Summary string
+	Created bool
+}
+
+// Tracker creates or updates tickets in an issue tracker.
+type Tracker interface {
+	// Upsert updates the open ticket whose summary matches t.Summary,
+	// or creates a new ticket when none exists.
+	Upsert(ctx context.Context, t Ticket) (Result, error)
+}
+
+// New builds a Tracker for the configured provider, reading credentials from
+// the environment (JIRA_EMAIL/JIRA_API_TOKEN or LINEAR_API_KEY).
+func New(cfg config.TicketsConfig) (Tracker, error) {
+	if cfg.Project == "" {
+		return nil, fmt.Errorf("tickets: project is not configured")
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	switch strings.ToLower(cfg.Provider) {
+	case "jira":
+		baseURL := cfg.BaseURL
+		if baseURL == "" {
+			baseURL = os.Getenv("JIRA_BASE_URL")
+		}
+		token := os.Getenv("JIRA_API_TOKEN")
+		if baseURL == "" || token == "" {
+			return nil, fmt.Errorf("tickets: jira requires base_url (or JIRA_BASE_URL) and JIRA_API_TOKEN")
+		}
+		issueType := cfg.IssueType
+		if issueType == "" {
+			issueType = "Task"
+		}
+		return &jiraTracker{
+			baseURL:   strings.TrimRight(baseURL, "/"),
+			email:     os.Getenv("JIRA_EMAIL"),
+			token:     token,
+			project:   cfg.Project,
+			issueType: issueType,
+			client:    client,
+		}, nil
+	case "linear":
+		apiKey := os.Getenv("LINEAR_API_KEY")
+		if apiKey == "" {
+			return nil, fmt.Errorf("tickets: linear requires LINEAR_API_KEY")
+		}
+		return &linearTracker{
+			endpoint: linearEndpoint,
+			apiKey:   apiKey,
+			teamID:   cfg.Project,
+			client:   client,
+		}, nil
+	case "":
+		return nil, fmt.Errorf("tickets: provider is not configured")
+	default:
+		return nil, fmt.Errorf("tickets: unknown provider %q (want jira or linear)", cfg.Provider)
+	}
+}
+
+// FromImprovements builds one ticket per critical finding category, carrying
+// the remediation steps from the report's priority improvements.
+func FromImprovements(docTitle string, improvements []parser.Improvement, labels []string) []Ticket {
+	if docTitle == "" {
+		docTitle = "Untitled PR-FAQ"
+	}
+
+	tickets := make([]Ticket, 0, len(improvements))
+	for _, improvement := range improvements {
+		var desc strings.Builder
+		desc.WriteString("Document: " + docTitle + "\n\n")
+		desc.WriteString("Impact: " + improvement.Impact + "\n\n")
+		desc.WriteString("Action steps:\n")
+		for _, step := range improvement.Steps {
+			desc.WriteString("- " + step + "\n")
+		}
+		desc.WriteString("\nFiled by pr-faq-validator.\n")
+
+		tickets = append(tickets, Ticket{
+			Summary:     fmt.Sprintf("PR-FAQ %q: %s", docTitle, improvement.Title),
+			Description: desc.String(),
+			Labels:      labels,
+		})
+	}
+
+	return tickets
+}
+
+// Sync upserts every ticket and returns the results in input order.
+// It stops at the first tracker error.
+func Sync(ctx context.Context, tracker Tracker, tickets []Ticket) ([]Result, error) {
+	results := make([]Result, 0, len(tickets))
+	for _, t := range tickets {
+		result, err := tracker.Upsert(ctx, t)
+		if err != nil {
+			return results, fmt.Errorf("failed to sync ticket %q: %w", t.Summary, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,117 @@
+package tickets
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/config"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+type fakeTracker struct {
+	calls []Ticket
+	err   error
+}
+
+func (f *fakeTracker) Upsert(_ context.Context, t Ticket) (Result, error) {
+	if f.err != nil {
+		return Result{}, f.err
+	}
+	f.calls = append(f.calls, t)
+	return Result{Key: "KEY-1", Summary: t.Summary, Created: true}, nil
+}
+
+func TestFromImprovements(t *testing.T) {
+	improvements := []parser.Improvement{
+		{Title: "Create Compelling Headline", Impact: "Headlines matter.", Steps: []string{"Use verbs", "Add metrics"}},
+		{Title: "Eliminate Marketing Fluff", Impact: "Fluff hurts.", Steps: []string{"Remove hype"}},
+	}
+
+	got := FromImprovements("Launch Doc", improvements, []string{"prfaq"})
+
+	if len(got) != 2 {
+		t.Fatalf("got %d tickets, want 2", len(got))
+	}
+	if got[0].Summary != `PR-FAQ "Launch Doc": Create Compelling Headline` {
+		t.Errorf("Summary = %q", got[0].Summary)
+	}
+	for _, want := range []string{"Headlines matter.", "- Use verbs", "- Add metrics"} {
+		if !strings.Contains(got[0].Description, want) {
+			t.Errorf("Description missing %q", want)
+		}
+	}
+	if len(got[1].Labels) != 1 || got[1].Labels[0] != "prfaq" {
+		t.Errorf("Labels = %v, want [prfaq]", got[1].Labels)
+	}
+}
+
+func TestFromImprovements_UntitledDocument(t *testing.T) {
+	got := FromImprovements("", []parser.Improvement{{Title: "Complete the 5 Ws"}}, nil)
+	if !strings.Contains(got[0].Summary, "Untitled PR-FAQ") {
+		t.Errorf("Summary = %q, want untitled placeholder", got[0].Summary)
+	}
+}
+
+func TestSync(t *testing.T) {
+	t.Run("upserts all tickets in order", func(t *testing.T) {
+		tracker := &fakeTracker{}
+		results, err := Sync(context.Background(), tracker, []Ticket{{Summary: "a"}, {Summary: "b"}})
+		if err != nil {
+			t.Fatalf("Sync() error = %v", err)
+		}
+		if len(results) != 2 || results[1].Summary != "b" {
+			t.Errorf("results = %+v", results)
+		}
+	})
+
+	t.Run("stops at first error", func(t *testing.T) {
+		tracker := &fakeTracker{err: errors.New("boom")}
+		_, err := Sync(context.Background(), tracker, []Ticket{{Summary: "a"}})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.TicketsConfig
+		env     map[string]string
+		wantErr bool
+	}{
+		{name: "missing project", cfg: config.TicketsConfig{Provider: "jira"}, wantErr: true},
+		{name: "missing provider", cfg: config.TicketsConfig{Project: "DOCS"}, wantErr: true},
+		{name: "unknown provider", cfg: config.TicketsConfig{Provider: "trello", Project: "DOCS"}, wantErr: true},
+		{name: "jira without token", cfg: config.TicketsConfig{Provider: "jira", Project: "DOCS", BaseURL: "https://x"}, wantErr: true},
+		{
+			name: "jira configured",
+			cfg:  config.TicketsConfig{Provider: "jira", Project: "DOCS", BaseURL: "https://x"},
+			env:  map[string]string{"JIRA_API_TOKEN": "token"},
+		},
+		{name: "linear without key", cfg: config.TicketsConfig{Provider: "linear", Project: "team"}, wantErr: true},
+		{
+			name: "linear configured",
+			cfg:  config.TicketsConfig{Provider: "Linear", Project: "team"},
+			env:  map[string]string{"LINEAR_API_KEY": "key"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("JIRA_API_TOKEN", "")
+			t.Setenv("JIRA_BASE_URL", "")
+			t.Setenv("LINEAR_API_KEY", "")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			_, err := New(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package ui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// accessibleSections are the parts of the analysis the accessible interface
+// reads out, in menu order. Each is plain text, one item per line, without
+// styling, box drawing, or symbols a screen reader would spell out.
+var accessibleSections = []struct {
+	name string
+	text func(m Model) string
+}{
+	{"Overview", Model.summaryText},
+	{"Score breakdown", Model.breakdownText},
+	{"Findings", Model.findingText},
+	{"Quotes", Model.quoteText},
+	{"Claims", Model.claimText},
+	{"Paragraph heatmap", Model.accessibleHeatmapText},
+	{"AI feedback", Model.feedbackText},
+}
+
+// RunAccessible runs a linear, prompt-driven interface in place of the TUI,
+// for screen readers: it writes the score and a numbered menu of the
+// analysis to out, and reads the number of the part to read out from in,
+// one line at a time, until q or the end of in. Nothing is redrawn, so
+// everything written stays in the terminal's history. It returns the
+// model as it was left, scored, and with AI feedback once requested.
+func RunAccessible(m Model, in io.Reader, out io.Writer) (Model, error) {
+	if m.scoring() {
+		m, _ = m.updateScoring(ScoreReadyMsg{Score: parser.Score(&m.sections)})
+	}
+
+	w := &errWriter{w: out}
+	w.printf("PR-FAQ Validator, accessible mode.\n")
+	if m.sections.Title != "" {
+		w.printf("Document: %s.\n", m.sections.Title)
+	}
+	w.printf("Overall score: %d out of 100.\n", m.sections.PRScore.OverallScore)
+	writeMenu(w)
+
+	analyzed := false
+	scanner := bufio.NewScanner(in)
+	for w.err == nil {
+		w.printf("\nChoice: ")
+		if !scanner.Scan() {
+			w.printf("\n")
+			break
+		}
+		choice := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		switch choice {
+		case "q", "quit", "exit":
+			return m, w.err
+		case "", "m", "menu", "?":
+			writeMenu(w)
+			continue
+		}
+
+		n, err := strconv.Atoi(choice)
+		if err != nil || n < 1 || n > len(accessibleSections) {
+			w.printf("Unknown choice %q. Enter a number from 1 to %d, m for the menu, or q to quit.\n", choice, len(accessibleSections))
+			continue
+		}
+		section := accessibleSections[n-1]
+		if section.name == "AI feedback" && !analyzed && !llm.Offline() {
+			w.printf("Requesting AI feedback. This can take a minute.\n")
+			m = runCmd(m, StartAIAnalysis(m.sections, m.selected))
+			analyzed = true
+		}
+		w.printf("\n%s.\n", section.name)
+		text := strings.TrimSpace(section.text(m))
+		if text == "" {
+			text = emptyText(section.name)
+		}
+		w.printf("%s\n", text)
+	}
+	if err := scanner.Err(); err != nil && w.err == nil {
+		return m, fmt.Errorf("failed to read choice: %w", err)
+	}
+	return m, w.err
+}
+
+// writeMenu lists the parts of the analysis with their numbers.
+func writeMenu(w *errWriter) {
+	w.printf("\nSections:\n")
+	for i, s := range accessibleSections {
+		w.printf("%d. %s\n", i+1, s.name)
+	}
+	w.printf("Enter a section number, m for this menu, or q to quit.\n")
+}
+
+// emptyText says why a part of the analysis has nothing to read out.
+func emptyText(name string) string {
+	switch name {
+	case "Findings":
+		return "No findings."
+	case "Quotes":
+		return "No quotes found in the press release section."
+	case "Claims":
+		return "No quantitative claims found in the press release section."
+	case "Paragraph heatmap":
+		return "No press release paragraphs found."
+	case "AI feedback":
+		if llm.Offline() {
+			return "Offline mode: AI analysis is disabled."
+		}
+		return "No AI feedback."
+	}
+	return "Nothing to show."
+}
+
+// breakdownText lists the score of every category.
+func (m Model) breakdownText() string {
+	var b strings.Builder
+	for _, c := range m.sections.PRScore.QualityBreakdown.Categories() {
+		fmt.Fprintf(&b, "%s: %d out of %d.\n", c.Name, c.Score, c.Max)
+	}
+	return b.String()
+}
+
+// findingText lists every finding, numbered, with its line, severity, and
+// rule, then the dismissed findings with their status.
+func (m Model) findingText() string {
+	findings := m.sections.Findings()
+	var b strings.Builder
+	for i, f := range findings {
+		fmt.Fprintf(&b, "Finding %d of %d. Line %d, %s: %s Rule %s.\n",
+			i+1, len(findings), f.Line, f.Severity, sentence(f.Message), f.RuleID)
+	}
+	dismissed := m.sections.DismissedFindings()
+	for i, d := range dismissed {
+		fmt.Fprintf(&b, "Dismissed finding %d of %d. Line %d, %s: %s",
+			i+1, len(dismissed), d.Line, strings.ToLower(d.Dismissal.Status.Label()), sentence(d.Message))
+		if d.Dismissal.Reason != "" {
+			b.WriteString(" Reason: " + sentence(d.Dismissal.Reason))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// accessibleHeatmapText lists the press release paragraphs with their heat
+// levels and findings, in words.
+func (m Model) accessibleHeatmapText() string {
+	var b strings.Builder
+	for _, p := range m.sections.Heatmap() {
+		lines := fmt.Sprintf("Line %d", p.Span.Start)
+		if p.Span.End > p.Span.Start {
+			lines = fmt.Sprintf("Lines %d to %d", p.Span.Start, p.Span.End)
+		}
+		switch len(p.Findings) {
+		case 0:
+			fmt.Fprintf(&b, "%s, no findings.\n", lines)
+		case 1:
+			fmt.Fprintf(&b, "%s, %s heat, 1 finding.\n", lines, parser.HeatLevels[p.Level()])
+		default:
+			fmt.Fprintf(&b, "%s, %s heat, %d findings.\n", lines, parser.HeatLevels[p.Level()], len(p.Findings))
+		}
+		for _, f := range p.Findings {
+			b.WriteString("  " + sentence(f.Message) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// sentence ends s with a period unless it ends in punctuation already.
+func sentence(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.ContainsAny(s[len(s)-1:], ".!?") {
+		return s
+	}
+	return s + "."
+}
+
+// runCmd runs cmd and the commands its messages lead to, in order, and
+// applies their messages to m, without a Bubble Tea program.
+func runCmd(m Model, cmd tea.Cmd) Model {
+	if cmd == nil {
+		return m
+	}
+	switch msg := cmd().(type) {
+	case nil:
+	case tea.BatchMsg:
+		for _, c := range msg {
+			m = runCmd(m, c)
+		}
+	default:
+		next, c := m.Update(msg)
+		m = runCmd(next.(Model), c)
+	}
+	return m
+}
+
+// errWriter writes formatted text until the first error, which it keeps.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+// printf writes the formatted text unless an earlier write failed.
+func (w *errWriter) printf(format string, args ...any) {
+	if w.err == nil {
+		_, w.err = fmt.Fprintf(w.w, format, args...)
+	}
+}
@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+func TestRunAccessible(t *testing.T) {
+	llm.SetOffline(true)
+	defer llm.SetOffline(false)
+
+	sections, err := parser.Parse(strings.NewReader(scoringDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := sections.PRScore.OverallScore
+	sections.PRScore = nil
+
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"menu", "", []string{"Overall score: ", "1. Overview", "7. AI feedback"}},
+		{"breakdown", "2\nq\n", []string{"Score breakdown.", "Headline Quality: "}},
+		{"findings", "3\n", []string{"Findings.", "Finding 1 of ", ", warning: "}},
+		{"heatmap", "6\n", []string{"Paragraph heatmap.", "Line 5, "}},
+		{"offline feedback", "7\n", []string{"AI feedback.", "Offline mode: AI analysis is disabled."}},
+		{"unknown", "9\nfoo\n", []string{`Unknown choice "9"`, `Unknown choice "foo"`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			m, err := RunAccessible(NewModel(*sections), strings.NewReader(tt.input), &out)
+			if err != nil {
+				t.Fatalf("RunAccessible() error = %v", err)
+			}
+			if m.sections.PRScore == nil || m.sections.PRScore.OverallScore != want {
+				t.Errorf("RunAccessible() left the score %+v, want %d", m.sections.PRScore, want)
+			}
+			got := out.String()
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("output lacks %q:\n%s", w, got)
+				}
+			}
+			// Nothing a screen reader would spell out or a redraw would need
+			if strings.ContainsAny(got, "\x1b│╭─•✓✗") {
+				t.Errorf("output has styling or drawing characters:\n%s", got)
+			}
+		})
+	}
+}
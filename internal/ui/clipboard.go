@@ -0,0 +1,194 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/clipboard"
+	"github.com/bordenet/pr-faq-validator/internal/crosscheck"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// CopyFunc puts text on the clipboard and returns how it got there, e.g.
+// "pbcopy".
+type CopyFunc func(text string) (string, error)
+
+// CopiedMsg reports the outcome of copying the current tab's content.
+type CopiedMsg struct {
+	What   string
+	Method string
+	Err    error
+}
+
+// copyContent creates a command that copies text with copyText.
+func copyContent(what, text string, copyText CopyFunc) tea.Cmd {
+	return func() tea.Msg {
+		method, err := copyText(text)
+		return CopiedMsg{What: what, Method: method, Err: err}
+	}
+}
+
+// copyTab copies the content of the active tab as plain text: the summary,
+// the issue list, the quotes, the claims, the AI feedback, the rewrite under
+// the cursor, the picked questions, the FAQ scorecard, or the what-if
+// weights.
+func (m Model) copyTab() (Model, tea.Cmd) {
+	what, text := m.tabText()
+	if strings.TrimSpace(text) == "" {
+		m.status = "Nothing to copy on this tab"
+		return m, nil
+	}
+	m.status = fmt.Sprintf("Copying %s...", what)
+	return m, copyContent(what, text, m.copy)
+}
+
+// tabText returns a name for the active tab's content and the content as
+// plain text, without styling.
+func (m Model) tabText() (string, string) {
+	if m.scoring() && m.activeTab != TabFeedback && m.activeTab != TabFixes && m.activeTab != TabQuestions && m.activeTab != TabRubric {
+		return "score", ""
+	}
+	switch m.activeTab {
+	case TabBreakdown:
+		return "issue list", m.issueText()
+	case TabFindings:
+		if text := m.dismissedText(); text != "" {
+			return "findings", m.issueText() + "\nDismissed:\n" + text
+		}
+		return "findings", m.issueText()
+	case TabQuotes:
+		return "quotes", m.quoteText()
+	case TabClaims:
+		return "claims", m.claimText()
+	case TabHeatmap:
+		return "heatmap", m.heatmapText()
+	case TabFeedback:
+		return "AI feedback", m.feedbackText()
+	case TabFixes:
+		if len(m.fixes) == 0 || m.fixes[m.fixCursor].err != nil {
+			return "rewrite", ""
+		}
+		f := m.fixes[m.fixCursor]
+		return f.section + " rewrite", f.rewrite
+	case TabQuestions:
+		if len(m.questions) == 0 {
+			return "questions", ""
+		}
+		var lines []string
+		for _, q := range m.picked() {
+			lines = append(lines, q.Text)
+		}
+		return "questions", strings.Join(lines, "\n")
+	case TabRubric:
+		return "FAQ scorecard", m.rubricText()
+	case TabWhatIf:
+		return "weights", m.whatIfText()
+	}
+	return "summary", m.summaryText()
+}
+
+// summaryText is the Overview tab as plain text.
+func (m Model) summaryText() string {
+	var b strings.Builder
+	if m.sections.Title != "" {
+		b.WriteString(m.sections.Title + "\n")
+	}
+	fmt.Fprintf(&b, "Overall Score: %d/100\n", m.sections.PRScore.OverallScore)
+	if sev := m.sections.PRScore.Severity; sev != nil {
+		b.WriteString("Scored by finding severity: " + sev.Note() + "\n")
+	}
+	if strengths := m.sections.PRScore.QualityBreakdown.Strengths; len(strengths) > 0 {
+		b.WriteString("\nStrengths:\n")
+		for _, s := range strengths {
+			b.WriteString("- " + s + "\n")
+		}
+	}
+	if issues := m.sections.PRScore.QualityBreakdown.Issues; len(issues) > 0 {
+		b.WriteString("\nImprovements:\n")
+		for _, issue := range issues {
+			b.WriteString("- " + issue + "\n")
+		}
+	}
+	return b.String()
+}
+
+// issueText lists every finding with its line and rule.
+func (m Model) issueText() string {
+	var b strings.Builder
+	for _, f := range m.sections.Findings() {
+		fmt.Fprintf(&b, "- line %d: %s [%s]\n", f.Line, f.Message, f.RuleID)
+	}
+	return b.String()
+}
+
+// quoteText lists the press release quotes with their scores and metrics.
+func (m Model) quoteText() string {
+	var b strings.Builder
+	for _, q := range m.sections.PRScore.MetricDetails {
+		fmt.Fprintf(&b, "\"%s\" (%d/10)\n", q.Quote, q.Score)
+		if len(q.Metrics) > 0 {
+			b.WriteString("  Metrics: " + strings.Join(q.Metrics, ", ") + "\n")
+		}
+	}
+	return b.String()
+}
+
+// claimText lists the press release claims and where each is substantiated.
+func (m Model) claimText() string {
+	var b strings.Builder
+	for _, c := range m.sections.Claims() {
+		if c.Substantiated() {
+			fmt.Fprintf(&b, "- %s (line %d): %s, line %d\n", c.Metric, c.Line, c.Section, c.SectionLine)
+		} else {
+			fmt.Fprintf(&b, "- %s (line %d): not substantiated\n", c.Metric, c.Line)
+		}
+	}
+	return b.String()
+}
+
+// heatmapText lists the press release paragraphs by heat level, each with
+// its findings.
+func (m Model) heatmapText() string {
+	var b strings.Builder
+	for _, p := range m.sections.Heatmap() {
+		fmt.Fprintf(&b, "- %s\n", p.Label())
+		for _, f := range p.Findings {
+			fmt.Fprintf(&b, "  - %s\n", f.Message)
+		}
+	}
+	return b.String()
+}
+
+// feedbackText joins the AI feedback of every reviewed section.
+func (m Model) feedbackText() string {
+	var parts []string
+	for _, s := range []struct{ name, feedback string }{
+		{"Press Release", m.prFeedback},
+		{"FAQ", m.faqFeedback},
+		{"Success Metrics", m.metricsFeedback},
+	} {
+		if s.feedback != "" {
+			parts = append(parts, "## "+s.name+"\n\n"+strings.TrimSpace(s.feedback))
+		}
+		if s.name == "Press Release" && len(m.disagreements) > 0 {
+			parts = append(parts, strings.TrimSpace(crosscheck.Report(m.disagreements)))
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// updateCopied reports a finished copy in the status line.
+func (m Model) updateCopied(msg CopiedMsg) Model {
+	switch {
+	case errors.Is(msg.Err, clipboard.ErrEmpty):
+		m.status = "Nothing to copy on this tab"
+	case msg.Err != nil:
+		m.status = fmt.Sprintf("Could not copy %s: %v", msg.What, msg.Err)
+	case msg.Method == clipboard.Terminal:
+		m.status = fmt.Sprintf("Copied %s through the terminal (OSC 52)", msg.What)
+	default:
+		m.status = fmt.Sprintf("Copied %s to the clipboard", msg.What)
+	}
+	return m
+}
@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/clipboard"
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_Copy(t *testing.T) {
+	sections := parser.SpecSections{
+		Title:        "CloudSync",
+		PressRelease: "Content",
+		PRScore: &parser.PRScore{
+			OverallScore: 72,
+			QualityBreakdown: parser.PRQualityBreakdown{
+				Issues: []string{"Missing customer quote"},
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		tab      Tab
+		setup    func(*Model)
+		want     string
+		wantWhat string
+	}{
+		{"overview", TabOverview, nil, "Overall Score: 72/100", "summary"},
+		{"claims", TabClaims, func(m *Model) {
+			parsed, err := parser.Parse(strings.NewReader("# CloudSync\n\n## Press Release\n\nCloudSync cuts backup time by 80%.\n\n## FAQ\n\nQ: How?\nA: In the beta, backups fell 80%.\n"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			m.sections = *parsed
+		}, "- 80% (line 5): FAQ, line 10", "claims"},
+		{"heatmap", TabHeatmap, func(m *Model) {
+			parsed, err := parser.Parse(strings.NewReader("# CloudSync\n\n## Press Release\n\nCloudSync may possibly speed up backups somewhat.\n"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			m.sections = *parsed
+		}, "- Line 5 · ", "heatmap"},
+		{"feedback", TabFeedback, func(m *Model) { m.faqFeedback = "Answer the pricing question." }, "## FAQ\n\nAnswer the pricing question.", "AI feedback"},
+		{"fix", TabFixes, func(m *Model) {
+			m.fixes = []fix{{section: "FAQ", rewrite: "Q: Why?"}, {section: "Press Release", rewrite: "Acme launched CloudSync."}}
+			m.fixCursor = 1
+		}, "Acme launched CloudSync.", "Press Release rewrite"},
+		{"questions", TabQuestions, func(m *Model) {
+			m.questions = []question{{Question: llm.Question{Text: "Why now?"}}, {Question: llm.Question{Text: "What does it cost?"}}}
+			m.questionCursor = 1
+		}, "What does it cost?", "questions"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var copied string
+			m := NewModel(sections)
+			m.activeTab = tt.tab
+			m.copy = func(text string) (string, error) {
+				copied = text
+				return "pbcopy", nil
+			}
+			if tt.setup != nil {
+				tt.setup(&m)
+			}
+			updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+			if cmd == nil {
+				t.Fatal("c should return a command")
+			}
+			updated, _ = updated.(Model).Update(cmd())
+			if !strings.Contains(copied, tt.want) {
+				t.Errorf("copied %q, want it to contain %q", copied, tt.want)
+			}
+			if status := updated.(Model).status; status != "Copied "+tt.wantWhat+" to the clipboard" {
+				t.Errorf("status = %q", status)
+			}
+		})
+	}
+}
+
+func TestModel_Copy_Status(t *testing.T) {
+	m := NewModel(parser.SpecSections{PRScore: &parser.PRScore{}})
+	m.activeTab = TabFixes
+	if _, cmd := m.copyTab(); cmd != nil {
+		t.Error("copyTab() with no fixes should not return a command")
+	}
+
+	tests := []struct {
+		msg  CopiedMsg
+		want string
+	}{
+		{CopiedMsg{What: "quotes", Method: clipboard.Terminal}, "Copied quotes through the terminal (OSC 52)"},
+		{CopiedMsg{What: "quotes", Err: errors.New("xclip: exit status 1")}, "Could not copy quotes: xclip: exit status 1"},
+	}
+	for _, tt := range tests {
+		if got := m.updateCopied(tt.msg).status; got != tt.want {
+			t.Errorf("updateCopied(%+v) status = %q, want %q", tt.msg, got, tt.want)
+		}
+	}
+}
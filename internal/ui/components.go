@@ -5,12 +5,26 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/bordenet/pr-faq-validator/internal/crosscheck"
 	"github.com/bordenet/pr-faq-validator/internal/parser"
 	"github.com/charmbracelet/lipgloss"
 )
 
-// RenderHeader creates a styled header section.
-func RenderHeader(title string, score int) string {
+// RenderHeader creates a styled header section width columns wide.
+func RenderHeader(title string, score int, width int) string {
+	// Overall score in simple format
+	scoreText := GetScoreStyle(score).Render(fmt.Sprintf("%d/100", score))
+	return renderHeader(title, fmt.Sprintf("Overall Score: %s", scoreText), width)
+}
+
+// RenderScoringHeader creates the header while the document is still being
+// scored, with frame in place of the overall score.
+func RenderScoringHeader(title string, frame string, width int) string {
+	return renderHeader(title, "Overall Score: "+frame+" scoring...", width)
+}
+
+// renderHeader creates the header above a score display.
+func renderHeader(title string, scoreDisplay string, width int) string {
 	var parts []string
 
 	// Main title in a simple border
@@ -22,79 +36,152 @@ func RenderHeader(title string, score int) string {
 		Render("🔍 PR-FAQ Validator")
 	parts = append(parts, titleBox)
 
-	// Document title
+	// Document title, cut off rather than wrapped on narrow terminals
 	if title != "" {
-		parts = append(parts, SubtitleStyle.Render("📄 "+title))
+		parts = append(parts, SubtitleStyle.Render(truncate("📄 "+title, width)))
 	}
 
-	// Overall score in simple format
-	scoreText := GetScoreStyle(score).Render(fmt.Sprintf("%d/100", score))
-	scoreDisplay := fmt.Sprintf("Overall Score: %s", scoreText)
 	parts = append(parts, scoreDisplay)
 
 	return lipgloss.JoinVertical(lipgloss.Center, parts...)
 }
 
-// RenderScoreBreakdown creates a styled score breakdown table.
-func RenderScoreBreakdown(breakdown parser.PRQualityBreakdown) string {
-	var rows []string
+// RenderScoreBreakdown creates a styled score breakdown table that fits in
+// width columns.
+func RenderScoreBreakdown(breakdown parser.PRQualityBreakdown, width int) string {
+	scores := map[string]parser.CategoryScore{}
+	for _, c := range breakdown.Categories() {
+		scores[c.Name] = c
+	}
+	return RenderPartialBreakdown(scores, "", width)
+}
+
+// RenderPartialBreakdown creates the score breakdown table while categories
+// are still being scored. Categories missing from scores show frame, and
+// so do the groups they belong to.
+func RenderPartialBreakdown(scores map[string]parser.CategoryScore, frame string, width int) string {
+	cardWidth := fitWidth(85, width, CardStyle.GetHorizontalBorderSize())
+	cols := newBreakdownColumns(cardWidth - CardStyle.GetHorizontalPadding())
 
-	// Header
-	header := lipgloss.JoinHorizontal(lipgloss.Left,
-		TableHeaderStyle.Width(25).Render("Category"),
-		TableHeaderStyle.Width(12).Render("Score"),
-		TableHeaderStyle.Width(12).Render("Max"),
-		TableHeaderStyle.Width(30).Render("Progress"),
-	)
-	rows = append(rows, header)
-
-	// Structure & Hook section (now 30 points)
-	structureTotal := breakdown.HeadlineScore + breakdown.HookScore + breakdown.ReleaseDateScore
-	rows = append(rows, renderScoreRow("Structure & Hook", structureTotal, 30, false))
-	rows = append(rows, renderScoreRow("  Headline Quality", breakdown.HeadlineScore, 10, true))
-	rows = append(rows, renderScoreRow("  Newsworthy Hook", breakdown.HookScore, 15, true))
-	rows = append(rows, renderScoreRow("  Release Date", breakdown.ReleaseDateScore, 5, true))
-
-	// Content Quality section
-	contentTotal := breakdown.FiveWsScore + breakdown.CredibilityScore + breakdown.StructureScore
-	rows = append(rows, renderScoreRow("Content Quality", contentTotal, 35, false))
-	rows = append(rows, renderScoreRow("  5 Ws Coverage", breakdown.FiveWsScore, 15, true))
-	rows = append(rows, renderScoreRow("  Credibility", breakdown.CredibilityScore, 10, true))
-	rows = append(rows, renderScoreRow("  Structure", breakdown.StructureScore, 10, true))
-
-	// Professional Quality section (now 20 points)
-	professionalTotal := breakdown.ToneScore + breakdown.FluffScore
-	rows = append(rows, renderScoreRow("Professional Quality", professionalTotal, 20, false))
-	rows = append(rows, renderScoreRow("  Tone & Readability", breakdown.ToneScore, 10, true))
-	rows = append(rows, renderScoreRow("  Fluff Avoidance", breakdown.FluffScore, 10, true))
-
-	// Customer Evidence section
-	rows = append(rows, renderScoreRow("Customer Evidence", breakdown.QuoteScore, 15, false))
+	var rows []string
+	rows = append(rows, cols.row(TableHeaderStyle, "Category", "Score", "Max", "Progress"))
+
+	for _, group := range breakdownGroups {
+		total, done := 0, true
+		var children []string
+		for _, name := range group.categories {
+			c, ok := scores[name]
+			if !ok {
+				done = false
+				children = append(children, renderPendingRow(cols, "  "+name, categoryMax(name), true, frame))
+				continue
+			}
+			total += c.Score
+			children = append(children, renderScoreRow(cols, "  "+name, c.Score, c.Max, true))
+		}
+		if done {
+			rows = append(rows, renderScoreRow(cols, group.name, total, group.max, false))
+		} else {
+			rows = append(rows, renderPendingRow(cols, group.name, group.max, false, frame))
+		}
+		// A group of one category is shown as the group alone
+		if len(group.categories) > 1 {
+			rows = append(rows, children...)
+		}
+	}
 
 	content := lipgloss.JoinVertical(lipgloss.Left, rows...)
-	return CardStyle.Width(85).Render(content)
+	return CardStyle.Width(cardWidth).Render(content)
+}
+
+// breakdownColumns are the widths of the breakdown table's columns, and of
+// the progress bars in its progress column. A progress width of 0 drops
+// the column.
+type breakdownColumns struct {
+	category, score, max, progress, bar int
+}
+
+// newBreakdownColumns lays the breakdown table out in width columns: the
+// full table when it fits, and otherwise narrower score columns, then a
+// shorter progress bar, then no progress column at all.
+func newBreakdownColumns(width int) breakdownColumns {
+	full := breakdownColumns{category: 25, score: 12, max: 12, progress: 30, bar: 20}
+	if width >= full.category+full.score+full.max+full.progress {
+		return full
+	}
+	cols := breakdownColumns{category: 25, score: 7, max: 7}
+	// A bar is drawn in a rounded border and padding, inside the cell's
+	// own padding
+	cols.progress = width - cols.category - cols.score - cols.max
+	cols.bar = min(cols.progress-6, full.bar)
+	if cols.bar < 5 {
+		cols.progress, cols.bar = 0, 0
+		cols.category = min(width-cols.score-cols.max, full.category)
+	}
+	return cols
+}
+
+// row joins the cells of a breakdown row rendered in style. The category
+// is cut off when its column is too narrow.
+func (c breakdownColumns) row(style lipgloss.Style, category, score, maxScore, progress string) string {
+	cells := []string{
+		style.Width(c.category).Render(truncate(category, c.category-style.GetHorizontalPadding())),
+		style.Width(c.score).Render(score),
+		style.Width(c.max).Render(maxScore),
+	}
+	if c.progress > 0 {
+		cells = append(cells, style.Width(c.progress).Render(progress))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Left, cells...)
+}
+
+// breakdownGroups are the Breakdown tab's groups of categories, in order.
+var breakdownGroups = []struct {
+	name       string
+	max        int
+	categories []string
+}{
+	{"Structure & Hook", 30, []string{"Headline Quality", "Newsworthy Hook", "Release Date"}},
+	{"Content Quality", 35, []string{"5 Ws Coverage", "Credibility", "Structure"}},
+	{"Professional Quality", 20, []string{"Tone & Readability", "Fluff Avoidance"}},
+	{"Customer Evidence", 15, []string{"Quote Quality"}},
+}
+
+// categoryMax is the most points a category can award.
+func categoryMax(name string) int {
+	for _, c := range parser.AllCategories() {
+		if c.Name == name {
+			return c.Max
+		}
+	}
+	return 0
+}
+
+// renderPendingRow creates a breakdown row for a category still being scored.
+func renderPendingRow(cols breakdownColumns, category string, maxScore int, isSubcategory bool, frame string) string {
+	style := TableRowStyle
+	if isSubcategory {
+		style = TableRowAltStyle
+	}
+	return cols.row(style, category, frame, fmt.Sprintf("%d", maxScore), StatusStyle.Render("scoring..."))
 }
 
 // renderScoreRow creates a single row in the score breakdown table.
-func renderScoreRow(category string, score, maxScore int, isSubcategory bool) string {
+func renderScoreRow(cols breakdownColumns, category string, score, maxScore int, isSubcategory bool) string {
 	style := TableRowStyle
 	if isSubcategory {
 		style = TableRowAltStyle
 	}
 
 	scoreText := GetScoreStyle(score).Render(fmt.Sprintf("%d", score))
-	progressBar := CreateProgressBar(score, maxScore, 20)
+	progressBar := CreateProgressBar(score, maxScore, cols.bar)
 
-	return lipgloss.JoinHorizontal(lipgloss.Left,
-		style.Width(25).Render(category),
-		style.Width(12).Render(scoreText),
-		style.Width(12).Render(fmt.Sprintf("%d", maxScore)),
-		style.Width(30).Render(progressBar),
-	)
+	return cols.row(style, category, scoreText, fmt.Sprintf("%d", maxScore), progressBar)
 }
 
-// RenderStrengths creates a styled strengths section.
-func RenderStrengths(strengths []string) string {
+// RenderStrengths creates a styled strengths section that fits in width
+// columns.
+func RenderStrengths(strengths []string, width int) string {
 	if len(strengths) == 0 {
 		return ""
 	}
@@ -108,11 +195,12 @@ func RenderStrengths(strengths []string) string {
 	}
 
 	content := lipgloss.JoinVertical(lipgloss.Left, items...)
-	return SuccessCardStyle.Width(65).Render(content)
+	return SuccessCardStyle.Width(fitWidth(65, width, SuccessCardStyle.GetHorizontalBorderSize())).Render(content)
 }
 
-// RenderImprovements creates a styled improvements section.
-func RenderImprovements(issues []string) string {
+// RenderImprovements creates a styled improvements section that fits in
+// width columns.
+func RenderImprovements(issues []string, width int) string {
 	if len(issues) == 0 {
 		return ""
 	}
@@ -126,11 +214,12 @@ func RenderImprovements(issues []string) string {
 	}
 
 	content := lipgloss.JoinVertical(lipgloss.Left, items...)
-	return WarningCardStyle.Width(65).Align(lipgloss.Left).Render(content)
+	return WarningCardStyle.Width(fitWidth(65, width, WarningCardStyle.GetHorizontalBorderSize())).Align(lipgloss.Left).Render(content)
 }
 
-// RenderQuoteAnalysis creates a styled quote analysis section.
-func RenderQuoteAnalysis(score parser.PRScore) string {
+// RenderQuoteAnalysis creates a styled quote analysis section that fits in
+// width columns.
+func RenderQuoteAnalysis(score parser.PRScore, width int) string {
 	if len(score.MetricDetails) == 0 {
 		return ""
 	}
@@ -147,6 +236,9 @@ func RenderQuoteAnalysis(score parser.PRScore) string {
 			" ",
 			GetScoreStyle(detail.Score).Render(fmt.Sprintf("%d/10", detail.Score)),
 		)
+		if detail.Voice != "" {
+			header += " " + ListItemStyle.Render(string(detail.Voice))
+		}
 		quoteItems = append(quoteItems, header)
 
 		// Quote text (truncated if too long)
@@ -172,11 +264,76 @@ func RenderQuoteAnalysis(score parser.PRScore) string {
 		))
 	}
 
-	return CardStyle.Render(lipgloss.JoinVertical(lipgloss.Left, items...))
+	return fitCard(CardStyle, lipgloss.JoinVertical(lipgloss.Left, items...), width)
+}
+
+// RenderClaims creates a styled claims traceability section that fits in
+// width columns: each press release claim and the section that
+// substantiates it.
+func RenderClaims(claims []parser.Claim, width int) string {
+	supported := 0
+	for _, c := range claims {
+		if c.Substantiated() {
+			supported++
+		}
+	}
+
+	var items []string
+	items = append(items, SubtitleStyle.Render(fmt.Sprintf("🔗 Claims Traceability (%d of %d substantiated)", supported, len(claims))))
+	for _, c := range claims {
+		claim := fmt.Sprintf("%s (line %d)", c.Metric, c.Line)
+		if c.Substantiated() {
+			items = append(items, SuccessListItemStyle.Render(fmt.Sprintf("✓ %s → %s, line %d", claim, c.Section, c.SectionLine)))
+		} else {
+			items = append(items, WarningListItemStyle.Render("✗ "+claim+" → not substantiated"))
+		}
+	}
+
+	return fitCard(CardStyle, lipgloss.JoinVertical(lipgloss.Left, items...), width)
 }
 
-// RenderLLMFeedback creates a styled LLM feedback section.
-func RenderLLMFeedback(title, feedback string) string {
+// heatColors color a paragraph's border by its parser heat level.
+var heatColors = []lipgloss.Color{mutedColor, successColor, warningColor, errorColor}
+
+// RenderHeatmap creates a styled view of the press release paragraphs, each
+// bordered in the color of its heat level and followed by its findings,
+// that fits in width columns.
+func RenderHeatmap(heatmap []parser.ParagraphHeat, width int) string {
+	// Paragraphs are indented by the card and their own border and padding
+	textWidth := fitWidth(70, width, CardStyle.GetHorizontalFrameSize()+2)
+
+	hot := 0
+	for _, p := range heatmap {
+		if p.Level() >= parser.HeatMedium {
+			hot++
+		}
+	}
+
+	var items []string
+	items = append(items, SubtitleStyle.Render(fmt.Sprintf("🔥 Paragraph Heatmap (%d of %d need work)", hot, len(heatmap))))
+	for _, p := range heatmap {
+		level := p.Level()
+		lines := []string{
+			lipgloss.NewStyle().Foreground(heatColors[level]).Bold(true).Render(p.Label()),
+			lipgloss.NewStyle().Foreground(textColor).Width(textWidth).Render(p.Text),
+		}
+		for _, f := range p.Findings {
+			lines = append(lines, StatusStyle.Render("• "+f.Message))
+		}
+		items = append(items, lipgloss.NewStyle().
+			Border(lipgloss.ThickBorder(), false, false, false, true).
+			BorderForeground(heatColors[level]).
+			PaddingLeft(1).
+			MarginBottom(1).
+			Render(lipgloss.JoinVertical(lipgloss.Left, lines...)))
+	}
+
+	return fitCard(CardStyle, lipgloss.JoinVertical(lipgloss.Left, items...), width)
+}
+
+// RenderLLMFeedback creates a styled LLM feedback section that fits in
+// width columns.
+func RenderLLMFeedback(title, feedback string, width int) string {
 	if feedback == "" {
 		return ""
 	}
@@ -185,11 +342,36 @@ func RenderLLMFeedback(title, feedback string) string {
 	items = append(items, SubtitleStyle.Render("🤖 AI Analysis: "+title))
 	items = append(items, ListItemStyle.Render(feedback))
 
-	return CardStyle.Render(lipgloss.JoinVertical(lipgloss.Left, items...))
+	return fitCard(CardStyle, lipgloss.JoinVertical(lipgloss.Left, items...), width)
 }
 
-// RenderTabs creates a styled tab interface.
-func RenderTabs(tabs []string, activeTab int) string {
+// RenderDisagreements creates a callout for the categories the deterministic
+// scores and the AI review rate far apart, with each side's view, that fits
+// in width columns.
+func RenderDisagreements(disagreements []crosscheck.Disagreement, width int) string {
+	if len(disagreements) == 0 {
+		return ""
+	}
+
+	items := []string{
+		SubtitleStyle.Render("⚖ Disagreement: Rules vs. AI Review"),
+		StatusStyle.Render("Neither score is authoritative here: check both views before acting on either."),
+	}
+	for _, d := range disagreements {
+		items = append(items,
+			"",
+			WarningListItemStyle.Render(d.Summary()),
+			ListItemStyle.Render("Rules: "+d.RulesView()),
+			ListItemStyle.Render("AI review: "+d.AIView()))
+	}
+
+	return fitCard(WarningCardStyle, lipgloss.JoinVertical(lipgloss.Left, items...), width)
+}
+
+// RenderTabs creates a styled tab interface that fits in width columns.
+// When every tab does not fit, the tabs around the active one are shown,
+// with an ellipsis where tabs are left out.
+func RenderTabs(tabs []string, activeTab int, width int) string {
 	var renderedTabs []string
 
 	for i, tab := range tabs {
@@ -200,22 +382,69 @@ func RenderTabs(tabs []string, activeTab int) string {
 		}
 	}
 
-	return lipgloss.JoinHorizontal(lipgloss.Top, renderedTabs...)
+	all := lipgloss.JoinHorizontal(lipgloss.Top, renderedTabs...)
+	if lipgloss.Width(all) <= width || activeTab < 0 || activeTab >= len(tabs) {
+		return all
+	}
+
+	// Widen the window of shown tabs to the right, then to the left, while
+	// it fits beside an ellipsis on each side
+	marker := lipgloss.NewStyle().Foreground(mutedColor).Render("\n" + ellipsis)
+	room := width - 2*lipgloss.Width(marker)
+	lo, hi := activeTab, activeTab+1
+	used := lipgloss.Width(renderedTabs[activeTab])
+	for grown := true; grown; {
+		grown = false
+		if hi < len(tabs) && used+lipgloss.Width(renderedTabs[hi]) <= room {
+			used += lipgloss.Width(renderedTabs[hi])
+			hi++
+			grown = true
+		}
+		if lo > 0 && used+lipgloss.Width(renderedTabs[lo-1]) <= room {
+			lo--
+			used += lipgloss.Width(renderedTabs[lo])
+			grown = true
+		}
+	}
+
+	shown := renderedTabs[lo:hi:hi]
+	if lo > 0 {
+		shown = append([]string{marker}, shown...)
+	}
+	if hi < len(tabs) {
+		shown = append(shown, marker)
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, shown...)
 }
 
-// RenderHelp creates a styled help section.
-func RenderHelp() string {
+// RenderHelp creates a styled help section that fits in width columns.
+func RenderHelp(width int) string {
 	helpText := `
 Navigation:
   ←/→ or h/l    Switch tabs
   ↑/↓ or j/k    Scroll content
+  /             Search issues, strengths, and quotes, and jump to one
+  r             Re-run AI analysis for this tab
+  c             Copy this tab's content (issues, claims, heatmap, feedback, rewrite, questions, scorecard)
+  w/a           Mark a finding as won't fix/acknowledged (Findings tab)
+  u             Restore a dismissed finding (Findings tab)
+  f             Generate AI rewrites (Fixes tab)
+  n/p           Next/previous rewrite (Fixes tab)
+  a             Apply rewrite to the file, keeping a .bak (Fixes tab)
+  g             Suggest questions the FAQ is missing (Questions tab)
+  space         Pick a question (Questions tab)
+  a             Add picked questions as TODO stubs (Questions tab)
+  g             Rate every FAQ answer (FAQ Rubric tab)
+  +/-           Change a category weight (What-If tab)
+  space         Switch a rule off or on (What-If tab)
+  x             Reset weights and rules (What-If tab)
   q or esc      Quit
   ?             Toggle help
 `
-	return HelpStyle.Render(helpText)
+	return fitCard(HelpStyle, helpText, width)
 }
 
-// RenderStatus creates a styled status line.
-func RenderStatus(message string) string {
-	return StatusStyle.Render(message)
+// RenderStatus creates a styled status line, cut off at width columns.
+func RenderStatus(message string, width int) string {
+	return StatusStyle.Render(truncate(message, width))
 }
@@ -0,0 +1,198 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DismissedMsg reports the outcome of dismissing or restoring a finding:
+// the re-scored document, or the error that left the sidecar untouched.
+type DismissedMsg struct {
+	Message  string
+	Status   parser.DismissalStatus // "" when the finding was restored
+	Sections *parser.SpecSections
+	Err      error
+}
+
+// saveDismissals creates a command that writes dismissals to the dismissals
+// sidecar of the file at path and re-scores the file like applyFix.
+func saveDismissals(path string, dismissals []parser.Dismissal, message string, status parser.DismissalStatus, prev parser.SpecSections) tea.Cmd {
+	return func() tea.Msg {
+		if err := parser.WriteDismissals(path, dismissals); err != nil {
+			return DismissedMsg{Err: err}
+		}
+		sections, err := rescore(path, prev)
+		if err != nil {
+			return DismissedMsg{Err: err}
+		}
+		return DismissedMsg{Message: message, Status: status, Sections: sections}
+	}
+}
+
+// findingRow is a row of the Findings tab: an open finding, or a dismissed
+// one with its dismissal.
+type findingRow struct {
+	parser.Finding
+	dismissal *parser.Dismissal
+}
+
+// findingRows lists the open findings followed by the dismissed ones.
+func (m Model) findingRows() []findingRow {
+	var rows []findingRow
+	for _, f := range m.sections.Findings() {
+		rows = append(rows, findingRow{Finding: f})
+	}
+	for _, d := range m.sections.DismissedFindings() {
+		rows = append(rows, findingRow{Finding: d.Finding, dismissal: &d.Dismissal})
+	}
+	return rows
+}
+
+// updateFindings handles the Findings tab keys and the dismissal messages.
+func (m Model) updateFindings(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleFindingKey(msg.String())
+
+	case DismissedMsg:
+		m.loading = false
+		if msg.Err != nil {
+			m.status = fmt.Sprintf("Could not save dismissals: %v", msg.Err)
+			return m, nil
+		}
+		before := m.overallScore()
+		m.sections = *msg.Sections
+		m.sandbox = newSandbox(m.sections)
+		m.findingCursor = min(m.findingCursor, max(0, len(m.findingRows())-1))
+		what := "Restored"
+		if msg.Status != "" {
+			what = "Marked as " + strings.ToLower(msg.Status.Label())
+		}
+		m.status = fmt.Sprintf("%s: %s (saved to %s) - score %d → %d",
+			what, msg.Message, parser.DismissalsFile(m.source), before, m.sections.PRScore.OverallScore)
+	}
+	return m, nil
+}
+
+// handleFindingKey runs a Findings tab key: n and p move the cursor, w marks
+// the finding under it as won't fix, a acknowledges it, and u restores a
+// dismissed finding.
+func (m Model) handleFindingKey(key string) (Model, tea.Cmd) {
+	if m.scoring() {
+		return m, nil
+	}
+	rows := m.findingRows()
+	switch key {
+	case "n":
+		if m.findingCursor < len(rows)-1 {
+			m.findingCursor++
+		}
+		return m, nil
+
+	case "p":
+		if m.findingCursor > 0 {
+			m.findingCursor--
+		}
+		return m, nil
+	}
+
+	switch {
+	case len(rows) == 0:
+		m.status = "No findings to dismiss"
+		return m, nil
+	case m.source == "":
+		m.status = "No source file to keep dismissals next to"
+		return m, nil
+	}
+	row := rows[m.findingCursor]
+	rule, dismissals := row.RuleID, m.sections.Dismissals
+
+	var status parser.DismissalStatus
+	switch key {
+	case "w", "a":
+		if row.dismissal != nil {
+			m.status = "Already dismissed - press u to restore it first"
+			return m, nil
+		}
+		status = parser.DismissAcknowledged
+		if key == "w" {
+			status = parser.DismissWontFix
+		}
+		dismissals = parser.Dismiss(dismissals, parser.Dismissal{
+			Rule: rule, Message: row.Message, Status: status, Date: time.Now().Format(time.DateOnly),
+		})
+
+	case "u":
+		if row.dismissal == nil {
+			m.status = "Not dismissed - press w or a to dismiss it"
+			return m, nil
+		}
+		dismissals = parser.Undismiss(dismissals, row.dismissal.Rule, row.dismissal.Message)
+
+	default:
+		return m, nil
+	}
+	m.loading = true
+	m.status = "Saving dismissals..."
+	return m, saveDismissals(m.source, dismissals, row.Message, status, m.sections)
+}
+
+// renderFindings renders the Findings tab: the open findings, then the
+// dismissed ones, with the cursor.
+func (m Model) renderFindings() string {
+	if m.scoring() {
+		return m.renderScoring("📋 Findings")
+	}
+	rows := m.findingRows()
+	open := len(m.sections.Findings())
+	title := SubtitleStyle.Render(fmt.Sprintf("📋 Findings (%d open, %d dismissed)", open, len(rows)-open))
+	if len(rows) == 0 {
+		return m.card(title + "\n\n" + SuccessListItemStyle.Render("No findings."))
+	}
+
+	width := fitWidth(100, m.windowWidth, CardStyle.GetHorizontalFrameSize())
+	var lines []string
+	for i, r := range rows {
+		if i == open {
+			if i > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, SubtitleStyle.Render("🔕 Dismissed"))
+		}
+		cursor, style := "  ", ListItemStyle
+		if i == m.findingCursor {
+			cursor = "› "
+		}
+		text := fmt.Sprintf("%sline %d · %s · %s", cursor, r.Line, r.Severity, r.Message)
+		if r.dismissal != nil {
+			style = StatusStyle
+			text = fmt.Sprintf("%sline %d · %s · %s", cursor, r.Line, r.dismissal.Status.Label(), r.Message)
+			if r.dismissal.Reason != "" {
+				text += " (" + r.dismissal.Reason + ")"
+			}
+		}
+		lines = append(lines, style.Render(truncate(text, width-style.GetHorizontalPadding())))
+	}
+	help := "w won't fix · a acknowledge · u restore · n/p next/previous"
+	if m.source != "" {
+		help += " · saved to " + parser.DismissalsFile(m.source)
+	}
+	return m.card(title + "\n\n" + strings.Join(lines, "\n") + "\n\n" + StatusStyle.Render(help))
+}
+
+// dismissedText lists the dismissed findings with their status and reason.
+func (m Model) dismissedText() string {
+	var b strings.Builder
+	for _, d := range m.sections.DismissedFindings() {
+		fmt.Fprintf(&b, "- line %d: %s [%s] (%s", d.Line, d.Message, d.RuleID, d.Dismissal.Status.Label())
+		if d.Dismissal.Reason != "" {
+			b.WriteString(": " + d.Dismissal.Reason)
+		}
+		b.WriteString(")\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_Findings(t *testing.T) {
+	doc := "# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today launched Ledger Sync.\n"
+	path := filepath.Join(t.TempDir(), "prfaq.md")
+	if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	sections, err := parser.ParsePRFAQ(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	model := NewModel(*sections).WithSource(path)
+	model.activeTab = TabFindings
+	key := func(m Model, k string) (Model, tea.Cmd) {
+		updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+		return updated.(Model), cmd
+	}
+	run := func(m Model, cmd tea.Cmd) Model {
+		t.Helper()
+		if cmd == nil {
+			t.Fatal("key should return a command")
+		}
+		updated, _ := m.Update(cmd())
+		return updated.(Model)
+	}
+
+	open := len(sections.Findings())
+	if open < 2 {
+		t.Fatalf("document has %d findings, want at least 2", open)
+	}
+	second := sections.Findings()[1]
+
+	// Mark the second finding as won't fix
+	m, _ := key(model, "n")
+	m, cmd := key(m, "w")
+	m = run(m, cmd)
+	if got := len(m.sections.Findings()); got != open-1 {
+		t.Errorf("len(Findings()) = %d, want %d", got, open-1)
+	}
+	dismissed := m.sections.DismissedFindings()
+	if len(dismissed) != 1 || dismissed[0].Message != second.Message || dismissed[0].Dismissal.Status != parser.DismissWontFix {
+		t.Fatalf("DismissedFindings() = %+v, want %q won't fix", dismissed, second.Message)
+	}
+	if !strings.Contains(m.status, "Marked as won't fix") {
+		t.Errorf("status = %q", m.status)
+	}
+	view := m.renderFindings()
+	for _, want := range []string{"1 dismissed", "🔕 Dismissed", "Won't fix · " + second.Message[:10]} {
+		if !strings.Contains(view, want) {
+			t.Errorf("renderFindings() lacks %q", want)
+		}
+	}
+	if ds, err := parser.ReadDismissals(path); err != nil || len(ds) != 1 || ds[0].Rule != second.RuleID {
+		t.Errorf("ReadDismissals() = %+v, %v, want the %s dismissal", ds, err, second.RuleID)
+	}
+
+	// The dismissed finding is listed last; restore it
+	for range open {
+		m, _ = key(m, "n")
+	}
+	m, cmd = key(m, "u")
+	m = run(m, cmd)
+	if got := len(m.sections.Findings()); got != open || len(m.sections.DismissedFindings()) != 0 {
+		t.Errorf("Findings() = %d, dismissed %d, want %d and none", got, len(m.sections.DismissedFindings()), open)
+	}
+	if _, err := os.Stat(parser.DismissalsFile(path)); !os.IsNotExist(err) {
+		t.Errorf("sidecar still exists: %v", err)
+	}
+
+	// Without a source file nothing is written
+	m = NewModel(*sections)
+	m.activeTab = TabFindings
+	m, cmd = key(m, "a")
+	if cmd != nil || !strings.Contains(m.status, "No source file") {
+		t.Errorf("status = %q, cmd %v, want no command", m.status, cmd != nil)
+	}
+}
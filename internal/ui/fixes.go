@@ -0,0 +1,349 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// errStaleFix reports that the source file changed after a fix was generated.
+var errStaleFix = errors.New("source file changed since the rewrite was generated")
+
+// RewriteFunc rewrites a section so it resolves the given issues.
+type RewriteFunc func(section, content string, issues []string) (string, error)
+
+// fix is an AI-suggested rewrite of one section, applied by replacing the
+// section's lines in the source file.
+type fix struct {
+	section  string
+	span     parser.LineSpan
+	original string
+	rewrite  string
+	err      error
+}
+
+// FixReadyMsg carries a generated rewrite, or the error that prevented one.
+type FixReadyMsg struct {
+	Section  string
+	Span     parser.LineSpan
+	Original string
+	Rewrite  string
+	Err      error
+}
+
+// FixAppliedMsg reports the outcome of applying a fix: the re-scored
+// document, or the error that left the source untouched.
+type FixAppliedMsg struct {
+	Section  string
+	Backup   string
+	Sections *parser.SpecSections
+	Err      error
+}
+
+// fixTarget is a section that can be rewritten in place, with the issues
+// its rewrite should resolve.
+type fixTarget struct {
+	section string
+	span    parser.LineSpan
+	content string
+	issues  []string
+}
+
+// fixTargets returns the sections whose source lines are known. Like the
+// editor rewrite action, the press release is rewritten against the quality
+// issues and the FAQ on its own.
+func fixTargets(s parser.SpecSections) []fixTarget {
+	var out []fixTarget
+	if s.Positions.PressRelease.Start > 0 && s.PressRelease != "" {
+		var issues []string
+		if s.PRScore != nil {
+			issues = s.PRScore.QualityBreakdown.Issues
+		}
+		out = append(out, fixTarget{"Press Release", s.Positions.PressRelease, s.PressRelease, issues})
+	}
+	if s.Positions.FAQs.Start > 0 && s.FAQs != "" {
+		out = append(out, fixTarget{"FAQs", s.Positions.FAQs, s.FAQs, nil})
+	}
+	return out
+}
+
+// GenerateFixes creates a command per rewritable section that asks rewrite
+// for a revised version of it.
+func GenerateFixes(sections parser.SpecSections, rewrite RewriteFunc) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, target := range fixTargets(sections) {
+		cmds = append(cmds, func() tea.Msg {
+			rewritten, err := rewrite(target.section, target.content, target.issues)
+			return FixReadyMsg{
+				Section:  target.section,
+				Span:     target.span,
+				Original: target.content,
+				Rewrite:  strings.TrimSpace(rewritten),
+				Err:      err,
+			}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// applyFix creates a command that writes f into the source file at path,
+// keeping the previous contents in path+".bak", then re-parses and re-scores
+// the file with the audience and document type of prev.
+func applyFix(path string, f fix, prev parser.SpecSections) tea.Cmd {
+	return func() tea.Msg {
+		backup, err := applyRewrite(path, f)
+		if err != nil {
+			return FixAppliedMsg{Section: f.section, Err: err}
+		}
+		sections, err := rescore(path, prev)
+		if err != nil {
+			return FixAppliedMsg{Section: f.section, Backup: backup, Err: err}
+		}
+		return FixAppliedMsg{Section: f.section, Backup: backup, Sections: sections}
+	}
+}
+
+// rescore re-parses the edited file at path and scores it with the audience,
+// document type, tone, and scoring mode of prev.
+func rescore(path string, prev parser.SpecSections) (*parser.SpecSections, error) {
+	sections, err := parser.ParsePRFAQ(path)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Audience != "" || prev.DocType != "" || prev.Tone != "" || prev.ScoringMode != "" {
+		sections.Audience = prev.Audience
+		if prev.DocType != "" {
+			sections.DocType = prev.DocType
+		}
+		if prev.Tone != "" {
+			sections.Tone = prev.Tone
+		}
+		sections.ScoringMode, sections.SeverityCosts = prev.ScoringMode, prev.SeverityCosts
+		sections.PRScore = parser.Score(sections)
+	}
+	return sections, nil
+}
+
+// applyRewrite replaces the lines of f.span in the file at path with the
+// rewrite and returns the backup path. The file is left alone when the span
+// lies outside it, since the document was edited after the fix was generated.
+func applyRewrite(path string, f fix) (string, error) {
+	return editLines(path, func(lines []string) ([]string, error) {
+		if f.span.Start < 1 || f.span.End < f.span.Start || f.span.End > len(lines) {
+			return nil, errStaleFix
+		}
+		return append(append(append([]string{}, lines[:f.span.Start-1]...), strings.Split(f.rewrite, "\n")...), lines[f.span.End:]...), nil
+	})
+}
+
+// editLines rewrites the file at path with the lines edit returns, keeping
+// the previous contents in path+".bak", and returns the backup path. The
+// file is left alone when edit fails.
+func editLines(path string, edit func(lines []string) ([]string, error)) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", parser.ErrRead, err)
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // path is user-provided CLI argument
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", parser.ErrRead, err)
+	}
+
+	edited, err := edit(strings.Split(string(data), "\n"))
+	if err != nil {
+		return "", err
+	}
+
+	backup := path + ".bak"
+	if err := os.WriteFile(backup, data, info.Mode().Perm()); err != nil {
+		return "", fmt.Errorf("failed to write backup: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(edited, "\n")), info.Mode().Perm()); err != nil {
+		return backup, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return backup, nil
+}
+
+// diffOp marks a line of a diff as kept, removed, or added.
+type diffOp byte
+
+const (
+	diffKeep   diffOp = ' '
+	diffRemove diffOp = '-'
+	diffAdd    diffOp = '+'
+)
+
+// diffLine is one line of a line diff.
+type diffLine struct {
+	op   diffOp
+	text string
+}
+
+// lineDiff compares a and b line by line using their longest common subsequence.
+func lineDiff(a, b string) []diffLine {
+	x, y := strings.Split(a, "\n"), strings.Split(b, "\n")
+	// lcs[i][j] is the common subsequence length of x[i:] and y[j:]
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []diffLine
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			out = append(out, diffLine{diffKeep, x[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, diffLine{diffRemove, x[i]})
+			i++
+		default:
+			out = append(out, diffLine{diffAdd, y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		out = append(out, diffLine{diffRemove, x[i]})
+	}
+	for ; j < len(y); j++ {
+		out = append(out, diffLine{diffAdd, y[j]})
+	}
+	return out
+}
+
+// RenderDiff renders a line diff with removed lines in red and added lines in green.
+func RenderDiff(original, rewrite string) string {
+	removed := lipgloss.NewStyle().Foreground(lipgloss.Color("#EF4444"))
+	added := lipgloss.NewStyle().Foreground(lipgloss.Color("#10B981"))
+	var lines []string
+	for _, l := range lineDiff(original, rewrite) {
+		text := string(l.op) + " " + l.text
+		switch l.op {
+		case diffRemove:
+			text = removed.Render(text)
+		case diffAdd:
+			text = added.Render(text)
+		}
+		lines = append(lines, text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// updateFixes handles the Fixes tab keys and the fix messages: f generates
+// rewrites, n and p select one, and a applies the selected one.
+func (m Model) updateFixes(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleFixKey(msg.String())
+
+	case FixReadyMsg:
+		m.loading = false
+		m.fixes = append(m.fixes, fix{section: msg.Section, span: msg.Span, original: msg.Original, rewrite: msg.Rewrite, err: msg.Err})
+		m.status = fmt.Sprintf("Rewrite of %s ready - press a to apply", msg.Section)
+		if msg.Err != nil {
+			m.status = fmt.Sprintf("Rewrite of %s failed: %v", msg.Section, msg.Err)
+		}
+
+	case FixAppliedMsg:
+		m.loading = false
+		if msg.Err != nil {
+			m.status = fmt.Sprintf("Could not apply %s rewrite: %v", msg.Section, msg.Err)
+			return m, nil
+		}
+		before := m.overallScore()
+		m.sections = *msg.Sections
+		m.sandbox = newSandbox(m.sections)
+		// The remaining rewrites were made against the old line numbers
+		m.fixes, m.fixCursor = nil, 0
+		m.status = fmt.Sprintf("Applied %s rewrite (backup: %s) - score %d → %d",
+			msg.Section, msg.Backup, before, m.sections.PRScore.OverallScore)
+	}
+	return m, nil
+}
+
+// handleFixKey runs a Fixes tab key.
+func (m Model) handleFixKey(key string) (Model, tea.Cmd) {
+	switch key {
+	case "f":
+		if llm.Offline() {
+			m.status = "Offline mode: AI rewrites are disabled"
+			return m, nil
+		}
+		if len(fixTargets(m.sections)) == 0 {
+			m.status = "No press release or FAQ to rewrite"
+			return m, nil
+		}
+		m.fixes, m.fixCursor = nil, 0
+		m.loading = true
+		m.status = "Generating rewrites with AI..."
+		return m, GenerateFixes(m.sections, m.rewrite)
+
+	case "n":
+		if m.fixCursor < len(m.fixes)-1 {
+			m.fixCursor++
+			m.scrollPos = 0
+		}
+
+	case "p":
+		if m.fixCursor > 0 {
+			m.fixCursor--
+			m.scrollPos = 0
+		}
+
+	case "a":
+		switch {
+		case len(m.fixes) == 0:
+			m.status = "No rewrite to apply - press f to generate one"
+		case m.source == "":
+			m.status = "No source file to apply the rewrite to"
+		case m.fixes[m.fixCursor].err != nil:
+			m.status = "The selected rewrite failed and cannot be applied"
+		default:
+			m.loading = true
+			m.status = "Applying rewrite..."
+			return m, applyFix(m.source, m.fixes[m.fixCursor], m.sections)
+		}
+	}
+	return m, nil
+}
+
+// renderFixes renders the Fixes tab: the selected rewrite as a diff against
+// the current section text.
+func (m Model) renderFixes() string {
+	title := SubtitleStyle.Render("🛠 Fixes")
+	switch {
+	case len(m.fixes) == 0 && llm.Offline():
+		return m.card(title + "\n\n" +
+			StatusStyle.Render("Offline mode: AI rewrites are disabled."))
+	case len(m.fixes) == 0:
+		return m.card(title + "\n\n" +
+			StatusStyle.Render("Press f to generate AI rewrites of the press release and FAQ."))
+	}
+
+	f := m.fixes[m.fixCursor]
+	heading := fmt.Sprintf("%s (lines %d-%d) — %d of %d", f.section, f.span.Start, f.span.End, m.fixCursor+1, len(m.fixes))
+	if f.err != nil {
+		return m.card(title + "\n\n" + ListItemStyle.Render(heading) + "\n\n" +
+			WarningListItemStyle.Render(fmt.Sprintf("Rewrite failed: %v", f.err)))
+	}
+	return m.card(title + "\n\n" + ListItemStyle.Render(heading) + "\n\n" +
+		RenderDiff(f.original, f.rewrite) + "\n\n" +
+		StatusStyle.Render("a apply (writes a .bak backup) · n/p next/previous · f regenerate"))
+}
@@ -0,0 +1,161 @@
+package ui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestLineDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"unchanged", "a\nb", "a\nb", "  a|  b"},
+		{"replaced line", "a\nb\nc", "a\nB\nc", "  a|- b|+ B|  c"},
+		{"appended", "a", "a\nb", "  a|+ b"},
+		{"removed", "a\nb", "b", "- a|  b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, l := range lineDiff(tt.a, tt.b) {
+				got = append(got, string(l.op)+" "+l.text)
+			}
+			if strings.Join(got, "|") != tt.want {
+				t.Errorf("lineDiff() = %q, want %q", strings.Join(got, "|"), tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyRewrite(t *testing.T) {
+	tests := []struct {
+		name    string
+		span    parser.LineSpan
+		want    string
+		wantErr error
+	}{
+		{"middle lines", parser.LineSpan{Start: 2, End: 3}, "one\nNEW\nfour", nil},
+		{"past the end", parser.LineSpan{Start: 3, End: 9}, "", errStaleFix},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "prfaq.md")
+			original := "one\ntwo\nthree\nfour"
+			if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			backup, err := applyRewrite(path, fix{span: tt.span, rewrite: "NEW"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("applyRewrite() error = %v, want %v", err, tt.wantErr)
+			}
+			data, _ := os.ReadFile(path) //nolint:gosec // test file in a temp dir
+			if tt.wantErr != nil {
+				if string(data) != original {
+					t.Errorf("file = %q, want it unchanged", data)
+				}
+				return
+			}
+			if string(data) != tt.want {
+				t.Errorf("file = %q, want %q", data, tt.want)
+			}
+			saved, _ := os.ReadFile(backup) //nolint:gosec // test file in a temp dir
+			if string(saved) != original {
+				t.Errorf("backup = %q, want %q", saved, original)
+			}
+		})
+	}
+}
+
+func TestModel_Fixes(t *testing.T) {
+	doc := "# CloudSync\n\n## Press Release\n\nAcme launched a synergies paradigm.\n\n## FAQ\n\n### Q: Why?\nBecause.\n"
+	path := filepath.Join(t.TempDir(), "prfaq.md")
+	if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	sections, err := parser.ParsePRFAQ(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	model := NewModel(*sections).WithSource(path)
+	model.activeTab = TabFixes
+	model.rewrite = func(section, content string, _ []string) (string, error) {
+		if section == "FAQs" {
+			return "", errors.New("rate limited")
+		}
+		return "SEATTLE, WA — Aug 20, 2024 — Acme today launched CloudSync, cutting sync time 40%.", nil
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if cmd == nil {
+		t.Fatal("f should return a command")
+	}
+	m := updated.(Model)
+	for _, target := range fixTargets(m.sections) {
+		msg := FixReadyMsg{Section: target.section, Span: target.span, Original: target.content}
+		msg.Rewrite, msg.Err = m.rewrite(target.section, target.content, target.issues)
+		updated, _ = m.Update(msg)
+		m = updated.(Model)
+	}
+	if len(m.fixes) != 2 {
+		t.Fatalf("fixes = %d, want 2", len(m.fixes))
+	}
+	if view := m.renderFixes(); !strings.Contains(view, "+ SEATTLE, WA") {
+		t.Errorf("renderFixes() = %q, want the rewrite as an added line", view)
+	}
+
+	// The failed FAQ rewrite cannot be applied
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	m = updated.(Model)
+	if _, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")}); cmd != nil {
+		t.Error("applying a failed rewrite should not return a command")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	m = updated.(Model)
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if cmd == nil {
+		t.Fatal("a should return a command")
+	}
+	m = updated.(Model)
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	if !strings.Contains(m.sections.PressRelease, "cutting sync time 40%") {
+		t.Errorf("PressRelease = %q, want the rewrite after re-parsing", m.sections.PressRelease)
+	}
+	if len(m.fixes) != 0 {
+		t.Errorf("fixes = %d, want them cleared after applying", len(m.fixes))
+	}
+	if !strings.Contains(m.status, "Applied Press Release rewrite") {
+		t.Errorf("status = %q, want the applied rewrite", m.status)
+	}
+	if _, err := os.Stat(path + ".bak"); err != nil {
+		t.Errorf("backup not written: %v", err)
+	}
+}
+
+func TestModel_Fixes_Offline(t *testing.T) {
+	llm.SetOffline(true)
+	defer llm.SetOffline(false)
+
+	model := NewModel(parser.SpecSections{PressRelease: "Content", PRScore: &parser.PRScore{}})
+	model.activeTab = TabFixes
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if cmd != nil {
+		t.Error("f should not return a command offline")
+	}
+	if m := updated.(Model); !strings.Contains(m.status, "Offline") {
+		t.Errorf("status = %q, want the offline notice", m.status)
+	}
+}
@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ellipsis marks text cut off at the edge of the terminal.
+const ellipsis = "…"
+
+// minWidth is the narrowest terminal the layouts shrink to; narrower ones
+// have every line truncated instead.
+const minWidth = 40
+
+// fitWidth is the width of a block that is natural columns wide on a
+// terminal width columns wide, keeping frame columns for its border and
+// margins.
+func fitWidth(natural, width, frame int) int {
+	width = max(width, minWidth)
+	return min(natural, width-frame)
+}
+
+// fitCard renders content in style, wrapped to fit width columns when it
+// would be wider.
+func fitCard(style lipgloss.Style, content string, width int) string {
+	out := style.Render(content)
+	if lipgloss.Width(out) <= width {
+		return out
+	}
+	frame := style.GetHorizontalBorderSize() + style.GetHorizontalMargins()
+	return style.Width(fitWidth(lipgloss.Width(out), width, frame)).Render(content)
+}
+
+// truncate cuts s to width columns, ending it with an ellipsis when it is
+// cut. Styling in s is kept.
+func truncate(s string, width int) string {
+	if lipgloss.Width(s) <= width {
+		return s
+	}
+	if width <= 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().MaxWidth(width-1).Render(s) + ellipsis
+}
+
+// truncateLines truncates every line of s to width columns. A terminal
+// that reports no width leaves s as is.
+func truncateLines(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = truncate(line, width)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// card renders content in a card that fits the terminal.
+func (m Model) card(content string) string {
+	return fitCard(CardStyle, content, m.windowWidth)
+}
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestModel_View_Fits(t *testing.T) {
+	llm.SetOffline(true)
+	defer llm.SetOffline(false)
+
+	sections, err := parser.Parse(strings.NewReader(scoringDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewModel(*sections)
+	m.prFeedback = strings.Repeat("The hook buries the customer benefit below the launch details. ", 8)
+
+	for _, size := range []tea.WindowSizeMsg{{Width: 80, Height: 24}, {Width: 200, Height: 60}} {
+		next, _ := m.Update(size)
+		sized := next.(Model)
+		for _, help := range []bool{false, true} {
+			sized.showHelp = help
+			for tab := range sized.tabs {
+				sized.activeTab = Tab(tab)
+				// The tab must fit on its own, not only once the view is cut off
+				for name, view := range map[string]string{"View()": sized.View(), "renderActiveTab()": sized.renderActiveTab()} {
+					for _, line := range strings.Split(view, "\n") {
+						if w := lipgloss.Width(line); w > size.Width {
+							t.Errorf("%dx%d %s of %s is %d columns wide:\n%s", size.Width, size.Height, name, sized.tabs[tab], w, line)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestRenderScoreBreakdown_Width(t *testing.T) {
+	breakdown := parser.PRQualityBreakdown{HeadlineScore: 8, HookScore: 12, QuoteScore: 10}
+	tests := []struct {
+		width        int
+		wantProgress bool
+	}{
+		{200, true},
+		{80, true},
+		{40, false},
+	}
+	for _, tt := range tests {
+		got := RenderScoreBreakdown(breakdown, tt.width)
+		if w := lipgloss.Width(got); w > tt.width {
+			t.Errorf("RenderScoreBreakdown(%d) is %d columns wide", tt.width, w)
+		}
+		if strings.Contains(got, "Progress") != tt.wantProgress {
+			t.Errorf("RenderScoreBreakdown(%d) progress column shown = %v, want %v", tt.width, !tt.wantProgress, tt.wantProgress)
+		}
+	}
+	// Wide terminals keep the table at its full width
+	if w := lipgloss.Width(RenderScoreBreakdown(breakdown, 200)); w != 87 {
+		t.Errorf("RenderScoreBreakdown(200) is %d columns wide, want 87", w)
+	}
+}
+
+func TestRenderTabs_Narrow(t *testing.T) {
+	tabs := NewModel(parser.SpecSections{}).tabs
+	for active := range tabs {
+		got := RenderTabs(tabs, active, 60)
+		if w := lipgloss.Width(got); w > 60 {
+			t.Errorf("RenderTabs(%d) is %d columns wide", active, w)
+		}
+		if !strings.Contains(got, tabs[active]) {
+			t.Errorf("RenderTabs(%d) lacks the active tab %q:\n%s", active, tabs[active], got)
+		}
+		if !strings.Contains(got, ellipsis) {
+			t.Errorf("RenderTabs(%d) does not mark the tabs left out:\n%s", active, got)
+		}
+	}
+	if got := RenderTabs(tabs, 0, 200); strings.Contains(got, ellipsis) {
+		t.Errorf("RenderTabs() on a wide terminal left tabs out:\n%s", got)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		s     string
+		width int
+		want  string
+	}{
+		{"Overview", 10, "Overview"},
+		{"Overview", 8, "Overview"},
+		{"Overview", 5, "Over…"},
+		{"📄 Overview", 6, "📄 Ov…"},
+		{"Overview", 0, ""},
+	}
+	for _, tt := range tests {
+		got := truncate(tt.s, tt.width)
+		if got != tt.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/bordenet/pr-faq-validator/internal/clipboard"
+	"github.com/bordenet/pr-faq-validator/internal/crosscheck"
 	"github.com/bordenet/pr-faq-validator/internal/llm"
 	"github.com/bordenet/pr-faq-validator/internal/parser"
 	tea "github.com/charmbracelet/bubbletea"
@@ -18,18 +20,39 @@ const (
 	TabOverview Tab = iota
 	// TabBreakdown shows detailed score breakdown.
 	TabBreakdown
+	// TabFindings lists every finding and marks findings as won't fix or
+	// acknowledged.
+	TabFindings
 	// TabQuotes shows quote analysis.
 	TabQuotes
+	// TabClaims maps press release claims to where the document substantiates them.
+	TabClaims
+	// TabHeatmap colors press release paragraphs by the findings they contain.
+	TabHeatmap
 	// TabFeedback shows AI feedback.
 	TabFeedback
+	// TabFixes previews AI rewrites as diffs and applies them to the source.
+	TabFixes
+	// TabQuestions suggests questions the FAQ does not answer and adds them as stubs.
+	TabQuestions
+	// TabRubric rates every FAQ answer on a 1-5 rubric and aggregates the
+	// ratings into a FAQ Quality score.
+	TabRubric
+	// TabWhatIf recomputes the score with rules switched off and weights changed.
+	TabWhatIf
 )
 
 // Model represents the TUI application state.
 type Model struct {
 	// Core data
-	sections    parser.SpecSections
-	prFeedback  string
-	faqFeedback string
+	sections        parser.SpecSections
+	selected        parser.SectionSet // sections sent for AI review; nil for all
+	prFeedback      string
+	faqFeedback     string
+	metricsFeedback string
+	// disagreements are the categories the press release review rates far
+	// from the deterministic scores
+	disagreements []crosscheck.Disagreement
 
 	// UI state
 	activeTab    Tab
@@ -45,25 +68,97 @@ type Model struct {
 	// Status
 	status  string
 	loading bool
+
+	// session reviews every section in one cached AI conversation
+	session *llm.Session
+
+	// copy puts the active tab's content on the clipboard
+	copy CopyFunc
+
+	// Fixes
+	source    string // PR-FAQ file fixes are applied to; "" disables applying
+	rewrite   RewriteFunc
+	fixes     []fix
+	fixCursor int
+
+	// Findings
+	findingCursor int
+
+	// Questions
+	suggest        SuggestFunc
+	questions      []question
+	questionCursor int
+
+	// FAQ Rubric
+	rate    RateFunc
+	ratings []llm.AnswerRating
+
+	// What-If
+	sandbox      *parser.Sandbox
+	whatIfCursor int
+
+	// Search over issues, strengths, and quotes; nil when closed
+	search *search
+
+	// Background scoring of a document opened unscored
+	categories map[string]parser.CategoryScore // categories scored so far
+	spinner    int                             // frame of the scoring spinner
 }
 
-// NewModel creates a new TUI model.
+// NewModel creates a new TUI model. Sections without a PRScore are scored
+// in the background once the TUI starts.
 func NewModel(sections parser.SpecSections) Model {
 	return Model{
 		sections:     sections,
 		activeTab:    TabOverview,
 		showHelp:     false,
-		tabs:         []string{"Overview", "Breakdown", "Quotes", "AI Feedback"},
+		tabs:         []string{"Overview", "Breakdown", "Findings", "Quotes", "Claims", "Heatmap", "AI Feedback", "Fixes", "Questions", "FAQ Rubric", "What-If"},
 		windowWidth:  80,
 		windowHeight: 24,
 		status:       "Ready",
+		session:      llm.NewSession(),
+		rewrite:      llm.RewriteSection,
+		suggest:      llm.SuggestQuestions,
+		rate:         llm.RateAnswers,
+		copy:         clipboard.Copy,
+		sandbox:      newSandbox(sections),
 	}
 }
 
-// Init initializes the TUI model.
+// WithSections restricts AI review to the selected sections.
+func (m Model) WithSections(selected parser.SectionSet) Model {
+	m.selected = selected
+	return m
+}
+
+// WithSource sets the PR-FAQ file that fixes from the Fixes tab and questions
+// from the Questions tab are written to.
+func (m Model) WithSource(path string) Model {
+	m.source = path
+	return m
+}
+
+// Sections returns the document as the TUI has it: scored once background
+// scoring finishes, and re-parsed after fixes and questions are applied.
+func (m Model) Sections() parser.SpecSections {
+	return m.sections
+}
+
+// Init initializes the TUI model: it starts scoring an unscored document,
+// so the TUI is up before the analysis of a large one finishes, and AI
+// analysis unless offline.
 func (m Model) Init() tea.Cmd {
-	// Return a command to start AI analysis
-	return StartAIAnalysis(m.sections)
+	var cmds []tea.Cmd
+	if m.scoring() {
+		cmds = append(cmds, ScoreInBackground(m.sections), tickSpinner())
+	}
+	if !llm.Offline() {
+		cmds = append(cmds, StartAIAnalysis(m.sections, m.selected))
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	return tea.Batch(cmds...)
 }
 
 // Update handles TUI events and state changes.
@@ -75,14 +170,26 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.search != nil {
+			return m.updateSearch(msg)
+		}
 		switch msg.String() {
 		case "ctrl+c", "q", "esc":
 			return m, tea.Quit
 
+		case "/":
+			return m.startSearch(), nil
+
 		case "?":
 			m.showHelp = !m.showHelp
 			return m, nil
 
+		case "r":
+			return m.rerunAnalysis()
+
+		case "c":
+			return m.copyTab()
+
 		case "left", "h":
 			if m.activeTab > 0 {
 				m.activeTab--
@@ -110,14 +217,70 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.scrollPos++
 			}
 			return m, nil
+
+		case "f", "n", "p", "a":
+			if m.activeTab == TabFindings {
+				return m.updateFindings(msg)
+			}
+			if m.activeTab == TabFixes {
+				return m.updateFixes(msg)
+			}
+			if m.activeTab == TabQuestions {
+				return m.updateQuestions(msg)
+			}
+			if m.activeTab == TabWhatIf {
+				return m.handleWhatIfKey(msg.String())
+			}
+
+		case "g", " ":
+			if m.activeTab == TabQuestions {
+				return m.updateQuestions(msg)
+			}
+			if m.activeTab == TabRubric {
+				return m.updateRubric(msg)
+			}
+			if m.activeTab == TabWhatIf {
+				return m.handleWhatIfKey(msg.String())
+			}
+
+		case "w", "u":
+			if m.activeTab == TabFindings {
+				return m.updateFindings(msg)
+			}
+
+		case "+", "=", "-", "x":
+			if m.activeTab == TabWhatIf {
+				return m.handleWhatIfKey(msg.String())
+			}
 		}
 
+	case FixReadyMsg, FixAppliedMsg:
+		return m.updateFixes(msg)
+
+	case DismissedMsg:
+		return m.updateFindings(msg)
+
+	case QuestionsReadyMsg, QuestionsInsertedMsg:
+		return m.updateQuestions(msg)
+
+	case RubricReadyMsg:
+		return m.updateRubric(msg)
+
+	case CopiedMsg:
+		return m.updateCopied(msg), nil
+
+	case CategoryScoredMsg, ScoreReadyMsg, spinnerTickMsg:
+		return m.updateScoring(msg)
+
 	case SetFeedbackMsg:
 		switch msg.Section {
 		case "Press Release":
 			m.prFeedback = msg.Feedback
+			m.disagreements = crosscheck.Compare(&m.sections, msg.Review)
 		case "FAQs":
 			m.faqFeedback = msg.Feedback
+		case "Success Metrics":
+			m.metricsFeedback = msg.Feedback
 		}
 
 		// Set completion status
@@ -143,37 +306,53 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case AIAnalysisMsg:
 		m.loading = true
 		m.status = fmt.Sprintf("Analyzing %s with AI...", msg.Section)
-		return m, AnalyzeSection(msg.Section, msg.Content)
+		return m, AnalyzeSection(m.session, msg.Section, msg.Content)
 	}
 
 	return m, nil
 }
 
+// rerunAnalysis requests AI feedback again for the current tab: every
+// selected section on the AI Feedback tab, and the press release that the
+// other tabs describe. It starts a new session and reloads the prompt files,
+// so nothing from the earlier analysis is reused.
+func (m Model) rerunAnalysis() (Model, tea.Cmd) {
+	if llm.Offline() {
+		m.status = "Offline mode: AI analysis is disabled"
+		return m, nil
+	}
+
+	selected := m.selected
+	if m.activeTab != TabFeedback {
+		selected = parser.SectionSet{"pr": true}
+	}
+	llm.ReloadPrompts()
+	m.session = llm.NewSession()
+	m.status = "Re-running AI analysis..."
+	return m, StartAIAnalysis(m.sections, selected)
+}
+
 // View renders the TUI interface.
 func (m Model) View() string {
 	var content []string
 
 	// Header
-	header := RenderHeader(m.sections.Title, m.sections.PRScore.OverallScore)
+	header := RenderHeader(m.sections.Title, m.overallScore(), m.windowWidth)
+	if m.scoring() {
+		header = RenderScoringHeader(m.sections.Title, m.spinnerFrame(), m.windowWidth)
+	}
 	content = append(content, header)
 	content = append(content, "") // Add spacing
 
 	// Tabs
-	tabs := RenderTabs(m.tabs, int(m.activeTab))
+	tabs := RenderTabs(m.tabs, int(m.activeTab), m.windowWidth)
 	content = append(content, tabs)
 	content = append(content, "") // Add spacing
 
 	// Content based on active tab
-	var tabContent string
-	switch m.activeTab {
-	case TabOverview:
-		tabContent = m.renderOverview()
-	case TabBreakdown:
-		tabContent = m.renderBreakdown()
-	case TabQuotes:
-		tabContent = m.renderQuotes()
-	case TabFeedback:
-		tabContent = m.renderFeedback()
+	tabContent := m.renderActiveTab()
+	if m.search != nil {
+		tabContent = m.renderSearch()
 	}
 
 	// Apply scrolling to content
@@ -199,23 +378,61 @@ func (m Model) View() string {
 	// Help section
 	if m.showHelp {
 		content = append(content, "")
-		content = append(content, RenderHelp())
+		content = append(content, RenderHelp(m.windowWidth))
 	}
 
 	// Status line
 	content = append(content, "")
-	statusLine := RenderStatus(m.status)
+	statusLine := RenderStatus(m.status, m.windowWidth)
 	if m.loading {
-		statusLine = RenderStatus("🔄 " + m.status)
+		statusLine = RenderStatus("🔄 "+m.status, m.windowWidth)
 	}
 	content = append(content, statusLine)
 
-	return lipgloss.JoinVertical(lipgloss.Left, content...)
+	// Anything still wider than the terminal is cut off rather than wrapped
+	return truncateLines(lipgloss.JoinVertical(lipgloss.Left, content...), m.windowWidth)
+}
+
+// renderActiveTab renders the content of the active tab.
+func (m Model) renderActiveTab() string {
+	switch m.activeTab {
+	case TabOverview:
+		return m.renderOverview()
+	case TabBreakdown:
+		return m.renderBreakdown()
+	case TabFindings:
+		return m.renderFindings()
+	case TabQuotes:
+		return m.renderQuotes()
+	case TabClaims:
+		return m.renderClaims()
+	case TabHeatmap:
+		return m.renderHeatmap()
+	case TabFeedback:
+		return m.renderFeedback()
+	case TabFixes:
+		return m.renderFixes()
+	case TabQuestions:
+		return m.renderQuestions()
+	case TabRubric:
+		return m.renderRubric()
+	case TabWhatIf:
+		return m.renderWhatIf()
+	}
+	return ""
 }
 
 // renderOverview renders the overview tab.
 func (m Model) renderOverview() string {
 	var sections []string
+	if m.scoring() {
+		return m.card(lipgloss.JoinVertical(lipgloss.Left,
+			SubtitleStyle.Render("📊 Summary"),
+			ListItemStyle.Render("Overall Score: "+m.spinnerFrame()+" scoring..."),
+			ListItemStyle.Render(fmt.Sprintf("Press Release: %s", m.getStatusText(len(m.sections.PressRelease) > 0))),
+			ListItemStyle.Render(fmt.Sprintf("FAQ Section: %s", m.getStatusText(len(m.sections.FAQs) > 0))),
+		))
+	}
 
 	// Quick summary with better formatting
 	scoreText := GetScoreStyle(m.sections.PRScore.OverallScore).Render(fmt.Sprintf("%d/100", m.sections.PRScore.OverallScore))
@@ -227,8 +444,12 @@ func (m Model) renderOverview() string {
 		ListItemStyle.Render(fmt.Sprintf("FAQ Section: %s", m.getStatusText(len(m.sections.FAQs) > 0))),
 		ListItemStyle.Render(fmt.Sprintf("Quotes Found: %d", m.sections.PRScore.TotalQuotes)),
 	)
+	if sev := m.sections.PRScore.Severity; sev != nil {
+		summaryContent = lipgloss.JoinVertical(lipgloss.Left, summaryContent,
+			ListItemStyle.Render("Scored by finding severity: "+sev.Note()))
+	}
 
-	summary := CardStyle.Render(summaryContent)
+	summary := m.card(summaryContent)
 	sections = append(sections, summary)
 
 	// Top strengths
@@ -237,7 +458,7 @@ func (m Model) renderOverview() string {
 		if len(topStrengths) > 3 {
 			topStrengths = topStrengths[:3]
 		}
-		sections = append(sections, RenderStrengths(topStrengths))
+		sections = append(sections, RenderStrengths(topStrengths, m.windowWidth))
 	}
 
 	// Top improvements
@@ -246,7 +467,7 @@ func (m Model) renderOverview() string {
 		if len(topImprovements) > 3 {
 			topImprovements = topImprovements[:3]
 		}
-		sections = append(sections, RenderImprovements(topImprovements))
+		sections = append(sections, RenderImprovements(topImprovements, m.windowWidth))
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, sections...)
@@ -254,18 +475,49 @@ func (m Model) renderOverview() string {
 
 // renderBreakdown renders the detailed score breakdown tab.
 func (m Model) renderBreakdown() string {
-	return RenderScoreBreakdown(m.sections.PRScore.QualityBreakdown)
+	if m.scoring() {
+		return RenderPartialBreakdown(m.categories, m.spinnerFrame(), m.windowWidth)
+	}
+	return RenderScoreBreakdown(m.sections.PRScore.QualityBreakdown, m.windowWidth)
 }
 
 // renderQuotes renders the quotes analysis tab.
 func (m Model) renderQuotes() string {
+	if m.scoring() {
+		return m.renderScoring("💬 Quote Analysis")
+	}
 	if len(m.sections.PRScore.MetricDetails) == 0 {
-		return CardStyle.Render(
+		return m.card(
 			SubtitleStyle.Render("💬 Quote Analysis") + "\n\n" +
 				WarningListItemStyle.Render("No quotes found in the press release section."))
 	}
 
-	return RenderQuoteAnalysis(*m.sections.PRScore)
+	return RenderQuoteAnalysis(*m.sections.PRScore, m.windowWidth)
+}
+
+// renderClaims renders the claims traceability tab.
+func (m Model) renderClaims() string {
+	claims := m.sections.Claims()
+	if len(claims) == 0 {
+		return m.card(
+			SubtitleStyle.Render("🔗 Claims Traceability") + "\n\n" +
+				ListItemStyle.Render("No quantitative claims found in the press release section."))
+	}
+	return RenderClaims(claims, m.windowWidth)
+}
+
+// renderHeatmap renders the paragraph heatmap tab.
+func (m Model) renderHeatmap() string {
+	if m.scoring() {
+		return m.renderScoring("🔥 Paragraph Heatmap")
+	}
+	heatmap := m.sections.Heatmap()
+	if len(heatmap) == 0 {
+		return m.card(
+			SubtitleStyle.Render("🔥 Paragraph Heatmap") + "\n\n" +
+				ListItemStyle.Render("No press release paragraphs found."))
+	}
+	return RenderHeatmap(heatmap, m.windowWidth)
 }
 
 // renderFeedback renders the AI feedback tab.
@@ -273,15 +525,29 @@ func (m Model) renderFeedback() string {
 	var sections []string
 
 	if m.prFeedback != "" {
-		sections = append(sections, RenderLLMFeedback("Press Release", m.prFeedback))
+		sections = append(sections, RenderLLMFeedback("Press Release", m.prFeedback, m.windowWidth))
+	}
+
+	if len(m.disagreements) > 0 {
+		sections = append(sections, RenderDisagreements(m.disagreements, m.windowWidth))
 	}
 
 	if m.faqFeedback != "" {
-		sections = append(sections, RenderLLMFeedback("FAQ", m.faqFeedback))
+		sections = append(sections, RenderLLMFeedback("FAQ", m.faqFeedback, m.windowWidth))
+	}
+
+	if m.metricsFeedback != "" {
+		sections = append(sections, RenderLLMFeedback("Success Metrics", m.metricsFeedback, m.windowWidth))
+	}
+
+	if len(sections) == 0 && llm.Offline() {
+		return m.card(
+			SubtitleStyle.Render("🤖 AI Feedback") + "\n\n" +
+				StatusStyle.Render("Offline mode: AI analysis is disabled. Scores above are deterministic."))
 	}
 
 	if len(sections) == 0 {
-		return CardStyle.Render(
+		return m.card(
 			SubtitleStyle.Render("🤖 AI Feedback") + "\n\n" +
 				StatusStyle.Render("AI analysis will appear here once processing is complete."))
 	}
@@ -303,6 +569,9 @@ func (m Model) getStatusText(present bool) string {
 type SetFeedbackMsg struct {
 	Section  string
 	Feedback string
+	// Review is the parsed review, with its scores; nil when the review
+	// failed or only text is known.
+	Review *llm.Feedback
 }
 
 // SetStatusMsg is a message to update the status text.
@@ -338,39 +607,40 @@ type AIAnalysisMsg struct {
 	Content string
 }
 
-// StartAIAnalysis creates a command to start AI analysis.
-func StartAIAnalysis(sections parser.SpecSections) tea.Cmd {
-	return tea.Batch(
-		// Start PR analysis if available
-		func() tea.Msg {
-			if sections.PressRelease != "" {
-				return AIAnalysisMsg{Section: "Press Release", Content: sections.PressRelease}
-			}
-			return nil
-		},
-		// Start FAQ analysis if available
-		func() tea.Msg {
-			if sections.FAQs != "" {
-				return AIAnalysisMsg{Section: "FAQs", Content: sections.FAQs}
+// StartAIAnalysis creates a command to start AI analysis of every selected
+// section that has content. A nil selection analyzes all sections.
+func StartAIAnalysis(sections parser.SpecSections, selected parser.SectionSet) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, sec := range parser.ReviewSections {
+		if !selected.Has(sec.Key) {
+			continue
+		}
+		msg := AIAnalysisMsg{Section: sec.Name, Content: sections.Content(sec.Key)}
+		// Start analysis if the section is present
+		cmds = append(cmds, func() tea.Msg {
+			if msg.Content == "" {
+				return nil
 			}
-			return nil
-		},
-	)
+			return msg
+		})
+	}
+	return tea.Batch(cmds...)
 }
 
-// AnalyzeSection creates a command to analyze a specific section.
-func AnalyzeSection(section, content string) tea.Cmd {
+// AnalyzeSection creates a command to analyze a specific section within session.
+func AnalyzeSection(session *llm.Session, section, content string) tea.Cmd {
 	return func() tea.Msg {
-		feedback, err := llm.AnalyzeSection(section, content)
+		feedback, err := session.AnalyzeSection(section, content)
 		if err != nil {
 			return SetFeedbackMsg{
 				Section:  section,
-				Feedback: fmt.Sprintf("AI analysis unavailable: %v\n\nTo enable AI feedback:\n1. Set your OpenAI API key: export OPENAI_API_KEY=your_key_here\n2. Restart the application\n\nNote: The deterministic scoring above provides comprehensive quality analysis without requiring an API key.", err),
+				Feedback: fmt.Sprintf("AI analysis unavailable: %v\n\nTo enable AI feedback:\n1. Set your API key: export OPENAI_API_KEY=your_key_here (or ANTHROPIC_API_KEY or GEMINI_API_KEY)\n2. Restart the application\n\nNote: The deterministic scoring above provides comprehensive quality analysis without requiring an API key.", err),
 			}
 		}
 		return SetFeedbackMsg{
 			Section:  section,
 			Feedback: feedback.Comments,
+			Review:   feedback,
 		}
 	}
 }
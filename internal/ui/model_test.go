@@ -1,8 +1,12 @@
 package ui
 
 import (
+	"context"
+	"strings"
 	"testing"
 
+	"github.com/bordenet/pr-faq-validator/internal/crosscheck"
+	"github.com/bordenet/pr-faq-validator/internal/llm"
 	"github.com/bordenet/pr-faq-validator/internal/parser"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -22,8 +26,8 @@ func TestNewModel(t *testing.T) {
 		t.Errorf("activeTab = %v, want %v", model.activeTab, TabOverview)
 	}
 
-	if len(model.tabs) != 4 {
-		t.Errorf("tabs length = %d, want 4", len(model.tabs))
+	if len(model.tabs) != 11 {
+		t.Errorf("tabs length = %d, want 11", len(model.tabs))
 	}
 
 	if model.sections.Title != "Test PR-FAQ" {
@@ -48,6 +52,24 @@ func TestModel_Init(t *testing.T) {
 	}
 }
 
+func TestModel_Init_Offline(t *testing.T) {
+	llm.SetOffline(true)
+	defer llm.SetOffline(false)
+
+	model := NewModel(parser.SpecSections{
+		Title:        "Test",
+		PressRelease: "Content",
+		PRScore:      &parser.PRScore{OverallScore: 50},
+	})
+	if cmd := model.Init(); cmd != nil {
+		t.Error("Init() should not start AI analysis in offline mode")
+	}
+
+	if got := model.renderFeedback(); !strings.Contains(got, "Offline mode") {
+		t.Errorf("renderFeedback() = %q, want offline notice", got)
+	}
+}
+
 func TestModel_Update_Quit(t *testing.T) {
 	sections := parser.SpecSections{
 		PRScore: &parser.PRScore{},
@@ -166,7 +188,7 @@ func TestRenderHeader(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := RenderHeader(tt.title, tt.score)
+			result := RenderHeader(tt.title, tt.score, 80)
 			if result == "" {
 				t.Error("RenderHeader returned empty string")
 			}
@@ -188,7 +210,7 @@ func TestRenderScoreBreakdown(t *testing.T) {
 		QuoteScore:       10,
 	}
 
-	result := RenderScoreBreakdown(breakdown)
+	result := RenderScoreBreakdown(breakdown, 80)
 	if result == "" {
 		t.Error("RenderScoreBreakdown returned empty string")
 	}
@@ -207,7 +229,7 @@ func TestRenderStrengths(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := RenderStrengths(tt.strengths)
+			result := RenderStrengths(tt.strengths, 80)
 			// Should not panic
 			_ = result
 		})
@@ -227,7 +249,7 @@ func TestRenderImprovements(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := RenderImprovements(tt.improvements)
+			result := RenderImprovements(tt.improvements, 80)
 			// Should not panic
 			_ = result
 		})
@@ -263,7 +285,7 @@ func TestRenderQuoteAnalysis(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := RenderQuoteAnalysis(tt.score)
+			result := RenderQuoteAnalysis(tt.score, 80)
 			// Should not panic
 			_ = result
 		})
@@ -291,19 +313,35 @@ func TestRenderLLMFeedback(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := RenderLLMFeedback(tt.title, tt.feedback)
+			result := RenderLLMFeedback(tt.title, tt.feedback, 80)
 			// Should not panic
 			_ = result
 		})
 	}
 }
 
+func TestRenderHeatmap(t *testing.T) {
+	heatmap := []parser.ParagraphHeat{
+		{Span: parser.LineSpan{Start: 5, End: 5}, Text: "Acme launched CloudSync."},
+		{Span: parser.LineSpan{Start: 7, End: 8}, Text: "CloudSync may help.", Heat: 3, Findings: []parser.Finding{
+			{Severity: parser.SeverityWarning, Message: "Hedging language"},
+			{Severity: parser.SeverityInfo, Message: "Long sentence"},
+		}},
+	}
+	result := RenderHeatmap(heatmap, 80)
+	for _, want := range []string{"1 of 2 need work", "Line 5 · none · 0 findings", "Lines 7-8 · medium · 2 findings", "Hedging language"} {
+		if !strings.Contains(result, want) {
+			t.Errorf("RenderHeatmap() lacks %q", want)
+		}
+	}
+}
+
 // Test RenderTabs function
 func TestRenderTabs(t *testing.T) {
 	tabs := []string{"Overview", "Breakdown", "Quotes", "AI Feedback"}
 
 	for activeTab := 0; activeTab < len(tabs); activeTab++ {
-		result := RenderTabs(tabs, activeTab)
+		result := RenderTabs(tabs, activeTab, 80)
 		if result == "" {
 			t.Errorf("RenderTabs returned empty string for activeTab=%d", activeTab)
 		}
@@ -312,7 +350,7 @@ func TestRenderTabs(t *testing.T) {
 
 // Test RenderHelp function
 func TestRenderHelp(t *testing.T) {
-	result := RenderHelp()
+	result := RenderHelp(80)
 	if result == "" {
 		t.Error("RenderHelp returned empty string")
 	}
@@ -330,7 +368,7 @@ func TestRenderStatus(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := RenderStatus(tt.status)
+			result := RenderStatus(tt.status, 80)
 			// Should not panic
 			_ = result
 		})
@@ -434,7 +472,7 @@ func TestModel_View(t *testing.T) {
 	model.windowHeight = 24
 
 	// Test View for each tab
-	for tab := TabOverview; tab <= TabFeedback; tab++ {
+	for tab := TabOverview; tab <= TabWhatIf; tab++ {
 		model.activeTab = tab
 		result := model.View()
 		if result == "" {
@@ -523,6 +561,39 @@ func TestModel_Update_SetFeedbackMsg(t *testing.T) {
 	_ = m
 }
 
+// stubClient answers every AI request with reply.
+type stubClient struct{ reply string }
+
+func (c stubClient) Complete(context.Context, llm.Request) (llm.Response, error) {
+	return llm.Response{Text: c.reply}, nil
+}
+
+func (stubClient) Ping(context.Context) error { return nil }
+
+func TestModel_AIFeedbackTab(t *testing.T) {
+	// The rules score this bare release low; the stub review rates it highly
+	llm.SetClient(stubClient{reply: "Quantify the problem in the second paragraph.\n\n**Score: 10/10**"})
+	defer llm.SetClient(nil)
+
+	sections, err := parser.Parse(strings.NewReader("# Launch\n\n## Press Release\n\nAcme launches a product.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	model := NewModel(*sections)
+	msg := AnalyzeSection(model.session, "Press Release", sections.PressRelease)()
+	updated, _ := model.Update(msg)
+	m := updated.(Model)
+	m.activeTab = TabFeedback
+
+	got := m.renderFeedback()
+	if !strings.Contains(got, "Quantify the problem") {
+		t.Errorf("AI Feedback tab = %q, want the client's feedback", got)
+	}
+	if !strings.Contains(got, "Disagreement") || !strings.Contains(m.feedbackText(), crosscheck.Overall+": the rules score") {
+		t.Errorf("AI Feedback tab = %q, want the overall disagreement called out", got)
+	}
+}
+
 // Test Model Update with SetStatusMsg
 func TestModel_Update_SetStatusMsg(t *testing.T) {
 	sections := parser.SpecSections{
@@ -556,3 +627,42 @@ func TestModel_Update_SetLoadingMsg(t *testing.T) {
 		t.Error("Expected loading to be true")
 	}
 }
+
+func TestStartAIAnalysis_Selected(t *testing.T) {
+	sections := parser.SpecSections{PressRelease: "PR content", FAQs: "Q: Why?\nA: Because."}
+	selected, err := parser.ParseSectionSet("faq")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := StartAIAnalysis(sections, selected)()
+	if got, ok := msg.(AIAnalysisMsg); !ok || got.Section != "FAQs" || got.Content != sections.FAQs {
+		t.Errorf("StartAIAnalysis(faq) = %#v, want only the FAQ analysis", msg)
+	}
+}
+
+func TestModel_RerunKey(t *testing.T) {
+	sections := parser.SpecSections{PressRelease: "PR content", FAQs: "Q: Why?\nA: Because.", PRScore: &parser.PRScore{}}
+	model := NewModel(sections)
+	before := model.session
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	m := updated.(Model)
+	if cmd == nil {
+		t.Fatal("r should start AI analysis")
+	}
+	if m.session == before {
+		t.Error("r should start a new AI session")
+	}
+	// Outside the AI Feedback tab only the press release is analyzed
+	if msg, ok := cmd().(AIAnalysisMsg); !ok || msg.Section != "Press Release" {
+		t.Errorf("r on the overview tab = %#v, want the press release analysis", msg)
+	}
+
+	llm.SetOffline(true)
+	defer llm.SetOffline(false)
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if cmd != nil || !strings.Contains(updated.(Model).status, "Offline") {
+		t.Errorf("r while offline = %v, status %q", cmd, updated.(Model).status)
+	}
+}
@@ -0,0 +1,262 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// questionSuggestions is how many questions the Questions tab asks for.
+const questionSuggestions = 10
+
+// SuggestFunc proposes up to count questions the FAQ does not answer yet.
+type SuggestFunc func(pressRelease, faqs string, count int) ([]llm.Question, error)
+
+// question is a suggested FAQ question and whether it is picked for insertion.
+type question struct {
+	llm.Question
+	picked bool
+}
+
+// QuestionsReadyMsg carries the suggested questions, or the error that
+// prevented them.
+type QuestionsReadyMsg struct {
+	Questions []llm.Question
+	Err       error
+}
+
+// QuestionsInsertedMsg reports the outcome of inserting question stubs: the
+// re-scored document, or the error that left the source untouched.
+type QuestionsInsertedMsg struct {
+	Inserted []llm.Question
+	Backup   string
+	Sections *parser.SpecSections
+	Err      error
+}
+
+// GenerateQuestions creates a command that asks suggest for the questions
+// readers of the press release would ask and the FAQ does not answer.
+func GenerateQuestions(sections parser.SpecSections, suggest SuggestFunc) tea.Cmd {
+	return func() tea.Msg {
+		questions, err := suggest(sections.PressRelease, sections.FAQs, questionSuggestions)
+		return QuestionsReadyMsg{Questions: questions, Err: err}
+	}
+}
+
+// insertQuestions creates a command that adds a TODO stub for each question
+// to the end of the FAQ in the file at path, keeping the previous contents
+// in path+".bak", then re-scores the file like applyFix.
+func insertQuestions(path string, questions []llm.Question, prev parser.SpecSections) tea.Cmd {
+	return func() tea.Msg {
+		backup, err := editLines(path, func(lines []string) ([]string, error) {
+			return withQuestionStubs(lines, prev.Positions.FAQs, prev.FAQs, questions)
+		})
+		if err != nil {
+			return QuestionsInsertedMsg{Err: err}
+		}
+		sections, err := rescore(path, prev)
+		if err != nil {
+			return QuestionsInsertedMsg{Backup: backup, Err: err}
+		}
+		return QuestionsInsertedMsg{Inserted: questions, Backup: backup, Sections: sections}
+	}
+}
+
+// withQuestionStubs returns lines with a stub for each question after the
+// last line of the FAQ at span, or under a new FAQ heading at the end of the
+// document when it has no FAQ.
+func withQuestionStubs(lines []string, span parser.LineSpan, faqs string, questions []llm.Question) ([]string, error) {
+	var stubs []string
+	for _, q := range questions {
+		stubs = append(stubs, "", questionStub(faqs, q))
+	}
+
+	if span.Start == 0 {
+		for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+			lines = lines[:len(lines)-1]
+		}
+		return append(append(lines, "", "## FAQ"), append(stubs, "")...), nil
+	}
+	if span.End < span.Start || span.End > len(lines) {
+		return nil, errStaleFix
+	}
+	rest := lines[span.End:]
+	if len(rest) > 0 && strings.TrimSpace(rest[0]) != "" {
+		stubs = append(stubs, "")
+	}
+	return append(append(append([]string{}, lines[:span.End]...), stubs...), rest...), nil
+}
+
+// faqQuestionLine captures the markup before an FAQ question: the heading
+// marks and the "Q:" label, e.g. "### " and "Q: " in "### Q: Who is it for?".
+var faqQuestionLine = regexp.MustCompile(`^(#{1,6}\s+)?(?:\*\*)?(Q\d*\s*[:.)]\s*)?\S.*\?(?:\*\*)?\s*$`)
+
+// questionStub formats q like the first question in faqs, e.g. as a
+// "### Q:" heading, with a TODO answer. Without an existing question it
+// uses "Q:" and "A:" lines.
+func questionStub(faqs string, q llm.Question) string {
+	heading, label := "", "Q: "
+	for _, line := range strings.Split(faqs, "\n") {
+		if m := faqQuestionLine.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			heading, label = m[1], ""
+			if m[2] != "" {
+				label = "Q: "
+			}
+			break
+		}
+	}
+	answer := "A: TODO: answer this question."
+	if q.Asker != "" {
+		answer = fmt.Sprintf("A: TODO: answer this %s question.", strings.ToLower(q.Asker))
+	}
+	return heading + label + q.Text + "\n" + answer
+}
+
+// updateQuestions handles the Questions tab keys and the question messages.
+func (m Model) updateQuestions(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleQuestionKey(msg.String())
+
+	case QuestionsReadyMsg:
+		m.loading = false
+		if msg.Err != nil {
+			m.status = fmt.Sprintf("Question suggestions failed: %v", msg.Err)
+			return m, nil
+		}
+		m.questions, m.questionCursor = nil, 0
+		for _, q := range msg.Questions {
+			m.questions = append(m.questions, question{Question: q})
+		}
+		m.status = fmt.Sprintf("%d questions the FAQ does not answer - space to pick, a to add", len(m.questions))
+
+	case QuestionsInsertedMsg:
+		m.loading = false
+		if msg.Err != nil {
+			m.status = fmt.Sprintf("Could not add questions: %v", msg.Err)
+			return m, nil
+		}
+		before := m.overallScore()
+		m.sections = *msg.Sections
+		m.sandbox = newSandbox(m.sections)
+		m.questions = remaining(m.questions, msg.Inserted)
+		m.questionCursor = min(m.questionCursor, max(0, len(m.questions)-1))
+		m.status = fmt.Sprintf("Added %d question stubs (backup: %s) - score %d → %d",
+			len(msg.Inserted), msg.Backup, before, m.sections.PRScore.OverallScore)
+	}
+	return m, nil
+}
+
+// remaining returns the questions that were not inserted.
+func remaining(questions []question, inserted []llm.Question) []question {
+	done := map[llm.Question]bool{}
+	for _, q := range inserted {
+		done[q] = true
+	}
+	var out []question
+	for _, q := range questions {
+		if !done[q.Question] {
+			out = append(out, q)
+		}
+	}
+	return out
+}
+
+// handleQuestionKey runs a Questions tab key: g generates questions, n and p
+// move the cursor, space picks a question, and a adds the picked questions,
+// or the one under the cursor, to the FAQ.
+func (m Model) handleQuestionKey(key string) (Model, tea.Cmd) {
+	switch key {
+	case "g":
+		if llm.Offline() {
+			m.status = "Offline mode: AI question suggestions are disabled"
+			return m, nil
+		}
+		if m.sections.PressRelease == "" {
+			m.status = "No press release to ask questions about"
+			return m, nil
+		}
+		m.loading = true
+		m.status = "Finding questions the FAQ does not answer..."
+		return m, GenerateQuestions(m.sections, m.suggest)
+
+	case "n":
+		if m.questionCursor < len(m.questions)-1 {
+			m.questionCursor++
+		}
+
+	case "p":
+		if m.questionCursor > 0 {
+			m.questionCursor--
+		}
+
+	case " ":
+		if len(m.questions) > 0 {
+			m.questions[m.questionCursor].picked = !m.questions[m.questionCursor].picked
+		}
+
+	case "a":
+		switch {
+		case len(m.questions) == 0:
+			m.status = "No questions to add - press g to suggest some"
+		case m.source == "":
+			m.status = "No source file to add the questions to"
+		default:
+			m.loading = true
+			m.status = "Adding questions..."
+			return m, insertQuestions(m.source, m.picked(), m.sections)
+		}
+	}
+	return m, nil
+}
+
+// picked returns the picked questions, or the one under the cursor when
+// none is picked.
+func (m Model) picked() []llm.Question {
+	var out []llm.Question
+	for _, q := range m.questions {
+		if q.picked {
+			out = append(out, q.Question)
+		}
+	}
+	if len(out) == 0 {
+		out = append(out, m.questions[m.questionCursor].Question)
+	}
+	return out
+}
+
+// renderQuestions renders the Questions tab: the suggested questions with
+// the cursor and the picked ones marked.
+func (m Model) renderQuestions() string {
+	title := SubtitleStyle.Render("❓ Questions")
+	switch {
+	case len(m.questions) == 0 && llm.Offline():
+		return m.card(title + "\n\n" +
+			StatusStyle.Render("Offline mode: AI question suggestions are disabled."))
+	case len(m.questions) == 0:
+		return m.card(title + "\n\n" +
+			StatusStyle.Render("Press g to ask the AI which customer, executive, and journalist questions the FAQ does not answer."))
+	}
+
+	var lines []string
+	for i, q := range m.questions {
+		cursor, box := "  ", "[ ]"
+		if i == m.questionCursor {
+			cursor = "› "
+		}
+		if q.picked {
+			box = "[x]"
+		}
+		text := q.Text
+		if q.Asker != "" {
+			text = q.Asker + ": " + text
+		}
+		lines = append(lines, ListItemStyle.Render(fmt.Sprintf("%s%s %s", cursor, box, text)))
+	}
+	return m.card(title + "\n\n" + strings.Join(lines, "\n") + "\n\n" +
+		StatusStyle.Render("space pick · a add to the FAQ as TODO stubs (writes a .bak backup) · n/p next/previous · g regenerate"))
+}
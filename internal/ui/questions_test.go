@@ -0,0 +1,142 @@
+package ui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestQuestionStub(t *testing.T) {
+	q := llm.Question{Asker: "Customer", Text: "How much does it cost?"}
+	tests := []struct {
+		name string
+		faqs string
+		want string
+	}{
+		{"Q heading", "### Q: Who is it for?\nA: Teams.", "### Q: How much does it cost?\nA: TODO: answer this customer question."},
+		{"plain heading", "## Who is it for?\nTeams.", "## How much does it cost?\nA: TODO: answer this customer question."},
+		{"Q line", "**Q: Who is it for?**\nA: Teams.", "Q: How much does it cost?\nA: TODO: answer this customer question."},
+		{"no questions", "", "Q: How much does it cost?\nA: TODO: answer this customer question."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := questionStub(tt.faqs, q); got != tt.want {
+				t.Errorf("questionStub() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithQuestionStubs(t *testing.T) {
+	questions := []llm.Question{{Text: "Why now?"}}
+	lines := strings.Split("# T\n\n## FAQ\n\nQ: Who?\nA: Us.\n## Metrics\nNone", "\n")
+
+	got, err := withQuestionStubs(lines, parser.LineSpan{Start: 5, End: 6}, "Q: Who?\nA: Us.", questions)
+	if err != nil {
+		t.Fatalf("withQuestionStubs() error = %v", err)
+	}
+	want := "# T\n\n## FAQ\n\nQ: Who?\nA: Us.\n\nQ: Why now?\nA: TODO: answer this question.\n\n## Metrics\nNone"
+	if strings.Join(got, "\n") != want {
+		t.Errorf("withQuestionStubs() = %q, want %q", strings.Join(got, "\n"), want)
+	}
+
+	got, err = withQuestionStubs(strings.Split("# T\n\nText\n\n", "\n"), parser.LineSpan{}, "", questions)
+	if err != nil {
+		t.Fatalf("withQuestionStubs() without an FAQ error = %v", err)
+	}
+	if want := "# T\n\nText\n\n## FAQ\n\nQ: Why now?\nA: TODO: answer this question.\n"; strings.Join(got, "\n") != want {
+		t.Errorf("withQuestionStubs() without an FAQ = %q, want %q", strings.Join(got, "\n"), want)
+	}
+
+	if _, err := withQuestionStubs(lines, parser.LineSpan{Start: 5, End: 20}, "", questions); !errors.Is(err, errStaleFix) {
+		t.Errorf("withQuestionStubs() past the end error = %v, want errStaleFix", err)
+	}
+}
+
+func TestModel_Questions(t *testing.T) {
+	doc := "# CloudSync\n\n## Press Release\n\nAcme today launched CloudSync.\n\n## FAQ\n\n### Q: Who is it for?\nA: Small teams.\n"
+	path := filepath.Join(t.TempDir(), "prfaq.md")
+	if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	sections, err := parser.ParsePRFAQ(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	model := NewModel(*sections).WithSource(path)
+	model.activeTab = TabQuestions
+	model.suggest = func(_, _ string, count int) ([]llm.Question, error) {
+		if count != questionSuggestions {
+			t.Errorf("count = %d, want %d", count, questionSuggestions)
+		}
+		return []llm.Question{
+			{Asker: "Customer", Text: "How much does it cost?"},
+			{Asker: "Executive", Text: "What does it depend on?"},
+			{Asker: "Journalist", Text: "How is it different from Dropbox?"},
+		}, nil
+	}
+	key := func(m Model, k string) (Model, tea.Cmd) {
+		updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+		return updated.(Model), cmd
+	}
+
+	m, cmd := key(model, "g")
+	if cmd == nil {
+		t.Fatal("g should return a command")
+	}
+	updated, _ := m.Update(cmd())
+	m = updated.(Model)
+	if len(m.questions) != 3 || !strings.Contains(m.renderQuestions(), "Executive: What does it depend on?") {
+		t.Fatalf("renderQuestions() = %q", m.renderQuestions())
+	}
+
+	// Pick the first and third questions, then add them
+	m, _ = key(m, " ")
+	m, _ = key(m, "n")
+	m, _ = key(m, "n")
+	m, _ = key(m, " ")
+	m, cmd = key(m, "a")
+	if cmd == nil {
+		t.Fatal("a should return a command")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	if !strings.Contains(m.sections.FAQs, "How much does it cost?") || !strings.Contains(m.sections.FAQs, "How is it different from Dropbox?") {
+		t.Errorf("FAQs = %q, want the picked questions", m.sections.FAQs)
+	}
+	if strings.Contains(m.sections.FAQs, "What does it depend on?") {
+		t.Errorf("FAQs = %q, want the unpicked question left out", m.sections.FAQs)
+	}
+	if len(m.questions) != 1 || m.questions[0].Asker != "Executive" || m.questionCursor != 0 {
+		t.Errorf("questions = %+v (cursor %d), want the unpicked one left", m.questions, m.questionCursor)
+	}
+	if !strings.Contains(m.status, "Added 2 question stubs") {
+		t.Errorf("status = %q", m.status)
+	}
+	if _, err := os.Stat(path + ".bak"); err != nil {
+		t.Errorf("backup not written: %v", err)
+	}
+}
+
+func TestModel_Questions_Offline(t *testing.T) {
+	llm.SetOffline(true)
+	defer llm.SetOffline(false)
+
+	model := NewModel(parser.SpecSections{PressRelease: "Content", PRScore: &parser.PRScore{}})
+	model.activeTab = TabQuestions
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if cmd != nil {
+		t.Error("g should not return a command offline")
+	}
+	if m := updated.(Model); !strings.Contains(m.status, "Offline") {
+		t.Errorf("status = %q, want the offline notice", m.status)
+	}
+}
@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RateFunc rates FAQ answers on the directness, evidence, and trade-offs
+// rubric.
+type RateFunc func(faqs []llm.FAQAnswer) ([]llm.AnswerRating, error)
+
+// RubricReadyMsg carries the FAQ answer ratings, or the error that
+// prevented them.
+type RubricReadyMsg struct {
+	Ratings []llm.AnswerRating
+	Err     error
+}
+
+// RateFAQ creates a command that asks rate for the rubric ratings of every
+// question and answer in the FAQ.
+func RateFAQ(sections parser.SpecSections, rate RateFunc) tea.Cmd {
+	return func() tea.Msg {
+		var faqs []llm.FAQAnswer
+		for _, p := range sections.FAQPairs() {
+			faqs = append(faqs, llm.FAQAnswer{Question: p.Question, Answer: p.Answer})
+		}
+		ratings, err := rate(faqs)
+		return RubricReadyMsg{Ratings: ratings, Err: err}
+	}
+}
+
+// updateRubric handles the FAQ Rubric tab keys and the rating message.
+func (m Model) updateRubric(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() != "g" {
+			return m, nil
+		}
+		if llm.Offline() {
+			m.status = "Offline mode: AI answer ratings are disabled"
+			return m, nil
+		}
+		if len(m.sections.FAQPairs()) == 0 {
+			m.status = "No FAQ questions to rate"
+			return m, nil
+		}
+		m.loading = true
+		m.status = "Rating the FAQ answers..."
+		return m, RateFAQ(m.sections, m.rate)
+
+	case RubricReadyMsg:
+		m.loading = false
+		if msg.Err != nil {
+			m.status = fmt.Sprintf("FAQ rating failed: %v", msg.Err)
+			return m, nil
+		}
+		m.ratings = msg.Ratings
+		m.status = fmt.Sprintf("Rated %d FAQ answers - FAQ Quality %d/100", len(m.ratings), llm.FAQQuality(m.ratings))
+	}
+	return m, nil
+}
+
+// renderRubric renders the FAQ Rubric tab: the FAQ Quality score and a
+// scorecard of every rated question, in FAQ order.
+func (m Model) renderRubric() string {
+	title := SubtitleStyle.Render("📋 FAQ Rubric")
+	switch {
+	case len(m.ratings) == 0 && llm.Offline():
+		return m.card(title + "\n\n" +
+			StatusStyle.Render("Offline mode: AI answer ratings are disabled."))
+	case len(m.ratings) == 0:
+		return m.card(title + "\n\n" +
+			StatusStyle.Render("Press g to have the AI rate every FAQ answer from 1 to 5 for directness, evidence, and honesty about trade-offs."))
+	}
+
+	quality := llm.FAQQuality(m.ratings)
+	lines := []string{ListItemStyle.Render("FAQ Quality: " + GetScoreStyle(quality).Render(fmt.Sprintf("%d/100", quality))), ""}
+	for i, r := range m.ratings {
+		style := ListItemStyle
+		if r.Rating() < 3 {
+			style = WarningListItemStyle
+		}
+		lines = append(lines,
+			style.Render(fmt.Sprintf("%d. %s", i+1, r.Question)),
+			ListItemStyle.Render("   "+rubricScores(r)))
+		if r.Note != "" {
+			lines = append(lines, ListItemStyle.Render("   → "+r.Note))
+		}
+	}
+	return m.card(title + "\n\n" + strings.Join(lines, "\n") + "\n\n" +
+		StatusStyle.Render("g re-rate · answers averaging under 3/5 are highlighted"))
+}
+
+// rubricScores formats an answer's ratings, e.g. "Directness 4/5 ·
+// Evidence 2/5 · Trade-offs 3/5 · average 3.0".
+func rubricScores(r llm.AnswerRating) string {
+	return fmt.Sprintf("Directness %d/5 · Evidence %d/5 · Trade-offs %d/5 · average %.1f", r.Directness, r.Evidence, r.TradeOffs, r.Rating())
+}
+
+// rubricText is the FAQ Rubric tab as plain text.
+func (m Model) rubricText() string {
+	if len(m.ratings) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "FAQ Quality: %d/100\n", llm.FAQQuality(m.ratings))
+	for i, r := range m.ratings {
+		fmt.Fprintf(&b, "\n%d. %s\n   %s\n", i+1, r.Question, rubricScores(r))
+		if r.Note != "" {
+			fmt.Fprintf(&b, "   %s\n", r.Note)
+		}
+	}
+	return b.String()
+}
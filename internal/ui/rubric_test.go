@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_Rubric(t *testing.T) {
+	sections := parser.SpecSections{
+		FAQs:    "Q: Is it free?\nA: Yes.\n\nQ: Does it upload documents?\nA: No, everything runs locally.",
+		PRScore: &parser.PRScore{},
+	}
+	model := NewModel(sections)
+	model.activeTab = TabRubric
+	model.rate = func(faqs []llm.FAQAnswer) ([]llm.AnswerRating, error) {
+		if len(faqs) != 2 || faqs[1].Answer != "No, everything runs locally." {
+			t.Errorf("faqs = %+v, want both pairs", faqs)
+		}
+		return []llm.AnswerRating{
+			{Question: faqs[0].Question, Directness: 5, Evidence: 1, TradeOffs: 1, Note: "Name the license."},
+			{Question: faqs[1].Question, Directness: 5, Evidence: 4, TradeOffs: 3},
+		}, nil
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if cmd == nil {
+		t.Fatal("g should return a command")
+	}
+	updated, _ = updated.(Model).Update(cmd())
+	m := updated.(Model)
+
+	view := m.renderRubric()
+	for _, want := range []string{"FAQ Quality", "54/100", "Is it free?", "Directness 5/5 · Evidence 1/5 · Trade-offs 1/5", "Name the license."} {
+		if !strings.Contains(view, want) {
+			t.Errorf("renderRubric() missing %q:\n%s", want, view)
+		}
+	}
+	if what, text := m.tabText(); what != "FAQ scorecard" || !strings.Contains(text, "2. Does it upload documents?") {
+		t.Errorf("tabText() = %q, %q", what, text)
+	}
+
+	m.rate = func([]llm.FAQAnswer) ([]llm.AnswerRating, error) { return nil, errors.New("boom") }
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	updated, _ = updated.(Model).Update(cmd())
+	if m := updated.(Model); !strings.Contains(m.status, "boom") || len(m.ratings) != 2 {
+		t.Errorf("after a failed rating status = %q with %d ratings, want the error and the old ratings", m.status, len(m.ratings))
+	}
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// spinnerFrames animate categories that are still being scored.
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// spinnerInterval is how often the scoring spinner advances.
+const spinnerInterval = 100 * time.Millisecond
+
+// CategoryScoredMsg carries one breakdown category scored in the background.
+type CategoryScoredMsg struct {
+	Score parser.CategoryScore
+}
+
+// ScoreReadyMsg carries the full score of a document opened unscored: the
+// breakdown, issues, strengths, and quotes.
+type ScoreReadyMsg struct {
+	Score *parser.PRScore
+}
+
+// spinnerTickMsg advances the scoring spinner.
+type spinnerTickMsg struct{}
+
+// ScoreInBackground creates the commands that score an unscored document
+// while the TUI is up: one per breakdown category, so the Breakdown tab
+// fills in as each finishes, and one for the full score.
+func ScoreInBackground(sections parser.SpecSections) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, c := range sections.Categories() {
+		cmds = append(cmds, func() tea.Msg {
+			return CategoryScoredMsg{Score: parser.ScoreCategory(&sections, c.Name)}
+		})
+	}
+	cmds = append(cmds, func() tea.Msg {
+		return ScoreReadyMsg{Score: parser.Score(&sections)}
+	})
+	return tea.Batch(cmds...)
+}
+
+// tickSpinner creates a command that advances the scoring spinner.
+func tickSpinner() tea.Cmd {
+	return tea.Tick(spinnerInterval, func(time.Time) tea.Msg { return spinnerTickMsg{} })
+}
+
+// scoring reports whether the document is still being scored.
+func (m Model) scoring() bool {
+	return m.sections.PRScore == nil
+}
+
+// updateScoring handles background scoring messages. Results that arrive
+// after the document has a score, e.g. from an applied fix, are stale and
+// dropped.
+func (m Model) updateScoring(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.scoring() {
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case CategoryScoredMsg:
+		if m.categories == nil {
+			m.categories = map[string]parser.CategoryScore{}
+		}
+		m.categories[msg.Score.Name] = msg.Score
+		m.status = fmt.Sprintf("Scored %s (%d of %d categories)", msg.Score.Name, len(m.categories), len(m.sections.Categories()))
+
+	case ScoreReadyMsg:
+		m.sections.PRScore = msg.Score
+		m.sandbox = newSandbox(m.sections)
+		m.categories = nil
+		m.status = fmt.Sprintf("Scoring complete - %d/100", msg.Score.OverallScore)
+
+	case spinnerTickMsg:
+		m.spinner = (m.spinner + 1) % len(spinnerFrames)
+		return m, tickSpinner()
+	}
+	return m, nil
+}
+
+// spinnerFrame is the scoring spinner's current frame.
+func (m Model) spinnerFrame() string {
+	return spinnerFrames[m.spinner]
+}
+
+// overallScore is the document's score, or 0 while it is being scored.
+func (m Model) overallScore() int {
+	if m.scoring() {
+		return 0
+	}
+	return m.sections.PRScore.OverallScore
+}
+
+// renderScoring is shown instead of tabs that need the full score.
+func (m Model) renderScoring(title string) string {
+	return m.card(SubtitleStyle.Render(title) + "\n\n" +
+		ListItemStyle.Render(m.spinnerFrame()+" Scoring the press release..."))
+}
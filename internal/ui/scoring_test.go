@@ -0,0 +1,130 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const scoringDoc = `# Acme Launches Ledger Sync
+
+## Press Release
+
+SEATTLE - Acme today launched Ledger Sync, which closes the books in two days instead of ten.
+
+"We closed our quarter 40% faster," said Dana Lee, controller at Initech.
+
+## FAQ
+
+Q: How much does it cost?
+A: $5 a month.
+`
+
+func TestModel_ScoreInBackground(t *testing.T) {
+	llm.SetOffline(true)
+	defer llm.SetOffline(false)
+
+	sections, err := parser.Parse(strings.NewReader(scoringDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := sections.PRScore
+	sections.PRScore = nil
+	m := NewModel(*sections)
+	if m.Init() == nil {
+		t.Fatal("Init() = nil, want background scoring even offline")
+	}
+
+	// Every tab renders while the score is pending
+	for tab := range m.tabs {
+		m.activeTab = Tab(tab)
+		_ = m.View()
+	}
+	m.activeTab = TabBreakdown
+	if got := m.renderBreakdown(); strings.Count(got, "scoring...") != 12 {
+		t.Errorf("renderBreakdown() = %q, want every row pending", got)
+	}
+
+	var ready tea.Msg
+	for _, cmd := range ScoreInBackground(*sections)().(tea.BatchMsg) {
+		msg := cmd()
+		if _, ok := msg.(ScoreReadyMsg); ok {
+			ready = msg
+			continue
+		}
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	if m.sections.PRScore != nil {
+		t.Fatal("PRScore set before ScoreReadyMsg")
+	}
+	if got := m.renderBreakdown(); strings.Contains(got, "scoring...") {
+		t.Errorf("renderBreakdown() = %q, want every category scored", got)
+	}
+	partial := m.renderBreakdown()
+
+	next, _ := m.Update(ready)
+	m = next.(Model)
+	if m.sections.PRScore == nil || m.sections.PRScore.OverallScore != want.OverallScore {
+		t.Fatalf("PRScore = %+v, want %d", m.sections.PRScore, want.OverallScore)
+	}
+	if got := m.renderBreakdown(); got != partial {
+		t.Errorf("renderBreakdown() once scored =\n%s\nwant the progressive breakdown\n%s", got, partial)
+	}
+	if _, cmd := m.Update(spinnerTickMsg{}); cmd != nil {
+		t.Error("spinner still ticking after scoring finished")
+	}
+}
+
+func TestScoreInBackground_RulesVersion(t *testing.T) {
+	sections, err := parser.Parse(strings.NewReader(scoringDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sections.PRScore = nil
+	sections.Rules = parser.RulesVersion{Major: 4}
+	m := NewModel(*sections)
+
+	var scored []string
+	for _, cmd := range ScoreInBackground(*sections)().(tea.BatchMsg) {
+		msg, ok := cmd().(CategoryScoredMsg)
+		if !ok {
+			continue
+		}
+		scored = append(scored, msg.Score.Name)
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	// Customer Focus arrived in rules/v5
+	if len(scored) != 9 || slices.Contains(scored, "Customer Focus") {
+		t.Errorf("scored %v, want the 9 categories of rules/v4", scored)
+	}
+	if !strings.Contains(m.status, "(9 of 9 categories)") {
+		t.Errorf("status = %q, want 9 of 9 categories", m.status)
+	}
+}
+
+func TestModel_ScoreReady_Stale(t *testing.T) {
+	m := NewModel(parser.SpecSections{PressRelease: "Content", PRScore: &parser.PRScore{OverallScore: 80}})
+	next, _ := m.Update(ScoreReadyMsg{Score: &parser.PRScore{OverallScore: 20}})
+	if got := next.(Model).sections.PRScore.OverallScore; got != 80 {
+		t.Errorf("OverallScore = %d, want the newer score kept", got)
+	}
+}
+
+func TestRenderPartialBreakdown(t *testing.T) {
+	got := RenderPartialBreakdown(map[string]parser.CategoryScore{
+		"Headline Quality": {Name: "Headline Quality", Score: 8, Max: 10},
+	}, "*", 80)
+	// Structure & Hook waits on its other two categories
+	if n := strings.Count(got, "scoring..."); n != 11 {
+		t.Errorf("RenderPartialBreakdown() has %d pending rows, want 11:\n%s", n, got)
+	}
+	if !strings.Contains(got, "8") {
+		t.Errorf("RenderPartialBreakdown() = %q, want the scored headline", got)
+	}
+}
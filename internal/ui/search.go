@@ -0,0 +1,225 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// searchResultsShown is how many matches the search lists at once.
+const searchResultsShown = 8
+
+// searchItem is something / finds: an issue, a strength, or a quote, and
+// the tab that shows it.
+type searchItem struct {
+	kind string
+	text string
+	line int // source line of an issue; 0 when it has none
+	tab  Tab
+}
+
+// search is the state of a / search: the query typed so far, the items
+// matching it, best first, and the one under the cursor.
+type search struct {
+	items   []searchItem
+	query   string
+	matches []searchItem
+	cursor  int
+}
+
+// startSearch opens the search over the document's issues, strengths, and
+// quotes.
+func (m Model) startSearch() Model {
+	if m.scoring() {
+		m.status = "Search is available once scoring finishes"
+		return m
+	}
+	s := &search{items: m.searchItems()}
+	s.filter()
+	m.search = s
+	m.status = "Search: type to filter, ↑/↓ to choose, enter to jump, esc to cancel"
+	return m
+}
+
+// searchItems lists every issue, strength, and quote. Issues in a press
+// release paragraph are shown on the Heatmap tab, and the rest on Findings.
+func (m Model) searchItems() []searchItem {
+	inParagraph := map[string]bool{}
+	for _, p := range m.sections.Heatmap() {
+		for _, f := range p.Findings {
+			inParagraph[fmt.Sprintf("%d:%s", f.Line, f.Message)] = true
+		}
+	}
+
+	var items []searchItem
+	for _, f := range m.sections.Findings() {
+		tab := TabFindings
+		if inParagraph[fmt.Sprintf("%d:%s", f.Line, f.Message)] {
+			tab = TabHeatmap
+		}
+		items = append(items, searchItem{kind: "Issue", text: f.Message, line: f.Line, tab: tab})
+	}
+	for _, s := range m.sections.PRScore.QualityBreakdown.Strengths {
+		items = append(items, searchItem{kind: "Strength", text: s, tab: TabOverview})
+	}
+	for _, q := range m.sections.PRScore.MetricDetails {
+		items = append(items, searchItem{kind: "Quote", text: q.Quote, tab: TabQuotes})
+	}
+	return items
+}
+
+// filter matches the items against the query, best match first, and moves
+// the cursor back to the top.
+func (s *search) filter() {
+	type scored struct {
+		item  searchItem
+		score int
+	}
+	var found []scored
+	for _, item := range s.items {
+		if score, ok := fuzzyScore(s.query, item.kind+" "+item.text); ok {
+			found = append(found, scored{item, score})
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].score > found[j].score })
+	s.matches = s.matches[:0]
+	for _, f := range found {
+		s.matches = append(s.matches, f.item)
+	}
+	s.cursor = 0
+}
+
+// fuzzyScore reports whether every character of query appears in text in
+// order, ignoring case, and how well: characters that follow the previous
+// match or start a word score more, so "hook met" ranks "Hook lacks
+// specific metrics" above text that merely contains the letters.
+func fuzzyScore(query, text string) (int, bool) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return 0, true
+	}
+	text = strings.ToLower(text)
+	score, prev, last := 0, ' ', -2
+	q := []rune(query)
+	for i, r := range []rune(text) {
+		if len(q) == 0 {
+			break
+		}
+		if r == q[0] {
+			score++
+			if last == i-1 {
+				score += 5
+			}
+			if !unicode.IsLetter(prev) && !unicode.IsDigit(prev) {
+				score += 3
+			}
+			last = i
+			q = q[1:]
+		}
+		prev = r
+	}
+	return score, len(q) == 0
+}
+
+// updateSearch handles a key while the search is open: typing filters,
+// ↑ and ↓ choose a match, enter jumps to it, and esc closes the search.
+func (m Model) updateSearch(msg tea.KeyMsg) (Model, tea.Cmd) {
+	s := m.search
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+
+	case tea.KeyEsc:
+		m.search = nil
+		m.status = "Search closed"
+
+	case tea.KeyEnter:
+		if len(s.matches) == 0 {
+			return m, nil
+		}
+		m = m.jumpTo(s.matches[s.cursor])
+		m.search = nil
+
+	case tea.KeyUp, tea.KeyCtrlP:
+		if s.cursor > 0 {
+			s.cursor--
+		}
+
+	case tea.KeyDown, tea.KeyCtrlN:
+		if s.cursor < len(s.matches)-1 {
+			s.cursor++
+		}
+
+	case tea.KeyBackspace:
+		if s.query != "" {
+			_, size := utf8.DecodeLastRuneInString(s.query)
+			s.query = s.query[:len(s.query)-size]
+			s.filter()
+		}
+
+	case tea.KeyRunes, tea.KeySpace:
+		s.query += string(msg.Runes)
+		if msg.Type == tea.KeySpace {
+			s.query += " "
+		}
+		s.filter()
+	}
+	return m, nil
+}
+
+// jumpTo switches to the tab that shows item and scrolls to its first line
+// there.
+func (m Model) jumpTo(item searchItem) Model {
+	m.activeTab = item.tab
+	m.scrollPos = 0
+	needle := item.text
+	if r := []rune(needle); len(r) > 20 {
+		needle = string(r[:20])
+	}
+	for i, line := range strings.Split(m.renderActiveTab(), "\n") {
+		if strings.Contains(line, needle) {
+			m.scrollPos = i
+			break
+		}
+	}
+	m.status = fmt.Sprintf("%s on %s: %s", item.kind, m.tabs[item.tab], item.text)
+	if item.line > 0 {
+		m.status = fmt.Sprintf("%s on %s, line %d: %s", item.kind, m.tabs[item.tab], item.line, item.text)
+	}
+	return m
+}
+
+// renderSearch renders the open search in place of the active tab: the
+// query and the matches around the cursor.
+func (m Model) renderSearch() string {
+	s := m.search
+	title := SubtitleStyle.Render(fmt.Sprintf("🔎 Search (%d of %d)", len(s.matches), len(s.items)))
+	lines := []string{ListItemStyle.Render("/ " + s.query + "▏"), ""}
+	if len(s.matches) == 0 {
+		lines = append(lines, StatusStyle.Render("  No issues, strengths, or quotes match."))
+	}
+
+	// Keep the cursor in the window of matches shown
+	start := max(0, min(s.cursor-searchResultsShown/2, len(s.matches)-searchResultsShown))
+	end := min(start+searchResultsShown, len(s.matches))
+	width := fitWidth(100, m.windowWidth, CardStyle.GetHorizontalFrameSize())
+	for i := start; i < end; i++ {
+		item := s.matches[i]
+		cursor, style := "  ", ListItemStyle
+		if i == s.cursor {
+			cursor, style = "› ", SuccessListItemStyle
+		}
+		where := m.tabs[item.tab]
+		if item.line > 0 {
+			where = fmt.Sprintf("%s, line %d", where, item.line)
+		}
+		text := fmt.Sprintf("%s%s (%s): %s", cursor, item.kind, where, item.text)
+		lines = append(lines, style.Render(truncate(text, width-style.GetHorizontalPadding())))
+	}
+	return m.card(title + "\n\n" + strings.Join(lines, "\n") + "\n\n" +
+		StatusStyle.Render("↑/↓ choose · enter jump · esc cancel"))
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFuzzyScore(t *testing.T) {
+	tests := []struct {
+		query, text string
+		wantOK      bool
+	}{
+		{"", "Hook lacks specific metrics", true},
+		{"hook", "Hook lacks specific metrics", true},
+		{"HLSM", "Hook lacks specific metrics", true},
+		{"metrics hook", "Hook lacks specific metrics", false},
+		{"xyz", "Hook lacks specific metrics", false},
+	}
+	for _, tt := range tests {
+		if _, ok := fuzzyScore(tt.query, tt.text); ok != tt.wantOK {
+			t.Errorf("fuzzyScore(%q, %q) ok = %v, want %v", tt.query, tt.text, ok, tt.wantOK)
+		}
+	}
+
+	// Consecutive letters and word starts rank higher than scattered ones
+	exact, _ := fuzzyScore("met", "Hook lacks specific metrics")
+	scattered, _ := fuzzyScore("met", "Make the timeline clear")
+	if exact <= scattered {
+		t.Errorf("fuzzyScore() ranks %d for a word match, %d for scattered letters", exact, scattered)
+	}
+}
+
+func typeKeys(m Model, keys ...tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		var next tea.Model
+		next, cmd = m.Update(k)
+		m = next.(Model)
+	}
+	return m, cmd
+}
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestModel_Search(t *testing.T) {
+	sections, err := parser.Parse(strings.NewReader(scoringDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewModel(*sections)
+
+	m, _ = typeKeys(m, runes("/"))
+	if m.search == nil {
+		t.Fatal("/ did not open the search")
+	}
+	if got, want := len(m.search.matches), len(m.search.items); got != want || got == 0 {
+		t.Fatalf("empty query matches %d of %d items, want all", got, want)
+	}
+
+	// Keys that act on tabs are typed into the query instead
+	m, cmd := typeKeys(m, runes("q"), tea.KeyMsg{Type: tea.KeyBackspace}, runes("quarter"))
+	if cmd != nil || m.search == nil || m.search.query != "quarter" {
+		t.Fatalf("query = %q, cmd = %v, want quarter typed", m.search.query, cmd)
+	}
+	if len(m.search.matches) == 0 || m.search.matches[0].kind != "Quote" {
+		t.Fatalf("matches = %+v, want the quote first", m.search.matches)
+	}
+	if got := m.View(); !strings.Contains(got, "Quote (Quotes)") {
+		t.Errorf("View() lacks the match:\n%s", got)
+	}
+
+	m, _ = typeKeys(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.search != nil || m.activeTab != TabQuotes {
+		t.Errorf("enter left search = %v on tab %d, want it closed on Quotes", m.search, m.activeTab)
+	}
+	if !strings.Contains(m.status, "Quote on Quotes") {
+		t.Errorf("status = %q, want the match", m.status)
+	}
+
+	m, _ = typeKeys(m, runes("/"), runes("zzzz"))
+	if len(m.search.matches) != 0 || !strings.Contains(m.View(), "No issues, strengths, or quotes match") {
+		t.Errorf("matches = %+v, want none", m.search.matches)
+	}
+	m, _ = typeKeys(m, tea.KeyMsg{Type: tea.KeyEnter}, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.search != nil || m.activeTab != TabQuotes {
+		t.Errorf("esc left search = %v on tab %d, want it closed on Quotes", m.search, m.activeTab)
+	}
+}
+
+func TestModel_Search_Scoring(t *testing.T) {
+	m := NewModel(parser.SpecSections{PressRelease: "Content"})
+	m, _ = typeKeys(m, runes("/"))
+	if m.search != nil {
+		t.Error("/ opened the search before scoring finished")
+	}
+}
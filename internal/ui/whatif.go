@@ -0,0 +1,122 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// weightStep is how much + and - change a category weight.
+const weightStep = 0.25
+
+// handleWhatIfKey runs a What-If tab key: n and p move the cursor over the
+// categories and then the rules, + and - change the weight of the category
+// under the cursor, space switches the rule under the cursor off or on, and
+// x resets everything.
+func (m Model) handleWhatIfKey(key string) (Model, tea.Cmd) {
+	sb := m.sandbox
+	categories := sb.Categories()
+	rows := len(categories) + len(sb.Rules())
+	switch key {
+	case "n":
+		if m.whatIfCursor < rows-1 {
+			m.whatIfCursor++
+		}
+
+	case "p":
+		if m.whatIfCursor > 0 {
+			m.whatIfCursor--
+		}
+
+	case "+", "=", "-":
+		if m.whatIfCursor >= len(categories) {
+			m.status = "Move to a category to change its weight"
+			return m, nil
+		}
+		name := categories[m.whatIfCursor].Name
+		step := weightStep
+		if key == "-" {
+			step = -weightStep
+		}
+		sb.SetWeight(name, sb.Weight(name)+step)
+		m.status = fmt.Sprintf("%s weight %g - what-if score %d", name, sb.Weight(name), sb.Overall())
+
+	case " ":
+		if m.whatIfCursor < len(categories) {
+			m.status = "Move to a rule to switch it off"
+			return m, nil
+		}
+		i := m.whatIfCursor - len(categories)
+		sb.Toggle(i)
+		verb := "on"
+		if !sb.On(i) {
+			verb = "off"
+		}
+		m.status = fmt.Sprintf("Switched %q %s - what-if score %d", sb.Rules()[i].Rule, verb, sb.Overall())
+
+	case "x":
+		sb.Reset()
+		m.status = "What-if reset to the document's scoring"
+	}
+	return m, nil
+}
+
+// renderWhatIf renders the What-If tab: the recomputed score, the category
+// weights and scores, the rules that awarded or deducted points, and the
+// config setting for the weights.
+func (m Model) renderWhatIf() string {
+	if m.scoring() {
+		return m.renderScoring("🧪 What-If")
+	}
+	sb := m.sandbox
+	title := SubtitleStyle.Render("🧪 What-If")
+	if m.sections.PRScore.Severity != nil {
+		return m.card(title + "\n\n" +
+			StatusStyle.Render("This document is scored by finding severity, so category weights and rules do not change its score. Set scoring.mode to categories to try them."))
+	}
+	actual, whatIf := m.sections.PRScore.OverallScore, sb.Overall()
+	lines := []string{ListItemStyle.Render(fmt.Sprintf("Score: %s → %s (%+d)",
+		GetScoreStyle(actual).Render(fmt.Sprintf("%d", actual)),
+		GetScoreStyle(whatIf).Render(fmt.Sprintf("%d", whatIf)), whatIf-actual)), ""}
+
+	row := 0
+	cursor := func() string {
+		defer func() { row++ }()
+		if row == m.whatIfCursor {
+			return "› "
+		}
+		return "  "
+	}
+	lines = append(lines, SubtitleStyle.Render("Weights"))
+	for _, c := range sb.Categories() {
+		lines = append(lines, ListItemStyle.Render(fmt.Sprintf("%s%-20s ×%-5g %2d/%d", cursor(), c.Name, sb.Weight(c.Name), c.Score, c.Max)))
+	}
+	lines = append(lines, "", SubtitleStyle.Render("Rules"))
+	for i, e := range sb.Rules() {
+		box := "[x]"
+		if !sb.On(i) {
+			box = "[ ]"
+		}
+		lines = append(lines, ListItemStyle.Render(fmt.Sprintf("%s%s %s: %s", cursor(), box, e.Category, e.String())))
+	}
+	if cfg := sb.Config(); cfg != "" {
+		lines = append(lines, "", SubtitleStyle.Render("Config"), ListItemStyle.Render(strings.TrimRight(cfg, "\n")))
+	}
+	return m.card(title + "\n\n" + strings.Join(lines, "\n") + "\n\n" +
+		StatusStyle.Render("+/- weight · space rule off/on · x reset · n/p next/previous · c copy the weights for the config"))
+}
+
+// whatIfText is the weights setting for the config file.
+func (m Model) whatIfText() string {
+	return m.sandbox.Config()
+}
+
+// newSandbox starts the What-If tab's sandbox for sections.
+func newSandbox(sections parser.SpecSections) *parser.Sandbox {
+	if sections.PRScore == nil {
+		return parser.NewSandbox(&parser.PRScore{})
+	}
+	return parser.NewSandbox(sections.PRScore)
+}
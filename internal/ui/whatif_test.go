@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_WhatIf(t *testing.T) {
+	sections, err := parser.Parse(strings.NewReader("# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today launched Ledger Sync, the revolutionary, game-changing way to close the books!!!\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	model := NewModel(*sections)
+	model.activeTab = TabWhatIf
+	key := func(m Model, k string) Model {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+		return updated.(Model)
+	}
+	actual := sections.PRScore.OverallScore
+
+	// The first category is Headline Quality; doubling it changes the score
+	m := key(model, "+")
+	m = key(m, "+")
+	m = key(m, "+")
+	m = key(m, "+")
+	if w := m.sandbox.Weight("Headline Quality"); w != 2 {
+		t.Fatalf("Headline Quality weight = %g, want 2", w)
+	}
+	if !strings.Contains(m.whatIfText(), "Headline Quality: 2") {
+		t.Errorf("whatIfText() = %q, want the weight", m.whatIfText())
+	}
+	if !strings.Contains(m.renderWhatIf(), "Headline Quality") {
+		t.Errorf("renderWhatIf() lacks the categories")
+	}
+
+	// Switch off the first deduction
+	m = key(m, "x")
+	deduction := -1
+	for i, e := range m.sandbox.Rules() {
+		if e.Delta < 0 {
+			deduction = i
+			break
+		}
+	}
+	if deduction < 0 {
+		t.Fatal("no deduction in the trace")
+	}
+	for range len(m.sandbox.Categories()) + deduction {
+		m = key(m, "n")
+	}
+	m = key(m, " ")
+	if m.sandbox.On(deduction) || m.sandbox.Overall() <= actual {
+		t.Errorf("after switching off %q, what-if score = %d, want above %d", m.sandbox.Rules()[deduction].Rule, m.sandbox.Overall(), actual)
+	}
+	if !strings.Contains(m.status, "off - what-if score") {
+		t.Errorf("status = %q", m.status)
+	}
+	if m.sections.PRScore.OverallScore != actual {
+		t.Errorf("document score changed to %d; the sandbox must not change it", m.sections.PRScore.OverallScore)
+	}
+
+	m = key(m, "+")
+	if !strings.Contains(m.status, "Move to a category") {
+		t.Errorf("+ on a rule: status = %q", m.status)
+	}
+}
@@ -0,0 +1,228 @@
+// Package update checks GitHub releases for a newer pr-faq-validator and
+// replaces the running binary with it.
+package update
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Repo is the GitHub repository releases are published to.
+const Repo = "bordenet/pr-faq-validator"
+
+// checksumsAsset is the release asset listing the SHA-256 of every binary.
+const checksumsAsset = "checksums.txt"
+
+// apiBaseURL is a variable so tests can point it at a fake server.
+var apiBaseURL = "https://api.github.com"
+
+var (
+	// ErrNoAsset is returned when a release has no binary for this platform.
+	ErrNoAsset = errors.New("release has no binary for this platform")
+	// ErrChecksum is returned when a downloaded binary does not match checksums.txt.
+	ErrChecksum = errors.New("checksum mismatch")
+)
+
+// Release is a published GitHub release.
+type Release struct {
+	Tag    string  `json:"tag_name"`
+	URL    string  `json:"html_url"`
+	Assets []Asset `json:"assets"`
+}
+
+// Asset is a file attached to a release.
+type Asset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+}
+
+// Latest fetches the newest published release.
+func Latest(ctx context.Context, client *http.Client) (*Release, error) {
+	body, err := get(ctx, client, apiBaseURL+"/repos/"+Repo+"/releases/latest", 1<<20)
+	if err != nil {
+		return nil, err
+	}
+	var rel Release
+	if err := json.Unmarshal(body, &rel); err != nil {
+		return nil, fmt.Errorf("failed to decode release: %w", err)
+	}
+	return &rel, nil
+}
+
+// AssetName is the release binary name for a platform, matching the release workflow.
+func AssetName(goos, goarch string) string {
+	name := "pr-faq-validator-" + goos + "-" + goarch
+	if goos == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// Newer reports whether version latest is newer than current. Development
+// builds, whose version is not a release tag, are never considered current.
+func Newer(current, latest string) bool {
+	cur, ok := parseVersion(current)
+	if !ok {
+		return true
+	}
+	lat, ok := parseVersion(latest)
+	if !ok {
+		return false
+	}
+	for i := range cur.parts {
+		if cur.parts[i] != lat.parts[i] {
+			return lat.parts[i] > cur.parts[i]
+		}
+	}
+	// v1.2.3-rc.1 precedes v1.2.3
+	return cur.pre != "" && (lat.pre == "" || lat.pre > cur.pre)
+}
+
+type version struct {
+	parts [3]int
+	pre   string
+}
+
+// parseVersion parses "v1.2.3" and "v1.2.3-rc.1" release tags.
+func parseVersion(s string) (version, bool) {
+	var v version
+	s, ok := strings.CutPrefix(s, "v")
+	if !ok {
+		return v, false
+	}
+	s, v.pre, _ = strings.Cut(s, "-")
+	fields := strings.Split(s, ".")
+	if len(fields) != len(v.parts) {
+		return v, false
+	}
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return v, false
+		}
+		v.parts[i] = n
+	}
+	return v, true
+}
+
+// Apply downloads the release binary for this platform, verifies it against
+// the release checksums and replaces the executable at path with it.
+func Apply(ctx context.Context, client *http.Client, rel *Release, path string) error {
+	name := AssetName(runtime.GOOS, runtime.GOARCH)
+	binary, sums := findAsset(rel, name), findAsset(rel, checksumsAsset)
+	if binary == nil {
+		return fmt.Errorf("%w: %s has no %s", ErrNoAsset, rel.Tag, name)
+	}
+	if sums == nil {
+		return fmt.Errorf("%w: %s has no %s", ErrNoAsset, rel.Tag, checksumsAsset)
+	}
+
+	list, err := get(ctx, client, sums.URL, 1<<20)
+	if err != nil {
+		return err
+	}
+	want, ok := checksum(list, name)
+	if !ok {
+		return fmt.Errorf("%w: %s does not list %s", ErrChecksum, checksumsAsset, name)
+	}
+
+	data, err := get(ctx, client, binary.URL, 256<<20)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != want {
+		return fmt.Errorf("%w: %s is %s, want %s", ErrChecksum, name, got, want)
+	}
+	return replace(path, data)
+}
+
+func findAsset(rel *Release, name string) *Asset {
+	for i := range rel.Assets {
+		if rel.Assets[i].Name == name {
+			return &rel.Assets[i]
+		}
+	}
+	return nil
+}
+
+// checksum finds name in sha256sum output.
+func checksum(list []byte, name string) (string, bool) {
+	scanner := bufio.NewScanner(bytes.NewReader(list))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			return strings.ToLower(fields[0]), true
+		}
+	}
+	return "", false
+}
+
+// replace swaps the executable at path for data. The new binary is written
+// next to it first so the final rename stays on one filesystem.
+func replace(path string, data []byte) error {
+	path, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return fmt.Errorf("failed to resolve executable: %w", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat executable: %w", err)
+	}
+
+	tmp := path + ".new"
+	if err := os.WriteFile(tmp, data, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write new binary: %w", err)
+	}
+	// Windows cannot overwrite a running executable but can rename it
+	old := path + ".old"
+	_ = os.Remove(old)
+	if err := os.Rename(path, old); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to move old binary aside: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Rename(old, path)
+		return fmt.Errorf("failed to install new binary: %w", err)
+	}
+	if runtime.GOOS != "windows" {
+		_ = os.Remove(old)
+	}
+	return nil
+}
+
+func get(ctx context.Context, client *http.Client, url string, limit int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", "pr-faq-validator")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("update request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", url, err)
+	}
+	return body, nil
+}
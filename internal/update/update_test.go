@@ -0,0 +1,113 @@
+package update
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestNewer(t *testing.T) {
+	tests := []struct {
+		current, latest string
+		want            bool
+	}{
+		{"v1.2.3", "v1.2.4", true},
+		{"v1.2.3", "v1.10.0", true},
+		{"v1.2.3", "v1.2.3", false},
+		{"v1.3.0", "v1.2.9", false},
+		{"v1.2.3-rc.1", "v1.2.3", true},
+		{"v1.2.3", "v1.2.3-rc.1", false},
+		{"dev", "v1.0.0", true},
+		{"v1.0.0", "nightly", false},
+	}
+	for _, tt := range tests {
+		if got := Newer(tt.current, tt.latest); got != tt.want {
+			t.Errorf("Newer(%q, %q) = %v, want %v", tt.current, tt.latest, got, tt.want)
+		}
+	}
+}
+
+// fakeRelease serves a release whose binary for this platform is binary and
+// whose checksums.txt lists sum for it.
+func fakeRelease(t *testing.T, binary []byte, sum string) *httptest.Server {
+	t.Helper()
+	name := AssetName(runtime.GOOS, runtime.GOARCH)
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/" + Repo + "/releases/latest":
+			_, _ = fmt.Fprintf(w, `{"tag_name":"v2.0.0","assets":[{"name":%q,"browser_download_url":%q},{"name":"checksums.txt","browser_download_url":%q}]}`,
+				name, srv.URL+"/bin", srv.URL+"/sums")
+		case "/bin":
+			_, _ = w.Write(binary)
+		case "/sums":
+			_, _ = fmt.Fprintf(w, "%s  %s\n", sum, name)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	old := apiBaseURL
+	apiBaseURL = srv.URL
+	t.Cleanup(func() { apiBaseURL = old })
+	return srv
+}
+
+func TestApply(t *testing.T) {
+	binary := []byte("new binary")
+	digest := sha256.Sum256(binary)
+
+	tests := []struct {
+		name    string
+		sum     string
+		wantErr error
+	}{
+		{"verified", hex.EncodeToString(digest[:]), nil},
+		{"checksum mismatch", hex.EncodeToString(make([]byte, sha256.Size)), ErrChecksum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := fakeRelease(t, binary, tt.sum)
+			rel, err := Latest(context.Background(), srv.Client())
+			if err != nil {
+				t.Fatalf("Latest() error = %v", err)
+			}
+			if rel.Tag != "v2.0.0" {
+				t.Errorf("Tag = %q, want v2.0.0", rel.Tag)
+			}
+
+			exe := filepath.Join(t.TempDir(), "pr-faq-validator")
+			if err := os.WriteFile(exe, []byte("old binary"), 0o755); err != nil { //nolint:gosec // test executable
+				t.Fatal(err)
+			}
+
+			err = Apply(context.Background(), srv.Client(), rel, exe)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Apply() error = %v, want %v", err, tt.wantErr)
+			}
+			want := "old binary"
+			if tt.wantErr == nil {
+				want = string(binary)
+			}
+			if got, _ := os.ReadFile(exe); string(got) != want { //nolint:gosec // test path
+				t.Errorf("executable = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestApply_NoAsset(t *testing.T) {
+	rel := &Release{Tag: "v2.0.0", Assets: []Asset{{Name: "pr-faq-validator-plan9-386"}}}
+	if err := Apply(context.Background(), http.DefaultClient, rel, "unused"); !errors.Is(err, ErrNoAsset) {
+		t.Errorf("Apply() error = %v, want ErrNoAsset", err)
+	}
+}
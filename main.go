@@ -2,91 +2,1726 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"io/fs"
 	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
 
+	"github.com/bordenet/pr-faq-validator/internal/batch"
+	"github.com/bordenet/pr-faq-validator/internal/benchmark"
+	"github.com/bordenet/pr-faq-validator/internal/blame"
+	"github.com/bordenet/pr-faq-validator/internal/buildinfo"
+	"github.com/bordenet/pr-faq-validator/internal/completion"
+	"github.com/bordenet/pr-faq-validator/internal/config"
+	"github.com/bordenet/pr-faq-validator/internal/crosscheck"
+	"github.com/bordenet/pr-faq-validator/internal/doctor"
+	"github.com/bordenet/pr-faq-validator/internal/export"
+	"github.com/bordenet/pr-faq-validator/internal/flow"
 	"github.com/bordenet/pr-faq-validator/internal/llm"
+	"github.com/bordenet/pr-faq-validator/internal/logging"
+	"github.com/bordenet/pr-faq-validator/internal/lsp"
 	"github.com/bordenet/pr-faq-validator/internal/parser"
+	"github.com/bordenet/pr-faq-validator/internal/pii"
+	"github.com/bordenet/pr-faq-validator/internal/profile"
+	"github.com/bordenet/pr-faq-validator/internal/progress"
+	"github.com/bordenet/pr-faq-validator/internal/redact"
+	"github.com/bordenet/pr-faq-validator/internal/report"
+	"github.com/bordenet/pr-faq-validator/internal/schema"
+	"github.com/bordenet/pr-faq-validator/internal/semantic"
+	"github.com/bordenet/pr-faq-validator/internal/server"
+	"github.com/bordenet/pr-faq-validator/internal/similar"
+	"github.com/bordenet/pr-faq-validator/internal/summary"
+	"github.com/bordenet/pr-faq-validator/internal/telemetry"
+	"github.com/bordenet/pr-faq-validator/internal/tickets"
 	"github.com/bordenet/pr-faq-validator/internal/ui"
+	"github.com/bordenet/pr-faq-validator/internal/update"
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 var logger *slog.Logger
 
+// logsOnStderr is true while log records go to stderr. Fatal errors are then
+// reported through the logger alone so stderr stays parseable in JSON mode.
+var logsOnStderr = true
+
 func init() {
-	// Initialize structured logger
-	logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
-		Level: slog.LevelInfo,
-	}))
+	// Default logger until flags are parsed
+	logger, _, _ = logging.New(logging.Options{Output: os.Stderr})
+}
+
+// logFlags are the logging options shared by every subcommand.
+type logFlags struct {
+	verbose     *bool
+	veryVerbose *bool
+	format      *string
+	file        *string
+}
+
+func addLogFlags(fs *flag.FlagSet) logFlags {
+	return logFlags{
+		verbose:     fs.Bool("v", false, "Verbose logging (info level)"),
+		veryVerbose: fs.Bool("vv", false, "Very verbose logging (debug level)"),
+		format:      fs.String("log-format", "text", "Log format: text or json"),
+		file:        fs.String("log-file", "", "Append logs to this file instead of stderr"),
+	}
+}
+
+// setupLogging replaces the default logger. When quiet is set and no log
+// file is given, records are discarded so the TUI owns the terminal.
+func setupLogging(f logFlags, quiet bool) {
+	opts := logging.Options{Format: *f.format, File: *f.file, Output: os.Stderr}
+	if *f.verbose {
+		opts.Verbosity = 1
+	}
+	if *f.veryVerbose {
+		opts.Verbosity = 2
+	}
+	if quiet {
+		opts.Output = nil
+	}
+
+	l, _, err := logging.New(opts)
+	if err != nil {
+		fatal("invalid logging options", err)
+	}
+	// The log file, if any, stays open until the process exits.
+	logger = l
+	logsOnStderr = opts.File == "" && opts.Output != nil
+	slog.SetDefault(logger)
+}
+
+// Process exit codes, documented in the README.
+const (
+	exitPass           = 0 // every scored document met the threshold
+	exitBelowThreshold = 1 // a document scored below -min-score, or does not match its schema
+	exitInput          = 2 // the document could not be read or is missing key sections
+	exitLLM            = 3 // AI analysis failed
+	exitConfig         = 4 // the config file or command-line flags are invalid
+	exitFailure        = 5 // any error without a more specific code
+)
+
+// exitStatus names each exit code in the run summary.
+var exitStatus = map[int]string{
+	exitPass:           "pass",
+	exitBelowThreshold: "below-threshold",
+	exitInput:          "parse-error",
+	exitLLM:            "llm-error",
+	exitConfig:         "config-error",
+	exitFailure:        "error",
+}
+
+// errUsage marks invalid flags and flag combinations.
+var errUsage = errors.New("invalid usage")
+
+// usage wraps err as a usage error.
+func usage(err error) error {
+	return fmt.Errorf("%w: %w", errUsage, err)
+}
+
+// exitCode maps an error to the process exit code for its category.
+func exitCode(err error) int {
+	switch {
+	case errors.Is(err, parser.ErrRead), errors.Is(err, parser.ErrNoPressRelease), errors.Is(err, parser.ErrSectionEmpty),
+		errors.Is(err, parser.ErrFrontMatter), errors.Is(err, parser.ErrReview), errors.Is(err, parser.ErrDismissals), errors.Is(err, parser.ErrManifest),
+		errors.Is(err, parser.ErrInclude):
+		return exitInput
+	case errors.Is(err, llm.ErrRequestFailed), errors.Is(err, llm.ErrSensitiveContent):
+		return exitLLM
+	case errors.Is(err, config.ErrInvalid), errors.Is(err, config.ErrPolicy), errors.Is(err, schema.ErrInvalid), errors.Is(err, llm.ErrUnknownProvider),
+		errors.Is(err, errUsage):
+		return exitConfig
+	default:
+		return exitFailure
+	}
+}
+
+// run records this invocation for the -summary file.
+var (
+	run         = summary.New(time.Now())
+	summaryFile string
+)
+
+// stats counts scores and rule hits for telemetryURL, when the run opts in
+// to telemetry.
+var (
+	stats        = telemetry.New()
+	telemetryURL string
+)
+
+// stopProfiling ends -cpuprofile and writes -memprofile, once profiling has
+// started.
+var stopProfiling func() error
+
+// exit writes the profiles, completes the run summary, writes it if -summary
+// was given, and exits with code.
+func exit(code int, err error) {
+	if stopProfiling != nil {
+		if perr := stopProfiling(); perr != nil {
+			logger.Error("failed to write profile", "error", perr)
+		}
+		stopProfiling = nil
+	}
+	if summaryFile != "" {
+		run.Finish(exitStatus[code], code, err, time.Now())
+		if werr := run.Write(summaryFile); werr != nil {
+			logger.Error("failed to write run summary", "file", summaryFile, "error", werr)
+			if code == exitPass {
+				code = exitFailure
+			}
+		}
+	}
+	sendTelemetry()
+	os.Exit(code)
+}
+
+// sendTelemetry posts the run's anonymous statistics when it opted in.
+// Failing to send never changes the outcome of the run.
+func sendTelemetry() {
+	if telemetryURL == "" || stats.Documents == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := telemetry.Send(ctx, &http.Client{}, telemetryURL, stats); err != nil {
+		logger.Warn("failed to send telemetry", "endpoint", telemetryURL, "error", err)
+	}
+}
+
+// fatal logs err and exits with the code for its category.
+func fatal(msg string, err error, args ...any) {
+	logger.Error(msg, append(args, "error", err)...)
+	if !logsOnStderr {
+		fmt.Fprintf(os.Stderr, "Error: %s: %v\n", msg, err)
+	}
+	exit(exitCode(err), err)
+}
+
+// parseFlags parses args, exiting 0 for -h and with exitConfig for bad flags.
+// The flag package has already printed the problem and the usage text.
+func parseFlags(fs *flag.FlagSet, args []string) {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(exitPass)
+		}
+		os.Exit(exitConfig)
+	}
+}
+
+// subcommands are the commands dispatched on the first argument, for shell completion.
+var subcommands = []completion.Command{
+	{Name: "lsp", Help: "Run the language server for editors"},
+	{Name: "serve", Help: "Serve score badges over HTTP"},
+	{Name: "doctor", Help: "Diagnose the environment and configuration"},
+	{Name: "version", Help: "Print build information"},
+	{Name: "update", Help: "Install the latest release"},
+	{Name: "completion", Help: "Print a shell completion script"},
+	{Name: "boilerplate", Help: "Draft an About section from the config"},
+	{Name: "config", Help: "Show the effective config and where each setting came from"},
+	{Name: "schema", Help: "Check documents against a team's section schema"},
+}
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "lsp":
+			runLSPServer(os.Args[2:])
+			return
+		case "serve":
+			runHTTPServer(os.Args[2:])
+			return
+		case "doctor":
+			runDoctor(os.Args[2:])
+			return
+		case "version":
+			runVersion(os.Args[2:])
+			return
+		case "update":
+			runUpdate(os.Args[2:])
+			return
+		case "completion":
+			runCompletion(os.Args[2:])
+			return
+		case "boilerplate":
+			runBoilerplate(os.Args[2:])
+			return
+		case "config":
+			runConfig(os.Args[2:])
+			return
+		case "schema":
+			runSchema(os.Args[2:])
+			return
+		}
+	}
+
+	runCLI()
+	if run.Totals.Failed > 0 {
+		logger.Warn("score below threshold", "min_score", run.MinScore, "documents", run.Totals.Failed)
+		exit(exitBelowThreshold, nil)
+	}
+	exit(exitPass, nil)
+}
+
+// fileList collects -file, which may be repeated for a document kept in
+// several files.
+type fileList []string
+
+func (f *fileList) String() string {
+	return strings.Join(*f, ",")
+}
+
+// Set adds a file.
+func (f *fileList) Set(s string) error {
+	*f = append(*f, s)
+	return nil
+}
+
+// runCLI scores the -file document or directory and writes the requested output.
+func runCLI() {
+	flag.CommandLine.Init(os.Args[0], flag.ContinueOnError)
+	var inputFiles fileList
+	flag.Var(&inputFiles, "file", "Path to the PR-FAQ markdown file, or a directory to score every document in it (requires -format); repeat it, or give a .yaml manifest, for a document kept in several files")
+	reportFile := flag.String("report", "", "Optional: Output markdown report file (default: interactive TUI)")
+	reportTemplate := flag.String("report-template", "", "Go template file, or built-in template name ("+strings.Join(prfaq.BuiltinTemplates(), ", ")+"), for -report and -format markdown (an .html template is HTML-escaped)")
+	noTUI := flag.Bool("no-tui", false, "Disable interactive TUI and output to stdout")
+	accessible := flag.Bool("accessible", os.Getenv(accessibleEnv) != "", "Replace the TUI with a linear, prompt-driven text interface for screen readers (default: on when $"+accessibleEnv+" is set)")
+	configFile := flag.String("config", "", "Path to config file (default: "+config.DefaultFile+" if present)")
+	policyFlag := flag.String("policy", "", "Organization policy, a config file at an http(s) URL or a path, that the config file is layered over; URLs are cached for an hour (default: $"+config.PolicyEnv+")")
+	createTickets := flag.Bool("tickets", false, "Create or update tracker tickets for critical findings (see tickets in config)")
+	exportReport := flag.Bool("export", false, "Publish the report to Notion or as a Google Doc comment (see export in config)")
+	format := flag.String("format", "", "Print the analysis to stdout instead of the TUI: markdown, json, gcc (file:line:col: severity: message [rule-id]), csv, tsv, junit, or text (72-column plain text for email)")
+	offline := flag.Bool("offline", false, "Guarantee no network access: deterministic scores only, no AI analysis")
+	redactFlag := flag.Bool("redact", false, "Replace company names, people, and dollar figures with placeholders before sending content to the AI provider (default: llm.redact from config)")
+	temperature := flag.Float64("temperature", 0, "AI sampling temperature from 0 to 2, for every prompt (default: each prompt file's temperature; Anthropic caps it at 1)")
+	maxTokens := flag.Int("max-tokens", 0, "Maximum tokens in each AI response (default: each prompt file's max_tokens)")
+	semanticFlag := flag.Bool("semantic", false, "Match FAQ questions to the required questions by meaning, with OpenAI or Gemini embeddings, instead of by keyword")
+	flowFlag := flag.Bool("flow", false, "Ask the AI whether the press release tells a coherent story and report where it breaks as findings")
+	legalStrict := flag.Bool("legal-strict", false, "Report guarantees, unsubstantiated superiority claims, screened phrases, and registered marks without ® as errors (default: compliance.legal_strict from config)")
+	llmLog := flag.String("llm-log", "", "Write each AI request and response, with timing, model, and token counts, to a JSON file in this directory (content omitted with llm.log_omit_content in config)")
+	llmRecord := flag.String("llm-record", "", "Save each AI response as a fixture in this directory, for -llm-replay")
+	llmReplay := flag.String("llm-replay", "", "Answer AI requests from the fixtures -llm-record saved in this directory, without an API key")
+	allowPII := flag.Bool("allow-pii", false, "Send content to the AI provider even when it contains email addresses, phone numbers, API keys, or internal hostnames (default: llm.allow_pii from config)")
+	dashboardFile := flag.String("dashboard", "", "For a directory, write an aggregate dashboard to this file: markdown, or HTML for a .html path")
+	historyFile := flag.String("history", "", "For a directory, append each run's scores to this JSON Lines file and chart every document's recent scores in the -dashboard")
+	historyRuns := flag.Int("history-runs", batch.DefaultTrendRuns, "Number of recent runs the -dashboard score trends cover")
+	badgeFile := flag.String("badge", "", "Write a score badge to this file: SVG, or shields.io endpoint JSON for a .json path")
+	corpusDir := flag.String("corpus", "", "Directory of existing PR-FAQs; link the ones each document substantially duplicates in the report")
+	benchmarkFlag := flag.Bool("benchmark", false, "Compare the overall and category scores with a corpus of well-written press releases, as percentiles")
+	benchmarkCorpus := flag.String("benchmark-corpus", "", "Directory of press releases to benchmark against instead of the bundled corpus (implies -benchmark)")
+	deterministic := flag.Bool("deterministic", false, "Make -report and -format markdown identical on every run of the same document, for snapshot tests: a fixed analysis date and no build details")
+	explain := flag.Bool("explain", false, "Print the points every scoring rule awarded or deducted (adds a trace to -format json and markdown)")
+	checkpointFile := flag.String("checkpoint", batch.DefaultCheckpoint, "For a directory, record finished documents in this file until the run completes")
+	resume := flag.Bool("resume", false, "For a directory, restore the documents recorded by an interrupted run's -checkpoint instead of scoring them again")
+	noCache := flag.Bool("no-cache", false, "For a directory, score every document instead of reusing the cached results of unchanged ones")
+	quiet := flag.Bool("quiet", false, "For a directory, do not print the progress bar and timing to stderr")
+	changed := flag.Bool("changed", false, "For a directory, score only the documents git reports as modified or untracked")
+	since := flag.String("since", "", "With -changed, also score documents changed by commits since this ref diverged, e.g. origin/main (implies -changed)")
+	sectionList := flag.String("sections", parser.DefaultSections, "Comma-separated sections to require and send for AI feedback: pr, faq, metrics")
+	glossaryFlag := flag.String("glossary", "", "Define the product names, acronyms, and technical terms with AI: "+glossaryReport+" appends a glossary to -report, or prints it without one; "+glossaryDocument+" adds it to the PR-FAQ file, keeping a .bak")
+	suggest := flag.Bool("suggest-headlines", false, "Ask the AI for alternative headlines and print them ranked by the headline score")
+	audienceFlag := flag.String("audience", "", "Readership that selects jargon lists, sentence-length limits, and required FAQ questions: "+audienceNames()+" (default: audience from config, else general)")
+	toneFlag := flag.String("tone", "", "Register the Tone & Readability score expects: "+toneNames()+" (default: tone from the front matter, else from config, else formal)")
+	docTypeFlag := flag.String("doc-type", "", "Document type that selects required sections and score weights: "+docTypeNames()+" (default: doc_type from the front matter, else external)")
+	rulesVersionFlag := flag.String("rules-version", "", "Pin the scoring model, e.g. rules/v1; fail if this release cannot score with it (default: rules_version from config, else "+parser.CurrentRules.ID()+")")
+	requireApproval := flag.Bool("require-approval", false, "Hold only documents whose review status is approved to -min-score; drafts and documents in review may score lower")
+	minScoreFlag := flag.Int("min-score", 0, "Exit with code 1 when a document scores below this (default: min_score from config, else no threshold)")
+	flag.BoolVar(&blameFindings, "blame", false, "Annotate findings with the commit and author that last changed their line, from git blame (-format json, gcc, junit, text, and templates; needs git on PATH)")
+	flag.BoolVar(&plainReport, "plain", false, "Label statuses PASS, WARN, and FAIL instead of with emoji in the markdown report (-report, -format markdown, and -no-tui)")
+	telemetryFlag := flag.String("telemetry", "", "Post anonymous score bands and rule hit counts, never file names or text, to this http(s) URL (default: telemetry.endpoint from config, else off)")
+	noTelemetry := flag.Bool("no-telemetry", false, "Send no telemetry, even when telemetry.endpoint is set in config")
+	cpuProfile := flag.String("cpuprofile", "", "Write a CPU profile of the run to this file, for go tool pprof")
+	memProfile := flag.String("memprofile", "", "Write a heap profile to this file at the end of the run, for go tool pprof")
+	flag.StringVar(&summaryFile, "summary", "", "Write a machine-readable run summary (status, timing, scores, finding counts) to this JSON file")
+	logOpts := addLogFlags(flag.CommandLine)
+	parseFlags(flag.CommandLine, os.Args[1:])
+
+	// A badge alone replaces the TUI; with any other output it is written alongside
+	benchmarking := *benchmarkFlag || *benchmarkCorpus != ""
+	otherOutput := *reportFile != "" || *noTUI || *format != "" || *explain || *dashboardFile != "" || *suggest || *glossaryFlag != "" || benchmarking
+	tuiMode := !otherOutput && *badgeFile == ""
+	setupLogging(logOpts, tuiMode)
+	stop, err := profile.Start(*cpuProfile, *memProfile)
+	if err != nil {
+		fatal("failed to start profiling", err)
+	}
+	stopProfiling = stop
+
+	if len(inputFiles) == 0 {
+		fatal("missing required flag", usage(errors.New("please provide a markdown file with -file")))
+	}
+	// A document kept in several files is named, and its findings located, by file
+	inputFile := &inputFiles[0]
+	multiFile := len(inputFiles) > 1 || parser.IsManifest(*inputFile)
+	docName := batch.Name(inputFiles)
+
+	var outputFormat prfaq.Format
+	if *format != "" {
+		f, err := prfaq.ParseFormat(*format)
+		if err != nil {
+			fatal("invalid -format", usage(err))
+		}
+		outputFormat = f
+	}
+	if *explain && outputFormat != "" && outputFormat != prfaq.FormatJSON && outputFormat != prfaq.FormatMarkdown && outputFormat != prfaq.FormatText {
+		fatal("invalid -explain", usage(fmt.Errorf("-explain is not supported with -format %s", outputFormat)))
+	}
+	selected, err := parser.ParseSectionSet(*sectionList)
+	if err != nil {
+		fatal("invalid -sections", usage(err))
+	}
+	tmpl, err := loadTemplate(*reportTemplate, outputFormat, *reportFile != "")
+	if err != nil {
+		fatal("invalid -report-template", usage(err), "file", *reportTemplate)
+	}
+	if blameFindings && outputFormat == "" && tmpl == nil {
+		fatal("invalid -blame", usage(errors.New("-blame applies only to -format and -report-template output")))
+	}
+	if blameFindings {
+		if err := blame.Check(); err != nil {
+			fatal("invalid -blame", usage(err))
+		}
+	}
+
+	if plainReport && tmpl != nil {
+		fatal("invalid -plain", usage(errors.New("-plain applies only to the built-in markdown report, not -report-template")))
+	}
+
+	if *glossaryFlag != "" && *glossaryFlag != glossaryReport && *glossaryFlag != glossaryDocument {
+		fatal("invalid -glossary", usage(fmt.Errorf("-glossary must be %s or %s, got %q", glossaryReport, glossaryDocument, *glossaryFlag)))
+	}
+	if *glossaryFlag == glossaryDocument && multiFile {
+		fatal("invalid -glossary", usage(errors.New("-glossary document needs a single -file to add the glossary to")))
+	}
+
+	if *offline {
+		if err := checkOffline(*createTickets || *exportReport, *suggest, *semanticFlag, *glossaryFlag != "", *flowFlag); err != nil {
+			fatal("offline mode conflict", usage(err))
+		}
+		llm.SetOffline(true)
+	}
+
+	cfg, err := loadConfig(*configFile, *policyFlag, *offline)
+	if err != nil {
+		fatal("failed to load config", err)
+	}
+	if run.MinScore, err = minScore(*minScoreFlag, cfg); err != nil {
+		fatal("invalid -min-score", err)
+	}
+	if *requireApproval && run.MinScore == 0 {
+		fatal("invalid -require-approval", usage(errors.New("-require-approval needs a threshold from -min-score or min_score")))
+	}
+	run.RequireApproval = *requireApproval
+	if telemetryURL, err = telemetryEndpoint(*telemetryFlag, *noTelemetry, *offline, cfg); err != nil {
+		fatal("invalid -telemetry", err)
+	}
+	aud, err := audience(*audienceFlag, cfg)
+	if err != nil {
+		fatal("invalid -audience", err)
+	}
+	var docType parser.DocType
+	if *docTypeFlag != "" {
+		if docType, err = parser.ParseDocType(*docTypeFlag); err != nil {
+			fatal("invalid -doc-type", usage(err))
+		}
+	}
+	tone, defaultTone, err := tones(*toneFlag, cfg)
+	if err != nil {
+		fatal("invalid -tone", err)
+	}
+	rulesPin, err := rulesVersion(*rulesVersionFlag, cfg)
+	if err != nil {
+		fatal("invalid -rules-version", err)
+	}
+	hedgeSeverity, err := hedgingSeverity(cfg)
+	if err != nil {
+		fatal("failed to load config", err)
+	}
+	if err := parser.CheckWeights(cfg.Weights); err != nil {
+		fatal("failed to load config", fmt.Errorf("%w: weights: %w", config.ErrInvalid, err))
+	}
+	if err := checkScoring(cfg); err != nil {
+		fatal("failed to load config", err)
+	}
+	if err := parser.CheckCodenames(cfg.Compliance.CodenamePatterns); err != nil {
+		fatal("failed to load config", fmt.Errorf("%w: compliance.codename_patterns: %w", config.ErrInvalid, err))
+	}
+	opts := prfaq.Options{Explain: *explain, Audience: aud, DocType: docType, Tone: tone, DefaultTone: defaultTone, HedgeSeverity: string(hedgeSeverity), PublicCompany: cfg.Compliance.PublicCompany, Weights: cfg.Weights, ScoringMode: cfg.Scoring.Mode, SeverityCosts: cfg.Scoring.SeverityCosts, Deterministic: *deterministic, RulesVersion: rulesPin}
+	opts.Legal = prfaq.LegalOptions{Phrases: cfg.Compliance.LegalPhrases, RegisteredMarks: cfg.Compliance.RegisteredMarks, Strict: *legalStrict || cfg.Compliance.LegalStrict}
+	opts.BrandNames = cfg.Compliance.BrandNames
+	opts.Codenames = cfg.Compliance.CodenamePatterns
+	if *semanticFlag {
+		opts.TopicMatcher = semantic.New()
+	}
+	if *flowFlag {
+		opts.FlowChecker = flow.New()
+	}
+	if *corpusDir != "" {
+		corpus, err := similar.FromDir(*corpusDir)
+		if err != nil {
+			fatal("failed to load -corpus", err, "dir", *corpusDir)
+		}
+		logger.Debug("corpus indexed", "dir", *corpusDir, "documents", corpus.Len())
+		opts.Corpus = corpus
+	}
+	llm.SetRateLimit(llm.RateLimit{
+		RequestsPerMinute: cfg.LLM.RequestsPerMinute,
+		TokensPerMinute:   cfg.LLM.TokensPerMinute,
+		OnThrottle:        throttleNotice(throttleOutput(*noTUI)),
+	})
+	llm.SetRedactor(redactor(*redactFlag, cfg))
+	llm.SetPreflight(preflightScanner(cfg), *allowPII || cfg.LLM.AllowPII)
+	setProxy(cfg)
+	if err := llm.SetTranscript(*llmLog, cfg.LLM.LogOmitContent); err != nil {
+		fatal("failed to set up -llm-log", err, "dir", *llmLog)
+	}
+	if err := setFixtures(*llmRecord, *llmReplay, *offline); err != nil {
+		fatal("invalid -llm-record or -llm-replay", err)
+	}
+	if err := checkProvider(*offline); err != nil {
+		fatal("invalid "+llm.ProviderEnv, err)
+	}
+	gen, err := generation(*temperature, *maxTokens)
+	if err != nil {
+		fatal("invalid generation parameters", err)
+	}
+	llm.SetGeneration(gen)
+
+	if info, err := os.Stat(*inputFile); err == nil && info.IsDir() && !multiFile {
+		if err := checkBatch(outputFormat, *dashboardFile, *reportFile != "" || *badgeFile != "" || *createTickets || *exportReport || *suggest || *glossaryFlag != "" || benchmarking); err != nil {
+			fatal("invalid flags for a directory", usage(err))
+		}
+		if *resume && outputFormat == prfaq.FormatMarkdown && tmpl == nil {
+			fatal("invalid -resume", usage(errors.New("restored documents cannot be rendered as -format markdown; use json, csv, tsv, gcc, junit, or -report-template")))
+		}
+		paths, err := documents(*inputFile, *changed || *since != "", *since)
+		if err != nil {
+			fatal("failed to find documents", err, "dir", *inputFile)
+		}
+		if len(paths) == 0 {
+			logger.Info("no changed documents", "dir", *inputFile, "since", *since)
+			fmt.Fprintln(os.Stderr, "No changed PR-FAQ documents")
+			return
+		}
+		if *historyRuns < 1 {
+			fatal("invalid -history-runs", usage(fmt.Errorf("-history-runs must be at least 1, got %d", *historyRuns)))
+		}
+		var history *batch.History
+		if *historyFile != "" {
+			if history, err = batch.LoadHistory(*historyFile); err != nil {
+				fatal("failed to load history", err, "file", *historyFile)
+			}
+		}
+		cp, err := batch.OpenCheckpoint(*checkpointFile, *resume)
+		if err != nil {
+			fatal("failed to open checkpoint", err, "file", *checkpointFile)
+		}
+		var cache *batch.Cache
+		// Cached results, like restored ones, cannot be rendered as -format markdown
+		if !*noCache && (outputFormat != prfaq.FormatMarkdown || tmpl != nil) {
+			if cache, err = openCache(); err != nil {
+				logger.Warn("results cache unavailable; scoring every document", "error", err)
+			}
+		}
+		if err := runBatch(*inputFile, paths, outputFormat, tmpl, *dashboardFile, history, *historyRuns, opts, cp, cache, *quiet); err != nil {
+			fatal("batch run failed", err, "dir", *inputFile)
+		}
+		return
+	}
+	if *changed || *since != "" {
+		fatal("invalid -changed", usage(errors.New("-changed and -since need a directory for -file")))
+	}
+	if *dashboardFile != "" {
+		fatal("invalid -dashboard", usage(errors.New("-dashboard needs a directory for -file")))
+	}
+	if *historyFile != "" {
+		fatal("invalid -history", usage(errors.New("-history needs a directory for -file")))
+	}
+	if *resume {
+		fatal("invalid -resume", usage(errors.New("-resume needs a directory for -file")))
+	}
+
+	logger.Debug("parsing PR-FAQ", "file", docName)
+	// Scored once below, with the options applied
+	sections, err := parser.ParseOutline(inputFiles...)
+	if err != nil {
+		fatal("failed to parse PR-FAQ", err, "file", docName)
+	}
+	// rulesVersion already validated the pin
+	rules, _ := parser.ResolveRules(rulesPin)
+	docTone := sections.Tone
+	if docTone == "" {
+		docTone = defaultTone
+	}
+	if tone != "" {
+		docTone = tone
+	}
+	sections.PublicCompany = opts.PublicCompany
+	sections.Legal = opts.Legal
+	sections.BrandNames = opts.BrandNames
+	sections.Codenames = opts.Codenames
+	sections.Weights = opts.Weights
+	// checkScoring already validated the scoring settings
+	sections.ScoringMode, _ = parser.ParseScoringMode(opts.ScoringMode)
+	sections.SeverityCosts, _ = parser.ParseSeverityCosts(opts.SeverityCosts)
+	sections.HedgeSeverity = hedgeSeverity
+	sections.Audience = aud
+	sections.TopicMatcher = opts.TopicMatcher
+	sections.FlowChecker = opts.FlowChecker
+	if docType != "" {
+		sections.DocType = docType
+	}
+	sections.Tone = docTone
+	sections.Rules = rules
+	// The TUI scores in the background, so a large document opens at once;
+	// filing tickets and the accessible interface need the score first
+	background := tuiMode && !*createTickets && !*accessible
+	if !background {
+		sections.PRScore = parser.Score(sections)
+	}
+	sections.Deterministic = opts.Deterministic
+	if opts.Corpus != nil {
+		sections.Similar = opts.Corpus.Similar(docName, sections)
+	}
+	if err := sections.ValidateSections(selected); err != nil {
+		fatal("incomplete PR-FAQ", err, "file", docName)
+	}
+	if !background {
+		recordScore(docName, sections)
+	}
+
+	if *suggest {
+		if err := suggestHeadlines(os.Stdout, sections); err != nil {
+			fatal("failed to suggest headlines", err)
+		}
+		return
+	}
+
+	var glossary string
+	if *glossaryFlag != "" {
+		if glossary, err = buildGlossary(sections); err != nil {
+			fatal("failed to generate glossary", err)
+		}
+		switch {
+		case *glossaryFlag == glossaryDocument:
+			if err := addGlossary(*inputFile, glossary); err != nil {
+				fatal("failed to add glossary", err, "file", *inputFile)
+			}
+			fmt.Printf("Glossary added to %s (backup: %s.bak)\n", *inputFile, *inputFile)
+			return
+		case *reportFile == "":
+			fmt.Print(glossary)
+			return
+		}
+	}
+
+	if *createTickets {
+		if err := fileTickets(cfg.Tickets, sections); err != nil {
+			fatal("failed to file tickets", err)
+		}
+	}
+
+	if *exportReport {
+		if err := exportResult(cfg.Export, inputFiles, opts); err != nil {
+			fatal("failed to export report", err)
+		}
+	}
+
+	if *badgeFile != "" {
+		if err := writeBadge(*badgeFile, sections.PRScore.OverallScore); err != nil {
+			fatal("failed to write badge", err, "file", *badgeFile)
+		}
+		logger.Info("badge generated", "file", *badgeFile, "score", sections.PRScore.OverallScore)
+		if !otherOutput {
+			fmt.Printf("Badge generated: %s\n", *badgeFile)
+			return
+		}
+	}
+
+	if outputFormat != "" {
+		if err := writeFormatted(inputFiles, outputFormat, tmpl, opts); err != nil {
+			fatal("failed to write analysis", err)
+		}
+		return
+	}
+
+	if benchmarking {
+		if err := writeBenchmark(inputFiles, *benchmarkCorpus, opts); err != nil {
+			fatal("failed to benchmark", err)
+		}
+		if !*explain && *reportFile == "" {
+			return
+		}
+		fmt.Println()
+	}
+
+	if *explain {
+		fmt.Print(parser.Explain(sections.PRScore))
+		if *reportFile == "" {
+			return
+		}
+		fmt.Println()
+	}
+
+	// If markdown report is requested, generate and save it
+	if *reportFile != "" {
+		if err := writeReport(*reportFile, inputFiles, sections, tmpl, opts, glossary); err != nil {
+			fatal("failed to write report", err, "file", *reportFile)
+		}
+		logger.Info("report generated", "file", *reportFile, "score", sections.PRScore.OverallScore)
+		fmt.Printf("Report generated: %s\n", *reportFile)
+		fmt.Printf("Overall Score: %d/100\n", sections.PRScore.OverallScore)
+		return
+	}
+
+	// If TUI is disabled, output to stdout (legacy mode)
+	if *noTUI {
+		if err := runLegacyOutput(*sections, selected); err != nil {
+			fatal("AI analysis failed", err)
+		}
+		return
+	}
+
+	if *accessible {
+		scored := runAccessible(*sections, selected)
+		recordScore(docName, &scored)
+		return
+	}
+
+	// Run interactive TUI
+	// Fixes are applied to the source file, which a document kept in several
+	// files, or assembled from includes, lacks
+	source := *inputFile
+	if multiFile || len(sections.Parts) > 0 {
+		source = ""
+	}
+	scored := runInteractiveTUI(*sections, selected, source)
+	recordScore(docName, &scored)
+}
+
+// recordScore logs the document's score and adds it to the run summary and
+// telemetry, scoring it first if the TUI closed before it finished.
+func recordScore(docName string, sections *parser.SpecSections) {
+	if sections.PRScore == nil {
+		sections.PRScore = parser.Score(sections)
+	}
+	logger.Info("PR-FAQ scored", "file", docName, "score", sections.PRScore.OverallScore)
+	run.AddReviewed(docName, sections.PRScore.OverallScore, string(sections.Review.State), severities(sections.Findings()))
+	stats.Add(sections.PRScore.OverallScore, ruleIDs(sections.Findings()))
+}
+
+// minScore resolves the pass threshold: -min-score when it was given,
+// otherwise min_score from the config file.
+func minScore(flagValue int, cfg *config.Config) (int, error) {
+	set := false
+	flag.Visit(func(f *flag.Flag) { set = set || f.Name == "min-score" })
+	if !set {
+		return cfg.MinScore, nil
+	}
+	if flagValue < 0 || flagValue > 100 {
+		return 0, usage(fmt.Errorf("-min-score %d is outside 0-100", flagValue))
+	}
+	return flagValue, nil
+}
+
+// loadConfig loads the config file at path layered over the organization
+// policy named by source, or by $PRFAQ_POLICY when source is empty. A policy
+// that cannot be fetched falls back to its cached copy, with a warning.
+func loadConfig(path, source string, offline bool) (*config.Config, error) {
+	cfg, _, err := loadConfigLayers(path, source, offline)
+	return cfg, err
+}
+
+// loadConfigLayers is loadConfig, also returning where each setting came from.
+func loadConfigLayers(path, source string, offline bool) (*config.Config, config.Origins, error) {
+	if source == "" {
+		source = os.Getenv(config.PolicyEnv)
+	}
+	if source == "" {
+		return config.LoadLayers(nil, path)
+	}
+	cacheDir, err := config.PolicyCache()
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	policy, err := config.LoadPolicy(ctx, &http.Client{}, source, cacheDir, offline, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	if policy.Stale {
+		logger.Warn("failed to fetch policy; using the cached copy", "policy", source)
+	}
+	logger.Debug("loaded policy", "policy", source, "cached", policy.Cached)
+	return config.LoadLayers(policy, path)
+}
+
+// telemetryEndpoint returns the URL to send telemetry to, or "" when the run
+// has not opted in. -offline turns off telemetry set in the config, and
+// rejects -telemetry.
+func telemetryEndpoint(flagValue string, disabled, offline bool, cfg *config.Config) (string, error) {
+	if disabled {
+		return "", nil
+	}
+	if flagValue != "" {
+		if offline {
+			return "", usage(errors.New("-telemetry requires network access and cannot be combined with -offline"))
+		}
+		if err := telemetry.CheckEndpoint(flagValue); err != nil {
+			return "", usage(err)
+		}
+		return flagValue, nil
+	}
+	if offline || cfg.Telemetry.Endpoint == "" {
+		return "", nil
+	}
+	if err := telemetry.CheckEndpoint(cfg.Telemetry.Endpoint); err != nil {
+		return "", fmt.Errorf("%w: telemetry.endpoint: %w", config.ErrInvalid, err)
+	}
+	return cfg.Telemetry.Endpoint, nil
+}
+
+// generation returns the -temperature and -max-tokens overrides of the
+// prompt files' parameters, for the flags that were given.
+func generation(temperature float64, maxTokens int) (llm.Generation, error) {
+	var g llm.Generation
+	var err error
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "temperature":
+			if temperature < 0 || temperature > 2 {
+				err = usage(fmt.Errorf("-temperature %g is outside 0-2", temperature))
+			}
+			g.Temperature = &temperature
+		case "max-tokens":
+			if maxTokens <= 0 {
+				err = usage(fmt.Errorf("-max-tokens %d must be positive", maxTokens))
+			}
+			g.MaxTokens = maxTokens
+		}
+	})
+	return g, err
+}
+
+// audience resolves the readership: -audience when it was given, otherwise
+// audience from the config file.
+func audience(flagValue string, cfg *config.Config) (parser.Audience, error) {
+	if flagValue != "" {
+		a, err := parser.ParseAudience(flagValue)
+		if err != nil {
+			return "", usage(err)
+		}
+		return a, nil
+	}
+	a, err := parser.ParseAudience(cfg.Audience)
+	if err != nil {
+		return "", fmt.Errorf("%w: audience: %w", config.ErrInvalid, err)
+	}
+	return a, nil
+}
+
+// tones returns the tone -tone forces on every document and the tone from
+// the config file for documents whose front matter sets none. Either is ""
+// when not given.
+func tones(flagValue string, cfg *config.Config) (parser.Tone, parser.Tone, error) {
+	var tone, defaultTone parser.Tone
+	var err error
+	if flagValue != "" {
+		if tone, err = parser.ParseTone(flagValue); err != nil {
+			return "", "", usage(err)
+		}
+	}
+	if cfg.Tone != "" {
+		if defaultTone, err = parser.ParseTone(cfg.Tone); err != nil {
+			return "", "", fmt.Errorf("%w: tone: %w", config.ErrInvalid, err)
+		}
+	}
+	return tone, defaultTone, nil
+}
+
+// hedgingSeverity returns hedging.severity from the config file, or "" when
+// it is not set.
+func hedgingSeverity(cfg *config.Config) (parser.Severity, error) {
+	if cfg.Hedging.Severity == "" {
+		return "", nil
+	}
+	sev, err := parser.ParseSeverity(cfg.Hedging.Severity)
+	if err != nil {
+		return "", fmt.Errorf("%w: hedging.severity: %w", config.ErrInvalid, err)
+	}
+	return sev, nil
+}
+
+// checkScoring validates the scoring mode and severity costs of the config
+// file.
+func checkScoring(cfg *config.Config) error {
+	if _, err := parser.ParseScoringMode(cfg.Scoring.Mode); err != nil {
+		return fmt.Errorf("%w: scoring.mode: %w", config.ErrInvalid, err)
+	}
+	if _, err := parser.ParseSeverityCosts(cfg.Scoring.SeverityCosts); err != nil {
+		return fmt.Errorf("%w: scoring.severity_costs: %w", config.ErrInvalid, err)
+	}
+	return nil
+}
+
+// rulesVersion checks and returns the pinned scoring model: -rules-version
+// when it was given, otherwise rules_version from the config file.
+func rulesVersion(flagValue string, cfg *config.Config) (string, error) {
+	if flagValue != "" {
+		if _, err := parser.ResolveRules(flagValue); err != nil {
+			return "", usage(err)
+		}
+		return flagValue, nil
+	}
+	if _, err := parser.ResolveRules(cfg.RulesVersion); err != nil {
+		return "", fmt.Errorf("%w: rules_version: %w", config.ErrInvalid, err)
+	}
+	return cfg.RulesVersion, nil
+}
+
+// audienceNames lists the -audience values for help text.
+func audienceNames() string {
+	names := make([]string, len(parser.Audiences))
+	for i, a := range parser.Audiences {
+		names[i] = string(a)
+	}
+	return strings.Join(names, ", ")
+}
+
+// toneNames lists the -tone values for help text.
+func toneNames() string {
+	names := make([]string, len(parser.Tones))
+	for i, t := range parser.Tones {
+		names[i] = string(t)
+	}
+	return strings.Join(names, ", ")
+}
+
+// docTypeNames lists the -doc-type values for help text.
+func docTypeNames() string {
+	names := make([]string, len(parser.DocTypes))
+	for i, t := range parser.DocTypes {
+		names[i] = string(t)
+	}
+	return strings.Join(names, ", ")
+}
+
+// throttleOutput is where rate limit waits are shown outside a directory
+// run: stderr with -no-tui, and nowhere while the TUI owns the terminal.
+func throttleOutput(noTUI bool) io.Writer {
+	if noTUI {
+		return os.Stderr
+	}
+	return nil
+}
+
+// batchProgress is the progress display of a directory run, while one is
+// shown.
+var batchProgress *progress.Reporter
+
+// throttleNotice reports rate limit waits in the log, and next to the run's
+// progress: in the progress display of a directory run, or else on out when
+// it is set. Stdout is left to the report, so out is stderr or nil.
+func throttleNotice(out io.Writer) func(time.Duration) {
+	return func(wait time.Duration) {
+		logger.Info("throttling LLM requests", "wait", wait)
+		msg := fmt.Sprintf("Rate limit reached: waiting %s before the next AI request", wait.Round(time.Second))
+		switch {
+		case batchProgress != nil:
+			batchProgress.Notice(msg)
+		case out != nil:
+			fmt.Fprintln(out, msg)
+		}
+	}
+}
+
+// severities lists the severity of each finding, for the run summary.
+func severities(findings []parser.Finding) []string {
+	out := make([]string, len(findings))
+	for i, f := range findings {
+		out[i] = string(f.Severity)
+	}
+	return out
+}
+
+// ruleIDs returns the rule ID of each finding.
+func ruleIDs(findings []parser.Finding) []string {
+	out := make([]string, len(findings))
+	for i, f := range findings {
+		out[i] = f.RuleID
+	}
+	return out
+}
+
+// writeFormatted scores the document in files through the public prfaq API and prints it in format.
+func writeFormatted(files []string, format prfaq.Format, tmpl *prfaq.Template, opts prfaq.Options) error {
+	result, err := batch.ScoreFiles(files, opts)
+	if err != nil {
+		return err
+	}
+	out, err := renderAll([]prfaq.Result{*result}, format, tmpl)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
+
+// loadTemplate reads the -report-template file, if one was given, or the
+// built-in template of that name when no such file exists. Templates only
+// replace the markdown layout, so some markdown output must be requested.
+func loadTemplate(path string, format prfaq.Format, reportFile bool) (*prfaq.Template, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if format != prfaq.FormatMarkdown && (format != "" || !reportFile) {
+		return nil, errors.New("-report-template applies only to -report and -format markdown")
+	}
+	text, err := os.ReadFile(path) //nolint:gosec // path is user-provided CLI argument
+	if errors.Is(err, fs.ErrNotExist) {
+		if tmpl, berr := prfaq.BuiltinTemplate(path); berr == nil {
+			return tmpl, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return prfaq.ParseTemplate(path, string(text))
+}
+
+// writeBenchmark prints where the document in files falls within the bundled
+// corpus, or the documents under corpusDir when it is set.
+func writeBenchmark(files []string, corpusDir string, opts prfaq.Options) error {
+	result, err := batch.ScoreFiles(files, opts)
+	if err != nil {
+		return err
+	}
+	var stats *benchmark.Stats
+	if corpusDir != "" {
+		stats, err = benchmark.FromDir(corpusDir, opts)
+	} else {
+		stats, err = benchmark.Bundled(opts)
+	}
+	if err != nil {
+		return err
+	}
+	return benchmark.Write(os.Stdout, benchmark.Compare(*result, stats), stats)
+}
+
+// blameFindings is set by -blame.
+var blameFindings bool
+
+// addBlame annotates the findings of results scored from files with git
+// blame when -blame is set. A document git cannot blame, such as one outside
+// a repository, is left unannotated with a warning rather than failing the run.
+func addBlame(results []prfaq.Result) {
+	if !blameFindings {
+		return
+	}
+	for i := range results {
+		if err := prfaq.AddBlame(&results[i], results[i].Name); err != nil {
+			logger.Warn("failed to blame findings", "file", results[i].Name, "error", err)
+		}
+	}
 }
 
-func main() {
-	inputFile := flag.String("file", "", "Path to the PR-FAQ markdown file")
-	reportFile := flag.String("report", "", "Optional: Output markdown report file (default: interactive TUI)")
-	noTUI := flag.Bool("no-tui", false, "Disable interactive TUI and output to stdout")
-	flag.Parse()
+// plainReport is set by -plain.
+var plainReport bool
 
-	if *inputFile == "" {
-		logger.Error("missing required flag", "flag", "file")
-		fmt.Fprintln(os.Stderr, "Please provide a markdown file with -file")
-		os.Exit(1)
+// markdownReport is the built-in markdown report, without emoji when -plain is set.
+func markdownReport(sections *parser.SpecSections) string {
+	report := parser.GenerateMarkdownReport(sections, sections.PRScore)
+	if plainReport {
+		return parser.PlainReport(report)
 	}
+	return report
+}
+
+// renderAll renders results in format, using tmpl for markdown when set.
+func renderAll(results []prfaq.Result, format prfaq.Format, tmpl *prfaq.Template) ([]byte, error) {
+	addBlame(results)
+	if tmpl == nil || format != prfaq.FormatMarkdown {
+		out, err := prfaq.ReportAll(results, format)
+		if err == nil && plainReport && format == prfaq.FormatMarkdown {
+			out = []byte(parser.PlainReport(string(out)))
+		}
+		return out, err
+	}
+	var out []byte
+	for _, result := range results {
+		data, err := tmpl.Render(result)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", result.Name, err)
+		}
+		out = append(out, data...)
+	}
+	return out, nil
+}
 
-	sections, err := parser.ParsePRFAQ(*inputFile)
+// writeReport writes the -report file: the user's template if one was given,
+// otherwise the built-in markdown report.
+func writeReport(reportFile string, inputFiles []string, sections *parser.SpecSections, tmpl *prfaq.Template, opts prfaq.Options, glossary string) error {
+	if tmpl == nil {
+		return writeReportToFile(reportFile, withAppendix(markdownReport(sections), glossary))
+	}
+	result, err := batch.ScoreFiles(inputFiles, opts)
+	if err != nil {
+		return err
+	}
+	results := []prfaq.Result{*result}
+	addBlame(results)
+	out, err := tmpl.Render(results[0])
 	if err != nil {
-		logger.Error("failed to parse PR-FAQ", "file", *inputFile, "error", err)
-		fmt.Fprintf(os.Stderr, "Failed to parse PR-FAQ: %v\n", err)
-		os.Exit(1)
+		return err
 	}
+	return writeReportToFile(reportFile, withAppendix(string(out), glossary))
+}
 
-	// If markdown report is requested, generate and save it
-	if *reportFile != "" {
-		report := parser.GenerateMarkdownReport(sections, sections.PRScore)
-		err := writeReportToFile(*reportFile, report)
+// withAppendix returns report followed by appendix, such as a glossary,
+// separated by a blank line.
+func withAppendix(report, appendix string) string {
+	if appendix == "" {
+		return report
+	}
+	return strings.TrimRight(report, "\n") + "\n\n" + appendix
+}
+
+// -glossary targets.
+const (
+	glossaryReport   = "report"
+	glossaryDocument = "document"
+)
+
+// glossaryExtraTerms is how many technical terms -glossary lets the AI add
+// to the ones extracted from the document.
+const glossaryExtraTerms = 5
+
+// buildGlossary asks the LLM to define the document's product names,
+// acronyms, and technical terms, and renders the definitions as markdown.
+func buildGlossary(sections *parser.SpecSections) (string, error) {
+	content := strings.Join([]string{sections.PressRelease, sections.FAQs, sections.Metrics}, "\n\n")
+	defs, err := llm.DefineTerms(content, parser.GlossaryTerms(sections), glossaryExtraTerms)
+	if err != nil {
+		return "", err
+	}
+	return report.Glossary(defs), nil
+}
+
+// addGlossary writes glossary into the PR-FAQ at path, replacing an earlier
+// glossary, and keeps the previous contents in path+".bak".
+func addGlossary(path, glossary string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("%w: %w", parser.ErrRead, err)
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // path is user-provided CLI argument
+	if err != nil {
+		return fmt.Errorf("%w: %w", parser.ErrRead, err)
+	}
+	if err := os.WriteFile(path+".bak", data, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	return os.WriteFile(path, []byte(report.WithGlossary(string(data), glossary)), info.Mode().Perm())
+}
+
+// headlineSuggestions is how many alternatives -suggest-headlines asks for.
+const headlineSuggestions = 5
+
+// suggestHeadlines asks the LLM for alternative headlines and writes them,
+// with the current one, as a table ranked by the headline score.
+func suggestHeadlines(w io.Writer, sections *parser.SpecSections) error {
+	alternatives, err := llm.SuggestHeadlines(sections.Title, sections.PressRelease, headlineSuggestions)
+	if err != nil {
+		return err
+	}
+	return writeHeadlineTable(w, parser.RankHeadlines(sections.Title, alternatives))
+}
+
+// writeHeadlineTable writes ranked headlines, marking the document's own.
+func writeHeadlineTable(w io.Writer, ranked []parser.HeadlineCandidate) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "RANK\tSCORE\tHEADLINE\tISSUES")
+	for i, c := range ranked {
+		headline := c.Headline
+		if c.Current {
+			headline += " (current)"
+		}
+		issues := strings.Join(c.Issues, "; ")
+		if issues == "" {
+			issues = "-"
+		}
+		fmt.Fprintf(tw, "%d\t%d/%d\t%s\t%s\n", i+1, c.Score, parser.HeadlineMax, headline, issues)
+	}
+	return tw.Flush()
+}
+
+// checkOffline rejects explicitly requested features that need the network.
+// publish is set when -tickets or -export was given.
+func checkOffline(publish, suggestHeadlines, semanticMatching, glossary, flowCheck bool) error {
+	if publish {
+		return fmt.Errorf("-tickets and -export require network access and cannot be combined with -offline")
+	}
+	if suggestHeadlines {
+		return fmt.Errorf("-suggest-headlines requires network access and cannot be combined with -offline")
+	}
+	if semanticMatching {
+		return fmt.Errorf("-semantic requires network access and cannot be combined with -offline")
+	}
+	if glossary {
+		return fmt.Errorf("-glossary requires network access and cannot be combined with -offline")
+	}
+	if flowCheck {
+		return fmt.Errorf("-flow requires network access and cannot be combined with -offline")
+	}
+	return nil
+}
+
+// checkBatch rejects flag combinations a directory run cannot honor.
+// singleFileOutput is set when -report, -badge, -tickets, -export, -suggest-headlines, -glossary, or -benchmark was given.
+func checkBatch(format prfaq.Format, dashboard string, singleFileOutput bool) error {
+	if format == "" && dashboard == "" {
+		return errors.New("scoring a directory requires -format or -dashboard")
+	}
+	if singleFileOutput {
+		return errors.New("-report, -badge, -tickets, -export, -suggest-headlines, -glossary, and -benchmark need a single -file")
+	}
+	return nil
+}
+
+// openCache opens the results cache in the user's cache directory.
+func openCache() (*batch.Cache, error) {
+	dir, err := batch.DefaultCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	return batch.OpenCache(dir)
+}
+
+// documents lists the documents under dir to score: every one, or with
+// changed only those git reports as changed (since a ref, if set). Unlike
+// batch.Find, an empty list is not an error when changed is set.
+func documents(dir string, changed bool, since string) ([]string, error) {
+	if changed {
+		return batch.Changed(dir, since)
+	}
+	return batch.Find(dir)
+}
+
+// runBatch scores the documents at paths, found under dir, prints them in
+// format if one is set, and writes the aggregate dashboard if a path is set.
+// The scores are recorded in history, if set, and the dashboard charts the
+// last trendRuns runs of it. Finished documents are recorded in cp, which is
+// removed once every document is scored and kept for -resume if the run is
+// interrupted. Unchanged documents are taken from cache, if set. Progress
+// goes to stderr unless quiet is set.
+func runBatch(dir string, paths []string, format prfaq.Format, tmpl *prfaq.Template, dashboard string, history *batch.History, trendRuns int, opts prfaq.Options, cp *batch.Checkpoint, cache *batch.Cache, quiet bool) error {
+	logger.Info("scoring directory", "dir", dir, "documents", len(paths))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	var onDone batch.Progress
+	var bar *progress.Reporter
+	if !quiet {
+		bar = progress.New(os.Stderr, len(paths))
+		onDone = bar.Done
+		batchProgress = bar
+	}
+	results, err := batch.ScoreResumable(ctx, paths, opts, cp, cache, onDone)
+	if bar != nil {
+		batchProgress = nil
+		bar.Finish()
+	}
+	if err != nil {
+		_ = cp.Close()
+		return fmt.Errorf("%w (rerun with -resume to continue)", err)
+	}
+	if cp.Restored() > 0 {
+		logger.Info("resumed from checkpoint", "restored", cp.Restored(), "documents", len(paths))
+	}
+	if cache != nil && cache.Hits() > 0 {
+		logger.Info("reused cached results", "cached", cache.Hits(), "documents", len(paths))
+	}
+	if err := cp.Remove(); err != nil {
+		logger.Warn("failed to remove checkpoint", "error", err)
+	}
+	for _, result := range results {
+		sevs := make([]string, len(result.Findings))
+		rules := make([]string, len(result.Findings))
+		for i, f := range result.Findings {
+			sevs[i] = f.Severity
+			rules[i] = f.RuleID
+		}
+		status := ""
+		if result.Review != nil {
+			status = result.Review.Status
+		}
+		run.AddReviewed(result.Name, result.Score, status, sevs)
+		stats.Add(result.Score, rules)
+	}
+
+	summary := batch.Summarize(results)
+	if history != nil {
+		if err := history.Record(time.Now(), results); err != nil {
+			return err
+		}
+		summary.Trends = history.Trends(trendRuns)
+	}
+	if dashboard != "" {
+		if err := writeDashboard(dashboard, summary); err != nil {
+			return err
+		}
+		logger.Info("dashboard generated", "file", dashboard, "documents", len(results))
+		if format == "" {
+			fmt.Printf("Dashboard generated: %s\n", dashboard)
+			return nil
+		}
+	}
+
+	out, err := renderAll(results, format, tmpl)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
+
+// writeDashboard writes a markdown dashboard, or HTML when path ends in .html.
+func writeDashboard(path string, summary batch.Summary) error {
+	var buf bytes.Buffer
+	render := batch.WriteMarkdown
+	if ext := strings.ToLower(filepath.Ext(path)); ext == ".html" || ext == ".htm" {
+		render = batch.WriteHTML
+	}
+	if err := render(&buf, summary); err != nil {
+		return err
+	}
+	return writeReportToFile(path, buf.String())
+}
+
+// redactor returns the Redactor that -redact or llm.redact turns on, or nil.
+// The company and media contact from the boilerplate settings are always
+// redacted, since they are known without detection.
+func redactor(enabled bool, cfg *config.Config) *redact.Redactor {
+	if !enabled && !cfg.LLM.Redact {
+		return nil
+	}
+	terms := slices.Clone(cfg.LLM.RedactTerms)
+	for _, t := range []string{cfg.Boilerplate.Company, cfg.Boilerplate.ContactName} {
+		if t != "" {
+			terms = append(terms, t)
+		}
+	}
+	return redact.New(terms)
+}
+
+// preflightScanner returns the scanner for content sent to the AI provider.
+// The boilerplate media contact is public, so it is never flagged.
+func preflightScanner(cfg *config.Config) *pii.Scanner {
+	allow := slices.Clone(cfg.LLM.PIIAllowlist)
+	for _, v := range []string{cfg.Boilerplate.ContactEmail, cfg.Boilerplate.ContactPhone} {
+		if v != "" {
+			allow = append(allow, v)
+		}
+	}
+	return pii.New(cfg.LLM.InternalDomains, allow)
+}
+
+// setProxy routes AI requests through the config's llm.proxy, when it is set.
+func setProxy(cfg *config.Config) {
+	if cfg.LLM.Proxy == "" {
+		return
+	}
+	client, err := llm.NewProxyClient(cfg.LLM.Proxy)
+	if err != nil {
+		fatal("invalid llm.proxy in config", err)
+	}
+	llm.SetHTTPClient(client)
+}
+
+// checkProvider fails when PRFAQ_LLM_PROVIDER names no provider, so the
+// mistake is reported once before any analysis rather than by every AI
+// request. A missing API key only skips AI feedback, so it is left alone.
+func checkProvider(offline bool) error {
+	if offline {
+		return nil
+	}
+	if _, err := llm.ProviderName(); errors.Is(err, llm.ErrUnknownProvider) {
+		return err
+	}
+	return nil
+}
+
+// setFixtures records AI responses into, or replays them from, a fixtures
+// directory.
+func setFixtures(record, replay string, offline bool) error {
+	switch {
+	case record != "" && replay != "":
+		return usage(errors.New("-llm-record and -llm-replay cannot be combined"))
+	case record != "" && offline:
+		return usage(errors.New("-llm-record needs the AI provider, which -offline disables"))
+	case record != "":
+		r, err := llm.NewRecorder(record)
 		if err != nil {
-			logger.Error("failed to write report", "file", *reportFile, "error", err)
-			fmt.Fprintf(os.Stderr, "Failed to write report: %v\n", err)
-			os.Exit(1)
+			return err
 		}
-		logger.Info("report generated", "file", *reportFile, "score", sections.PRScore.OverallScore)
-		fmt.Printf("Report generated: %s\n", *reportFile)
-		fmt.Printf("Overall Score: %d/100\n", sections.PRScore.OverallScore)
+		llm.SetClient(r)
+	case replay != "":
+		r, err := llm.NewReplayer(replay)
+		if err != nil {
+			return usage(err)
+		}
+		llm.SetClient(r)
+	}
+	return nil
+}
+
+// runLSPServer serves the Language Server Protocol over stdin/stdout.
+func runLSPServer(args []string) {
+	fs := flag.NewFlagSet("lsp", flag.ContinueOnError)
+	offline := fs.Bool("offline", false, "Disable AI rewrite code actions (no network access)")
+	redactFlag := fs.Bool("redact", false, "Redact company names, people, and dollar figures in AI rewrite requests (default: llm.redact from "+config.DefaultFile+")")
+	allowPII := fs.Bool("allow-pii", false, "Send AI rewrite requests that contain email addresses, phone numbers, API keys, or internal hostnames (default: llm.allow_pii from "+config.DefaultFile+")")
+	logOpts := addLogFlags(fs)
+	parseFlags(fs, args)
+
+	// stdout carries the protocol, so logs go to stderr or the log file
+	setupLogging(logOpts, false)
+
+	cfg, err := loadConfig("", "", *offline)
+	if err != nil {
+		fatal("failed to load config", err)
+	}
+	llm.SetRedactor(redactor(*redactFlag, cfg))
+	llm.SetPreflight(preflightScanner(cfg), *allowPII || cfg.LLM.AllowPII)
+	setProxy(cfg)
+
+	if err := checkProvider(*offline); err != nil {
+		fatal("invalid "+llm.ProviderEnv, err)
+	}
+
+	rewrite := lsp.RewriteFunc(llm.RewriteSection)
+	if *offline {
+		llm.SetOffline(true)
+		rewrite = nil
+	}
+
+	server := lsp.NewServer(os.Stdin, os.Stdout, rewrite)
+	if err := server.Run(); err != nil {
+		fatal("LSP server error", err)
+	}
+}
+
+// runHTTPServer serves score badges over HTTP until the process is stopped.
+func runHTTPServer(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := fs.String("addr", "localhost:8080", "Address to listen on")
+	pprofFlag := fs.Bool("pprof", false, "Also serve runtime profiles under /debug/pprof/ for go tool pprof; keep -addr private")
+	logOpts := addLogFlags(fs)
+	parseFlags(fs, args)
+
+	setupLogging(logOpts, false)
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           server.NewHandler(*pprofFlag),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	logger.Info("serving", "addr", *addr, "pprof", *pprofFlag)
+	if err := srv.ListenAndServe(); err != nil {
+		fatal("HTTP server error", err)
+	}
+}
+
+// runDoctor prints environment and configuration diagnostics, exiting with
+// exitConfig when any check fails.
+func runDoctor(args []string) {
+	fs := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	configFile := fs.String("config", "", "Path to config file (default: "+config.DefaultFile+" if present)")
+	offline := fs.Bool("offline", false, "Skip the AI provider reachability check")
+	logOpts := addLogFlags(fs)
+	parseFlags(fs, args)
+
+	setupLogging(logOpts, false)
+
+	// The reachability check goes through the configured proxy; an invalid
+	// config is reported by the config check instead
+	if cfg, err := config.Load(*configFile); err == nil {
+		setProxy(cfg)
+	}
+	checks := doctor.Run(doctor.Options{Version: buildinfo.Get().String(), ConfigFile: *configFile, Offline: *offline})
+	if err := doctor.Write(os.Stdout, checks); err != nil {
+		fatal("failed to write diagnostics", err)
+	}
+	if doctor.Failed(checks) {
+		os.Exit(exitConfig)
+	}
+}
+
+// runVersion prints the build information of this binary.
+func runVersion(args []string) {
+	fs := flag.NewFlagSet("version", flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "Print the build information as JSON")
+	parseFlags(fs, args)
+
+	info := buildinfo.Get()
+	info.Rules = parser.CurrentRules.String()
+	if *asJSON {
+		data, err := json.MarshalIndent(info, "", "  ")
+		if err != nil {
+			fatal("failed to encode build information", err)
+		}
+		fmt.Println(string(data))
 		return
 	}
+	fmt.Printf("pr-faq-validator %s\n", info.Version)
+	if info.Commit != "" {
+		fmt.Printf("commit:   %s\n", info.Commit)
+	}
+	if info.Date != "" {
+		fmt.Printf("built:    %s\n", info.Date)
+	}
+	fmt.Printf("rules:    %s\n", info.Rules)
+	fmt.Printf("go:       %s\n", info.GoVersion)
+	fmt.Printf("platform: %s\n", info.Platform)
+}
 
-	// If TUI is disabled, output to stdout (legacy mode)
-	if *noTUI {
-		runLegacyOutput(*sections)
+// runUpdate replaces this binary with the latest GitHub release when it is newer.
+func runUpdate(args []string) {
+	fs := flag.NewFlagSet("update", flag.ContinueOnError)
+	checkOnly := fs.Bool("check", false, "Only report whether a newer release exists")
+	force := fs.Bool("force", false, "Install the latest release even if it is not newer")
+	logOpts := addLogFlags(fs)
+	parseFlags(fs, args)
+
+	setupLogging(logOpts, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	client := &http.Client{}
+
+	current := buildinfo.Get().Version
+	rel, err := update.Latest(ctx, client)
+	if err != nil {
+		fatal("failed to check for updates", err)
+	}
+	if !*force && !update.Newer(current, rel.Tag) {
+		fmt.Printf("pr-faq-validator %s is up to date (latest release %s)\n", current, rel.Tag)
+		return
+	}
+	if *checkOnly {
+		fmt.Printf("pr-faq-validator %s is available (running %s): %s\n", rel.Tag, current, rel.URL)
+		fmt.Println("Run 'pr-faq-validator update' to install it.")
 		return
 	}
 
-	// Run interactive TUI
-	runInteractiveTUI(*sections)
+	exe, err := os.Executable()
+	if err != nil {
+		fatal("failed to locate the running binary", err)
+	}
+	if err := update.Apply(ctx, client, rel, exe); err != nil {
+		fatal("failed to update", err, "release", rel.Tag)
+	}
+	fmt.Printf("Updated pr-faq-validator %s -> %s\n", current, rel.Tag)
+}
+
+// runConfig runs "config show", which prints the effective config with the
+// layer each setting came from.
+func runConfig(args []string) {
+	if len(args) == 0 || args[0] != "show" {
+		fmt.Fprintln(os.Stderr, "usage: pr-faq-validator config show [-config file] [-policy url]")
+		os.Exit(exitConfig)
+	}
+	fs := flag.NewFlagSet("config show", flag.ContinueOnError)
+	configFile := fs.String("config", "", "Path to config file (default: "+config.DefaultFile+" if present)")
+	policyFlag := fs.String("policy", "", "Organization policy the config file is layered over: an http(s) URL or a path (default: $"+config.PolicyEnv+")")
+	offline := fs.Bool("offline", false, "Use only the cached copy of a policy URL")
+	logOpts := addLogFlags(fs)
+	parseFlags(fs, args[1:])
+
+	setupLogging(logOpts, false)
+
+	cfg, origins, err := loadConfigLayers(*configFile, *policyFlag, *offline)
+	if err != nil {
+		fatal("failed to load config", err)
+	}
+	if err := config.Show(os.Stdout, cfg, origins); err != nil {
+		fatal("failed to show config", err)
+	}
+}
+
+// runSchema checks the skeleton of each document against a schema: required
+// sections, their order, and word counts. It exits 1 when any document
+// departs from the schema. Scores are not computed.
+func runSchema(args []string) {
+	if len(args) == 0 || args[0] != "validate" {
+		fmt.Fprintln(os.Stderr, "usage: pr-faq-validator schema validate [-schema file] file...")
+		os.Exit(exitConfig)
+	}
+	fs := flag.NewFlagSet("schema validate", flag.ContinueOnError)
+	schemaFile := fs.String("schema", schema.DefaultFile, "Path to the schema, in YAML or JSON")
+	logOpts := addLogFlags(fs)
+	parseFlags(fs, args[1:])
+	if fs.NArg() == 0 {
+		fatal("no documents", fmt.Errorf("%w: schema validate needs at least one file", errUsage))
+	}
+
+	setupLogging(logOpts, false)
+
+	s, err := schema.Load(*schemaFile)
+	if err != nil {
+		fatal("failed to load schema", err, "file", *schemaFile)
+	}
+	failed := 0
+	for _, path := range fs.Args() {
+		sections, err := parser.ParseOutline(path)
+		if err != nil {
+			fatal("failed to parse PR-FAQ", err, "file", path)
+		}
+		violations := s.Check(sections.Tree)
+		for _, v := range violations {
+			if v.Line > 0 {
+				fmt.Printf("%s:%d: %s: %s\n", path, v.Line, v.Section, v.Message)
+			} else {
+				fmt.Printf("%s: %s: %s\n", path, v.Section, v.Message)
+			}
+		}
+		if len(violations) > 0 {
+			failed++
+		}
+	}
+	if failed > 0 {
+		fmt.Fprintf(os.Stderr, "%d of %d documents do not match %s\n", failed, fs.NArg(), *schemaFile)
+		os.Exit(exitBelowThreshold)
+	}
+}
+
+// runBoilerplate prints an "About <Company>" section drafted from the
+// boilerplate settings in the config file.
+func runBoilerplate(args []string) {
+	fs := flag.NewFlagSet("boilerplate", flag.ContinueOnError)
+	configFile := fs.String("config", "", "Path to config file (default: "+config.DefaultFile+" if present)")
+	policyFlag := fs.String("policy", "", "Organization policy the config file is layered over: an http(s) URL or a path (default: $"+config.PolicyEnv+")")
+	logOpts := addLogFlags(fs)
+	parseFlags(fs, args)
+
+	setupLogging(logOpts, false)
+
+	cfg, err := loadConfig(*configFile, *policyFlag, false)
+	if err != nil {
+		fatal("failed to load config", err)
+	}
+	b := cfg.Boilerplate
+	if b.Company == "" {
+		fatal("missing company", fmt.Errorf("%w: boilerplate.company is not set", config.ErrInvalid))
+	}
+	fmt.Print(parser.DraftBoilerplate(parser.BoilerplateInfo{
+		Company:      b.Company,
+		Founded:      b.Founded,
+		Headquarters: b.Headquarters,
+		Mission:      b.Mission,
+		Description:  b.Description,
+		URL:          b.URL,
+		ContactName:  b.ContactName,
+		ContactEmail: b.ContactEmail,
+		ContactPhone: b.ContactPhone,
+	}))
+}
+
+// runCompletion prints the completion script for the shell named by the argument.
+func runCompletion(args []string) {
+	fs := flag.NewFlagSet("completion", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: pr-faq-validator completion %s\n", strings.Join(completion.Shells, "|"))
+	}
+	parseFlags(fs, args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(exitConfig)
+	}
+
+	sections := make([]string, 0, len(parser.ReviewSections)+1)
+	for _, s := range parser.ReviewSections {
+		sections = append(sections, s.Key)
+	}
+	formats := make([]string, len(prfaq.Formats))
+	for i, f := range prfaq.Formats {
+		formats[i] = string(f)
+	}
+	audiences := make([]string, len(parser.Audiences))
+	for i, a := range parser.Audiences {
+		audiences[i] = string(a)
+	}
+
+	err := completion.Write(os.Stdout, fs.Arg(0), completion.Spec{
+		Program:     "pr-faq-validator",
+		Subcommands: subcommands,
+		Values: map[string][]string{
+			"-format":     formats,
+			"-sections":   append(sections, parser.DefaultSections),
+			"-audience":   audiences,
+			"-doc-type":   strings.Split(docTypeNames(), ", "),
+			"-tone":       strings.Split(toneNames(), ", "),
+			"-log-format": {"text", "json"},
+			"completion":  completion.Shells,
+		},
+	})
+	if err != nil {
+		fatal("failed to write completion script", usage(err))
+	}
 }
 
-// runInteractiveTUI starts the interactive TUI interface.
-func runInteractiveTUI(sections parser.SpecSections) {
+// runInteractiveTUI starts the interactive TUI interface and returns the
+// document as the TUI left it, scored unless it closed first. Fixes accepted in
+// the TUI are written to path.
+func runInteractiveTUI(sections parser.SpecSections, selected parser.SectionSet, path string) parser.SpecSections {
 	// Initialize TUI model
-	model := ui.NewModel(sections)
+	model := ui.NewModel(sections).WithSections(selected).WithSource(path)
 
 	// Create Bubble Tea program
 	p := tea.NewProgram(model, tea.WithAltScreen())
 
 	// Run the TUI
-	if _, err := p.Run(); err != nil {
-		logger.Error("TUI error", "error", err)
-		fmt.Fprintf(os.Stderr, "Error running TUI: %v\n", err)
-		os.Exit(1)
+	final, err := p.Run()
+	if err != nil {
+		fatal("TUI error", err)
+	}
+	return final.(ui.Model).Sections()
+}
+
+// accessibleEnv turns on -accessible when set to any value, as in other
+// Charm-based tools.
+const accessibleEnv = "ACCESSIBLE"
+
+// runAccessible runs the accessible interface on stdin and stdout in place
+// of the TUI and returns the document as it left it.
+func runAccessible(sections parser.SpecSections, selected parser.SectionSet) parser.SpecSections {
+	final, err := ui.RunAccessible(ui.NewModel(sections).WithSections(selected), os.Stdin, os.Stdout)
+	if err != nil {
+		fatal("accessible interface error", err)
 	}
+	return final.Sections()
 }
 
-// runLegacyOutput provides the original stdout-based output.
-func runLegacyOutput(sections parser.SpecSections) {
+// runLegacyOutput provides the original stdout-based output. The deterministic
+// report is always printed; a returned error means AI analysis was attempted
+// and failed. A missing API key only skips AI analysis.
+func runLegacyOutput(sections parser.SpecSections, selected parser.SectionSet) error {
 	// Generate comprehensive markdown report
-	report := parser.GenerateMarkdownReport(&sections, sections.PRScore)
-	fmt.Print(report)
+	fmt.Print(markdownReport(&sections))
 
 	// Original detailed analysis follows for reference
 	fmt.Printf("\n---\n\n== Detailed Analysis ==\n\n")
@@ -146,27 +1781,110 @@ func runLegacyOutput(sections parser.SpecSections) {
 			}
 			fmt.Println()
 		}
+	}
+
+	return reviewSections(sections, selected)
+}
+
+// reviewSections prints AI feedback for each selected section with content,
+// in one session so later sections reuse the cached conversation, and calls
+// out where the press release review disagrees with the deterministic
+// scores. A returned error means a review was attempted and failed; a
+// missing API key only skips them.
+func reviewSections(sections parser.SpecSections, selected parser.SectionSet) error {
+	if llm.Offline() {
+		fmt.Println("Offline mode: AI analysis skipped")
+		return nil
+	}
+
+	var llmErrs []error
+	session := llm.NewSession()
+	for _, sec := range parser.ReviewSections {
+		content := sections.Content(sec.Key)
+		if content == "" || !selected.Has(sec.Key) {
+			continue
+		}
 
-		fmt.Println("Analyzing Press Release...")
-		feedback, err := llm.AnalyzeSection("Press Release", sections.PressRelease)
+		fmt.Printf("Analyzing %s...\n", sec.Name)
+		feedback, err := session.AnalyzeSection(sec.Name, content)
 		if err != nil {
-			logger.Warn("LLM analysis failed", "section", "Press Release", "error", err)
-			fmt.Fprintf(os.Stderr, "LLM error: %v\n", err)
-		} else {
-			fmt.Printf("== Feedback for Press Release ==\n%s\n\n", feedback.Comments)
+			logger.Warn("LLM analysis failed", "section", sec.Name, "error", err)
+			llmErrs = append(llmErrs, err)
+			continue
+		}
+		fmt.Printf("== Feedback for %s ==\n%s\n\n", sec.Name, feedback.Comments)
+		if sec.Key == "pr" {
+			if callout := crosscheck.Report(crosscheck.Compare(&sections, feedback)); callout != "" {
+				fmt.Println(callout)
+			}
 		}
 	}
 
-	if sections.FAQs != "" {
-		fmt.Println("Analyzing FAQs...")
-		feedback, err := llm.AnalyzeSection("FAQs", sections.FAQs)
-		if err != nil {
-			logger.Warn("LLM analysis failed", "section", "FAQs", "error", err)
-			fmt.Fprintf(os.Stderr, "LLM error: %v\n", err)
-		} else {
-			fmt.Printf("== Feedback for FAQs ==\n%s\n\n", feedback.Comments)
+	for _, err := range llmErrs {
+		if !errors.Is(err, llm.ErrNoAPIKey) {
+			return errors.Join(llmErrs...)
+		}
+	}
+	return nil
+}
+
+// fileTickets creates or updates one tracker ticket per critical finding category.
+func fileTickets(cfg config.TicketsConfig, sections *parser.SpecSections) error {
+	improvements := parser.PriorityImprovements(sections.PRScore.QualityBreakdown)
+	if len(improvements) == 0 {
+		fmt.Println("No critical findings - no tickets filed")
+		return nil
+	}
+
+	tracker, err := tickets.New(cfg)
+	if err != nil {
+		return err
+	}
+
+	results, err := tickets.Sync(context.Background(), tracker, tickets.FromImprovements(sections.Title, improvements, cfg.Labels))
+	for _, result := range results {
+		action := "Updated"
+		if result.Created {
+			action = "Created"
 		}
+		logger.Info("ticket synced", "key", result.Key, "created", result.Created)
+		fmt.Printf("%s ticket %s: %s\n", action, result.Key, result.Summary)
+	}
+	return err
+}
+
+// exportResult publishes the report for the document in files to the
+// configured destination. The link goes to stderr so it cannot mix with
+// -format output on stdout.
+func exportResult(cfg config.ExportConfig, files []string, opts prfaq.Options) error {
+	dest, err := export.New(cfg)
+	if err != nil {
+		return err
+	}
+	result, err := batch.ScoreFiles(files, opts)
+	if err != nil {
+		return err
+	}
+	link, err := dest.Publish(context.Background(), *result)
+	if err != nil {
+		return err
+	}
+	logger.Info("report exported", "provider", cfg.Provider, "url", link)
+	fmt.Fprintf(os.Stderr, "Exported report: %s\n", link)
+	return nil
+}
+
+// writeBadge writes an SVG badge, or shields.io endpoint JSON when path ends in .json.
+func writeBadge(path string, score int) error {
+	var buf bytes.Buffer
+	render := report.Badge
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		render = report.BadgeEndpoint
+	}
+	if err := render(&buf, score); err != nil {
+		return err
 	}
+	return writeReportToFile(path, buf.String())
 }
 
 func writeReportToFile(filename, content string) error {
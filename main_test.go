@@ -1,14 +1,24 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/bordenet/pr-faq-validator/internal/config"
+	"github.com/bordenet/pr-faq-validator/internal/llm"
 	"github.com/bordenet/pr-faq-validator/internal/parser"
+	"github.com/bordenet/pr-faq-validator/internal/progress"
+	"github.com/bordenet/pr-faq-validator/pkg/prfaq"
 )
 
 func TestMain_NoArgs(t *testing.T) {
@@ -38,9 +48,10 @@ func TestMain_InvalidFile(t *testing.T) {
 	cmd.Env = append(os.Environ(), "TEST_MAIN_INVALID_FILE=1")
 	err := cmd.Run()
 
-	// Should exit with error for invalid file
-	if err == nil {
-		t.Error("Expected error for invalid file, got nil")
+	// Should exit with the input error code for an invalid file
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != exitInput {
+		t.Errorf("exit = %v, want code %d", err, exitInput)
 	}
 }
 
@@ -78,6 +89,8 @@ A: A test document.
 
 	// Run with -no-tui flag
 	cmd := exec.Command(binPath, "-file", tmpFile, "-no-tui") //nolint:gosec // test code
+	// Without an API key AI analysis is skipped
+	cmd.Env = append(os.Environ(), "OPENAI_API_KEY=", "ANTHROPIC_API_KEY=")
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
@@ -259,7 +272,7 @@ func TestRunLegacyOutput(t *testing.T) {
 	os.Stdout = w
 
 	// Run the function (this will also try to call LLM which will fail without API key)
-	runLegacyOutput(sections)
+	runLegacyOutput(sections, nil)
 
 	// Restore stdout
 	_ = w.Close()
@@ -278,6 +291,37 @@ func TestRunLegacyOutput(t *testing.T) {
 	}
 }
 
+func TestThrottleNotice(t *testing.T) {
+	oldStdout, oldStderr := os.Stdout, os.Stderr
+	stdoutR, stdoutW, _ := os.Pipe()
+	stderrR, stderrW, _ := os.Pipe()
+	os.Stdout, os.Stderr = stdoutW, stderrW
+
+	// With -no-tui, as with -format json, and in a directory run
+	throttleNotice(throttleOutput(true))(5 * time.Second)
+	var bar bytes.Buffer
+	batchProgress = progress.New(&bar, 1)
+	throttleNotice(throttleOutput(true))(5 * time.Second)
+	batchProgress = nil
+
+	_ = stdoutW.Close()
+	_ = stderrW.Close()
+	os.Stdout, os.Stderr = oldStdout, oldStderr
+	stdout, _ := io.ReadAll(stdoutR)
+	stderr, _ := io.ReadAll(stderrR)
+
+	if len(stdout) != 0 {
+		t.Errorf("stdout = %q, want it left to the report", stdout)
+	}
+	const want = "Rate limit reached: waiting 5s before the next AI request\n"
+	if string(stderr) != want {
+		t.Errorf("stderr = %q, want %q", stderr, want)
+	}
+	if bar.String() != want {
+		t.Errorf("progress = %q, want %q", bar.String(), want)
+	}
+}
+
 func TestRunLegacyOutputEmptySections(t *testing.T) {
 	// Create minimal sections
 	sections := parser.SpecSections{
@@ -293,7 +337,7 @@ func TestRunLegacyOutputEmptySections(t *testing.T) {
 	os.Stdout = w
 
 	// Run the function
-	runLegacyOutput(sections)
+	runLegacyOutput(sections, nil)
 
 	// Restore stdout
 	_ = w.Close()
@@ -308,3 +352,766 @@ func TestRunLegacyOutputEmptySections(t *testing.T) {
 		t.Error("Expected non-empty output")
 	}
 }
+
+func TestMain_FormatGCC(t *testing.T) {
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, "test.md")
+
+	content := `# Short
+
+## Press Release
+
+We made a thing.
+`
+
+	if err := os.WriteFile(tmpFile, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	binPath := filepath.Join(tmpDir, "pr-faq-validator")
+	buildCmd := exec.Command("go", "build", "-o", binPath) //nolint:gosec // test code
+	if err := buildCmd.Run(); err != nil {
+		t.Fatalf("Failed to build binary: %v", err)
+	}
+
+	cmd := exec.Command(binPath, "-file", tmpFile, "-format", "gcc") //nolint:gosec // test code
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("Command failed: %v\nOutput: %s", err, output)
+	}
+
+	want := tmpFile + ":1:1: warning: Headline too short (lacks specificity) [headline-too-short]"
+	if !strings.Contains(string(output), want) {
+		t.Errorf("Output missing %q\nOutput: %s", want, output)
+	}
+
+	// Unknown formats are rejected
+	cmd = exec.Command(binPath, "-file", tmpFile, "-format", "xml") //nolint:gosec // test code
+	if err := cmd.Run(); err == nil {
+		t.Error("Expected error for unknown format, got nil")
+	}
+}
+
+func TestMain_MultipleFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	prFile := filepath.Join(tmpDir, "pr.md")
+	faqFile := filepath.Join(tmpDir, "faq.md")
+	files := map[string]string{
+		prFile:  "# Acme Launches Ledger Sync\n\n## Press Release\n\nAcme today announced Ledger Sync, which is 3x faster.\n",
+		faqFile: "## FAQ\n\nQ: How fast is it?\nA: Fast.\n",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+	}
+
+	binPath := filepath.Join(tmpDir, "pr-faq-validator")
+	buildCmd := exec.Command("go", "build", "-o", binPath) //nolint:gosec // test code
+	if err := buildCmd.Run(); err != nil {
+		t.Fatalf("Failed to build binary: %v", err)
+	}
+
+	cmd := exec.Command(binPath, "-file", prFile, "-file", faqFile, "-format", "gcc") //nolint:gosec // test code
+	output, _ := cmd.Output()
+	want := prFile + ":5:1: "
+	if !strings.Contains(string(output), want) || !strings.Contains(string(output), "[claim-unsubstantiated]") {
+		t.Errorf("Output missing %q claim finding\nOutput: %s", want, output)
+	}
+
+	// The glossary cannot be added to a document kept in several files
+	cmd = exec.Command(binPath, "-file", prFile, "-file", faqFile, "-glossary", "document") //nolint:gosec // test code
+	if err := cmd.Run(); err == nil {
+		t.Error("Expected error for -glossary document with several files, got nil")
+	}
+}
+
+func TestMain_RequireApproval(t *testing.T) {
+	tmpDir := t.TempDir()
+	binPath := filepath.Join(tmpDir, "pr-faq-validator")
+	buildCmd := exec.Command("go", "build", "-o", binPath) //nolint:gosec // test code
+	if err := buildCmd.Run(); err != nil {
+		t.Fatalf("Failed to build binary: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		status string
+		args   []string
+		want   int
+	}{
+		{"draft below threshold", "draft", []string{"-min-score", "90"}, exitPass},
+		{"approved below threshold", "approved", []string{"-min-score", "90"}, exitBelowThreshold},
+		{"no threshold", "approved", nil, exitConfig},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := filepath.Join(t.TempDir(), "launch.md")
+			content := "---\nstatus: " + tt.status + "\n---\n# Short\n\n## Press Release\n\nWe made a thing.\n"
+			if err := os.WriteFile(doc, []byte(content), 0600); err != nil {
+				t.Fatalf("Failed to create test file: %v", err)
+			}
+			args := append([]string{"-file", doc, "-format", "json", "-require-approval"}, tt.args...)
+			err := exec.Command(binPath, args...).Run() //nolint:gosec // test code
+			code := 0
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				code = exitErr.ExitCode()
+			}
+			if code != tt.want {
+				t.Errorf("exit code = %d, want %d", code, tt.want)
+			}
+		})
+	}
+}
+
+func TestMain_Explain(t *testing.T) {
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, "test.md")
+
+	content := `# Acme Launches Ledger Sync
+
+## Press Release
+
+SEATTLE, WA - March 3, 2026 - Acme today announced Ledger Sync.
+`
+
+	if err := os.WriteFile(tmpFile, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	binPath := filepath.Join(tmpDir, "pr-faq-validator")
+	buildCmd := exec.Command("go", "build", "-o", binPath) //nolint:gosec // test code
+	if err := buildCmd.Run(); err != nil {
+		t.Fatalf("Failed to build binary: %v", err)
+	}
+
+	cmd := exec.Command(binPath, "-file", tmpFile, "-explain") //nolint:gosec // test code
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("Command failed: %v\nOutput: %s", err, output)
+	}
+	for _, want := range []string{"Headline Quality: ", "+2 strong action verb (launches)", "+5 release date in opening lines (March 3, 2026)"} {
+		if !strings.Contains(string(output), want) {
+			t.Errorf("Output missing %q\nOutput: %s", want, output)
+		}
+	}
+
+	cmd = exec.Command(binPath, "-file", tmpFile, "-explain", "-format", "json") //nolint:gosec // test code
+	output, err = cmd.Output()
+	if err != nil {
+		t.Fatalf("Command failed: %v\nOutput: %s", err, output)
+	}
+	if !strings.Contains(string(output), `"trace": [`) {
+		t.Errorf("JSON output missing trace\nOutput: %s", output)
+	}
+
+	// gcc has nowhere to put the trace
+	cmd = exec.Command(binPath, "-file", tmpFile, "-explain", "-format", "gcc") //nolint:gosec // test code
+	if err := cmd.Run(); err == nil {
+		t.Error("Expected error for -explain with -format gcc, got nil")
+	}
+}
+
+func TestMain_Badge(t *testing.T) {
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, "test.md")
+
+	content := `# Short
+
+## Press Release
+
+We made a thing.
+`
+
+	if err := os.WriteFile(tmpFile, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	binPath := filepath.Join(tmpDir, "pr-faq-validator")
+	buildCmd := exec.Command("go", "build", "-o", binPath) //nolint:gosec // test code
+	if err := buildCmd.Run(); err != nil {
+		t.Fatalf("Failed to build binary: %v", err)
+	}
+
+	tests := []struct {
+		file string
+		want string
+	}{
+		{file: "badge.svg", want: "<svg"},
+		{file: "badge.json", want: `"schemaVersion":1`},
+	}
+	for _, tt := range tests {
+		badgePath := filepath.Join(tmpDir, tt.file)
+		cmd := exec.Command(binPath, "-file", tmpFile, "-badge", badgePath) //nolint:gosec // test code
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("Command failed: %v\nOutput: %s", err, output)
+		}
+
+		data, err := os.ReadFile(badgePath) //nolint:gosec // test code
+		if err != nil {
+			t.Fatalf("Badge not written: %v", err)
+		}
+		if !strings.Contains(string(data), tt.want) {
+			t.Errorf("%s missing %q\n%s", tt.file, tt.want, data)
+		}
+	}
+}
+
+func TestLoadTemplate(t *testing.T) {
+	if tmpl, err := loadTemplate("", "", false); tmpl != nil || err != nil {
+		t.Errorf("loadTemplate(\"\") = %v, %v; want nil, nil", tmpl, err)
+	}
+
+	path := filepath.Join("pkg", "prfaq", "templates", "review.md.tmpl")
+	tests := []struct {
+		name       string
+		format     prfaq.Format
+		reportFile bool
+		wantErr    bool
+	}{
+		{name: "format markdown", format: prfaq.FormatMarkdown},
+		{name: "report file", reportFile: true},
+		{name: "format json", format: prfaq.FormatJSON, reportFile: true, wantErr: true},
+		{name: "TUI", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadTemplate(path, tt.format, tt.reportFile)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("loadTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if tmpl, err := loadTemplate("review", prfaq.FormatMarkdown, false); tmpl == nil || err != nil {
+		t.Errorf("loadTemplate(review) = %v, %v; want the built-in template", tmpl, err)
+	}
+	if _, err := loadTemplate("missing.tmpl", prfaq.FormatMarkdown, false); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("loadTemplate(missing) error = %v, want fs.ErrNotExist", err)
+	}
+}
+
+func TestWriteHeadlineTable(t *testing.T) {
+	ranked := parser.RankHeadlines("New Product", []string{"Acme Launches CloudSync, Cutting Backup Time by 80% for Small Teams"})
+
+	var buf bytes.Buffer
+	if err := writeHeadlineTable(&buf, ranked); err != nil {
+		t.Fatalf("writeHeadlineTable() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "RANK") {
+		t.Fatalf("table =\n%s\nwant a header and 2 rows", buf.String())
+	}
+	if !strings.HasPrefix(lines[1], "1     10/10  Acme Launches") || !strings.Contains(lines[1], "  -") {
+		t.Errorf("first row = %q, want the full-marks alternative with no issues", lines[1])
+	}
+	if !strings.Contains(lines[2], "New Product (current)") {
+		t.Errorf("second row = %q, want the current headline marked", lines[2])
+	}
+}
+
+func TestCheckBatch(t *testing.T) {
+	if err := checkBatch(prfaq.FormatCSV, "", false); err != nil {
+		t.Errorf("checkBatch(csv) error = %v", err)
+	}
+	if err := checkBatch("", "dashboard.md", false); err != nil {
+		t.Errorf("checkBatch() with a dashboard error = %v", err)
+	}
+	if err := checkBatch("", "", false); err == nil {
+		t.Error("checkBatch() without a format or dashboard should fail")
+	}
+	if err := checkBatch(prfaq.FormatJSON, "", true); err == nil {
+		t.Error("checkBatch() with single-file outputs should fail")
+	}
+}
+
+func TestMain_Directory(t *testing.T) {
+	tmpDir := t.TempDir()
+	binPath := filepath.Join(tmpDir, "pr-faq-validator")
+	buildCmd := exec.Command("go", "build", "-o", binPath) //nolint:gosec // test code
+	if err := buildCmd.Run(); err != nil {
+		t.Fatalf("Failed to build binary: %v", err)
+	}
+
+	checkpoint := filepath.Join(tmpDir, "checkpoint")
+	cmd := exec.Command(binPath, "-file", "testdata", "-format", "csv", "-checkpoint", checkpoint) //nolint:gosec // test code
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("Command failed: %v\nOutput: %s", err, output)
+	}
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if len(lines) != 5 {
+		t.Errorf("got %d lines, want header and 4 documents\n%s", len(lines), output)
+	}
+	if !strings.HasPrefix(lines[1], filepath.Join("testdata", "example_prfaq_1.md")+",") {
+		t.Errorf("first row = %q", lines[1])
+	}
+	if _, err := os.Stat(checkpoint); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("checkpoint should be removed after a complete run: %v", err)
+	}
+
+	// Resuming without a checkpoint scores everything
+	cmd = exec.Command(binPath, "-file", "testdata", "-format", "csv", "-checkpoint", checkpoint, "-resume") //nolint:gosec // test code
+	resumed, err := cmd.Output()
+	if err != nil || string(resumed) != string(output) {
+		t.Errorf("resumed run = %v\n%s\nwant\n%s", err, resumed, output)
+	}
+
+	dashboard := filepath.Join(tmpDir, "dashboard.html")
+	cmd = exec.Command(binPath, "-file", "testdata", "-dashboard", dashboard, "-checkpoint", checkpoint) //nolint:gosec // test code
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("Command failed: %v\nOutput: %s", err, output)
+	}
+	data, err := os.ReadFile(dashboard) //nolint:gosec // test code
+	if err != nil {
+		t.Fatalf("Dashboard not written: %v", err)
+	}
+	if !strings.Contains(string(data), "<h2>Worst Offenders</h2>") {
+		t.Errorf("dashboard is not the HTML report:\n%s", data)
+	}
+}
+
+func TestCheckOffline(t *testing.T) {
+	tests := []struct {
+		name                                            string
+		publish, suggest, semanticMatching, gloss, flow bool
+		wantErr                                         bool
+	}{
+		{"nothing online", false, false, false, false, false, false},
+		{"-tickets and -export", true, false, false, false, false, true},
+		{"-suggest-headlines", false, true, false, false, false, true},
+		{"-semantic", false, false, true, false, false, true},
+		{"-glossary", false, false, false, true, false, true},
+		{"-flow", false, false, false, false, true, true},
+	}
+	for _, tt := range tests {
+		if err := checkOffline(tt.publish, tt.suggest, tt.semanticMatching, tt.gloss, tt.flow); (err != nil) != tt.wantErr {
+			t.Errorf("checkOffline() with %s = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestSetFixtures(t *testing.T) {
+	defer llm.SetClient(nil)
+	dir := t.TempDir()
+	tests := []struct {
+		name           string
+		record, replay string
+		offline        bool
+		wantErr        bool
+	}{
+		{"neither", "", "", false, false},
+		{"record", filepath.Join(dir, "new"), "", false, false},
+		{"replay", "", dir, false, false},
+		{"both", dir, dir, false, true},
+		{"record offline", dir, "", true, true},
+		{"replay missing directory", "", filepath.Join(dir, "missing"), false, true},
+	}
+	for _, tt := range tests {
+		if err := setFixtures(tt.record, tt.replay, tt.offline); (err != nil) != tt.wantErr {
+			t.Errorf("setFixtures() with %s = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestTelemetryEndpoint(t *testing.T) {
+	const flagURL, cfgURL = "https://flag.example.com/t", "https://config.example.com/t"
+	tests := []struct {
+		name              string
+		flagValue, cfgURL string
+		disabled, offline bool
+		want              string
+		wantErr           error
+	}{
+		{"off by default", "", "", false, false, "", nil},
+		{"config", "", cfgURL, false, false, cfgURL, nil},
+		{"flag overrides config", flagURL, cfgURL, false, false, flagURL, nil},
+		{"-no-telemetry", flagURL, cfgURL, true, false, "", nil},
+		{"-offline turns off config", "", cfgURL, false, true, "", nil},
+		{"-offline rejects flag", flagURL, "", false, true, "", errUsage},
+		{"bad flag URL", "telemetry.example.com", "", false, false, "", errUsage},
+		{"bad config URL", "", "ftp://example.com", false, false, "", config.ErrInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Telemetry: config.TelemetryConfig{Endpoint: tt.cfgURL}}
+			got, err := telemetryEndpoint(tt.flagValue, tt.disabled, tt.offline, cfg)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("telemetryEndpoint() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("telemetryEndpoint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddGlossary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prfaq.md")
+	original := "# Ledger Sync\n\n## FAQ\n\nQ: Why?\nA: Speed.\n"
+	if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := addGlossary(path, "## Glossary\n\n- **SOC 2**: A security audit.\n"); err != nil {
+		t.Fatalf("addGlossary() error = %v", err)
+	}
+	data, _ := os.ReadFile(path) //nolint:gosec // test file in a temp dir
+	if want := original + "\n## Glossary\n\n- **SOC 2**: A security audit.\n"; string(data) != want {
+		t.Errorf("document = %q, want %q", data, want)
+	}
+	if backup, _ := os.ReadFile(path + ".bak"); string(backup) != original { //nolint:gosec // test file in a temp dir
+		t.Errorf("backup = %q, want %q", backup, original)
+	}
+
+	if got := withAppendix("# Report\n\n", "## Glossary\n"); got != "# Report\n\n## Glossary\n" {
+		t.Errorf("withAppendix() = %q", got)
+	}
+}
+
+func TestMain_Offline(t *testing.T) {
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, "test.md")
+
+	content := `# Test PR-FAQ
+
+## Press Release
+
+Company announces new product.
+
+## FAQ
+
+Q: Test?
+A: Yes.
+`
+
+	if err := os.WriteFile(tmpFile, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	binPath := filepath.Join(tmpDir, "pr-faq-validator")
+	buildCmd := exec.Command("go", "build", "-o", binPath) //nolint:gosec // test code
+	if err := buildCmd.Run(); err != nil {
+		t.Fatalf("Failed to build binary: %v", err)
+	}
+
+	cmd := exec.Command(binPath, "-file", tmpFile, "-no-tui", "-offline") //nolint:gosec // test code
+	cmd.Env = append(os.Environ(), "OPENAI_API_KEY=test-key-for-testing")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Command failed: %v\nOutput: %s", err, output)
+	}
+	if !strings.Contains(string(output), "Offline mode: AI analysis skipped") {
+		t.Errorf("Output missing offline notice\nOutput: %s", output)
+	}
+	if strings.Contains(string(output), "Analyzing") {
+		t.Errorf("Offline run should not start AI analysis\nOutput: %s", output)
+	}
+
+	// Network features fail loudly instead of being silently skipped
+	cmd = exec.Command(binPath, "-file", tmpFile, "-offline", "-tickets") //nolint:gosec // test code
+	if err := cmd.Run(); err == nil {
+		t.Error("Expected error for -offline with -tickets, got nil")
+	}
+}
+
+func TestMain_JSONLogs(t *testing.T) {
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, "test.md")
+	if err := os.WriteFile(tmpFile, []byte("# Test PR-FAQ\n\n## Press Release\n\nContent.\n"), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	binPath := filepath.Join(tmpDir, "pr-faq-validator")
+	buildCmd := exec.Command("go", "build", "-o", binPath) //nolint:gosec // test code
+	if err := buildCmd.Run(); err != nil {
+		t.Fatalf("Failed to build binary: %v", err)
+	}
+
+	var stderr strings.Builder
+	cmd := exec.Command(binPath, "-file", tmpFile, "-format", "gcc", "-v", "-log-format", "json") //nolint:gosec // test code
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("Command failed: %v\nStderr: %s", err, stderr.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(stderr.String()), "\n")
+	for _, line := range lines {
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Errorf("stderr line is not JSON: %q", line)
+		}
+	}
+	if !strings.Contains(stderr.String(), `"msg":"PR-FAQ scored"`) {
+		t.Errorf("missing info record at -v\nStderr: %s", stderr.String())
+	}
+
+	// Log file destination keeps stderr empty
+	logPath := filepath.Join(tmpDir, "run.log")
+	stderr.Reset()
+	cmd = exec.Command(binPath, "-file", tmpFile, "-format", "gcc", "-vv", "-log-file", logPath) //nolint:gosec // test code
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+	if stderr.Len() != 0 {
+		t.Errorf("stderr = %q, want empty with -log-file", stderr.String())
+	}
+	data, err := os.ReadFile(logPath) //nolint:gosec // test code with controlled paths
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	if !strings.Contains(string(data), "level=DEBUG") {
+		t.Errorf("log file missing debug records: %s", data)
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"read failure", fmt.Errorf("%w: open x: no such file", parser.ErrRead), exitInput},
+		{"no press release", parser.ErrNoPressRelease, exitInput},
+		{"review file", fmt.Errorf("%w: launch.review.yaml: unknown review status", parser.ErrReview), exitInput},
+		{"manifest", fmt.Errorf("%w: launch.yaml lists no files", parser.ErrManifest), exitInput},
+		{"include cycle", fmt.Errorf("%w: include cycle a.md -> b.md -> a.md", parser.ErrInclude), exitInput},
+		{"empty section", errors.Join(fmt.Errorf("%w: FAQs", parser.ErrSectionEmpty)), exitInput},
+		{"llm failure", fmt.Errorf("%w: exceeded retries", llm.ErrRequestFailed), exitLLM},
+		{"sensitive content", fmt.Errorf("%w: email address \"jane@acme.internal\"", llm.ErrSensitiveContent), exitLLM},
+		{"config failure", fmt.Errorf("%w: failed to parse x", config.ErrInvalid), exitConfig},
+		{"unknown provider", fmt.Errorf("%w \"claude\" in %s", llm.ErrUnknownProvider, llm.ProviderEnv), exitConfig},
+		{"usage", usage(errors.New("-dashboard needs a directory")), exitConfig},
+		{"other", errors.New("boom"), exitFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCode(tt.err); got != tt.want {
+				t.Errorf("exitCode(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMain_Summary(t *testing.T) {
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, "test.md")
+	if err := os.WriteFile(tmpFile, []byte("# Test PR-FAQ\n\n## Press Release\n\nContent.\n\n## FAQ\n\nQ: Why?\nA: Because.\n"), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	binPath := filepath.Join(tmpDir, "pr-faq-validator")
+	buildCmd := exec.Command("go", "build", "-o", binPath) //nolint:gosec // test code
+	if err := buildCmd.Run(); err != nil {
+		t.Fatalf("Failed to build binary: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStatus string
+	}{
+		{"no threshold", nil, exitPass, "pass"},
+		{"below threshold", []string{"-min-score", "90"}, exitBelowThreshold, "below-threshold"},
+		{"bad flag value", []string{"-min-score", "101"}, exitConfig, "config-error"},
+		{"audience", []string{"-audience", "developer"}, exitPass, "pass"},
+		{"unknown audience", []string{"-audience", "martians"}, exitConfig, "config-error"},
+		{"doc type", []string{"-doc-type", "internal"}, exitPass, "pass"},
+		{"unknown doc type", []string{"-doc-type", "memo"}, exitConfig, "config-error"},
+		{"rules version", []string{"-rules-version", "rules/v1"}, exitPass, "pass"},
+		{"unavailable rules version", []string{"-rules-version", "rules/v99"}, exitConfig, "config-error"},
+		{"blame outside a repository", []string{"-blame"}, exitPass, "pass"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summaryPath := filepath.Join(t.TempDir(), "run-summary.json")
+			args := append([]string{"-file", tmpFile, "-format", "gcc", "-summary", summaryPath}, tt.args...)
+			err := exec.Command(binPath, args...).Run() //nolint:gosec // test code
+
+			code := 0
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				code = exitErr.ExitCode()
+			}
+			if code != tt.wantCode {
+				t.Errorf("exit = %v, want code %d", err, tt.wantCode)
+			}
+
+			data, err := os.ReadFile(summaryPath) //nolint:gosec // test code with controlled paths
+			if err != nil {
+				t.Fatalf("Failed to read summary: %v", err)
+			}
+			var got struct {
+				Status   string `json:"status"`
+				ExitCode int    `json:"exit_code"`
+			}
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("summary is not JSON: %v\n%s", err, data)
+			}
+			if got.Status != tt.wantStatus || got.ExitCode != tt.wantCode {
+				t.Errorf("summary = %+v, want status %q and exit code %d", got, tt.wantStatus, tt.wantCode)
+			}
+		})
+	}
+
+	// Unknown flags are usage errors
+	err := exec.Command(binPath, "-no-such-flag").Run() //nolint:gosec // test code
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != exitConfig {
+		t.Errorf("exit = %v, want code %d for an unknown flag", err, exitConfig)
+	}
+}
+
+func TestMain_SectionsFAQOnly(t *testing.T) {
+	if os.Getenv("TEST_MAIN_FAQ_ONLY") != "" {
+		os.Args = []string{"cmd", "-file", os.Getenv("TEST_MAIN_FAQ_ONLY"), "-no-tui", "-offline", "-sections", "faq"}
+		main()
+		return
+	}
+
+	tmpFile := filepath.Join(t.TempDir(), "test.md")
+	if err := os.WriteFile(tmpFile, []byte("# Title\n\n## FAQ\n\nQ: Why?\nA: Because.\n"), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	// Without the press release selected, its absence is not an error
+	cmd := exec.Command(os.Args[0], "-test.run=TestMain_SectionsFAQOnly") //nolint:gosec // test code
+	cmd.Env = append(os.Environ(), "TEST_MAIN_FAQ_ONLY="+tmpFile)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("exit = %v, want success\nOutput: %s", err, output)
+	}
+}
+
+func TestMain_NoPressRelease(t *testing.T) {
+	if os.Getenv("TEST_MAIN_NO_PR") != "" {
+		os.Args = []string{"cmd", "-file", os.Getenv("TEST_MAIN_NO_PR"), "-no-tui"}
+		main()
+		return
+	}
+
+	tmpFile := filepath.Join(t.TempDir(), "test.md")
+	if err := os.WriteFile(tmpFile, []byte("# Title\n\n## FAQ\n\nQ: Why?\nA: Because.\n"), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestMain_NoPressRelease") //nolint:gosec // test code
+	cmd.Env = append(os.Environ(), "TEST_MAIN_NO_PR="+tmpFile)
+	err := cmd.Run()
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != exitInput {
+		t.Errorf("exit = %v, want code %d", err, exitInput)
+	}
+}
+
+func TestMain_UnknownProvider(t *testing.T) {
+	if os.Getenv("TEST_MAIN_UNKNOWN_PROVIDER") != "" {
+		os.Args = []string{"cmd", "-file", os.Getenv("TEST_MAIN_UNKNOWN_PROVIDER"), "-no-tui"}
+		main()
+		return
+	}
+
+	tmpFile := filepath.Join(t.TempDir(), "test.md")
+	if err := os.WriteFile(tmpFile, []byte("# Title\n\n## Press Release\n\nContent.\n"), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestMain_UnknownProvider") //nolint:gosec // test code
+	cmd.Env = append(os.Environ(), "TEST_MAIN_UNKNOWN_PROVIDER="+tmpFile, llm.ProviderEnv+"=claude", "ANTHROPIC_API_KEY=k")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	err := cmd.Run()
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != exitConfig {
+		t.Errorf("exit = %v, want code %d", err, exitConfig)
+	}
+	// Reported once, before any analysis
+	if n := strings.Count(stderr.String(), "unknown provider"); n != 1 {
+		t.Errorf("stderr reports the provider %d times, want once:\n%s", n, stderr.String())
+	}
+	if strings.Contains(stdout.String(), "Analyzing") {
+		t.Errorf("stdout = %q, want no analysis", stdout.String())
+	}
+}
+
+func TestMain_BlameWithoutGit(t *testing.T) {
+	if os.Getenv("TEST_MAIN_BLAME") != "" {
+		os.Args = []string{"cmd", "-file", os.Getenv("TEST_MAIN_BLAME"), "-format", "json", "-blame"}
+		main()
+		return
+	}
+
+	tmpFile := filepath.Join(t.TempDir(), "test.md")
+	if err := os.WriteFile(tmpFile, []byte("# Title\n\n## Press Release\n\nContent.\n"), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestMain_BlameWithoutGit") //nolint:gosec // test code
+	cmd.Env = append(os.Environ(), "TEST_MAIN_BLAME="+tmpFile, "PATH="+t.TempDir())
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	err := cmd.Run()
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != exitConfig {
+		t.Errorf("exit = %v, want code %d", err, exitConfig)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("stdout = %q, want no report", stdout.String())
+	}
+}
+
+func TestMain_Version(t *testing.T) {
+	binPath := filepath.Join(t.TempDir(), "pr-faq-validator")
+	pkg := "github.com/bordenet/pr-faq-validator/internal/buildinfo"
+	buildCmd := exec.Command("go", "build", "-ldflags", "-X "+pkg+".Version=v1.2.3 -X "+pkg+".Commit=abcdef0123", "-o", binPath) //nolint:gosec // test code
+	if err := buildCmd.Run(); err != nil {
+		t.Fatalf("Failed to build binary: %v", err)
+	}
+
+	out, err := exec.Command(binPath, "version", "-json").Output() //nolint:gosec // test code
+	if err != nil {
+		t.Fatalf("version failed: %v", err)
+	}
+	var info struct {
+		Version string `json:"version"`
+		Commit  string `json:"commit"`
+	}
+	if err := json.Unmarshal(out, &info); err != nil {
+		t.Fatalf("version -json is not JSON: %v\n%s", err, out)
+	}
+	if info.Version != "v1.2.3" || info.Commit != "abcdef0123" {
+		t.Errorf("version = %+v, want the ldflags values", info)
+	}
+}
+
+func TestMain_ConfigShow(t *testing.T) {
+	binPath := filepath.Join(t.TempDir(), "pr-faq-validator")
+	if err := exec.Command("go", "build", "-o", binPath).Run(); err != nil { //nolint:gosec // test code
+		t.Fatalf("Failed to build binary: %v", err)
+	}
+	dir := t.TempDir()
+	policy := filepath.Join(dir, "policy.yaml")
+	repo := filepath.Join(dir, "repo.yaml")
+	if err := os.WriteFile(policy, []byte("min_score: 70\ntone: technical\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(repo, []byte("tone: conversational\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(binPath, "config", "show", "-config", repo, "-policy", policy) //nolint:gosec // test code
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+dir)
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("config show failed: %v", err)
+	}
+	for _, line := range []string{"min_score: 70 # policy " + policy, "tone: conversational # " + repo, "audience: \"\" # default"} {
+		if !strings.Contains(string(out), line) {
+			t.Errorf("config show lacks %q:\n%s", line, out)
+		}
+	}
+}
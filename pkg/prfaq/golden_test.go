@@ -0,0 +1,82 @@
+package prfaq
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// update rewrites the golden files: go test ./pkg/prfaq -run Golden -update
+var update = flag.Bool("update", false, "rewrite the golden report files")
+
+// TestReport_Golden compares the deterministic markdown report of every
+// example document with its snapshot in testdata/golden. Rerun with -update
+// after a change to scoring or the report, and review the diff.
+func TestReport_Golden(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("..", "..", "testdata", "example_prfaq_*"))
+	if err != nil || len(paths) == 0 {
+		t.Fatalf("no example documents: %v", err)
+	}
+	for _, path := range paths {
+		name := filepath.Base(path)
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(path) //nolint:gosec // test fixture
+			if err != nil {
+				t.Fatal(err)
+			}
+			doc, err := ParseSource(path, data)
+			if err != nil {
+				t.Fatalf("ParseSource() error = %v", err)
+			}
+			result, err := Score(doc, Options{Deterministic: true})
+			if err != nil {
+				t.Fatalf("Score() error = %v", err)
+			}
+			got, err := Report(*result, FormatMarkdown)
+			if err != nil {
+				t.Fatalf("Report() error = %v", err)
+			}
+
+			golden := filepath.Join("testdata", "golden", strings.TrimSuffix(name, filepath.Ext(name))+".md")
+			if *update {
+				if err := os.MkdirAll(filepath.Dir(golden), 0o750); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(golden, got, 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden) //nolint:gosec // test fixture
+			if err != nil {
+				t.Fatalf("%v (run with -update to create it)", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("report differs from %s; rerun with -update and review the diff\ngot:\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestReport_DeterministicHeader(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	for _, deterministic := range []bool{false, true} {
+		result, err := Score(doc, Options{Deterministic: deterministic})
+		if err != nil {
+			t.Fatalf("Score() error = %v", err)
+		}
+		out, err := Report(*result, FormatMarkdown)
+		if err != nil {
+			t.Fatalf("Report() error = %v", err)
+		}
+		frozen := strings.Contains(string(out), "**Analysis Date:** January 1, 2000\n**Validator:** pr-faq-validator\n")
+		if frozen != deterministic {
+			t.Errorf("Deterministic = %v, but frozen header = %v:\n%s", deterministic, frozen, out)
+		}
+	}
+}
@@ -0,0 +1,673 @@
+// Package prfaq is the public API for embedding PR-FAQ validation in other Go
+// programs. It exposes the same deterministic scoring as the pr-faq-validator
+// CLI without shelling out to the binary:
+//
+//	doc, err := prfaq.Parse(r)
+//	result, err := prfaq.Score(doc, prfaq.Options{})
+//	out, err := prfaq.Report(*result, prfaq.FormatJSON)
+package prfaq
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/bordenet/pr-faq-validator/internal/blame"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// Errors reported by Score in strict mode, and by AddBlame. Test for them with errors.Is.
+var (
+	// ErrGit means git could not blame the document, for instance because it
+	// is not in a repository.
+	ErrGit = blame.ErrGit
+	// ErrRulesVersion means Options.RulesVersion is not available in this release.
+	ErrRulesVersion = parser.ErrRulesVersion
+	// ErrNoPressRelease means the document has no press release section.
+	ErrNoPressRelease = parser.ErrNoPressRelease
+	// ErrSectionEmpty means a press release or FAQ heading has no content.
+	ErrSectionEmpty = parser.ErrSectionEmpty
+	// ErrManifest means a manifest given to ParseFiles is malformed or lists
+	// no files.
+	ErrManifest = parser.ErrManifest
+	// ErrInclude means an include directive names a file that cannot be
+	// read, or includes form a cycle.
+	ErrInclude = parser.ErrInclude
+	// ErrWeights means Options.Weights names an unknown category or a weight
+	// outside 0-3.
+	ErrWeights = parser.ErrWeights
+	// ErrScoring means Options.ScoringMode is unknown, or Options.SeverityCosts
+	// names an unknown severity or a cost outside 0-100.
+	ErrScoring = parser.ErrScoring
+	// ErrCodenames means a pattern in Options.Codenames is not a regular
+	// expression.
+	ErrCodenames = parser.ErrCodenames
+)
+
+// Document is a parsed PR-FAQ. Section text may be edited before scoring;
+// source positions for findings always refer to the text as parsed.
+type Document struct {
+	// Name identifies the document in reports, typically its file path.
+	Name string
+	// Dir is the directory relative image paths resolve against, typically
+	// the one holding the file. Empty skips the check that image files exist.
+	Dir           string
+	Title         string
+	PressRelease  string
+	FAQs          string // every FAQ section, internal and external together
+	InternalFAQs  string // the FAQ for the team and its reviewers, e.g. "## Internal FAQ"
+	ExternalFAQs  string // the FAQ for customers, when the document separates the two
+	Metrics       string
+	OtherSections map[string]string
+	// Appendices are the sections headed "Appendix ..." or "Appendices".
+	// They are not scored.
+	Appendices []Appendix
+	// DocType is the doc_type from the front matter, "" when there is none.
+	DocType DocType
+	// Tone is the tone from the front matter, "" when there is none.
+	Tone Tone
+	// Review is the workflow status and sign-offs from the front matter and
+	// the review sidecar file (see ParseSource).
+	Review Review
+
+	sections *parser.SpecSections
+}
+
+// Parse reads a markdown PR-FAQ and splits it into sections.
+func Parse(r io.Reader) (*Document, error) {
+	sections, err := parser.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return newDocument(sections), nil
+}
+
+// ParseFiles reads a PR-FAQ kept in several files, such as a press release
+// and an FAQ, as one document: the files in order, or those listed in a YAML
+// manifest ("files: [pr.md, faq.md]"). Finding lines refer to the file they
+// are in, given as Finding.File. The Name is left for the caller to set.
+func ParseFiles(paths ...string) (*Document, error) {
+	sections, err := parser.ParseOutline(paths...) // Score scores it with the options given
+	if err != nil {
+		return nil, err
+	}
+	doc := newDocument(sections)
+	doc.Dir = sections.Dir
+	return doc, nil
+}
+
+// ParseSource parses data read from path, replacing each line such as
+// "{{include: faq.md}}" with the file it names, relative to path. Finding
+// lines in included text refer to the included file, given as Finding.File.
+// An include cycle returns ErrInclude. The Name is left for the caller to set.
+func ParseSource(path string, data []byte) (*Document, error) {
+	sections, err := parser.ParseSource(path, data)
+	if err != nil {
+		return nil, err
+	}
+	doc := newDocument(sections)
+	doc.Dir = sections.Dir
+	return doc, nil
+}
+
+// IsManifest reports whether path names a manifest for ParseFiles rather
+// than a document.
+func IsManifest(path string) bool {
+	return parser.IsManifest(path)
+}
+
+func newDocument(sections *parser.SpecSections) *Document {
+	return &Document{
+		Title:         sections.Title,
+		PressRelease:  sections.PressRelease,
+		FAQs:          sections.FAQs,
+		InternalFAQs:  sections.InternalFAQs,
+		ExternalFAQs:  sections.ExternalFAQs,
+		Metrics:       sections.Metrics,
+		OtherSections: sections.OtherSections,
+		Appendices:    sections.Appendices,
+		DocType:       sections.DocType,
+		Tone:          sections.Tone,
+		Review:        sections.Review,
+		sections:      sections,
+	}
+}
+
+// Appendix is a section after the PR-FAQ proper, such as supporting data.
+type Appendix = parser.Appendix
+
+// Review workflow types. A document's status is set by "status:" in its
+// front matter or in a sidecar file beside it, docs/launch.review.yaml for
+// docs/launch.md, which also lists sign-offs:
+//
+//	status: approved
+//	signoffs:
+//	  - reviewer: Dana Lee
+//	    role: VP Product
+//	    date: 2026-03-02
+type (
+	// Review is a document's workflow status and sign-offs.
+	Review = parser.Review
+	// ReviewState is draft, in-review, or approved.
+	ReviewState = parser.ReviewState
+	// Signoff is a reviewer's approval of a document.
+	Signoff = parser.Signoff
+)
+
+// Review states.
+const (
+	ReviewDraft    = parser.ReviewDraft
+	ReviewInReview = parser.ReviewInReview
+	ReviewApproved = parser.ReviewApproved
+)
+
+// Document tree types. They are aliases of the parser's own model, so the
+// tree returned by Document.Tree is the one the analyzers see.
+type (
+	// Tree is the heading outline of a document.
+	Tree = parser.DocumentTree
+	// Section is a heading with its paragraphs and nested subsections.
+	Section = parser.Section
+	// Paragraph is a run of consecutive non-blank lines.
+	Paragraph = parser.Paragraph
+	// Sentence is a sentence within a paragraph.
+	Sentence = parser.Sentence
+	// TextQuote is double-quoted text within a paragraph.
+	TextQuote = parser.Quote
+	// Position is a 1-based line and rune column.
+	Position = parser.Position
+	// LineSpan is an inclusive, 1-based range of source lines.
+	LineSpan = parser.LineSpan
+)
+
+// Tree returns the structure of the document as parsed, or nil for a
+// Document that was not produced by Parse.
+func (d *Document) Tree() *Tree {
+	if d.sections == nil {
+		return nil
+	}
+	return d.sections.Tree
+}
+
+// Dependencies lists the files besides path, the file the document was
+// parsed from, that its result depends on: the files it includes, its
+// review sidecar, and its local images, whether or not they exist. It is
+// nil for a Document that was not produced by Parse.
+func (d *Document) Dependencies(path string) []string {
+	if d.sections == nil {
+		return nil
+	}
+	return d.sections.Dependencies(path)
+}
+
+// Options controls scoring.
+type Options struct {
+	// Strict fails scoring with ErrNoPressRelease or ErrSectionEmpty instead
+	// of returning a zero or partial score for an incomplete document.
+	Strict bool
+	// Explain fills Result.Trace with every point each rule awarded or deducted.
+	Explain bool
+	// Audience selects the jargon list, sentence-length limits, and required
+	// FAQ questions. The zero value is AudienceGeneral.
+	Audience Audience
+	// DocType overrides Document.DocType when set.
+	DocType DocType
+	// Tone overrides Document.Tone when set.
+	Tone Tone
+	// DefaultTone is the tone of documents whose front matter sets none,
+	// e.g. from a config file. The zero value is ToneFormal.
+	DefaultTone Tone
+	// HedgeSeverity is the severity of hedging findings: "error",
+	// "warning", or "info". Empty keeps "warning".
+	HedgeSeverity string
+	// PublicCompany requires a safe-harbor statement for forward-looking
+	// statements such as "expects to" and "later this year".
+	PublicCompany bool
+	// Legal configures the screening for legally risky phrasing: extra
+	// phrases, registered marks that need ® on first use, and strict mode,
+	// which reports the findings as errors.
+	Legal LegalOptions
+	// BrandNames are the canonical spellings of product and company names.
+	// Other spellings and casings, such as "PRFAQ validator" for "PR-FAQ
+	// Validator", are reported, and rewritten in Result.Rewrites.
+	BrandNames []string
+	// Codenames are regular expressions matching internal codenames, such
+	// as `Project [A-Z]\w+`. A match in the press release or external FAQ
+	// is reported as leaked, along with cost, strategy, and staffing details.
+	Codenames []string
+	// Weights scale categories of the overall score, by category name as in
+	// Result.Categories, from 0 to 3. They override the document type's
+	// weights; unlisted categories keep them.
+	Weights map[string]float64
+	// ScoringMode is how the overall score is computed: "categories", the
+	// default, adds up the category points, and "severity" starts at 100 and
+	// takes off points for every finding, more for errors than warnings.
+	ScoringMode string
+	// SeverityCosts are the points a finding costs under the "severity"
+	// mode, by severity: "error", "warning", or "info". Unlisted severities
+	// cost 10, 3, and 1.
+	SeverityCosts map[string]int
+	// Deterministic makes the markdown report the same on every run of the
+	// same document, for snapshot tests: the analysis date is January 1,
+	// 2000, and the validator line leaves out the build.
+	Deterministic bool
+	// RulesVersion pins the scoring model, e.g. "rules/v1". Score uses the
+	// latest version this release provides within the pinned major version,
+	// and fails with ErrRulesVersion when there is none. Empty uses the
+	// current model.
+	RulesVersion string
+	// TopicMatcher, when set, decides which required FAQ questions the
+	// document asks by meaning instead of by keyword. See package semantic.
+	TopicMatcher TopicMatcher
+	// FlowChecker, when set, reports where the press release story breaks:
+	// a missing or misplaced stage, a gap, or a non sequitur. See package
+	// flow.
+	FlowChecker FlowChecker
+	// Corpus, when set, fills Result.Similar with the existing documents the
+	// scored one substantially duplicates.
+	Corpus Corpus
+}
+
+// Corpus finds the existing documents a document duplicates. Documents with
+// the scored document's Name are skipped.
+type Corpus = parser.Corpus
+
+// TopicMatcher matches FAQ questions to the questions an audience expects,
+// e.g. by comparing embeddings.
+type TopicMatcher = parser.TopicMatcher
+
+// FlowChecker judges whether press release paragraphs tell a coherent
+// story, e.g. by asking an AI model.
+type FlowChecker = parser.FlowChecker
+
+// FlowIssue is a break in the story a press release tells.
+type FlowIssue = parser.FlowIssue
+
+// LegalOptions configures the legal phrase screening.
+type LegalOptions = parser.LegalOptions
+
+// RulesVersion is the scoring model of this release, e.g. "rules/v1.0.0".
+// Its major version changes whenever the same document can score differently.
+var RulesVersion = parser.CurrentRules.String()
+
+// DocType is the kind of document: a public launch, an internal initiative,
+// or an engineering design. It selects required sections and score weights.
+type DocType = parser.DocType
+
+// Supported document types.
+const (
+	DocTypeExternal = parser.DocTypeExternal
+	DocTypeInternal = parser.DocTypeInternal
+	DocTypeDesign   = parser.DocTypeDesign
+)
+
+// ParseDocType parses a document type name such as "internal".
+func ParseDocType(s string) (DocType, error) {
+	return parser.ParseDocType(s)
+}
+
+// Tone is the register a document is written in: formal, conversational, or
+// technical. It selects what the Tone & Readability category rewards.
+type Tone = parser.Tone
+
+// Supported tones.
+const (
+	ToneFormal         = parser.ToneFormal
+	ToneConversational = parser.ToneConversational
+	ToneTechnical      = parser.ToneTechnical
+)
+
+// ParseTone parses a tone name such as "conversational".
+func ParseTone(s string) (Tone, error) {
+	return parser.ParseTone(s)
+}
+
+// Audience is the readership a document is scored for.
+type Audience = parser.Audience
+
+// Supported audiences.
+const (
+	AudienceGeneral    = parser.AudienceGeneral
+	AudienceConsumer   = parser.AudienceConsumer
+	AudienceEnterprise = parser.AudienceEnterprise
+	AudienceDeveloper  = parser.AudienceDeveloper
+	AudienceInternal   = parser.AudienceInternal
+)
+
+// ParseAudience parses an audience name such as "developer".
+func ParseAudience(s string) (Audience, error) {
+	return parser.ParseAudience(s)
+}
+
+// Result is the outcome of scoring a document.
+type Result struct {
+	Name       string     `json:"name,omitempty"`
+	Title      string     `json:"title"`
+	Score      int        `json:"score"`              // 0-100
+	Rules      string     `json:"rules_version"`      // scoring model, e.g. "rules/v2.0.0"
+	Audience   string     `json:"audience,omitempty"` // set when scored for a specific audience
+	DocType    string     `json:"doc_type,omitempty"` // set for documents other than external launches
+	Tone       string     `json:"tone,omitempty"`     // set for tones other than formal
+	Scoring    string     `json:"scoring,omitempty"`  // "severity" when the score is computed from the findings
+	Tables     int        `json:"tables"`             // tables anywhere in the document
+	Figures    int        `json:"figures"`            // images or numbered figure captions
+	Appendices []string   `json:"appendices"`         // appendix section names
+	Categories []Category `json:"categories"`
+	Strengths  []string   `json:"strengths"`
+	Findings   []Finding  `json:"findings"`
+	// Dismissed are the findings the document's dismissals sidecar marks as
+	// won't fix or acknowledged; they are not in Findings.
+	Dismissed []DismissedFinding `json:"dismissed,omitempty"`
+	Quotes    []Quote            `json:"quotes"`
+	// Rewrites suggests before/after text for flagged sentences that can be
+	// rewritten mechanically.
+	Rewrites []Rewrite `json:"rewrites,omitempty"`
+	// Similar is set only when Options.Corpus is and the document duplicates
+	// one in it.
+	Similar []SimilarDocument `json:"similar,omitempty"`
+	// Review is set when the document gives a review status or sign-offs.
+	Review *ReviewResult `json:"review,omitempty"`
+	// Trace is set only when Options.Explain is.
+	Trace []ScoreEvent `json:"trace,omitempty"`
+
+	sections *parser.SpecSections
+}
+
+// ReviewResult is a document's review workflow status and sign-offs.
+type ReviewResult struct {
+	Status   string          `json:"status,omitempty"` // draft, in-review, or approved
+	Signoffs []SignoffResult `json:"signoffs"`
+}
+
+// SignoffResult is a reviewer's approval of a document.
+type SignoffResult struct {
+	Reviewer string `json:"reviewer"`
+	Role     string `json:"role,omitempty"`
+	Date     string `json:"date,omitempty"`
+}
+
+// SimilarDocument is an existing document that a scored one substantially
+// duplicates.
+type SimilarDocument struct {
+	Name       string `json:"name"` // path of the existing document
+	Title      string `json:"title"`
+	Similarity int    `json:"similarity"` // percent of shared phrasing
+}
+
+// Category is one scored quality dimension.
+type Category struct {
+	Name  string `json:"name"`
+	Score int    `json:"score"`
+	Max   int    `json:"max"`
+}
+
+// Finding is a rule violation tied to a source location.
+type Finding struct {
+	RuleID   string `json:"rule"`
+	Category string `json:"category"`
+	Severity string `json:"severity"` // error, warning, or info
+	Message  string `json:"message"`
+	Line     int    `json:"line"`   // 1-based
+	Column   int    `json:"column"` // 1-based
+	// File is the file Line is in, for a document parsed from several files
+	// by ParseFiles; empty otherwise.
+	File string `json:"file,omitempty"`
+	// Blame is set by AddBlame when the finding's line is known.
+	Blame *Blame `json:"blame,omitempty"`
+}
+
+// DismissedFinding is a finding dismissed in the document's dismissals
+// sidecar file.
+type DismissedFinding struct {
+	Finding
+	Status string `json:"status"` // wont-fix or acknowledged
+	Reason string `json:"reason,omitempty"`
+}
+
+// Blame is the last change to the line a finding points at.
+type Blame struct {
+	Commit string    `json:"commit,omitempty"` // full hash; empty for a line not yet committed
+	Author string    `json:"author"`
+	Email  string    `json:"email,omitempty"`
+	Date   time.Time `json:"date"`
+}
+
+// String describes the change, e.g. "3f2a9c1 by Jane Doe on 2026-03-04".
+func (b Blame) String() string {
+	return blame.Line{Commit: b.Commit, Author: b.Author, Email: b.Email, Time: b.Date}.String()
+}
+
+// AddBlame sets Blame on each finding with a known line, from git blame of
+// the document at path. path must be the file the result was scored from;
+// findings with a File are blamed in that file instead.
+func AddBlame(result *Result, path string) error {
+	blamed := map[string]map[int]blame.Line{}
+	for i, f := range result.Findings {
+		file := path
+		if f.File != "" {
+			file = f.File
+		}
+		lines, ok := blamed[file]
+		if !ok {
+			var err error
+			if lines, err = blame.File(file); err != nil {
+				return err
+			}
+			blamed[file] = lines
+		}
+		if l, ok := lines[f.Line]; ok {
+			result.Findings[i].Blame = &Blame{Commit: l.Commit, Author: l.Author, Email: l.Email, Date: l.Time.UTC()}
+		}
+	}
+	return nil
+}
+
+// Quote is a customer quote found in the press release.
+type Quote struct {
+	Text    string   `json:"text"`
+	Metrics []string `json:"metrics"`
+	Score   int      `json:"score"`           // 0-10
+	Voice   string   `json:"voice,omitempty"` // customer, partner, executive, or analyst; empty when unattributed
+}
+
+// Rewrite is a suggested rewrite of a press release sentence that is too
+// long, passive, or fluffy.
+type Rewrite struct {
+	Line   int    `json:"line"`   // 1-based line the sentence starts on
+	Reason string `json:"reason"` // e.g. "passive voice, too long"
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// ScoreEvent is one point award or deduction made by a scoring rule.
+type ScoreEvent struct {
+	Category string `json:"category"`
+	Rule     string `json:"rule"`
+	Delta    int    `json:"delta"`            // negative for deductions
+	Detail   string `json:"detail,omitempty"` // matched text or measured value
+}
+
+// Score runs the deterministic analyzers over doc.
+func Score(doc *Document, opts Options) (*Result, error) {
+	if doc == nil {
+		return nil, fmt.Errorf("prfaq: nil document")
+	}
+
+	sections := &parser.SpecSections{}
+	if doc.sections != nil {
+		*sections = *doc.sections // keep source positions
+	}
+	sections.Title = doc.Title
+	sections.PressRelease = doc.PressRelease
+	sections.FAQs = doc.FAQs
+	sections.InternalFAQs = doc.InternalFAQs
+	sections.ExternalFAQs = doc.ExternalFAQs
+	sections.Metrics = doc.Metrics
+	sections.OtherSections = doc.OtherSections
+	sections.Audience = opts.Audience
+	sections.Dir = doc.Dir
+	sections.TopicMatcher = opts.TopicMatcher
+	sections.FlowChecker = opts.FlowChecker
+	sections.DocType = doc.DocType
+	if opts.DocType != "" {
+		sections.DocType = opts.DocType
+	}
+	sections.Tone = doc.Tone
+	if sections.Tone == "" {
+		sections.Tone = opts.DefaultTone
+	}
+	if opts.Tone != "" {
+		sections.Tone = opts.Tone
+	}
+
+	rules, err := parser.ResolveRules(opts.RulesVersion)
+	if err != nil {
+		return nil, err
+	}
+	sections.Rules = rules
+	sections.Review = doc.Review
+	sections.PublicCompany = opts.PublicCompany
+	sections.Legal = opts.Legal
+	sections.BrandNames = opts.BrandNames
+	if err := parser.CheckCodenames(opts.Codenames); err != nil {
+		return nil, fmt.Errorf("prfaq: %w", err)
+	}
+	sections.Codenames = opts.Codenames
+	sections.Deterministic = opts.Deterministic
+	if len(opts.Weights) > 0 {
+		if err := parser.CheckWeights(opts.Weights); err != nil {
+			return nil, fmt.Errorf("prfaq: %w", err)
+		}
+		sections.Weights = opts.Weights
+	}
+	if opts.HedgeSeverity != "" {
+		if sections.HedgeSeverity, err = parser.ParseSeverity(opts.HedgeSeverity); err != nil {
+			return nil, fmt.Errorf("prfaq: hedge severity: %w", err)
+		}
+	}
+	if sections.ScoringMode, err = parser.ParseScoringMode(opts.ScoringMode); err != nil {
+		return nil, fmt.Errorf("prfaq: %w", err)
+	}
+	if sections.SeverityCosts, err = parser.ParseSeverityCosts(opts.SeverityCosts); err != nil {
+		return nil, fmt.Errorf("prfaq: %w", err)
+	}
+	if opts.Strict {
+		if err := sections.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	sections.PRScore = parser.Score(sections)
+	if opts.Corpus != nil {
+		sections.Similar = opts.Corpus.Similar(doc.Name, sections)
+	}
+	result := newResult(doc.Name, sections)
+	if opts.Explain {
+		for _, e := range sections.PRScore.QualityBreakdown.Trace {
+			result.Trace = append(result.Trace, ScoreEvent(e))
+		}
+	}
+	return result, nil
+}
+
+func newResult(name string, sections *parser.SpecSections) *Result {
+	score := sections.PRScore
+	result := &Result{
+		Name:       name,
+		Title:      sections.Title,
+		Score:      score.OverallScore,
+		Rules:      sections.ScoringRules().String(),
+		Audience:   audienceName(sections.Audience),
+		DocType:    docTypeName(sections.DocType),
+		Tone:       toneName(sections.Tone),
+		Scoring:    scoringName(score),
+		Tables:     sections.Tables,
+		Figures:    sections.Figures,
+		Appendices: []string{},
+		Categories: []Category{},
+		Strengths:  append([]string{}, score.QualityBreakdown.Strengths...),
+		Findings:   []Finding{},
+		Quotes:     []Quote{},
+		sections:   sections,
+	}
+	for _, a := range sections.Appendices {
+		result.Appendices = append(result.Appendices, a.Name)
+	}
+	for _, c := range score.QualityBreakdown.Categories() {
+		result.Categories = append(result.Categories, Category{Name: c.Name, Score: c.Score, Max: c.Max})
+	}
+	for _, f := range sections.Findings() {
+		result.Findings = append(result.Findings, Finding{
+			RuleID:   f.RuleID,
+			Category: f.Category,
+			Severity: string(f.Severity),
+			Message:  f.Message,
+			Line:     f.Line,
+			Column:   f.Column,
+			File:     f.File,
+		})
+	}
+	for _, d := range sections.DismissedFindings() {
+		result.Dismissed = append(result.Dismissed, DismissedFinding{
+			Finding: Finding{
+				RuleID:   d.RuleID,
+				Category: d.Category,
+				Severity: string(d.Severity),
+				Message:  d.Message,
+				Line:     d.Line,
+				Column:   d.Column,
+				File:     d.File,
+			},
+			Status: string(d.Dismissal.Status),
+			Reason: d.Dismissal.Reason,
+		})
+	}
+	for _, r := range sections.Rewrites() {
+		result.Rewrites = append(result.Rewrites, Rewrite(r))
+	}
+	for _, d := range sections.Similar {
+		result.Similar = append(result.Similar, SimilarDocument(d))
+	}
+	if r := sections.Review; r.State != "" || len(r.Signoffs) > 0 {
+		result.Review = &ReviewResult{Status: string(r.State), Signoffs: []SignoffResult{}}
+		for _, s := range r.Signoffs {
+			result.Review.Signoffs = append(result.Review.Signoffs, SignoffResult(s))
+		}
+	}
+	for _, q := range score.MetricDetails {
+		result.Quotes = append(result.Quotes, Quote{Text: q.Quote, Metrics: q.Metrics, Score: q.Score, Voice: string(q.Voice)})
+	}
+	return result
+}
+
+// audienceName is the Result.Audience of a document scored for a, "" for the general audience.
+func audienceName(a parser.Audience) string {
+	if a == parser.AudienceGeneral {
+		return ""
+	}
+	return string(a)
+}
+
+// toneName is the Result.Tone of a document written in tone t, "" for formal ones.
+func toneName(t parser.Tone) string {
+	if t == parser.ToneFormal {
+		return ""
+	}
+	return string(t)
+}
+
+// scoringName is the Result.Scoring of score, "" when it adds up the categories.
+func scoringName(score *parser.PRScore) string {
+	if score.Severity == nil {
+		return ""
+	}
+	return string(parser.ScoringSeverity)
+}
+
+// docTypeName is the Result.DocType of a document of type t, "" for external launches.
+func docTypeName(t parser.DocType) string {
+	if t == parser.DocTypeExternal {
+		return ""
+	}
+	return string(t)
+}
@@ -0,0 +1,383 @@
+package prfaq
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+const testDoc = `# Acme Launches Ledger Sync, Cutting Month-End Close Time by 40%
+
+## Press Release
+
+**SEATTLE, WA - March 3, 2026** - Acme today announced Ledger Sync, which reduces month-end close time by 40% for finance teams.
+
+"We closed our books in 3 days instead of 5," said Jane Doe, Controller at Example Corp.
+
+## FAQ
+
+Q: Who is it for?
+A: Finance teams.
+`
+
+func TestParse(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !strings.HasPrefix(doc.Title, "Acme Launches") {
+		t.Errorf("Title = %q", doc.Title)
+	}
+	if !strings.Contains(doc.PressRelease, "Ledger Sync") {
+		t.Errorf("PressRelease = %q", doc.PressRelease)
+	}
+	if !strings.Contains(doc.FAQs, "Who is it for?") {
+		t.Errorf("FAQs = %q", doc.FAQs)
+	}
+}
+
+func TestScore(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	result, err := Score(doc, Options{})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	if result.Score <= 0 || result.Score > 100 {
+		t.Errorf("Score = %d, want 1-100", result.Score)
+	}
+
+	if len(result.Categories) == 0 {
+		t.Fatal("expected category scores")
+	}
+	for _, c := range result.Categories {
+		if c.Score < 0 || c.Score > c.Max {
+			t.Errorf("category %s = %d/%d out of range", c.Name, c.Score, c.Max)
+		}
+	}
+	if len(result.Quotes) != 1 {
+		t.Errorf("got %d quotes, want 1", len(result.Quotes))
+	}
+	for _, f := range result.Findings {
+		if f.RuleID == "" || f.Line == 0 {
+			t.Errorf("incomplete finding %+v", f)
+		}
+	}
+}
+
+func TestScore_EditedDocument(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	before, err := Score(doc, Options{})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+
+	doc.Title = "New"
+	after, err := Score(doc, Options{})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	if after.Title != "New" || after.Score >= before.Score {
+		t.Errorf("edited title scored %d (was %d), want lower", after.Score, before.Score)
+	}
+}
+
+func TestScore_Strict(t *testing.T) {
+	doc, err := Parse(strings.NewReader("# Title\n\n## FAQ\n\nQ: Why?\nA: Because.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	result, err := Score(doc, Options{})
+	if err != nil || result.Score != 0 {
+		t.Errorf("lenient Score() = %v, %v; want zero score", result, err)
+	}
+
+	if _, err := Score(doc, Options{Strict: true}); !errors.Is(err, ErrNoPressRelease) {
+		t.Errorf("strict Score() error = %v, want ErrNoPressRelease", err)
+	}
+	if _, err := Score(nil, Options{}); err == nil {
+		t.Error("Score(nil) should fail")
+	}
+}
+
+func TestScore_RulesVersion(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	result, err := Score(doc, Options{})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	if result.Rules != RulesVersion {
+		t.Errorf("Rules = %q, want %q", result.Rules, RulesVersion)
+	}
+	// A pin to the previous major version scores with its latest release
+	pinned, err := Score(doc, Options{RulesVersion: "rules/v1"})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	if pinned.Rules != "rules/v1.2.0" {
+		t.Errorf("Rules = %q, want rules/v1.2.0", pinned.Rules)
+	}
+	if _, err := Score(doc, Options{RulesVersion: "rules/v99"}); !errors.Is(err, ErrRulesVersion) {
+		t.Errorf("Score() pinned to rules/v99 error = %v, want ErrRulesVersion", err)
+	}
+}
+
+func TestScore_Tone(t *testing.T) {
+	doc, err := Parse(strings.NewReader("---\ntone: technical\n---\n" + testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if doc.Tone != ToneTechnical {
+		t.Fatalf("Tone = %q, want %q", doc.Tone, ToneTechnical)
+	}
+
+	tests := []struct {
+		name string
+		opts Options
+		want string
+	}{
+		{"front matter", Options{}, "technical"},
+		{"front matter beats the default", Options{DefaultTone: ToneConversational}, "technical"},
+		{"override", Options{Tone: ToneFormal, DefaultTone: ToneConversational}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Score(doc, tt.opts)
+			if err != nil {
+				t.Fatalf("Score() error = %v", err)
+			}
+			if result.Tone != tt.want {
+				t.Errorf("Result.Tone = %q, want %q", result.Tone, tt.want)
+			}
+		})
+	}
+
+	doc.Tone = ""
+	if result, _ := Score(doc, Options{DefaultTone: ToneConversational}); result.Tone != "conversational" {
+		t.Errorf("Result.Tone = %q without front matter, want the default", result.Tone)
+	}
+}
+
+func TestScore_HedgeSeverity(t *testing.T) {
+	doc, err := Parse(strings.NewReader(strings.Replace(testDoc, "Acme today announced", "We hope Acme's", 1)))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	result, err := Score(doc, Options{HedgeSeverity: "info"})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	i := slices.IndexFunc(result.Findings, func(f Finding) bool { return f.RuleID == "tone-hedging" })
+	if i < 0 || result.Findings[i].Severity != "info" {
+		t.Errorf("Findings = %+v, want tone-hedging as info", result.Findings)
+	}
+	if _, err := Score(doc, Options{HedgeSeverity: "critical"}); err == nil {
+		t.Error("Score() with an unknown hedge severity succeeded")
+	}
+}
+
+func TestScore_Legal(t *testing.T) {
+	doc, err := Parse(strings.NewReader(strings.Replace(testDoc, "Acme today announced", "Acme guarantees", 1)))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	result, err := Score(doc, Options{Legal: LegalOptions{Strict: true}})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	i := slices.IndexFunc(result.Findings, func(f Finding) bool { return f.RuleID == "legal-guarantee" })
+	if i < 0 || result.Findings[i].Severity != "error" {
+		t.Errorf("Findings = %+v, want legal-guarantee as error", result.Findings)
+	}
+}
+
+func TestScore_InternalAudienceCost(t *testing.T) {
+	doc, err := Parse(strings.NewReader("# Ledger Sync\n\n## Press Release\n\nAcme today launched Ledger Sync.\n\n## FAQ\n\n" +
+		"Q: What will it cost to build?\nA: We need 12 engineers for six months, and the cost to build is $2M.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	result, err := Score(doc, Options{Audience: AudienceInternal})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	// The cost answer the internal FAQ asks for is not a leak
+	for _, f := range result.Findings {
+		if strings.HasPrefix(f.RuleID, "leakage-") || f.RuleID == "faq-cost" {
+			t.Errorf("finding %s: %s, want none for the cost answer", f.RuleID, f.Message)
+		}
+	}
+}
+
+func TestScore_Weights(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	base, err := Score(doc, Options{})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	weighted, err := Score(doc, Options{Weights: map[string]float64{"Quote Quality": 0}})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	if weighted.Score == base.Score {
+		t.Errorf("Score with Quote Quality weighted 0 = %d, want it to differ from %d", weighted.Score, base.Score)
+	}
+	if _, err := Score(doc, Options{Weights: map[string]float64{"Grammar": 1}}); !errors.Is(err, ErrWeights) {
+		t.Errorf("Score() with an unknown category error = %v, want ErrWeights", err)
+	}
+}
+
+func TestScore_SeverityMode(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	result, err := Score(doc, Options{ScoringMode: "severity", SeverityCosts: map[string]int{"warning": 2}})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	want := 100
+	for _, f := range result.Findings {
+		want -= map[string]int{"error": 10, "warning": 2, "info": 1}[f.Severity]
+	}
+	if result.Score != max(want, 0) || result.Scoring != "severity" {
+		t.Errorf("Score = %d, Scoring = %q, want %d and severity", result.Score, result.Scoring, max(want, 0))
+	}
+
+	for name, opts := range map[string]Options{
+		"unknown mode":     {ScoringMode: "strict"},
+		"unknown severity": {SeverityCosts: map[string]int{"fatal": 20}},
+		"negative cost":    {SeverityCosts: map[string]int{"info": -1}},
+	} {
+		if _, err := Score(doc, opts); !errors.Is(err, ErrScoring) {
+			t.Errorf("%s: Score() error = %v, want ErrScoring", name, err)
+		}
+	}
+}
+
+func TestScore_Codenames(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if _, err := Score(doc, Options{Codenames: []string{"Project (Falcon"}}); !errors.Is(err, ErrCodenames) {
+		t.Errorf("Score() with a bad codename pattern error = %v, want ErrCodenames", err)
+	}
+}
+
+func TestScore_Explain(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	plain, err := Score(doc, Options{})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	if plain.Trace != nil {
+		t.Errorf("Trace = %v without Explain, want nil", plain.Trace)
+	}
+
+	result, err := Score(doc, Options{Explain: true})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	total := 0
+	for _, e := range result.Trace {
+		if e.Category == "" || e.Rule == "" || e.Delta == 0 {
+			t.Errorf("incomplete trace event %+v", e)
+		}
+		total += e.Delta
+	}
+	if total != result.Score {
+		t.Errorf("trace sums to %d, score is %d", total, result.Score)
+	}
+
+	out, err := Report(*result, FormatMarkdown)
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	if !strings.Contains(string(out), "## Score Explanation") {
+		t.Error("markdown report with a trace should include the explanation")
+	}
+}
+
+// fakeCorpus reports one similar document for every document but self.
+type fakeCorpus struct{ self string }
+
+func (c fakeCorpus) Similar(name string, _ *parser.SpecSections) []parser.SimilarDocument {
+	if name == c.self {
+		return nil
+	}
+	return []parser.SimilarDocument{{Name: "archive/ledger.md", Title: "Ledger Sync", Similarity: 64}}
+}
+
+func TestScore_Corpus(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	doc.Name = "drafts/ledger.md"
+	result, err := Score(doc, Options{Corpus: fakeCorpus{self: "archive/ledger.md"}})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	want := []SimilarDocument{{Name: "archive/ledger.md", Title: "Ledger Sync", Similarity: 64}}
+	if !slices.Equal(result.Similar, want) {
+		t.Errorf("Similar = %+v, want %+v", result.Similar, want)
+	}
+	out, err := Report(*result, FormatMarkdown)
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	if !strings.Contains(string(out), "- [Ledger Sync](archive/ledger.md): 64% similar") {
+		t.Errorf("markdown report does not link the similar document:\n%s", out)
+	}
+
+	doc.Name = "archive/ledger.md"
+	if result, _ = Score(doc, Options{Corpus: fakeCorpus{self: "archive/ledger.md"}}); result.Similar != nil {
+		t.Errorf("Similar = %+v for the corpus document itself", result.Similar)
+	}
+}
+
+func TestDocumentTree(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	tree := doc.Tree()
+	if tree == nil {
+		t.Fatal("Tree() = nil")
+	}
+	pr := tree.Find("Press Release")
+	if pr == nil || len(pr.Paragraphs) != 2 {
+		t.Fatalf("press release section = %+v", pr)
+	}
+	quotes := pr.Paragraphs[1].Quotes
+	if len(quotes) != 1 || quotes[0].Position != (Position{Line: 7, Column: 1}) {
+		t.Errorf("quotes = %+v", quotes)
+	}
+
+	if (&Document{}).Tree() != nil {
+		t.Error("hand-built Document should have no tree")
+	}
+}
@@ -0,0 +1,166 @@
+package prfaq
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	"github.com/bordenet/pr-faq-validator/internal/report"
+)
+
+// Format selects a report rendering.
+type Format string
+
+const (
+	// FormatMarkdown is the human-readable report written by the CLI's -report flag.
+	FormatMarkdown Format = "markdown"
+	// FormatJSON is the Result serialized as indented JSON.
+	FormatJSON Format = "json"
+	// FormatGCC is one "file:line:col: severity: message [rule-id]" line per finding.
+	FormatGCC Format = "gcc"
+	// FormatCSV is a header row and one row per document with category scores
+	// and finding counts by severity, for spreadsheets.
+	FormatCSV Format = "csv"
+	// FormatTSV is FormatCSV separated by tabs.
+	FormatTSV Format = "tsv"
+	// FormatJUnit is JUnit XML with one test case per rule, failing where the
+	// rule fired, for CI test report views.
+	FormatJUnit Format = "junit"
+	// FormatText is an emoji-free plain-text summary wrapped at 72 columns,
+	// for email and ticketing systems that mangle markdown.
+	FormatText Format = "text"
+)
+
+// Formats lists every supported format.
+var Formats = []Format{FormatMarkdown, FormatJSON, FormatGCC, FormatCSV, FormatTSV, FormatJUnit, FormatText}
+
+// ErrUnknownFormat is returned for formats not listed in Formats.
+var ErrUnknownFormat = errors.New("unknown report format")
+
+// errNotScored is returned for a markdown report of a Result not produced
+// by Score, such as one decoded from JSON.
+var errNotScored = errors.New("prfaq: result was not produced by Score")
+
+// ParseFormat validates a format name such as a command-line flag value.
+func ParseFormat(name string) (Format, error) {
+	for _, f := range Formats {
+		if string(f) == name {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownFormat, name)
+}
+
+// Report renders a scored result.
+func Report(result Result, format Format) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode result: %w", err)
+		}
+		return append(data, '\n'), nil
+	case FormatMarkdown:
+		if result.sections == nil {
+			return nil, errNotScored
+		}
+		out := parser.GenerateMarkdownReport(result.sections, result.sections.PRScore)
+		if result.Trace != nil {
+			out += "\n## Score Explanation\n\n```text\n" + parser.Explain(result.sections.PRScore) + "```\n"
+		}
+		return []byte(out), nil
+	case FormatGCC:
+		var buf bytes.Buffer
+		if err := report.GCC(&buf, displayName(result), parserFindings(result)); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case FormatText:
+		return writeText(result), nil
+	case FormatCSV, FormatTSV, FormatJUnit:
+		return ReportAll([]Result{result}, format)
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+}
+
+// ReportAll renders several results as one output, such as a batch run over
+// a directory. JSON is an array of results, CSV/TSV share one header row,
+// and JUnit has one test suite per document; the other formats concatenate
+// the per-document reports.
+func ReportAll(results []Result, format Format) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		if results == nil {
+			results = []Result{}
+		}
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode results: %w", err)
+		}
+		return append(data, '\n'), nil
+	case FormatCSV:
+		return writeTable(results, ',')
+	case FormatTSV:
+		return writeTable(results, '\t')
+	case FormatJUnit:
+		return writeJUnit(results)
+	}
+
+	var out []byte
+	for i, result := range results {
+		data, err := Report(result, format)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && (format == FormatMarkdown || format == FormatText) {
+			out = append(out, '\n')
+		}
+		out = append(out, data...)
+	}
+	return out, nil
+}
+
+func writeJUnit(results []Result) ([]byte, error) {
+	docs := make([]report.JUnitDocument, 0, len(results))
+	for _, result := range results {
+		docs = append(docs, report.JUnitDocument{Path: displayName(result), Findings: parserFindings(result)})
+	}
+	var buf bytes.Buffer
+	if err := report.JUnit(&buf, docs); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// parserFindings converts the result's findings for the report renderers,
+// naming the last change to the line after the message when blamed. It works
+// from the exported fields, so results decoded from JSON render too.
+func parserFindings(result Result) []parser.Finding {
+	findings := make([]parser.Finding, len(result.Findings))
+	for i, f := range result.Findings {
+		message := f.Message
+		if f.Blame != nil {
+			message += " (last changed " + f.Blame.String() + ")"
+		}
+		findings[i] = parser.Finding{
+			RuleID:   f.RuleID,
+			Category: f.Category,
+			Severity: parser.Severity(f.Severity),
+			Message:  message,
+			Line:     f.Line,
+			Column:   f.Column,
+			File:     f.File,
+		}
+	}
+	return findings
+}
+
+// displayName names a result in reports, "-" when it has no name.
+func displayName(result Result) string {
+	if result.Name == "" {
+		return "-"
+	}
+	return result.Name
+}
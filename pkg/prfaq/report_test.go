@@ -0,0 +1,201 @@
+package prfaq
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func scoredResult(t *testing.T) *Result {
+	t.Helper()
+	doc, err := Parse(strings.NewReader(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	doc.Name = "docs/prfaq.md"
+	result, err := Score(doc, Options{})
+	if err != nil {
+		t.Fatalf("Score() error = %v", err)
+	}
+	return result
+}
+
+func TestReport(t *testing.T) {
+	result := scoredResult(t)
+
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{FormatMarkdown, "# PR-FAQ Analysis Report"},
+		{FormatJSON, `"score":`},
+		{FormatGCC, "docs/prfaq.md:"},
+		{FormatCSV, "docs/prfaq.md,"},
+		{FormatJUnit, `<testsuite name="docs/prfaq.md"`},
+		{FormatText, "Score: "},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			out, err := Report(*result, tt.format)
+			if err != nil {
+				t.Fatalf("Report() error = %v", err)
+			}
+			if !strings.Contains(string(out), tt.want) {
+				t.Errorf("Report() = %q, want it to contain %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestReport_JSONRoundTrip(t *testing.T) {
+	result := scoredResult(t)
+	out, err := Report(*result, FormatJSON)
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+
+	var decoded Result
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if decoded.Score != result.Score || len(decoded.Findings) != len(result.Findings) {
+		t.Errorf("decoded = %+v, want %+v", decoded, *result)
+	}
+
+	// Decoded results still render every format built from their findings
+	for _, format := range []Format{FormatGCC, FormatJUnit} {
+		want, _ := Report(*result, format)
+		got, err := Report(decoded, format)
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("Report(decoded, %s) = %v\n%s\nwant\n%s", format, err, got, want)
+		}
+	}
+}
+
+func TestReportAll(t *testing.T) {
+	first := scoredResult(t)
+	second := *first
+	second.Name = "docs/other.md"
+	results := []Result{*first, second}
+
+	t.Run("csv", func(t *testing.T) {
+		out, err := ReportAll(results, FormatCSV)
+		if err != nil {
+			t.Fatalf("ReportAll() error = %v", err)
+		}
+		rows, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+		if err != nil {
+			t.Fatalf("invalid CSV: %v", err)
+		}
+		if len(rows) != 3 {
+			t.Fatalf("got %d rows, want header and 2 documents", len(rows))
+		}
+		if rows[0][0] != "file" || rows[0][3] != "Headline Quality" || rows[0][len(rows[0])-1] != "info" {
+			t.Errorf("header = %v", rows[0])
+		}
+		if rows[2][0] != "docs/other.md" || rows[2][2] != strconv.Itoa(first.Score) {
+			t.Errorf("row = %v", rows[2])
+		}
+	})
+
+	t.Run("tsv", func(t *testing.T) {
+		out, err := ReportAll(results, FormatTSV)
+		if err != nil {
+			t.Fatalf("ReportAll() error = %v", err)
+		}
+		if !strings.HasPrefix(string(out), "file\ttitle\tscore\t") {
+			t.Errorf("TSV header = %q", strings.SplitN(string(out), "\n", 2)[0])
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		out, err := ReportAll(results, FormatJSON)
+		if err != nil {
+			t.Fatalf("ReportAll() error = %v", err)
+		}
+		var decoded []Result
+		if err := json.Unmarshal(out, &decoded); err != nil || len(decoded) != 2 {
+			t.Errorf("decoded %d results, err = %v", len(decoded), err)
+		}
+	})
+
+	t.Run("gcc", func(t *testing.T) {
+		out, err := ReportAll(results, FormatGCC)
+		if err != nil {
+			t.Fatalf("ReportAll() error = %v", err)
+		}
+		if !strings.Contains(string(out), "docs/prfaq.md:") || !strings.Contains(string(out), "docs/other.md:") {
+			t.Errorf("gcc output missing a document:\n%s", out)
+		}
+	})
+}
+
+func TestReport_Errors(t *testing.T) {
+	if _, err := Report(*scoredResult(t), Format("xml")); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("Report(xml) error = %v, want ErrUnknownFormat", err)
+	}
+	if _, err := Report(Result{}, FormatMarkdown); err == nil {
+		t.Error("Report() of an unscored result should fail for markdown")
+	}
+	if _, err := Report(Result{}, FormatJSON); err != nil {
+		t.Errorf("Report() of a hand-built result should encode as JSON: %v", err)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, f := range Formats {
+		if got, err := ParseFormat(string(f)); err != nil || got != f {
+			t.Errorf("ParseFormat(%q) = %q, %v", f, got, err)
+		}
+	}
+	if _, err := ParseFormat("xml"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("ParseFormat(xml) error = %v", err)
+	}
+}
+
+func TestReport_Blame(t *testing.T) {
+	result := scoredResult(t)
+	result.Findings = []Finding{{RuleID: "five-ws-who", Severity: "warning", Message: "WHO missing", Line: 5, Column: 1,
+		Blame: &Blame{Commit: "3f2a9c1e00", Author: "Jane Doe", Date: time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)}}}
+
+	out, err := Report(*result, FormatGCC)
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	want := "docs/prfaq.md:5:1: warning: WHO missing (last changed 3f2a9c1 by Jane Doe on 2026-03-04) [five-ws-who]\n"
+	if string(out) != want {
+		t.Errorf("Report() = %q, want %q", out, want)
+	}
+}
+
+func TestReport_Text(t *testing.T) {
+	result := scoredResult(t)
+	result.Title = "🚀 Acme Launches Ledger Sync, a Service That Reconciles Every Transaction Overnight for Finance Teams"
+	result.Findings = append(result.Findings, Finding{RuleID: "five-ws-who", Severity: "warning", Message: strings.Repeat("word ", 40), Line: 3})
+	result.Tables, result.Appendices = 2, []string{"Appendix A"}
+
+	out, err := Report(*result, FormatText)
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	text := string(out)
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		if n := utf8.RuneCountInString(line); n > textWidth {
+			t.Errorf("line is %d columns, want at most %d: %q", n, textWidth, line)
+		}
+	}
+	if strings.Contains(text, "🚀") || strings.Contains(text, "|") || strings.Contains(text, "**") {
+		t.Errorf("Report() = %q, want no emoji or markdown", text)
+	}
+	for _, want := range []string{"PR-FAQ REVIEW: Acme Launches", "SCORES\n", "FINDINGS\n", "  - [warning] line 3: word", "Contents: 2 tables, 1 appendix\n"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Report() = %q, want it to contain %q", text, want)
+		}
+	}
+}
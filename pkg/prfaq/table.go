@@ -0,0 +1,62 @@
+package prfaq
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// severities are the finding counts in a table row, in column order.
+var severities = []string{string(parser.SeverityError), string(parser.SeverityWarning), string(parser.SeverityInfo)}
+
+// writeTable renders one row per result with the given field separator.
+// Category columns come from the breakdown, so every row lines up.
+func writeTable(results []Result, comma rune) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Comma = comma
+
+	header := []string{"file", "title", "score"}
+	for _, c := range parser.AllCategories() {
+		header = append(header, c.Name)
+	}
+	header = append(header, severities...)
+	if err := w.Write(header); err != nil {
+		return nil, fmt.Errorf("failed to write table: %w", err)
+	}
+
+	for _, result := range results {
+		if err := w.Write(tableRow(result)); err != nil {
+			return nil, fmt.Errorf("failed to write table: %w", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write table: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func tableRow(result Result) []string {
+	row := []string{result.Name, result.Title, strconv.Itoa(result.Score)}
+
+	scores := make(map[string]int, len(result.Categories))
+	for _, c := range result.Categories {
+		scores[c.Name] = c.Score
+	}
+	for _, c := range parser.AllCategories() {
+		row = append(row, strconv.Itoa(scores[c.Name]))
+	}
+
+	counts := make(map[string]int, len(severities))
+	for _, f := range result.Findings {
+		counts[f.Severity]++
+	}
+	for _, sev := range severities {
+		row = append(row, strconv.Itoa(counts[sev]))
+	}
+	return row
+}
@@ -0,0 +1,142 @@
+package prfaq
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/bordenet/pr-faq-validator/internal/buildinfo"
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+	"github.com/bordenet/pr-faq-validator/internal/report"
+)
+
+// Template is a user-supplied report layout. It executes with a Result as
+// its data, so {{.Score}}, {{range .Categories}}, {{range .Findings}}, and
+// every other Result field are available.
+type Template struct {
+	execute func(w io.Writer, data any) error
+}
+
+// TemplateFuncs are the functions available to report templates in addition
+// to the text/template builtins.
+var TemplateFuncs = map[string]any{
+	// status returns the report status band of an overall score, e.g. "Good".
+	"status": parser.StatusLabel,
+	// percent returns score as a whole-number percentage of max.
+	"percent": parser.Percent,
+	// radar returns an SVG radar chart of categories for HTML templates.
+	"radar": func(categories []Category) (htmltemplate.HTML, error) {
+		var buf bytes.Buffer
+		if err := report.Radar(&buf, categoryScores(categories)); err != nil {
+			return "", err
+		}
+		return htmltemplate.HTML(buf.String()), nil //nolint:gosec // generated SVG; category names are escaped
+	},
+	// heatmap returns the press release paragraphs of a result, shaded by
+	// the findings in each, for HTML templates. It takes the whole result, as
+	// in {{heatmap .}}, and is empty for one that was not scored from a
+	// document.
+	"heatmap": func(result Result) (htmltemplate.HTML, error) {
+		if result.sections == nil {
+			return "", nil
+		}
+		var buf bytes.Buffer
+		if err := report.Heatmap(&buf, result.sections.Heatmap()); err != nil {
+			return "", err
+		}
+		return htmltemplate.HTML(buf.String()), nil //nolint:gosec // generated HTML; document text is escaped
+	},
+	// radarMermaid returns a Mermaid radar chart of categories for markdown templates.
+	"radarMermaid": func(categories []Category) string {
+		return parser.RadarMermaid(categoryScores(categories))
+	},
+	// validator returns the version of the validator rendering the report.
+	"validator": func() string { return "pr-faq-validator " + buildinfo.Get().String() },
+	"join":      strings.Join,
+	"upper":     strings.ToUpper,
+	"lower":     strings.ToLower,
+}
+
+// categoryScores converts result categories back to the parser's form.
+func categoryScores(categories []Category) []parser.CategoryScore {
+	scores := make([]parser.CategoryScore, len(categories))
+	for i, c := range categories {
+		scores[i] = parser.CategoryScore{Name: c.Name, Score: c.Score, Max: c.Max}
+	}
+	return scores
+}
+
+// ParseTemplate parses a report template. Templates whose name ends in
+// .html or .htm use html/template, which escapes document text for HTML;
+// all others use text/template, for markdown and plain text.
+func ParseTemplate(name, text string) (*Template, error) {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".html", ".htm":
+		t, err := htmltemplate.New(filepath.Base(name)).Funcs(TemplateFuncs).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template %s: %w", name, err)
+		}
+		return &Template{execute: t.Execute}, nil
+	default:
+		t, err := template.New(filepath.Base(name)).Funcs(TemplateFuncs).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template %s: %w", name, err)
+		}
+		return &Template{execute: t.Execute}, nil
+	}
+}
+
+// builtinTemplates are report templates shipped in the binary, named by
+// their file name up to the first dot: templates/review.md.tmpl is "review".
+//
+//go:embed templates/*.tmpl
+var builtinTemplates embed.FS
+
+// ErrUnknownTemplate is returned by BuiltinTemplate for names not listed in BuiltinTemplates.
+var ErrUnknownTemplate = errors.New("unknown built-in template")
+
+// BuiltinTemplates lists the names of the built-in report templates.
+func BuiltinTemplates() []string {
+	entries, _ := fs.ReadDir(builtinTemplates, "templates")
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		name, _, _ := strings.Cut(e.Name(), ".")
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BuiltinTemplate parses the built-in report template called name.
+func BuiltinTemplate(name string) (*Template, error) {
+	entries, _ := fs.ReadDir(builtinTemplates, "templates")
+	for _, e := range entries {
+		if base, _, _ := strings.Cut(e.Name(), "."); base != name {
+			continue
+		}
+		text, err := fs.ReadFile(builtinTemplates, "templates/"+e.Name())
+		if err != nil {
+			return nil, err
+		}
+		// review.html.tmpl parses as HTML
+		return ParseTemplate(strings.TrimSuffix(e.Name(), ".tmpl"), string(text))
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownTemplate, name)
+}
+
+// Render executes the template for one result.
+func (t *Template) Render(result Result) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.execute(&buf, result); err != nil {
+		return nil, fmt.Errorf("failed to render template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,100 @@
+package prfaq
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTemplate_Render(t *testing.T) {
+	result := Result{
+		Title:      "Acme <Launch>",
+		Score:      72,
+		Categories: []Category{{Name: "Headline Quality", Score: 8, Max: 10}},
+		Findings:   []Finding{{RuleID: "five-ws-who", Severity: "warning", Message: "WHO missing", Line: 5}},
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "report.md.tmpl",
+			text: `{{.Title}}: {{.Score}} {{status .Score}}{{range .Categories}} {{.Name}} {{percent .Score .Max}}%{{end}}`,
+			want: "Acme <Launch>: 72 Good Headline Quality 80%",
+		},
+		{
+			name: "report.html",
+			text: `<h1>{{.Title}}</h1>{{range .Findings}}<li>{{upper .Severity}}: {{.Message}}</li>{{end}}`,
+			want: "<h1>Acme &lt;Launch&gt;</h1><li>WARNING: WHO missing</li>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseTemplate(tt.name, tt.text)
+			if err != nil {
+				t.Fatalf("ParseTemplate() error = %v", err)
+			}
+			out, err := tmpl.Render(result)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if string(out) != tt.want {
+				t.Errorf("Render() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplate_Errors(t *testing.T) {
+	if _, err := ParseTemplate("bad.tmpl", "{{.Score"); err == nil {
+		t.Error("ParseTemplate() should reject malformed templates")
+	}
+
+	tmpl, err := ParseTemplate("missing.tmpl", "{{.NoSuchField}}")
+	if err != nil {
+		t.Fatalf("ParseTemplate() error = %v", err)
+	}
+	if _, err := tmpl.Render(Result{}); err == nil {
+		t.Error("Render() should fail for unknown fields")
+	}
+}
+
+func TestBuiltinTemplate(t *testing.T) {
+	if names := BuiltinTemplates(); !slices.Equal(names, []string{"review", "review-html"}) {
+		t.Errorf("BuiltinTemplates() = %v, want [review review-html]", names)
+	}
+	if _, err := BuiltinTemplate("missing"); !errors.Is(err, ErrUnknownTemplate) {
+		t.Errorf("BuiltinTemplate(missing) error = %v, want ErrUnknownTemplate", err)
+	}
+
+	result := scoredResult(t)
+	result.Rewrites = []Rewrite{{Line: 5, Reason: "passive voice", Before: "It was built by <us>.", After: "<We> built it."}}
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"review", []string{"# Doc Review: Acme Launches", "| Headline Quality |", "```mermaid\nradar-beta\n", "- [ ] **", "```diff\n- It was built by <us>.\n+ <We> built it.\n```"}},
+		{"review-html", []string{"<h1>Doc Review: Acme Launches", "<td>Headline Quality</td>", `<svg xmlns="http://www.w3.org/2000/svg"`, `<div class="heatmap">`, `<div class="ins">+ &lt;We&gt; built it.</div>`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := BuiltinTemplate(tt.name)
+			if err != nil {
+				t.Fatalf("BuiltinTemplate() error = %v", err)
+			}
+			out, err := tmpl.Render(*result)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(out), want) {
+					t.Errorf("output missing %q\n%s", want, out)
+				}
+			}
+		})
+	}
+}
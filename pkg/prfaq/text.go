@@ -0,0 +1,130 @@
+package prfaq
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bordenet/pr-faq-validator/internal/parser"
+)
+
+// textWidth is the line length of FormatText, the traditional limit for
+// plain-text email.
+const textWidth = 72
+
+// writeText renders result as plain ASCII-friendly text wrapped at
+// textWidth columns, with no emoji and no markdown, for pasting into email
+// or tickets. It works from the exported fields, so results decoded from
+// JSON render too.
+func writeText(result Result) []byte {
+	var b strings.Builder
+	title := result.Title
+	if title == "" {
+		title = "(untitled)"
+	}
+	writeWrapped(&b, "", "", "PR-FAQ REVIEW: "+title)
+	if result.Name != "" {
+		writeWrapped(&b, "", "", "File: "+result.Name)
+	}
+	fmt.Fprintf(&b, "Score: %d/100 (%s)\n", result.Score, parser.StatusLabel(result.Score))
+	var profile []string
+	if result.Audience != "" {
+		profile = append(profile, "Audience: "+result.Audience)
+	}
+	if result.DocType != "" {
+		profile = append(profile, "Document type: "+result.DocType)
+	}
+	if result.Tone != "" {
+		profile = append(profile, "Tone: "+result.Tone)
+	}
+	if result.Rules != "" {
+		profile = append(profile, "Rules: "+result.Rules)
+	}
+	if len(profile) > 0 {
+		writeWrapped(&b, "", "", strings.Join(profile, ", "))
+	}
+	if contents := parser.ContentSummary(result.Tables, result.Figures, len(result.Appendices)); contents != "" {
+		writeWrapped(&b, "", "", "Contents: "+contents)
+	}
+
+	if len(result.Similar) > 0 {
+		b.WriteString("\nSIMILAR DOCUMENTS\n")
+		for _, d := range result.Similar {
+			writeWrapped(&b, "  - ", "    ", fmt.Sprintf("%s (%q), %d%% similar", d.Name, d.Title, d.Similarity))
+		}
+	}
+
+	b.WriteString("\nSCORES\n")
+	for _, c := range result.Categories {
+		label := c.Name + " "
+		score := fmt.Sprintf(" %d/%d", c.Score, c.Max)
+		fmt.Fprintf(&b, "  %s%s%s\n", label, strings.Repeat(".", max(2, 40-utf8.RuneCountInString(label)-len(score))), score)
+	}
+
+	if len(result.Strengths) > 0 {
+		b.WriteString("\nSTRENGTHS\n")
+		for _, s := range result.Strengths {
+			writeWrapped(&b, "  - ", "    ", s)
+		}
+	}
+
+	b.WriteString("\nFINDINGS\n")
+	if len(result.Findings) == 0 {
+		b.WriteString("  None.\n")
+	}
+	for _, f := range result.Findings {
+		where := ""
+		if f.Line > 0 {
+			where = fmt.Sprintf(" line %d:", f.Line)
+		}
+		text := fmt.Sprintf("[%s]%s %s (%s)", f.Severity, where, f.Message, f.RuleID)
+		if f.Blame != nil {
+			text += "; last changed " + f.Blame.String()
+		}
+		writeWrapped(&b, "  - ", "    ", text)
+	}
+
+	if len(result.Trace) > 0 {
+		b.WriteString("\nSCORE EXPLANATION\n")
+		for _, e := range result.Trace {
+			text := fmt.Sprintf("%s: %+d %s", e.Category, e.Delta, e.Rule)
+			if e.Detail != "" {
+				text += " (" + e.Detail + ")"
+			}
+			writeWrapped(&b, "  ", "    ", text)
+		}
+	}
+	return []byte(b.String())
+}
+
+// writeWrapped writes text word-wrapped at textWidth, starting with first and
+// indenting continuation lines with rest. Emoji are dropped. A word longer
+// than a line, such as a URL, is left whole.
+func writeWrapped(b *strings.Builder, first, rest, text string) {
+	line := first
+	empty := true
+	for _, word := range strings.Fields(plainText(text)) {
+		if !empty && utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) > textWidth {
+			b.WriteString(line + "\n")
+			line, empty = rest, true
+		}
+		if !empty {
+			line += " "
+		}
+		line += word
+		empty = false
+	}
+	b.WriteString(line + "\n")
+}
+
+// plainText removes emoji and other pictographic symbols, and the variation
+// selectors and joiners that accompany them.
+func plainText(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.So, r) || unicode.Is(unicode.Variation_Selector, r) || r == '\u200d' {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,10 @@
+// Package prompts embeds the default LLM prompt files, so a release binary
+// works without a copy of this directory next to it.
+package prompts
+
+import "embed"
+
+// FS holds every prompt file, at the same relative paths as on disk.
+//
+//go:embed analysis generation
+var FS embed.FS
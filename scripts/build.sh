@@ -46,7 +46,7 @@ log_success "Cleaned"
 
 # Get version info
 VERSION=$(git describe --tags --always --dirty 2>/dev/null || echo "dev")
-BUILD_TIME=$(date -u '+%Y-%m-%d_%H:%M:%S')
+BUILD_TIME=$(date -u '+%Y-%m-%dT%H:%M:%SZ')
 GIT_COMMIT=$(git rev-parse --short HEAD 2>/dev/null || echo "unknown")
 
 log_info "Version: $VERSION"
@@ -55,8 +55,9 @@ log_info "Build time: $BUILD_TIME"
 
 # Build binary
 log_step "Building binary..."
+BUILDINFO=github.com/bordenet/pr-faq-validator/internal/buildinfo
 go build \
-    -ldflags "-X main.Version=$VERSION -X main.BuildTime=$BUILD_TIME -X main.GitCommit=$GIT_COMMIT" \
+    -ldflags "-X $BUILDINFO.Version=$VERSION -X $BUILDINFO.Date=$BUILD_TIME -X $BUILDINFO.Commit=$GIT_COMMIT" \
     -o pr-faq-validator \
     .
 